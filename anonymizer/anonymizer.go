@@ -0,0 +1,276 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package anonymizer rewrites specs and concepts so that they can be shared without their business data. The words,
+// numbers, parameters and tags of the specs are replaced by placeholders, the same word always by the same
+// placeholder, so the structure of the specs, the steps they share and how they parse are kept.
+package anonymizer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+// Anonymizer replaces the text of specs and concepts by placeholders. Its zero value is not usable, use New.
+type Anonymizer struct {
+	words   map[string]string
+	numbers map[string]string
+	params  map[string]string
+	tags    map[string]string
+	visited map[interface{}]bool
+}
+
+// New gives an anonymizer with no placeholders given yet
+func New() *Anonymizer {
+	return &Anonymizer{
+		words:   make(map[string]string),
+		numbers: make(map[string]string),
+		params:  make(map[string]string),
+		tags:    make(map[string]string),
+		visited: make(map[interface{}]bool),
+	}
+}
+
+// Spec replaces the headings, comments, tags, steps and tables of the spec
+func (a *Anonymizer) Spec(spec *gauge.Specification) {
+	if spec.Heading != nil {
+		spec.Heading.Value = a.text(spec.Heading.Value)
+	}
+	a.items(spec.AllItems())
+	a.tagsOf(spec.Tags)
+	a.table(spec.DataTable.Table)
+	for _, scn := range spec.Scenarios {
+		if scn.Heading != nil && !a.seen(scn.Heading) {
+			scn.Heading.Value = a.text(scn.Heading.Value)
+		}
+		a.tagsOf(scn.Tags)
+	}
+}
+
+// Concept replaces the heading, comments, tags and steps of the concept
+func (a *Anonymizer) Concept(concept *gauge.Concept) {
+	for _, comment := range concept.ConceptStep.PreComments {
+		a.comment(comment)
+	}
+	a.step(concept.ConceptStep)
+	a.tagsOf(concept.ConceptStep.Tags)
+	a.items(concept.ConceptStep.Items)
+}
+
+func (a *Anonymizer) items(items []gauge.Item) {
+	for _, item := range items {
+		switch item.Kind() {
+		case gauge.ScenarioKind:
+			scn := item.(*gauge.Scenario)
+			if scn.Heading != nil && !a.seen(scn.Heading) {
+				scn.Heading.Value = a.text(scn.Heading.Value)
+			}
+		case gauge.StepKind:
+			a.step(item.(*gauge.Step))
+		case gauge.CommentKind:
+			a.comment(item.(*gauge.Comment))
+		case gauge.TagKind:
+			a.tagsOf(item.(*gauge.Tags))
+		case gauge.TableKind:
+			a.table(item.(*gauge.Table))
+		case gauge.DataTableKind:
+			a.table(item.(*gauge.DataTable).Table)
+		}
+	}
+}
+
+func (a *Anonymizer) seen(v interface{}) bool {
+	if a.visited[v] {
+		return true
+	}
+	a.visited[v] = true
+	return false
+}
+
+func (a *Anonymizer) comment(comment *gauge.Comment) {
+	if a.seen(comment) {
+		return
+	}
+	comment.Value = a.text(comment.Value)
+}
+
+// step replaces the text of the step around its parameters and the values of its args. Special parameters are kept,
+// as they refer to files the spec needs to parse.
+func (a *Anonymizer) step(step *gauge.Step) {
+	if a.seen(step) {
+		return
+	}
+	parts := strings.Split(step.Value, gauge.ParameterPlaceholder)
+	for i, part := range parts {
+		parts[i] = a.text(part)
+	}
+	step.Value = strings.Join(parts, gauge.ParameterPlaceholder)
+	step.LineText = ""
+	step.Fragments = nil
+	for _, arg := range step.Args {
+		switch arg.ArgType {
+		case gauge.Static, gauge.MultilineText:
+			arg.Value = a.text(arg.Value)
+		case gauge.Dynamic:
+			arg.Value = a.param(arg.Value)
+			if arg.Name != "" {
+				arg.Name = a.param(arg.Name)
+			}
+		case gauge.TableArg:
+			a.table(&arg.Table)
+		}
+	}
+	a.tagsOf(step.StepTags)
+	for _, s := range step.ConceptSteps {
+		a.step(s)
+	}
+}
+
+func (a *Anonymizer) tagsOf(tags *gauge.Tags) {
+	if tags == nil || a.seen(tags) {
+		return
+	}
+	for _, line := range tags.RawValues {
+		for i, tag := range line {
+			line[i] = a.tag(tag)
+		}
+	}
+}
+
+// table replaces the headers of the table as parameters, since dynamic parameters refer to them, and its cells
+func (a *Anonymizer) table(table *gauge.Table) {
+	if !table.IsInitialized() || a.seen(table) {
+		return
+	}
+	headers := make([]string, len(table.Headers))
+	for i, header := range table.Headers {
+		headers[i] = a.param(header)
+	}
+	t := &gauge.Table{LineNo: table.LineNo}
+	t.AddHeaders(headers)
+	for row := 0; row < table.GetRowCount(); row++ {
+		cells, _ := table.Row(row)
+		for i, cell := range cells {
+			switch cell.CellType {
+			case gauge.Dynamic:
+				cells[i].Value = a.param(cell.Value)
+			case gauge.Static:
+				cells[i].Value = a.text(cell.Value)
+			}
+		}
+		t.AddRowValues(cells)
+	}
+	*table = *t
+}
+
+// text replaces each run of letters and digits of the text, keeping the spaces and punctuation between them
+func (a *Anonymizer) text(text string) string {
+	var b strings.Builder
+	runes := []rune(text)
+	for i := 0; i < len(runes); {
+		if !isWordRune(runes[i]) {
+			b.WriteRune(runes[i])
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && isWordRune(runes[j]) {
+			j++
+		}
+		b.WriteString(a.word(string(runes[i:j])))
+		i = j
+	}
+	return b.String()
+}
+
+// word gives the placeholder of a word. A number is replaced by a number, so that it still converts as one.
+func (a *Anonymizer) word(word string) string {
+	if strings.IndexFunc(word, func(r rune) bool { return !unicode.IsDigit(r) }) == -1 {
+		return placeholder(a.numbers, word, "")
+	}
+	return placeholder(a.words, word, "word")
+}
+
+func (a *Anonymizer) param(name string) string {
+	return placeholder(a.params, name, "param")
+}
+
+func (a *Anonymizer) tag(tag string) string {
+	return placeholder(a.tags, tag, "tag")
+}
+
+func placeholder(m map[string]string, value, prefix string) string {
+	if p, ok := m[value]; ok {
+		return p
+	}
+	p := fmt.Sprintf("%s%d", prefix, len(m)+1)
+	m[value] = p
+	return p
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// AnonymizeProject writes the anonymized specs of the given dirs and the anonymized concepts of the project to the
+// out dir, at their paths relative to the project root. The files are anonymized in order of their paths, so the
+// same project always gives the same placeholders. Specs or concepts which fail to parse are not written.
+func AnonymizeProject(specDirs []string, out string) ([]*parser.ParseResult, error) {
+	a := New()
+	files := make(map[string]string)
+	specs, results := parser.ParseSpecFiles(util.GetSpecFiles(specDirs), &gauge.ConceptDictionary{}, gauge.NewBuildErrors())
+	failed := make(map[string]bool)
+	for _, res := range results {
+		if !res.Ok {
+			failed[res.FileName] = true
+		}
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].FileName < specs[j].FileName })
+	for _, spec := range specs {
+		if failed[spec.FileName] {
+			continue
+		}
+		a.Spec(spec)
+		files[spec.FileName] = formatter.FormatSpecification(spec)
+	}
+	dict := gauge.NewConceptDictionary()
+	_, errs, err := parser.AddConcepts(util.GetConceptFiles(), dict)
+	if err != nil {
+		return results, err
+	}
+	if len(errs) > 0 {
+		results = append(results, &parser.ParseResult{ParseErrors: errs, Ok: false})
+	} else {
+		for _, concept := range dict.Query(gauge.ConceptQuery{}) {
+			a.Concept(concept)
+		}
+		for file, text := range formatter.FormatConcepts(dict) {
+			files[file] = text
+		}
+	}
+	for file, text := range files {
+		path := filepath.Join(out, util.RelPathToProjectRoot(file))
+		if err := os.MkdirAll(filepath.Dir(path), common.NewDirectoryPermissions); err != nil {
+			return results, err
+		}
+		if err := common.SaveFile(path, text, false); err != nil {
+			return results, err
+		}
+		logger.Debugf(true, "Anonymized %s to %s", util.RelPathToProjectRoot(file), path)
+	}
+	return results, nil
+}
@@ -0,0 +1,91 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package anonymizer
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+func (s *MySuite) TestSpecReplacesTextKeepingStructure(c *C) {
+	text := `# Checkout cart
+
+tags: cart, smoke
+
+   |id|name |
+   |--|-----|
+   |1 |apple|
+
+Some prose, with punctuation.
+
+## Pay by card
+
+* Pay "10 EUR" with <name>
+* Pay "10 EUR" with <name>
+* Check the items
+
+   |sku |qty |
+   |----|----|
+   |A1  |2   |
+
+___
+* Close the cart
+`
+	spec, res, err := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary(), "checkout.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+
+	New().Spec(spec)
+
+	c.Assert(formatter.FormatSpecification(spec), Equals, `# word1 word2
+
+tags: tag1, tag2
+
+   |param1|param2|
+   |------|------|
+   |1     |word3 |
+
+word4 word5, word6 word7.
+
+## word8 word9 word10
+
+* word8 "2 word11" word6 <param2>
+* word8 "2 word11" word6 <param2>
+* word12 word13 word14
+
+   |param3|param4|
+   |------|------|
+   |word15|3     |
+
+___
+* word16 word13 word2
+`)
+}
+
+func (s *MySuite) TestConceptAndItsUsagesGetTheSamePlaceholders(c *C) {
+	a := New()
+	spec, _, _ := new(parser.SpecParser).Parse("# Spec\n## Scenario\n* Login as \"admin\"\n", gauge.NewConceptDictionary(), "login.spec")
+	concepts, _ := new(parser.ConceptParser).Parse("# Login as <user>\n* Enter <user>\n", "login.cpt")
+
+	a.Spec(spec)
+	a.Concept(&gauge.Concept{ConceptStep: concepts[0], FileName: "login.cpt"})
+
+	c.Assert(spec.Scenarios[0].Steps[0].Value, Equals, "word3 word4 {}")
+	c.Assert(concepts[0].Value, Equals, "word3 word4 {}")
+	c.Assert(concepts[0].ConceptSteps[0].Value, Equals, "word6 {}")
+	c.Assert(concepts[0].ConceptSteps[0].Args[0].Value, Equals, concepts[0].Args[0].Value)
+}
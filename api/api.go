@@ -7,6 +7,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -18,6 +19,7 @@ import (
 	"github.com/getgauge/gauge/conn"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/metrics"
 	"github.com/getgauge/gauge/runner"
 	"github.com/getgauge/gauge/util"
 )
@@ -31,19 +33,19 @@ type StartChannels struct {
 	KillChan chan bool
 }
 
-// StartAPI calls StartAPIService and returns the channels
-func StartAPI(debug bool) *StartChannels {
+// StartAPI calls StartAPIService and returns the channels. The runner is killed when the context is done.
+func StartAPI(ctx context.Context, debug bool) *StartChannels {
 	startChan := &StartChannels{RunnerChan: make(chan runner.Runner), ErrorChan: make(chan error), KillChan: make(chan bool)}
 	sig := &infoGatherer.SpecInfoGatherer{}
-	go startAPIService(0, startChan, sig, debug)
+	go startAPIService(ctx, 0, startChan, sig, debug)
 	return startChan
 }
 
 // StartAPIService starts the Gauge API service
-func startAPIService(port int, startChannels *StartChannels, sig *infoGatherer.SpecInfoGatherer, debug bool) {
+func startAPIService(ctx context.Context, port int, startChannels *StartChannels, sig *infoGatherer.SpecInfoGatherer, debug bool) {
 	startAPIServiceWithoutRunner(port, startChannels, sig)
 
-	runner, err := ConnectToRunner(startChannels.KillChan, debug)
+	runner, err := ConnectToRunner(ctx, startChannels.KillChan, debug)
 	if err != nil {
 		startChannels.ErrorChan <- err
 		return
@@ -67,15 +69,17 @@ func startAPIServiceWithoutRunner(port int, startChannels *StartChannels, sig *i
 	go gaugeConnectionHandler.HandleMultipleConnections()
 }
 
-func ConnectToRunner(killChannel chan bool, debug bool) (runner.Runner, error) {
+// ConnectToRunner starts the runner of the project, which is killed when the context is done
+func ConnectToRunner(ctx context.Context, killChannel chan bool, debug bool) (runner.Runner, error) {
 	manifest, err := manifest.ProjectManifest()
 	if err != nil {
 		return nil, err
 	}
-	runner, connErr := runner.Start(manifest, 0, killChannel, debug)
+	runner, connErr := runner.Start(ctx, manifest, 0, killChannel, debug)
 	if connErr != nil {
 		return nil, connErr
 	}
+	metrics.RunnerStarts.Inc()
 
 	return runner, nil
 }
@@ -30,17 +30,24 @@ import (
 type gaugeAPIMessageHandler struct {
 	specInfoGatherer *infoGatherer.SpecInfoGatherer
 	Runner           runner.Runner
+	sessions         sessions
+}
+
+// ConnectionClosed ends the session of the client of the connection
+func (handler *gaugeAPIMessageHandler) ConnectionClosed(connection net.Conn) {
+	handler.sessions.close(connection)
 }
 
 func (handler *gaugeAPIMessageHandler) MessageBytesReceived(bytesRead []byte, connection net.Conn) {
 	apiMessage := &gauge_messages.APIMessage{}
 	var responseMessage *gauge_messages.APIMessage
+	sess := handler.sessions.of(connection)
 	err := proto.Unmarshal(bytesRead, apiMessage)
 	if err != nil {
-		logger.Errorf(false, "Failed to read API proto message: %s\n", err.Error())
+		sess.errorf("Failed to read API proto message: %s", err.Error())
 		responseMessage = handler.getErrorMessage(err)
 	} else {
-		logger.Debugf(false, "Api Request Received: %s", apiMessage)
+		sess.debugf("Api Request Received: %s", apiMessage)
 		messageType := apiMessage.GetMessageType()
 		switch messageType {
 		case gauge_messages.APIMessage_GetProjectRootRequest:
@@ -58,7 +65,7 @@ func (handler *gaugeAPIMessageHandler) MessageBytesReceived(bytesRead []byte, co
 		case gauge_messages.APIMessage_GetAllConceptsRequest:
 			responseMessage = handler.getAllConceptsRequestResponse(apiMessage)
 		case gauge_messages.APIMessage_PerformRefactoringRequest:
-			responseMessage = handler.performRefactoring(apiMessage)
+			responseMessage = handler.performRefactoring(apiMessage, sess)
 			handler.performRefresh(responseMessage.PerformRefactoringResponse.FilesChanged)
 		case gauge_messages.APIMessage_ExtractConceptRequest:
 			responseMessage = handler.extractConcept(apiMessage)
@@ -180,28 +187,30 @@ func (handler *gaugeAPIMessageHandler) createGetAllConceptsResponseMessageFor(co
 	return &gauge_messages.GetAllConceptsResponse{Concepts: conceptInfos}
 }
 
-func (handler *gaugeAPIMessageHandler) performRefactoring(message *gauge_messages.APIMessage) *gauge_messages.APIMessage {
+func (handler *gaugeAPIMessageHandler) performRefactoring(message *gauge_messages.APIMessage, sess *session) *gauge_messages.APIMessage {
 	refactoringRequest := message.PerformRefactoringRequest
 	response := &gauge_messages.PerformRefactoringResponse{}
+	release := acquireRunner(sess)
+	defer release()
 	c := make(chan bool)
-	runner, err := ConnectToRunner(c, false)
+	runner, err := ConnectToRunner(sess.runnerContext(), c, false)
+	if err != nil {
+		response.Success = false
+		response.Errors = []string{err.Error()}
+		return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_PerformRefactoringResponse, PerformRefactoringResponse: response}
+	}
 	defer func() {
 		err := runner.Kill()
 		if err != nil {
 			logger.Errorf(true, "failed to kill runner with pid: %d", runner.Pid())
 		}
 	}()
-	if err != nil {
-		response.Success = false
-		response.Errors = []string{err.Error()}
-		return &gauge_messages.APIMessage{MessageId: message.MessageId, MessageType: gauge_messages.APIMessage_PerformRefactoringResponse, PerformRefactoringResponse: response}
-	}
 	refactoringResult := refactor.GetRefactoringChanges(refactoringRequest.GetOldStep(), refactoringRequest.GetNewStep(), runner, handler.specInfoGatherer.SpecDirs, true)
 	refactoringResult.WriteToDisk()
 	if refactoringResult.Success {
-		logger.Infof(false, "%s", refactoringResult.String())
+		sess.infof("%s", refactoringResult.String())
 	} else {
-		logger.Errorf(false, "Refactoring response from gauge. Errors : %s", refactoringResult.Errors)
+		sess.errorf("Refactoring response from gauge. Errors : %s", refactoringResult.Errors)
 	}
 	response.Success = refactoringResult.Success
 	response.Errors = refactoringResult.Errors
@@ -178,7 +178,7 @@ func (s *SpecInfoGatherer) updateParamCacheFromSpecs(file string, specDetail *Sp
 	s.paramsCache.dynamicParams[file] = make(map[string]gauge.StepArg)
 	s.addParamsFromSteps(specDetail.Spec.Contexts, file)
 	for _, sce := range specDetail.Spec.Scenarios {
-		s.addParamsFromSteps(sce.Steps, file)
+		s.addParamsFromSteps(sce.AllSteps(), file)
 	}
 	s.addParamsFromSteps(specDetail.Spec.TearDownSteps, file)
 	if specDetail.Spec.DataTable.IsInitialized() {
@@ -242,8 +242,8 @@ func (s *SpecInfoGatherer) addToConceptsCache(key string, value *gauge.Concept)
 
 func (s *SpecInfoGatherer) deleteFromConceptDictionary(file string) {
 	for _, c := range s.conceptsCache.concepts[file] {
-		if file == s.conceptDictionary.ConceptsMap[c.ConceptStep.Value].FileName {
-			s.conceptDictionary.Remove(c.ConceptStep.Value)
+		if cpt, ok := s.conceptDictionary.ConceptsMap[gauge.ConceptKey(c.ConceptStep)]; ok && file == cpt.FileName {
+			s.conceptDictionary.Remove(gauge.ConceptKey(c.ConceptStep))
 		}
 	}
 }
@@ -574,6 +574,16 @@ func (s *SpecInfoGatherer) Tags() []string {
 	return removeDuplicateTags(allTags)
 }
 
+// QueryConcepts gives the concepts of the project matching the query
+func (s *SpecInfoGatherer) QueryConcepts(q gauge.ConceptQuery) []*gauge.Concept {
+	s.conceptsCache.mutex.RLock()
+	defer s.conceptsCache.mutex.RUnlock()
+	if s.conceptDictionary == nil {
+		return nil
+	}
+	return s.conceptDictionary.Query(q)
+}
+
 // SearchConceptDictionary searches for a concept in concept dictionary
 func (s *SpecInfoGatherer) SearchConceptDictionary(stepValue string) *gauge.Concept {
 	return s.conceptDictionary.Search(stepValue)
@@ -582,7 +592,7 @@ func (s *SpecInfoGatherer) SearchConceptDictionary(stepValue string) *gauge.Conc
 func getStepsFromSpec(spec *gauge.Specification) []*gauge.Step {
 	steps := spec.Contexts
 	for _, scenario := range spec.Scenarios {
-		steps = append(steps, scenario.Steps...)
+		steps = append(steps, scenario.AllSteps()...)
 	}
 	steps = append(steps, spec.TearDownSteps...)
 	return steps
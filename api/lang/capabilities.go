@@ -75,15 +75,17 @@ func gaugeLSPCapabilities() lsp.InitializeResult {
 	kind := lsp.TDSKFull
 	return lsp.InitializeResult{
 		Capabilities: lsp.ServerCapabilities{
-			TextDocumentSync:           &lsp.TextDocumentSyncOptionsOrKind{Kind: &kind, Options: &lsp.TextDocumentSyncOptions{Save: &lsp.SaveOptions{IncludeText: true}}},
-			CompletionProvider:         &lsp.CompletionOptions{ResolveProvider: true, TriggerCharacters: []string{"*", "* ", "\"", "<", ":", ","}},
-			DocumentFormattingProvider: true,
-			CodeLensProvider:           &lsp.CodeLensOptions{ResolveProvider: false},
-			DefinitionProvider:         true,
-			CodeActionProvider:         true,
-			DocumentSymbolProvider:     true,
-			WorkspaceSymbolProvider:    true,
-			RenameProvider:             true,
+			TextDocumentSync:                &lsp.TextDocumentSyncOptionsOrKind{Kind: &kind, Options: &lsp.TextDocumentSyncOptions{Save: &lsp.SaveOptions{IncludeText: true}}},
+			CompletionProvider:              &lsp.CompletionOptions{ResolveProvider: true, TriggerCharacters: []string{"*", "* ", "\"", "<", ":", ","}},
+			DocumentFormattingProvider:      true,
+			DocumentRangeFormattingProvider: true,
+			CodeLensProvider:                &lsp.CodeLensOptions{ResolveProvider: false},
+			DefinitionProvider:              true,
+			CodeActionProvider:              true,
+			DocumentSymbolProvider:          true,
+			WorkspaceSymbolProvider:         true,
+			RenameProvider:                  true,
+			ExecuteCommandProvider:          &lsp.ExecuteCommandOptions{Commands: []string{replaceStepCommand}},
 		},
 	}
 }
@@ -0,0 +1,84 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	replaceStepCommand = "gauge.replaceStep"
+	replaceStepTitle   = "Replace with '%s'"
+)
+
+type applyWorkspaceEditParams struct {
+	Label string            `json:"label,omitempty"`
+	Edit  lsp.WorkspaceEdit `json:"edit"`
+}
+
+// replaceStepActions gives a quick fix for each of the implemented steps and concepts closest to the unimplemented
+// step at the line, which replaces the step text and keeps its arguments
+func replaceStepActions(uri lsp.DocumentURI, line int) []lsp.Command {
+	if lRunner.runner == nil {
+		return nil
+	}
+	lineText := getLine(uri, line)
+	stepValue, err := parser.ExtractStepValueAndParams(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(lineText), "*")), false)
+	if err != nil {
+		return nil
+	}
+	givenArgs, err := getStepArgs(strings.TrimSpace(lineText))
+	if err != nil {
+		return nil
+	}
+	known, err := allImplementedStepValues()
+	if err != nil {
+		logDebug(nil, err.Error())
+	}
+	if provider != nil {
+		for _, c := range provider.Concepts() {
+			known = append(known, gauge.StepValue{StepValue: c.StepValue.StepValue, ParameterizedStepValue: c.StepValue.ParameterizedStepValue, Args: c.StepValue.Parameters})
+		}
+	}
+	var actions []lsp.Command
+	editRange := getStepEditRange(lineText, lsp.Position{Line: line, Character: len(lineText)})
+	for _, sv := range gauge.ClosestStepValues(stepValue.StepValue, known) {
+		text := getStepFilterText(sv.StepValue, sv.Args, givenArgs)
+		edit := lsp.WorkspaceEdit{Changes: map[string][]lsp.TextEdit{string(uri): {{Range: editRange, NewText: text}}}}
+		actions = append(actions, createCodeAction(replaceStepCommand, fmt.Sprintf(replaceStepTitle, text), []interface{}{edit}))
+	}
+	return actions
+}
+
+// executeServerCommand runs a command of a code action which the server, not the client, implements
+func executeServerCommand(ctx context.Context, conn jsonrpc2.JSONRPC2, req *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.ExecuteCommandParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, fmt.Errorf("failed to parse request %v", err)
+	}
+	if params.Command != replaceStepCommand || len(params.Arguments) != 1 {
+		return nil, fmt.Errorf("unknown command %s", params.Command)
+	}
+	b, err := json.Marshal(params.Arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	var edit lsp.WorkspaceEdit
+	if err := json.Unmarshal(b, &edit); err != nil {
+		return nil, fmt.Errorf("invalid arguments of %s. %v", params.Command, err)
+	}
+	var result interface{}
+	return nil, conn.Call(ctx, "workspace/applyEdit", applyWorkspaceEditParams{Label: "Replace step", Edit: edit}, &result)
+}
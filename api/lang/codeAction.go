@@ -39,6 +39,7 @@ func getSpecCodeAction(params lsp.CodeActionParams) ([]lsp.Command, error) {
 	for _, d := range params.Context.Diagnostics {
 		if d.Code != "" {
 			actions = append(actions, createCodeAction(generateStepCommand, generateStubTitle, []interface{}{d.Code}))
+			actions = append(actions, replaceStepActions(params.TextDocument.URI, line)...)
 			cptInfo, err := createConceptInfo(params.TextDocument.URI, line)
 			if err != nil {
 				return nil, err
@@ -94,8 +95,8 @@ func createConceptInfo(uri lsp.DocumentURI, line int) (interface{}, error) {
 
 func extractStepValueAndParams(step *gauge.Step, linetext string) *gauge.StepValue {
 	var stepValue *gauge.StepValue
-	if step.HasInlineTable {
-		stepValue, _ = parser.ExtractStepValueAndParams(step.LineText, true)
+	if step.HasInlineTable || step.HasMultilineText {
+		stepValue, _ = parser.ExtractStepValueAndParams(step.GetLineText(), false)
 	} else {
 		stepValue, _ = parser.ExtractStepValueAndParams(linetext, false)
 	}
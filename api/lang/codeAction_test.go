@@ -10,7 +10,10 @@ import (
 	"encoding/json"
 	"reflect"
 	"testing"
+	"time"
 
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/runner"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
 	"github.com/sourcegraph/jsonrpc2"
 )
@@ -277,3 +280,24 @@ func TestNotToPanicForUnimplementedWithInvalidStartLine(t *testing.T) {
 		t.Errorf("want: `%s`,\n got: `%s`", want, got)
 	}
 }
+
+func TestGetCodeActionReplacingUnimplementedStepWithClosestStep(t *testing.T) {
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add(lsp.DocumentURI("foo.spec"), "# spec heading\n## scenario heading\n* pay with crad \"1234\"")
+	provider = &dummyInfoProvider{}
+	responses := map[gm.Message_MessageType]interface{}{}
+	responses[gm.Message_StepNamesResponse] = &gm.StepNamesResponse{Steps: []string{"pay with card <number>", "open the shop"}}
+	lRunner.runner = &runner.GrpcRunner{LegacyClient: &mockClient{responses: responses}, Timeout: time.Second * 30}
+	defer func() { lRunner.runner = nil }()
+
+	got := replaceStepActions(lsp.DocumentURI("foo.spec"), 2)
+
+	edit := lsp.WorkspaceEdit{Changes: map[string][]lsp.TextEdit{"foo.spec": {{
+		Range:   lsp.Range{Start: lsp.Position{Line: 2, Character: 2}, End: lsp.Position{Line: 2, Character: 22}},
+		NewText: "pay with card \"1234\"",
+	}}}}
+	want := []lsp.Command{{Command: replaceStepCommand, Title: "Replace with 'pay with card \"1234\"'", Arguments: []interface{}{edit}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
@@ -9,22 +9,29 @@ package lang
 import (
 	"strings"
 
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/tagRegistry"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
 )
 
 func tagsCompletion(line string, pLine string, params lsp.TextDocumentPositionParams) (interface{}, error) {
 	list := completionList{IsIncomplete: false, Items: []completionItem{}}
 	suffix, editRange := getTagsEditRange(line, pLine, params.Position)
-	for _, t := range provider.Tags() {
+	r, err := tagRegistry.Load(config.ProjectRoot)
+	if err != nil {
+		logWarning(nil, err.Error())
+	}
+	for _, t := range completionTags(provider.Tags(), r) {
 		item := completionItem{
 			InsertTextFormat: text,
 			CompletionItem: lsp.CompletionItem{
-				SortText:   "a" + t,
-				Label:      t,
-				FilterText: t + suffix,
-				Detail:     tag,
-				Kind:       lsp.CIKVariable,
-				TextEdit:   &lsp.TextEdit{Range: editRange, NewText: " " + t + suffix},
+				SortText:      "a" + t,
+				Label:         t,
+				FilterText:    t + suffix,
+				Detail:        tag,
+				Documentation: tagDocumentation(t, r),
+				Kind:          lsp.CIKVariable,
+				TextEdit:      &lsp.TextEdit{Range: editRange, NewText: " " + t + suffix},
 			},
 		}
 		list.Items = append(list.Items, item)
@@ -32,6 +39,41 @@ func tagsCompletion(line string, pLine string, params lsp.TextDocumentPositionPa
 	return list, nil
 }
 
+// completionTags returns the tags used in the project followed by the ones declared in the tag registry
+func completionTags(used []string, r *tagRegistry.Registry) []string {
+	tags := append([]string{}, used...)
+	if r == nil {
+		return tags
+	}
+	for _, t := range r.Tags {
+		if t.Deprecated || strings.HasSuffix(t.Name, "*") || containsTag(tags, t.Name) {
+			continue
+		}
+		tags = append(tags, t.Name)
+	}
+	return tags
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func tagDocumentation(tag string, r *tagRegistry.Registry) string {
+	t, ok := r.Get(tag)
+	if !ok {
+		return emptyString
+	}
+	if t.Deprecated {
+		return r.Check(tag).Error()
+	}
+	return t.Description
+}
+
 func getTagsEditRange(line, pLine string, position lsp.Position) (string, lsp.Range) {
 	var editRange lsp.Range
 	suffix := emptyString
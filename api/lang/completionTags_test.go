@@ -12,6 +12,7 @@ import (
 
 	"reflect"
 
+	"github.com/getgauge/gauge/tagRegistry"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
 )
 
@@ -112,3 +113,23 @@ func TestGetTagsCompletionWhenEditingInMiddle(t *testing.T) {
 		t.Errorf("want: %v\n but got: %v", want, got)
 	}
 }
+
+func TestCompletionTagsIncludesRegisteredTags(t *testing.T) {
+	r, err := tagRegistry.Parse([]byte("tags:\n  - name: smoke\n    description: Quick checks\n  - name: team:*\n  - name: slow\n    deprecated: true\n  - name: hello\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := completionTags([]string{"hello", "slow"}, r)
+
+	want := []string{"hello", "slow", "smoke"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v. Got %v", want, got)
+	}
+	if d := tagDocumentation("smoke", r); d != "Quick checks" {
+		t.Errorf("Expected description of smoke. Got %q", d)
+	}
+	if d := tagDocumentation("slow", r); d != "tag 'slow' is deprecated" {
+		t.Errorf("Expected deprecation of slow. Got %q", d)
+	}
+}
@@ -102,6 +102,10 @@ func (p dummyInfoProvider) GetSpecDirs() []string {
 	return []string{"specs"}
 }
 
+func (p dummyInfoProvider) QueryConcepts(q gauge.ConceptQuery) []*gauge.Concept {
+	return nil
+}
+
 func (p dummyInfoProvider) SearchConceptDictionary(stepValue string) *gauge.Concept {
 	return &(gauge.Concept{FileName: "concept_uri.cpt", ConceptStep: &gauge.Step{
 		Value:    "concept1",
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/getgauge/gauge/execution"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/util"
@@ -37,6 +38,83 @@ func specs() (interface{}, error) {
 	return specs, nil
 }
 
+// prioritizedScenarioInfo is a scenario of gauge/prioritizedScenarios with the priority it is scheduled by. Level is
+// nil for scenarios without priority.
+type prioritizedScenarioInfo struct {
+	ScenarioInfo
+	File     string `json:"file"`
+	Priority string `json:"priority"`
+	Level    *int   `json:"level,omitempty"`
+}
+
+// prioritizedScenarios are the scenarios of the project in the order a run schedules them by priority. PriorityOrder
+// tells if runs order the scenarios by priority, as set by gauge_scenario_priority_ordering.
+type prioritizedScenarios struct {
+	PriorityOrder bool                      `json:"priorityOrder"`
+	Scenarios     []prioritizedScenarioInfo `json:"scenarios"`
+}
+
+func scenariosByPriority() (interface{}, error) {
+	var specs []*gauge.Specification
+	for _, d := range provider.GetAvailableSpecDetails([]string{}) {
+		if d.Spec != nil {
+			specs = append(specs, d.Spec)
+		}
+	}
+	res := prioritizedScenarios{PriorityOrder: parser.PriorityOrderEnabled(), Scenarios: make([]prioritizedScenarioInfo, 0)}
+	for _, p := range execution.NewPrioritizedScenarios(specs) {
+		info := prioritizedScenarioInfo{ScenarioInfo: getScenarioInfo(p.Scenario, p.Spec.FileName), File: p.Spec.FileName, Priority: parser.PriorityName(p.Priority)}
+		if p.Priority != parser.NoPriority {
+			level := p.Priority
+			info.Level = &level
+		}
+		res.Scenarios = append(res.Scenarios, info)
+	}
+	return res, nil
+}
+
+// conceptQueryParams selects the concepts of gauge/concepts, those matching all the fields which are set
+type conceptQueryParams struct {
+	URI   lsp.DocumentURI `json:"uri"`
+	Tag   string          `json:"tag"`
+	Param string          `json:"param"`
+	Uses  string          `json:"uses"`
+}
+
+type conceptInfo struct {
+	StepValue string   `json:"stepValue"`
+	Text      string   `json:"text"`
+	File      string   `json:"file"`
+	LineNo    int      `json:"lineNo"`
+	Tags      []string `json:"tags"`
+	Params    []string `json:"params"`
+}
+
+func queryConcepts(req *jsonrpc2.Request) (interface{}, error) {
+	var params conceptQueryParams
+	if err := json.Unmarshal(*req.Params, &params); err != nil {
+		return nil, fmt.Errorf("failed to parse request %s", err)
+	}
+	q := gauge.ConceptQuery{Tag: params.Tag, Param: params.Param, Uses: params.Uses}
+	if params.URI != "" {
+		q.File = util.ConvertURItoFilePath(params.URI)
+	}
+	infos := make([]conceptInfo, 0)
+	for _, c := range provider.QueryConcepts(q) {
+		info := conceptInfo{StepValue: c.ConceptStep.Value, Text: c.ConceptStep.LineText, File: c.FileName, LineNo: c.ConceptStep.LineNo, Tags: []string{}, Params: []string{}}
+		if c.ConceptStep.Tags != nil {
+			info.Tags = append(info.Tags, c.ConceptStep.Tags.Values()...)
+		}
+		for _, arg := range c.ConceptStep.Args {
+			if arg.ArgType == gauge.Dynamic {
+				info.Params = append(info.Params, arg.Value)
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
 func scenarios(req *jsonrpc2.Request) (interface{}, error) {
 	var params lsp.TextDocumentPositionParams
 	var err error
@@ -199,3 +199,66 @@ func TestGetSpecsShouldReturnAllSpecsInDirectory(t *testing.T) {
 		t.Errorf("expected %v to be equal %v", info, want)
 	}
 }
+
+type conceptQueryProvider struct {
+	dummyInfoProvider
+	query gauge.ConceptQuery
+}
+
+func (p *conceptQueryProvider) QueryConcepts(q gauge.ConceptQuery) []*gauge.Concept {
+	p.query = q
+	return []*gauge.Concept{{FileName: "concepts/login.cpt", ConceptStep: &gauge.Step{
+		Value:    "Login as {}",
+		LineText: "Login as <user>",
+		LineNo:   3,
+		Args:     []*gauge.StepArg{{Value: "user", ArgType: gauge.Dynamic}},
+		Tags:     &gauge.Tags{RawValues: [][]string{{"auth"}}},
+	}}}
+}
+
+func TestQueryConcepts(t *testing.T) {
+	p := &conceptQueryProvider{}
+	provider = p
+	b, _ := json.Marshal(conceptQueryParams{Tag: "auth", Param: "user"})
+	params := json.RawMessage(b)
+
+	got, err := queryConcepts(&jsonrpc2.Request{Params: &params})
+
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	if want := (gauge.ConceptQuery{Tag: "auth", Param: "user"}); !reflect.DeepEqual(p.query, want) {
+		t.Errorf("want query: `%v`,\n got: `%v`", want, p.query)
+	}
+	want := []conceptInfo{{StepValue: "Login as {}", Text: "Login as <user>", File: "concepts/login.cpt", LineNo: 3, Tags: []string{"auth"}, Params: []string{"user"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestScenariosByPriority(t *testing.T) {
+	p0 := gauge.Priority(0)
+	first := &gauge.Scenario{Heading: &gauge.Heading{Value: "First", LineNo: 3}}
+	critical := &gauge.Scenario{Heading: &gauge.Heading{Value: "Critical", LineNo: 7}, Priority: &p0}
+	provider = &dummyInfoProvider{
+		specsFunc: func(specs []string) []*infoGatherer.SpecDetail {
+			return []*infoGatherer.SpecDetail{
+				{Spec: &gauge.Specification{FileName: "foo.spec", Scenarios: []*gauge.Scenario{first, critical}}},
+			}
+		},
+	}
+
+	got, err := scenariosByPriority()
+
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	level := 0
+	want := prioritizedScenarios{Scenarios: []prioritizedScenarioInfo{
+		{ScenarioInfo: ScenarioInfo{Heading: "Critical", LineNo: 7, ExecutionIdentifier: "foo.spec:7"}, File: "foo.spec", Priority: "P0", Level: &level},
+		{ScenarioInfo: ScenarioInfo{Heading: "First", LineNo: 3, ExecutionIdentifier: "foo.spec:3"}, File: "foo.spec", Priority: "unprioritized"},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
@@ -41,3 +41,29 @@ func format(request *jsonrpc2.Request) (interface{}, error) {
 	}
 	return nil, fmt.Errorf("failed to format document. %s is not a valid spec file", file)
 }
+
+func formatRange(request *jsonrpc2.Request) (interface{}, error) {
+	var params lsp.DocumentRangeFormattingParams
+	if err := json.Unmarshal(*request.Params, &params); err != nil {
+		return nil, err
+	}
+	logDebug(request, "LangServer: request received : Type: Format Range URI: %s", params.TextDocument.URI)
+	file := util.ConvertURItoFilePath(params.TextDocument.URI)
+	if !util.IsValidSpecExtension(file) {
+		return nil, fmt.Errorf("failed to format document. %s is not a valid spec file", file)
+	}
+	content := getContent(params.TextDocument.URI)
+	spec, parseResult, err := new(parser.SpecParser).Parse(content, gauge.NewConceptDictionary(), file)
+	if err != nil {
+		return nil, err
+	}
+	if !parseResult.Ok {
+		return nil, fmt.Errorf("failed to format document. Fix all the problems first")
+	}
+	lines := strings.Split(content, "\n")
+	textEdits := []lsp.TextEdit{}
+	for _, r := range formatter.FormatRegions(spec, content, params.Range.Start.Line+1, params.Range.End.Line+1) {
+		textEdits = append(textEdits, createTextEdit(r.Text, r.Start-1, 0, r.End-1, len(strings.TrimSuffix(lines[r.End-1], "\r"))))
+	}
+	return textEdits, nil
+}
@@ -78,3 +78,41 @@ func TestFormatParseError(t *testing.T) {
 	}
 
 }
+
+func TestFormatRange(t *testing.T) {
+	specText := `# Specification Heading
+
+## First
+*   first   step
+
+## Second
+*   second   step
+`
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add("foo.spec", specText)
+
+	want := []lsp.TextEdit{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 5, Character: 0},
+				End:   lsp.Position{Line: 6, Character: 17},
+			},
+			NewText: "## Second\n* second   step",
+		},
+	}
+
+	b, _ := json.Marshal(lsp.DocumentRangeFormattingParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: "foo.spec"},
+		Range:        lsp.Range{Start: lsp.Position{Line: 6, Character: 0}, End: lsp.Position{Line: 6, Character: 5}},
+	})
+	p := json.RawMessage(b)
+
+	got, err := formatRange(&jsonrpc2.Request{Params: &p})
+	if err != nil {
+		t.Fatalf("Expected error == nil in formatRange, got %s", err.Error())
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("formatRange failed, want: `%v`, got: `%v`", want, got)
+	}
+}
@@ -91,6 +91,8 @@ func getNewStepName(params lsp.RenameParams, step *gauge.Step) string {
 	newName := strings.TrimSpace(strings.TrimPrefix(params.NewName, "*"))
 	if step.HasInlineTable {
 		newName = fmt.Sprintf("%s <%s>", newName, gauge.TableArg)
+	} else if step.HasMultilineText {
+		newName = fmt.Sprintf("%s <%s>", newName, "text")
 	}
 	return newName
 }
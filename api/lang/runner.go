@@ -7,6 +7,7 @@
 package lang
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/metrics"
 	"github.com/getgauge/gauge/runner"
 	"github.com/getgauge/gauge/util"
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
@@ -64,7 +66,10 @@ func connectToRunner() error {
 		return err
 	}
 
-	lRunner.runner, err = runner.StartGrpcRunner(manifest, outFile, outFile, config.IdeRequestTimeout(), false)
+	lRunner.runner, err = runner.StartGrpcRunner(context.Background(), manifest, outFile, outFile, config.IdeRequestTimeout(), false)
+	if err == nil {
+		metrics.RunnerStarts.Inc()
+	}
 	return err
 }
 
@@ -29,6 +29,7 @@ type infoProvider interface {
 	Params(file string, argType gauge.ArgType) []gauge.StepArg
 	Tags() []string
 	SearchConceptDictionary(string) *gauge.Concept
+	QueryConcepts(gauge.ConceptQuery) []*gauge.Concept
 	GetAvailableSpecDetails(specs []string) []*infoGatherer.SpecDetail
 	GetSpecDirs() []string
 }
@@ -148,6 +149,16 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			}
 		}
 		return data, err
+	case "textDocument/rangeFormatting":
+		data, err := formatRange(req)
+		if err != nil {
+			logDebug(req, err.Error())
+			e := showErrorMessageOnClient(ctx, conn, err)
+			if e != nil {
+				return nil, fmt.Errorf("unable to send '%s' error to LSP server. %s", err.Error(), e.Error())
+			}
+		}
+		return data, err
 	case "textDocument/codeLens":
 		val, err := codeLenses(req)
 		if err != nil {
@@ -160,6 +171,12 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			logDebug(req, err.Error())
 		}
 		return val, err
+	case "workspace/executeCommand":
+		val, err := executeServerCommand(ctx, conn, req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
 	case "textDocument/rename":
 		result, err := rename(ctx, conn, req)
 		if err != nil {
@@ -189,6 +206,18 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			logDebug(req, err.Error())
 		}
 		return val, err
+	case "gauge/stepLocations":
+		val, err := stepLocations(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
+	case "gauge/concepts":
+		val, err := queryConcepts(req)
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
 	case "gauge/stepValueAt":
 		val, err := stepValueAt(req)
 		if err != nil {
@@ -221,6 +250,12 @@ func (h *LangHandler) Handle(ctx context.Context, conn jsonrpc2.JSONRPC2, req *j
 			logDebug(req, err.Error())
 		}
 		return val, err
+	case "gauge/prioritizedScenarios":
+		val, err := scenariosByPriority()
+		if err != nil {
+			logDebug(req, err.Error())
+		}
+		return val, err
 	case "gauge/specs":
 		val, err := specs()
 		if err != nil {
@@ -0,0 +1,84 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lang
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+// stepLocation is where a step is implemented, by a concept or by the code of the runner. Location is nil when the
+// step is not implemented, or is implemented outside the project.
+type stepLocation struct {
+	StepValue   string        `json:"stepValue"`
+	Implemented bool          `json:"implemented"`
+	IsConcept   bool          `json:"isConcept"`
+	Location    *lsp.Location `json:"location,omitempty"`
+}
+
+// stepLocations gives where each of the step values of the request is implemented. The runner is asked for the steps
+// of each implementation file once, rather than for each step, so that editors can decorate all the steps of a file
+// at once.
+func stepLocations(req *jsonrpc2.Request) (interface{}, error) {
+	var stepValues []string
+	if err := json.Unmarshal(*req.Params, &stepValues); err != nil {
+		return nil, fmt.Errorf("failed to parse request %v", err)
+	}
+	locations := make([]stepLocation, 0, len(stepValues))
+	var implemented map[string]lsp.Location
+	for _, stepValue := range stepValues {
+		l := stepLocation{StepValue: stepValue}
+		if concept := provider.SearchConceptDictionary(stepValue); concept != nil {
+			l.Implemented, l.IsConcept = true, true
+			if loc, err := getLspLocationForConcept(concept.FileName, concept.ConceptStep.LineNo); err == nil {
+				location := loc.(lsp.Location)
+				l.Location = &location
+			}
+			locations = append(locations, l)
+			continue
+		}
+		if implemented == nil {
+			var err error
+			if implemented, err = implementedSteps(); err != nil {
+				return nil, err
+			}
+		}
+		if loc, ok := implemented[stepValue]; ok {
+			l.Implemented, l.Location = true, &loc
+		}
+		locations = append(locations, l)
+	}
+	return locations, nil
+}
+
+// implementedSteps gives the location of the steps implemented in the implementation files of the project
+func implementedSteps() (map[string]lsp.Location, error) {
+	steps := make(map[string]lsp.Location)
+	if lRunner.runner == nil {
+		return steps, nil
+	}
+	files, err := getImplementationFileList()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files.GetImplementationFilePaths() {
+		res, err := getStepPositionResponse(util.ConvertPathToURI(file))
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range res.GetStepPositions() {
+			if _, ok := steps[p.GetStepValue()]; !ok {
+				steps[p.GetStepValue()] = getLspLocationForStep(file, p.GetSpan())
+			}
+		}
+	}
+	return steps, nil
+}
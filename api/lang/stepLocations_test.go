@@ -0,0 +1,89 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lang
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/util"
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+type conceptInfoProvider struct {
+	dummyInfoProvider
+}
+
+func (p conceptInfoProvider) SearchConceptDictionary(stepValue string) *gauge.Concept {
+	if stepValue != "concept1" {
+		return nil
+	}
+	return p.dummyInfoProvider.SearchConceptDictionary(stepValue)
+}
+
+func TestStepLocations(t *testing.T) {
+	provider = conceptInfoProvider{}
+	conceptURI := util.ConvertPathToURI("concept_uri.cpt")
+	openFilesCache = &files{cache: make(map[lsp.DocumentURI][]string)}
+	openFilesCache.add(conceptURI, "# concept1\n* step")
+	responses := map[gauge_messages.Message_MessageType]interface{}{}
+	responses[gauge_messages.Message_ImplementationFileListResponse] = &gauge_messages.ImplementationFileListResponse{
+		ImplementationFilePaths: []string{"step_impl.js"},
+	}
+	responses[gauge_messages.Message_StepPositionsResponse] = &gauge_messages.StepPositionsResponse{
+		StepPositions: []*gauge_messages.StepPositionsResponse_StepPosition{
+			{StepValue: "Say {} to {}", Span: &gauge_messages.Span{Start: 2, StartChar: 0, End: 4, EndChar: 1}},
+		},
+	}
+	lRunner.runner = &runner.GrpcRunner{LegacyClient: &mockClient{responses: responses}, Timeout: time.Second * 30}
+	defer func() { lRunner.runner = nil }()
+	b, _ := json.Marshal([]string{"Say {} to {}", "concept1", "Unimplemented step"})
+	params := json.RawMessage(b)
+
+	got, err := stepLocations(&jsonrpc2.Request{Params: &params})
+
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	want := []stepLocation{
+		{StepValue: "Say {} to {}", Implemented: true, Location: &lsp.Location{
+			URI:   util.ConvertPathToURI("step_impl.js"),
+			Range: lsp.Range{Start: lsp.Position{Line: 1, Character: 0}, End: lsp.Position{Line: 3, Character: 1}},
+		}},
+		{StepValue: "concept1", Implemented: true, IsConcept: true, Location: &lsp.Location{
+			URI:   conceptURI,
+			Range: lsp.Range{Start: lsp.Position{Line: 0, Character: 0}, End: lsp.Position{Line: 0, Character: 10}},
+		}},
+		{StepValue: "Unimplemented step"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
+
+func TestStepLocationsWithoutRunner(t *testing.T) {
+	provider = conceptInfoProvider{}
+	lRunner.runner = nil
+	b, _ := json.Marshal([]string{"Say {} to {}"})
+	params := json.RawMessage(b)
+
+	got, err := stepLocations(&jsonrpc2.Request{Params: &params})
+
+	if err != nil {
+		t.Fatalf("Got error %s", err.Error())
+	}
+	want := []stepLocation{{StepValue: "Say {} to {}"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: `%v`,\n got: `%v`", want, got)
+	}
+}
@@ -0,0 +1,180 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/runner"
+)
+
+// daemonClient names the variable which gives the runners of a client the id of its session
+const daemonClient = "gauge_daemon_client"
+
+// session is a client of the daemon, from the time it connects until its connection closes. Clients share the
+// specs and concepts the daemon parsed. Each of them has its own log, api-client-<id>.log in the logs directory, and
+// its own environment, which its runners start with on top of the environment the daemon loaded.
+type session struct {
+	id       int
+	addr     string
+	requests int
+	env      map[string]string
+	log      *logger.FileLog
+}
+
+func newSession(id int, addr string) *session {
+	return &session{
+		id:   id,
+		addr: addr,
+		env:  map[string]string{daemonClient: strconv.Itoa(id)},
+		log:  logger.NewFileLog(fmt.Sprintf("client %d", id), fmt.Sprintf("api-client-%d.log", id)),
+	}
+}
+
+// runnerContext gives the context of the runners of the session, which start with its environment
+func (sess *session) runnerContext() context.Context {
+	return runner.WithEnv(context.Background(), sess.env)
+}
+
+// infof logs to the log of the session, and to the log of the daemon with the id of the client
+func (sess *session) infof(msg string, args ...interface{}) {
+	logger.Infof(false, "Client %d: %s", sess.id, fmt.Sprintf(msg, args...))
+	sess.log.Infof(msg, args...)
+}
+
+// errorf logs to the log of the session, and to the log of the daemon with the id of the client
+func (sess *session) errorf(msg string, args ...interface{}) {
+	logger.Errorf(false, "Client %d: %s", sess.id, fmt.Sprintf(msg, args...))
+	sess.log.Errorf(msg, args...)
+}
+
+// debugf logs to the log of the session, and to the log of the daemon with the id of the client
+func (sess *session) debugf(msg string, args ...interface{}) {
+	logger.Debugf(false, "Client %d: %s", sess.id, fmt.Sprintf(msg, args...))
+	sess.log.Debugf(msg, args...)
+}
+
+// sessions are the sessions of the clients connected to the daemon. The zero value has no sessions.
+type sessions struct {
+	mu     sync.Mutex
+	last   int
+	byConn map[net.Conn]*session
+}
+
+// of gives the session of the connection, which starts with its first request
+func (s *sessions) of(c net.Conn) *session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.byConn == nil {
+		s.byConn = make(map[net.Conn]*session)
+	}
+	sess, ok := s.byConn[c]
+	if !ok {
+		s.last++
+		sess = newSession(s.last, addrOf(c))
+		s.byConn[c] = sess
+		sess.infof("Connected from %s, %d clients connected", sess.addr, len(s.byConn))
+	}
+	sess.requests++
+	return sess
+}
+
+// close ends the session of the connection
+func (s *sessions) close(c net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.byConn[c]
+	if !ok {
+		return
+	}
+	delete(s.byConn, c)
+	sess.infof("Disconnected after %d requests", sess.requests)
+	if err := sess.log.Close(); err != nil {
+		logger.Debugf(false, "Failed to close the log of client %d. %s", sess.id, err.Error())
+	}
+}
+
+func addrOf(c net.Conn) string {
+	if c == nil || c.RemoteAddr() == nil {
+		return "unknown address"
+	}
+	return c.RemoteAddr().String()
+}
+
+// runnerScheduler shares the runners of the daemon between its clients. A client waiting for a runner gets the next
+// free one in turn with the other waiting clients, whatever the number of requests each of them has waiting.
+type runnerScheduler struct {
+	mu      sync.Mutex
+	max     int
+	inUse   int
+	turns   []*session
+	waiting map[*session][]chan struct{}
+}
+
+func newRunnerScheduler(max int) *runnerScheduler {
+	return &runnerScheduler{max: max, waiting: make(map[*session][]chan struct{})}
+}
+
+// acquire waits until the session can start a runner. The returned func gives the runner back.
+func (r *runnerScheduler) acquire(sess *session) (release func()) {
+	r.mu.Lock()
+	if r.inUse < r.max && len(r.turns) == 0 {
+		r.inUse++
+		r.mu.Unlock()
+		return r.release
+	}
+	ready := make(chan struct{})
+	if len(r.waiting[sess]) == 0 {
+		r.turns = append(r.turns, sess)
+	}
+	r.waiting[sess] = append(r.waiting[sess], ready)
+	r.mu.Unlock()
+	sess.infof("Waiting for a runner, %d of them are in use.", r.max)
+	<-ready
+	return r.release
+}
+
+// release hands the runner to the waiting client whose turn it is, which then goes to the back of the line if it has
+// more requests waiting.
+func (r *runnerScheduler) release() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.turns) == 0 {
+		r.inUse--
+		return
+	}
+	sess := r.turns[0]
+	r.turns = r.turns[1:]
+	ready := r.waiting[sess][0]
+	r.waiting[sess] = r.waiting[sess][1:]
+	if len(r.waiting[sess]) > 0 {
+		r.turns = append(r.turns, sess)
+	} else {
+		delete(r.waiting, sess)
+	}
+	close(ready)
+}
+
+var (
+	runnersOnce sync.Once
+	runners     *runnerScheduler
+)
+
+// acquireRunner waits until the session can start a runner, as the daemon starts at most gauge_daemon_max_runners
+// of them at the same time for all its clients, who take turns for them. The returned func gives the runner back.
+func acquireRunner(sess *session) (release func()) {
+	runnersOnce.Do(func() {
+		runners = newRunnerScheduler(env.DaemonMaxRunners())
+	})
+	return runners.acquire(sess)
+}
@@ -0,0 +1,145 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package api
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/getgauge/gauge/env"
+	. "gopkg.in/check.v1"
+)
+
+// withLogsDir has the logs of the sessions written to a directory of the test
+func withLogsDir(c *C) func() {
+	old, ok := os.LookupEnv(env.LogsDirectory)
+	c.Assert(os.Setenv(env.LogsDirectory, c.MkDir()), IsNil)
+	return func() {
+		if ok {
+			os.Setenv(env.LogsDirectory, old)
+		} else {
+			os.Unsetenv(env.LogsDirectory)
+		}
+	}
+}
+
+func (s *MySuite) TestSessionsGiveEachConnectionItsOwnSession(c *C) {
+	defer withLogsDir(c)()
+	c1, other1 := net.Pipe()
+	defer c1.Close()
+	defer other1.Close()
+	c2, other2 := net.Pipe()
+	defer c2.Close()
+	defer other2.Close()
+	ss := &sessions{}
+
+	first := ss.of(c1)
+	second := ss.of(c2)
+
+	c.Assert(first.id, Not(Equals), second.id)
+	c.Assert(ss.of(c1), Equals, first)
+	c.Assert(first.requests, Equals, 2)
+	c.Assert(second.requests, Equals, 1)
+}
+
+func (s *MySuite) TestSessionsEndWhenConnectionCloses(c *C) {
+	defer withLogsDir(c)()
+	conn, other := net.Pipe()
+	defer conn.Close()
+	defer other.Close()
+	h := &gaugeAPIMessageHandler{}
+	first := h.sessions.of(conn)
+
+	h.ConnectionClosed(conn)
+
+	c.Assert(len(h.sessions.byConn), Equals, 0)
+	c.Assert(h.sessions.of(conn).id, Not(Equals), first.id)
+}
+
+func (s *MySuite) TestAcquireRunnerWaitsForAFreeRunner(c *C) {
+	defer withLogsDir(c)()
+	release := acquireRunner(newSession(1, ""))
+	acquired := make(chan bool)
+	go func() {
+		acquireRunner(newSession(2, ""))()
+		acquired <- true
+	}()
+
+	select {
+	case <-acquired:
+		c.Fatal("Expected the second client to wait for the runner of the first one")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release()
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		c.Fatal("Expected the second client to get a runner once the first one gave it back")
+	}
+}
+
+func (s *MySuite) TestRunnerSchedulerGivesWaitingClientsTheRunnerInTurn(c *C) {
+	defer withLogsDir(c)()
+	r := newRunnerScheduler(1)
+	release := r.acquire(newSession(1, ""))
+	busy, quiet := newSession(2, ""), newSession(3, "")
+	order := make(chan int, 3)
+	wait := func(sess *session, waiting int) {
+		go func() {
+			release := r.acquire(sess)
+			order <- sess.id
+			release()
+		}()
+		for {
+			r.mu.Lock()
+			n := len(r.waiting[sess])
+			r.mu.Unlock()
+			if n == waiting {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	wait(busy, 1)
+	wait(busy, 2)
+	wait(quiet, 1)
+
+	release()
+
+	var got []int
+	for i := 0; i < 3; i++ {
+		select {
+		case id := <-order:
+			got = append(got, id)
+		case <-time.After(5 * time.Second):
+			c.Fatalf("Expected every client to get a runner, got %v", got)
+		}
+	}
+	c.Assert(got, DeepEquals, []int{2, 3, 2})
+}
+
+func (s *MySuite) TestSessionsHaveTheirOwnLogAndEnvironment(c *C) {
+	defer withLogsDir(c)()
+	first, second := newSession(1, "127.0.0.1:5001"), newSession(2, "127.0.0.1:5002")
+	defer first.log.Close()
+	defer second.log.Close()
+
+	first.infof("Renamed step")
+
+	c.Assert(first.env[daemonClient], Equals, "1")
+	c.Assert(second.env[daemonClient], Equals, "2")
+	c.Assert(filepath.Base(first.log.File()), Equals, "api-client-1.log")
+	b, err := ioutil.ReadFile(first.log.File())
+	c.Assert(err, IsNil)
+	c.Assert(strings.Contains(string(b), "Renamed step"), Equals, true)
+	_, err = os.Stat(second.log.File())
+	c.Assert(os.IsNotExist(err), Equals, true)
+}
@@ -0,0 +1,167 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package artifacts gathers the files left behind by a run, like logs, screenshots and reports, into a single archive
+keyed by the run ID and optionally hands the archive to an uploader.
+
+	gauge_artifacts = logs, .gauge/screenshots, reports/html-report, *.dump
+	gauge_artifacts_uploader = aws s3 cp {{.Archive}} s3://test-artifacts/{{.RunID}}.zip
+
+Paths are relative to the project root and may be globs. The uploader is a command, run from the project root,
+whose arguments can refer to the archive and the run ID.
+*/
+package artifacts
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/logger"
+)
+
+// RunIDEnv is the environment variable which sets the ID of a run, e.g. to the build number of the CI system
+const RunIDEnv = "GAUGE_RUN_ID"
+
+const artifactsDir = "artifacts"
+
+// Uploader sends the archive of a run somewhere it is kept
+type Uploader interface {
+	Upload(archive, runID string) error
+}
+
+// CustomUploader, when set, uploads the archives instead of the command configured in gauge_artifacts_uploader
+var CustomUploader Uploader
+
+// RunID returns the ID of the run, from GAUGE_RUN_ID or else from the current time
+func RunID() string {
+	if id := strings.TrimSpace(os.Getenv(RunIDEnv)); id != "" {
+		return id
+	}
+	return time.Now().Format("20060102-150405")
+}
+
+// CollectAfterRun archives the declared artifacts of the run into the reports directory and uploads the archive
+// if an uploader is configured. Nothing happens when the project declares no artifacts.
+func CollectAfterRun(reportsDir string) {
+	patterns := env.Artifacts()
+	if len(patterns) == 0 {
+		return
+	}
+	runID := RunID()
+	archive := filepath.Join(reportsDir, artifactsDir, runID+".zip")
+	n, err := Collect(config.ProjectRoot, patterns, archive)
+	if err != nil {
+		logger.Errorf(true, "Failed to collect artifacts. %s", err.Error())
+		return
+	}
+	logger.Infof(true, "Collected %d artifact(s) in %s", n, archive)
+	u := CustomUploader
+	if command := env.ArtifactsUploader(); u == nil && command != "" {
+		u = &commandUploader{command: command}
+	}
+	if u == nil {
+		return
+	}
+	if err := u.Upload(archive, runID); err != nil {
+		logger.Errorf(true, "Failed to upload artifacts. %s", err.Error())
+	}
+}
+
+// Collect writes the files matching the patterns, relative to the root, to a zip archive and returns how many it wrote.
+// Directories are added with all their files.
+func Collect(root string, patterns []string, archive string) (int, error) {
+	if err := os.MkdirAll(filepath.Dir(archive), common.NewDirectoryPermissions); err != nil {
+		return 0, err
+	}
+	f, err := os.Create(archive)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	added := make(map[string]bool)
+	archivesDir, _ := filepath.Abs(filepath.Dir(archive))
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(root, pattern))
+		if err != nil {
+			return 0, fmt.Errorf("invalid artifact path %s. %s", pattern, err.Error())
+		}
+		if len(matches) == 0 {
+			logger.Debugf(true, "No artifacts found at %s", pattern)
+		}
+		for _, m := range matches {
+			err := filepath.Walk(m, func(path string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() || added[path] {
+					return err
+				}
+				if abs, _ := filepath.Abs(path); filepath.Dir(abs) == archivesDir {
+					return nil
+				}
+				added[path] = true
+				return addFile(w, root, path)
+			})
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(added), w.Close()
+}
+
+func addFile(w *zip.Writer, root, path string) error {
+	name, err := filepath.Rel(root, path)
+	if err != nil {
+		return err
+	}
+	dst, err := w.Create(filepath.ToSlash(name))
+	if err != nil {
+		return err
+	}
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	_, err = io.Copy(dst, src)
+	return err
+}
+
+// commandUploader uploads the archive by running a command, whose arguments are templates of the archive and run ID
+type commandUploader struct {
+	command string
+}
+
+func (u *commandUploader) Upload(archive, runID string) error {
+	t, err := template.New("uploader").Parse(u.command)
+	if err != nil {
+		return fmt.Errorf("invalid uploader command. %s", err.Error())
+	}
+	var b bytes.Buffer
+	if err = t.Execute(&b, struct{ Archive, RunID string }{archive, runID}); err != nil {
+		return fmt.Errorf("invalid uploader command. %s", err.Error())
+	}
+	args := strings.Fields(b.String())
+	if len(args) == 0 {
+		return fmt.Errorf("uploader command is empty")
+	}
+	logger.Debugf(true, "Uploading artifacts with: %s", b.String())
+	cmd, err := common.ExecuteSystemCommand(args, config.ProjectRoot, os.Stdout, os.Stderr)
+	if err != nil {
+		return err
+	}
+	return cmd.Wait()
+}
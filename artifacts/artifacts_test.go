@@ -0,0 +1,73 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package artifacts
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCollectArchivesMatchingFilesAndDirectories(t *testing.T) {
+	root, err := ioutil.TempDir("", "artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	writeFile(t, filepath.Join(root, "logs", "gauge.log"), "log")
+	writeFile(t, filepath.Join(root, "reports", "html-report", "index.html"), "report")
+	writeFile(t, filepath.Join(root, "reports", "artifacts", "old-run.zip"), "old")
+	writeFile(t, filepath.Join(root, "runner.dump"), "dump")
+	writeFile(t, filepath.Join(root, "notes.txt"), "ignored")
+	archive := filepath.Join(root, "reports", "artifacts", "42.zip")
+
+	n, err := Collect(root, []string{"logs", "reports", "*.dump", "missing"}, archive)
+	if err != nil {
+		t.Fatalf("Expected no error. Got: %s", err.Error())
+	}
+
+	r, err := zip.OpenReader(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	sort.Strings(names)
+	want := []string{"logs/gauge.log", "reports/html-report/index.html", "runner.dump"}
+	if n != len(want) || len(names) != len(want) {
+		t.Fatalf("Expected %v. Got %d files: %v", want, n, names)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("Expected %v. Got: %v", want, names)
+		}
+	}
+}
+
+func TestRunIDComesFromEnvironment(t *testing.T) {
+	defer os.Setenv(RunIDEnv, os.Getenv(RunIDEnv))
+	os.Setenv(RunIDEnv, "build-123")
+
+	if got := RunID(); got != "build-123" {
+		t.Errorf("Expected build-123. Got: %s", got)
+	}
+}
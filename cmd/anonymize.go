@@ -0,0 +1,55 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/anonymizer"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/spf13/cobra"
+)
+
+const anonymizeOutDefault = "anonymized"
+
+var (
+	anonymizeCmd = &cobra.Command{
+		Use:   "anonymize [flags] [args]",
+		Short: "Write a copy of the specs and concepts with their text replaced by placeholders",
+		Long: `Write a copy of the specs and concepts with their text replaced by placeholders, to share a reproduction
+of a problem without business data.
+
+The words, numbers, parameters, table data and tags are replaced, the same word always by the same placeholder.
+The structure of the specs, the steps they share and how they parse are kept. Special parameters and external
+data tables are kept as they are, as they refer to files the specs need.`,
+		Example: `  gauge anonymize specs/
+  gauge anonymize --out sanitized/ specs/`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			results, err := anonymizer.AnonymizeProject(getSpecsDir(args), anonymizeOut)
+			if err != nil {
+				exit(err, "")
+			}
+			if parser.HandleParseResult(results...) {
+				exit(fmt.Errorf("Some specs or concepts failed to parse and were not anonymized"), "")
+			}
+			logger.Infof(true, "Anonymized specs and concepts are written to %s", anonymizeOut)
+		},
+		DisableAutoGenTag: true,
+	}
+	anonymizeOut string
+)
+
+func init() {
+	GaugeCmd.AddCommand(anonymizeCmd)
+	anonymizeCmd.Flags().StringVarP(&anonymizeOut, "out", "o", anonymizeOutDefault, "Directory to write the anonymized specs and concepts to")
+}
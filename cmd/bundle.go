@@ -0,0 +1,65 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/plugin/install"
+	"github.com/spf13/cobra"
+)
+
+var (
+	bundleCmd = &cobra.Command{
+		Use:   "bundle [command]",
+		Short: "Package plugins to install them without network access",
+		Long: `Package the language runner and plugins of the project's 'manifest.json' file into one archive, which
+'gauge install --from-bundle' installs on machines without network access.`,
+		DisableAutoGenTag: true,
+	}
+	bundleCreateCmd = &cobra.Command{
+		Use:   "create [flags]",
+		Short: "Create a bundle of the plugins of the project",
+		Long: `Download the latest compatible versions of the language runner and plugins of the project's 'manifest.json'
+file for an OS and architecture, and package them into one archive.`,
+		Example: `  gauge bundle create
+  gauge bundle create --os windows --arch amd64 -o plugins.zip`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			m, err := manifest.ProjectManifest()
+			if err != nil {
+				exit(err, "")
+			}
+			archive := bundleOutput
+			if archive == "" {
+				archive = fmt.Sprintf("gauge-bundle-%s-%s.zip", bundleOS, bundleArch)
+			}
+			if err := install.CreateBundle(m, bundleOS, bundleArch, archive); err != nil {
+				exit(err, "")
+			}
+			logger.Infof(true, "Created bundle %s", archive)
+		},
+		DisableAutoGenTag: true,
+	}
+	bundleOS     string
+	bundleArch   string
+	bundleOutput string
+)
+
+func init() {
+	GaugeCmd.AddCommand(bundleCmd)
+	bundleCmd.AddCommand(bundleCreateCmd)
+	bundleCreateCmd.Flags().StringVarP(&bundleOS, "os", "", runtime.GOOS, "OS of the machines to install the bundle on: linux, darwin or windows")
+	bundleCreateCmd.Flags().StringVarP(&bundleArch, "arch", "", runtime.GOARCH, "Architecture of the machines to install the bundle on: amd64, 386 or arm64")
+	bundleCreateCmd.Flags().StringVarP(&bundleOutput, "output", "o", "", "Path of the bundle. Defaults to gauge-bundle-<os>-<arch>.zip")
+}
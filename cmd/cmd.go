@@ -7,20 +7,26 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution"
 	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/order"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/reporter"
 	"github.com/getgauge/gauge/skel"
+	"github.com/getgauge/gauge/track"
 	"github.com/getgauge/gauge/util"
 	"github.com/getgauge/gauge/validation"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const (
@@ -58,6 +64,7 @@ var (
 			skel.CreateSkelFilesIfRequired()
 			setGlobalFlags()
 			initPackageFlags()
+			track.Command(cmd.CommandPath(), changedFlags(cmd))
 		},
 	}
 	logLevel        string
@@ -128,6 +135,15 @@ func getSpecsDir(args []string) []string {
 	return util.GetSpecDirs()
 }
 
+// changedFlags gives the names of the flags set on the command line, their values are left out
+func changedFlags(cmd *cobra.Command) []string {
+	var names []string
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		names = append(names, f.Name)
+	})
+	return names
+}
+
 func setGlobalFlags() {
 	util.SetWorkingDir(dir)
 }
@@ -159,8 +175,38 @@ func initPackageFlags() {
 		execution.Strategy = execution.Eager
 	}
 	filter.ScenariosName = scenarios
+	filter.SkipDeprecated = skipDeprecated
+	filter.IncludeWIP = includeWIP
+	filter.MaxPriority = maxPriority
+	parser.GithubAnnotations = githubAnnotations
+	parser.StrictMode = strict
+	execution.GithubAnnotations = githubAnnotations
+	parser.PriorityOrder = priorityOrder
+	execution.ReportFormats = reportFormats
 	execution.MaxRetriesCount = maxRetriesCount
 	execution.RetryOnlyTags = retryOnlyTags
+	execution.RetryInfraFailures = retryInfraFailures
+	execution.SkipStepTags = skipStepTags
+	execution.MaxFailures = maxFailures
+}
+
+// interruptContext gives a context which is cancelled when gauge is interrupted, like with Ctrl-C or SIGTERM, so
+// that the run stops gracefully rather than leaving the runner and plugins running. Interrupting again stops gauge
+// at once.
+func interruptContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-c:
+			logger.Infof(true, "Interrupted, finishing the running scenarios. Interrupt again to stop at once.")
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(c)
+	}()
+	return ctx, cancel
 }
 
 var exit = func(err error, additionalText string) {
@@ -189,7 +235,7 @@ func ensureScreenshotsDir() {
 		logger.Warningf(true, "Could not create %s.  %s", env.GaugeScreenshotsDir, err.Error())
 		return
 	}
-	err = os.MkdirAll(screenshotDirPath, 0750)
+	err = os.MkdirAll(util.LongPath(screenshotDirPath), 0750)
 	if err != nil {
 		logger.Warningf(true, "Could not create %s %s", env.GaugeScreenshotsDir, err.Error())
 	} else {
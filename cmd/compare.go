@@ -0,0 +1,84 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/getgauge/gauge/compare"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/spf13/cobra"
+)
+
+var (
+	compareCmd = &cobra.Command{
+		Use:   "compare [flags] [args]",
+		Short: "Compare the results of an external test suite with the scenarios of a gauge project",
+		Long: `Compare the results of an external test suite with the scenarios of a gauge project.
+
+Test cases are matched to scenarios by stable ID, or else by heading. The results of matched test cases are
+compared with the last gauge run, when its failure summary is available.`,
+		Example: "  gauge compare --junit build/test-results/TEST-suite.xml specs/",
+		Run: func(cmd *cobra.Command, args []string) {
+			if junitFile == "" {
+				exit(fmt.Errorf("Missing flag, specify the JUnit report to compare with --junit"), cmd.UsageString())
+			}
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			content, err := ioutil.ReadFile(junitFile)
+			if err != nil {
+				exit(fmt.Errorf("Unable to read %s. %s", junitFile, err.Error()), "")
+			}
+			cases, err := compare.ParseJUnit(content)
+			if err != nil {
+				exit(err, "")
+			}
+			filter.IncludeWIP = true
+			specs, failed := parser.ParseSpecs(getSpecsDir(args), gauge.NewConceptDictionary(), gauge.NewBuildErrors())
+			if failed {
+				return
+			}
+			result := compare.Compare(specs, cases, lastRunResult())
+			result.Print(os.Stdout, countScenarios(specs))
+		},
+		DisableAutoGenTag: true,
+	}
+	junitFile string
+)
+
+func init() {
+	GaugeCmd.AddCommand(compareCmd)
+	compareCmd.Flags().StringVarP(&junitFile, "junit", "", "", "JUnit XML report of the external test suite")
+}
+
+func lastRunResult() func(*compare.Scenario) (bool, bool) {
+	failures, err := execution.LastRunFailures()
+	if err != nil {
+		logger.Debugf(true, "Results will not be compared, the last run is unknown. %s", err.Error())
+		return func(*compare.Scenario) (bool, bool) { return false, false }
+	}
+	return func(s *compare.Scenario) (bool, bool) {
+		return failures[s.Spec.FileName][s.Scenario.Heading.Value], true
+	}
+}
+
+func countScenarios(specs []*gauge.Specification) int {
+	n := 0
+	for _, s := range specs {
+		n += len(s.Scenarios)
+	}
+	return n
+}
@@ -16,10 +16,11 @@ import (
 
 var (
 	configCmd = &cobra.Command{
-		Use:     "config [flags] [args]",
-		Short:   "Change global configurations",
-		Long:    `Change global configurations.`,
-		Example: `  gauge config check_updates false`,
+		Use:   "config [flags] [args]",
+		Short: "Change global configurations",
+		Long:  `Change global configurations.`,
+		Example: `  gauge config check_updates false
+  gauge config update_channel beta`,
 		Run: func(cmd *cobra.Command, args []string) {
 			if list || machineReadable {
 				text, err := config.List(machineReadable)
@@ -14,6 +14,7 @@ import (
 	"github.com/getgauge/gauge/api/lang"
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/metrics"
 	"github.com/getgauge/gauge/util"
 	"github.com/spf13/cobra"
 )
@@ -24,9 +25,14 @@ const (
 
 var (
 	daemonCmd = &cobra.Command{
-		Use:     "daemon [flags] <port> [args]",
-		Short:   "Run as a daemon",
-		Long:    `Run as a daemon.`,
+		Use:   "daemon [flags] <port> [args]",
+		Short: "Run as a daemon",
+		Long: `Run as a daemon.
+
+Several clients, like IDEs, can connect to the daemon at the same time. They share the specs and concepts it parsed,
+and take turns for the runners it starts for them, which are at most gauge_daemon_max_runners at a time. Each client
+has its own log, api-client-<id>.log in the logs directory, and its own environment, which its runners start with on
+top of the environment the daemon loaded. gauge_daemon_client gives them the id of the client.`,
 		Example: "  gauge daemon 1234",
 		Run: func(cmd *cobra.Command, args []string) {
 			err := os.Setenv(isDaemon, "true")
@@ -37,6 +43,10 @@ var (
 				exit(err, cmd.UsageString())
 			}
 			loadEnvAndReinitLogger(cmd)
+			if metricsPort > 0 {
+				metrics.Enable()
+				go serveMetrics(metricsPort)
+			}
 			if lsp {
 				lang.Start(&infoGatherer.SpecInfoGatherer{SpecDirs: getSpecsDir(args)}, logLevel)
 				return
@@ -52,7 +62,8 @@ var (
 		PersistentPostRun: func(cmd *cobra.Command, args []string) { /* noop */ },
 		DisableAutoGenTag: true,
 	}
-	lsp bool
+	lsp         bool
+	metricsPort int
 )
 
 func init() {
@@ -62,4 +73,12 @@ func init() {
 	if err != nil {
 		logger.Fatalf(true, "Unable to hide `--lsp` flag: %s", err.Error())
 	}
+	daemonCmd.Flags().IntVarP(&metricsPort, "metrics-port", "", 0, "Serve Prometheus metrics at /metrics on the given port")
+}
+
+func serveMetrics(port int) {
+	logger.Infof(true, "Serving metrics on port %d at %s", port, metrics.Path)
+	if err := metrics.Serve(port); err != nil {
+		logger.Errorf(true, "Unable to serve metrics. %s", err.Error())
+	}
 }
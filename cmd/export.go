@@ -0,0 +1,64 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/formatter"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/spf13/cobra"
+)
+
+const (
+	exportFormatGherkin = "gherkin"
+	exportOutDefault    = "features"
+)
+
+var (
+	exportCmd = &cobra.Command{
+		Use:   "export [flags] [args]",
+		Short: "Write a copy of the specs in another format, like Gherkin feature files",
+		Long: `Write a copy of the specs in another format, to share them with tools which do not read specs.
+
+The gherkin format writes a feature file per spec. The contexts are the background of the feature and the teardown
+steps end every scenario. Scenarios run for the rows of data tables are scenario outlines with these rows as examples.
+Concepts are replaced by their steps, or written as steps with --flatten-concepts=false.`,
+		Example: `  gauge export --format gherkin specs/
+  gauge export --format gherkin --out cucumber/ --flatten-concepts=false specs/`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			if exportFormat != exportFormatGherkin {
+				exit(fmt.Errorf("Unsupported export format '%s', supported formats are: %s", exportFormat, exportFormatGherkin), cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			results, err := formatter.ExportGherkin(getSpecsDir(args), exportOut, flattenConcepts)
+			if err != nil {
+				exit(err, "")
+			}
+			if parser.HandleParseResult(results...) {
+				exit(fmt.Errorf("Some specs or concepts failed to parse and were not exported"), "")
+			}
+			logger.Infof(true, "Exported specs are written to %s", exportOut)
+		},
+		DisableAutoGenTag: true,
+	}
+	exportFormat    string
+	exportOut       string
+	flattenConcepts bool
+)
+
+func init() {
+	GaugeCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVarP(&exportFormat, "format", "f", exportFormatGherkin, "Format to export the specs to. Supported: gherkin")
+	exportCmd.Flags().StringVarP(&exportOut, "out", "o", exportOutDefault, "Directory to write the exported specs to")
+	exportCmd.Flags().BoolVarP(&flattenConcepts, "flatten-concepts", "", true, "Write the steps of concepts in place of the concepts")
+}
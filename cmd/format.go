@@ -13,20 +13,40 @@ import (
 )
 
 var formatCmd = &cobra.Command{
-	Use:     "format [flags] [args]",
-	Short:   "Formats the specified spec files",
-	Long:    `Formats the specified spec files.`,
-	Example: "  gauge format specs/",
+	Use:   "format [flags] [args]",
+	Short: "Formats the specified spec files",
+	Long: `Formats the specified spec files.
+
+The tables, heading underlines and tag lines of the spec files are rewritten, keeping their comments.
+With --check, the files are left as they are and the command exits with an error if any is not formatted.`,
+	Example: `  gauge format specs/
+  gauge format --check specs/
+  gauge format --sort-scenarios=priority specs/`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := config.SetProjectRoot(args); err != nil {
 			exit(err, cmd.UsageString())
 		}
+		if err := formatter.ValidateScenarioOrder(sortScenarios); err != nil {
+			exit(err, cmd.UsageString())
+		}
 		loadEnvAndReinitLogger(cmd)
+		formatter.NormalizeTags = normalizeTags
+		formatter.SortScenarios = sortScenarios
+		formatter.Check = formatCheck
 		formatter.FormatSpecFilesIn(getSpecsDir(args)[0])
 	},
 	DisableAutoGenTag: true,
 }
 
+var (
+	normalizeTags bool
+	sortScenarios string
+	formatCheck   bool
+)
+
 func init() {
 	GaugeCmd.AddCommand(formatCmd)
+	formatCmd.Flags().BoolVarP(&normalizeTags, "normalize-tags", "", false, "Sort and deduplicate tags, listing priority tags first, and use the casing declared in tags.yaml")
+	formatCmd.Flags().BoolVarP(&formatCheck, "check", "", false, "Report the spec files which are not formatted, without rewriting them, and exit with an error if there are any")
+	formatCmd.Flags().StringVarP(&sortScenarios, "sort-scenarios", "", "", "Reorder the scenarios of each spec by priority or name. Priority follows the priority tags, in the order the scenarios are executed")
 }
@@ -0,0 +1,65 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/graph"
+	"github.com/getgauge/gauge/parser"
+	"github.com/spf13/cobra"
+)
+
+var (
+	graphCmd = &cobra.Command{
+		Use:   "graph [flags] [args]",
+		Short: "Print the graph of which specs use which concepts and which concepts nest others",
+		Long: `Print the graph of which specs use which concepts and which concepts nest others, in the DOT language
+of Graphviz or as JSON.
+
+Each concept node tells how many specs and concepts use it and how deeply it nests other concepts, to find the
+concepts used the most, the ones not used at all and the deeply nested ones. Concepts which are not used are
+dashed in the DOT graph.`,
+		Example: `  gauge graph specs/ | dot -Tsvg -o concepts.svg
+  gauge graph --json specs/`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			filter.IncludeWIP = true
+			dict, res, err := parser.ParseConcepts()
+			if err != nil {
+				exit(err, "")
+			}
+			if !res.Ok {
+				os.Exit(1)
+			}
+			specs, failed := parser.ParseSpecs(getSpecsDir(args), dict, gauge.NewBuildErrors())
+			if failed {
+				os.Exit(1)
+			}
+			g := graph.New(specs, dict)
+			if graphJSONFlag {
+				printJSON(g)
+				return
+			}
+			fmt.Print(g.DOT())
+		},
+		DisableAutoGenTag: true,
+	}
+	graphJSONFlag bool
+)
+
+func init() {
+	GaugeCmd.AddCommand(graphCmd)
+	graphCmd.Flags().BoolVarP(&graphJSONFlag, "json", "", false, "Print the graph as JSON instead of DOT")
+}
@@ -7,6 +7,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/plugin/install"
@@ -17,11 +19,25 @@ var (
 	installCmd = &cobra.Command{
 		Use:   "install [flags] [plugin]",
 		Short: "Download and install plugin(s)",
-		Long:  `Download and install specified plugin or all plugins in the project's 'manifest.json' file.`,
+		Long: `Download and install specified plugin or all plugins in the project's 'manifest.json' file.
+
+Unless a version is given, the latest version on the update channel set by update_channel in gauge.properties is
+installed: stable, beta to get pre-releases as well, or nightly to get every build.`,
 		Example: `  gauge install
   gauge install java
-  gauge install java -f gauge-java-0.6.3-darwin.x86_64.zip`,
+  gauge install java --latest
+  gauge install java -f gauge-java-0.6.3-darwin.x86_64.zip
+  gauge install --from-bundle gauge-bundle-linux-amd64.zip`,
 		Run: func(cmd *cobra.Command, args []string) {
+			if latest && pVersion != "" {
+				exit(fmt.Errorf("--latest and --version can not be used together"), cmd.UsageString())
+			}
+			if bundle != "" {
+				if err := install.InstallFromBundle(bundle); err != nil {
+					exit(err, "")
+				}
+				return
+			}
 			if len(args) < 1 {
 				install.AllPlugins(machineReadable, false)
 				return
@@ -41,10 +57,14 @@ var (
 	}
 	zip      string
 	pVersion string
+	latest   bool
+	bundle   string
 )
 
 func init() {
 	GaugeCmd.AddCommand(installCmd)
 	installCmd.Flags().StringVarP(&zip, "file", "f", "", "Installs the plugin from zip file")
 	installCmd.Flags().StringVarP(&pVersion, "version", "v", "", "Version of plugin to be installed")
+	installCmd.Flags().BoolVarP(&latest, "latest", "", false, "Install the latest version on the configured update channel")
+	installCmd.Flags().StringVarP(&bundle, "from-bundle", "", "", "Installs the plugins of a bundle created by gauge bundle create")
 }
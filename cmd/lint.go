@@ -0,0 +1,68 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/lint"
+	"github.com/getgauge/gauge/parser"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintCmd = &cobra.Command{
+		Use:   "lint [flags] [args]",
+		Short: "Check the specs of a gauge project against style and maintainability rules",
+		Long: fmt.Sprintf(`Check the specs of a gauge project against style and maintainability rules.
+
+The built-in rules are %s. The gauge-lint.yaml file of the project sets their severities, turns them off
+and sets their options. Spelling is checked against the word lists set in gauge_lint_dictionaries
+and the approved terms set in gauge_lint_glossary. Projects check rules of their own with the command set in
+gauge_lint_command.
+Exits with an error if any finding is an error.`, strings.Join(lint.Rules(), ", ")),
+		Example: `  gauge lint specs/
+  gauge lint --json specs/`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			filter.IncludeWIP = true
+			specs, failed := parser.ParseSpecs(getSpecsDir(args), gauge.NewConceptDictionary(), gauge.NewBuildErrors())
+			if failed {
+				os.Exit(1)
+			}
+			findings, err := lint.Lint(specs)
+			if err != nil {
+				exit(err, "")
+			}
+			if lintJSONFlag {
+				printJSON(findings)
+			} else {
+				for _, f := range findings {
+					fmt.Println(f.String())
+				}
+			}
+			if lint.HasErrors(findings) {
+				os.Exit(1)
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+	lintJSONFlag bool
+)
+
+func init() {
+	GaugeCmd.AddCommand(lintCmd)
+	lintCmd.Flags().BoolVarP(&lintJSONFlag, "json", "", false, "Print the findings as JSON")
+}
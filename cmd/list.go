@@ -7,6 +7,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	supersort "sort"
 
@@ -16,24 +17,31 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/tagRegistry"
 	"github.com/spf13/cobra"
 )
 
 var (
 	listCmd = &cobra.Command{
-		Use:     "list [flags] [args]",
-		Short:   "List specifications, scenarios or tags for a gauge project",
-		Long:    `List specifications, scenarios or tags for a gauge project`,
-		Example: `  gauge list --tags specs`,
+		Use:   "list [flags] [args]",
+		Short: "List specifications, scenarios or tags for a gauge project",
+		Long:  `List specifications, scenarios or tags for a gauge project`,
+		Example: `  gauge list --tags specs
+  gauge list --json specs`,
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := config.SetProjectRoot(args); err != nil {
 				exit(err, cmd.UsageString())
 			}
 			loadEnvAndReinitLogger(cmd)
+			filter.IncludeWIP = true
 			specs, failed := parser.ParseSpecs(getSpecsDir(args), gauge.NewConceptDictionary(), gauge.NewBuildErrors())
 			if failed {
 				return
 			}
+			if jsonFlag {
+				printJSON(listJSON(specs))
+				return
+			}
 			if specsFlag {
 				logger.Info(true, "[Specifications]")
 				listSpecifications(specs, print)
@@ -44,7 +52,7 @@ var (
 			}
 			if tagsFlag {
 				logger.Info(true, "[Tags]")
-				listTags(specs, print)
+				listTags(specs, describeTags(loadTagRegistry(), print))
 			}
 			if !specsFlag && !scenariosFlag && !tagsFlag {
 				exit(fmt.Errorf("Missing flag, nothing to list"), cmd.UsageString())
@@ -55,6 +63,7 @@ var (
 	tagsFlag      bool
 	specsFlag     bool
 	scenariosFlag bool
+	jsonFlag      bool
 )
 
 func init() {
@@ -62,6 +71,47 @@ func init() {
 	listCmd.Flags().BoolVarP(&tagsFlag, "tags", "", false, "List the tags in projects")
 	listCmd.Flags().BoolVarP(&specsFlag, "specs", "", false, "List the specifications in projects")
 	listCmd.Flags().BoolVarP(&scenariosFlag, "scenarios", "", false, "List the scenarios in projects")
+	listCmd.Flags().BoolVarP(&jsonFlag, "json", "", false, "List the specifications and their scenarios along with tags and annotations as JSON")
+}
+
+type specJSON struct {
+	File        string                 `json:"file"`
+	Heading     string                 `json:"heading"`
+	ID          string                 `json:"id,omitempty"`
+	Tags        []string               `json:"tags"`
+	Annotations gauge.Annotations      `json:"annotations,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Scenarios   []*scenarioJSON        `json:"scenarios"`
+}
+
+type scenarioJSON struct {
+	Heading     string            `json:"heading"`
+	Line        int               `json:"line"`
+	ID          string            `json:"id,omitempty"`
+	Tags        []string          `json:"tags"`
+	Annotations gauge.Annotations `json:"annotations,omitempty"`
+}
+
+func listJSON(specs []*gauge.Specification) []*specJSON {
+	res := make([]*specJSON, 0, len(specs))
+	for _, spec := range specs {
+		s := &specJSON{File: spec.FileName, Heading: spec.Heading.Value, ID: spec.ID, Tags: appendTags([]string{}, spec.Tags), Annotations: spec.Annotations, Metadata: spec.Metadata, Scenarios: make([]*scenarioJSON, 0)}
+		for _, scn := range spec.Scenarios {
+			s.Scenarios = append(s.Scenarios, &scenarioJSON{Heading: scn.Heading.Value, Line: scn.Heading.LineNo, ID: scn.ID, Tags: appendTags([]string{}, scn.Tags), Annotations: scn.Annotations})
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+func printJSON(v interface{}) {
+	b, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal the list as JSON. %s", err.Error())
+		return
+	}
+	// the logger can not be used, since it wraps the output in a JSON message of its own
+	fmt.Println(string(b))
 }
 
 type handleResult func([]string)
@@ -83,6 +133,37 @@ func listTags(s []*gauge.Specification, f handleResult) {
 	f(sortedDistinctElements(allTags))
 }
 
+func loadTagRegistry() *tagRegistry.Registry {
+	r, err := tagRegistry.Load(config.ProjectRoot)
+	if err != nil {
+		logger.Warningf(true, err.Error())
+	}
+	return r
+}
+
+// describeTags adds the description and deprecation status from the tag registry to every tag
+func describeTags(r *tagRegistry.Registry, f handleResult) handleResult {
+	return func(tags []string) {
+		var res []string
+		for _, tag := range tags {
+			t, ok := r.Get(tag)
+			if !ok {
+				res = append(res, tag)
+				continue
+			}
+			s := tag
+			if t.Description != "" {
+				s = fmt.Sprintf("%s - %s", s, t.Description)
+			}
+			if t.Deprecated {
+				s = fmt.Sprintf("%s (deprecated)", s)
+			}
+			res = append(res, s)
+		}
+		f(res)
+	}
+}
+
 func listScenarios(s []*gauge.Specification, f handleResult) {
 	allScenarios := filter.GetAllScenarios(s)
 	f(sortedDistinctElements(allScenarios))
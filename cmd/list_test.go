@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/tagRegistry"
 )
 
 func TestOnlyUniqueTagsAreReturned(t *testing.T) {
@@ -79,3 +80,49 @@ func verifyUniqueness(actual []string, wanted []string, t *testing.T) {
 		t.Errorf("wanted: `%s`,\n got: `%s` ", wanted, actual)
 	}
 }
+
+func TestTagsAreDescribedFromRegistry(t *testing.T) {
+	r, err := tagRegistry.Parse([]byte("tags:\n  - name: foo\n    description: Foo tests\n  - name: bar\n    deprecated: true\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	describeTags(r, func(res []string) {
+		want := []string{"foo - Foo tests", "bar (deprecated)", "baz"}
+		if !reflect.DeepEqual(res, want) {
+			t.Errorf("Expected %v. Got %v", want, res)
+		}
+	})([]string{"foo", "bar", "baz"})
+}
+
+func TestListJSON(t *testing.T) {
+	spec := &gauge.Specification{
+		FileName:    "specs/foo.spec",
+		Heading:     &gauge.Heading{Value: "Spec1"},
+		Annotations: gauge.Annotations{"owner": {"payments"}},
+		Scenarios: []*gauge.Scenario{{
+			Heading:     &gauge.Heading{Value: "scenario1", LineNo: 4},
+			ID:          "CHK-102",
+			Tags:        &gauge.Tags{RawValues: [][]string{{"foo"}}},
+			Annotations: gauge.Annotations{"jira": {"CHK-102"}},
+		}},
+	}
+
+	got := listJSON([]*gauge.Specification{spec})
+
+	want := []*specJSON{{
+		File:        "specs/foo.spec",
+		Heading:     "Spec1",
+		Tags:        []string{},
+		Annotations: gauge.Annotations{"owner": {"payments"}},
+		Scenarios: []*scenarioJSON{{
+			Heading:     "scenario1",
+			Line:        4,
+			ID:          "CHK-102",
+			Tags:        []string{"foo"},
+			Annotations: gauge.Annotations{"jira": {"CHK-102"}},
+		}},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want[0].Scenarios[0], got[0].Scenarios[0])
+	}
+}
@@ -0,0 +1,371 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/spf13/cobra"
+)
+
+var (
+	parseCmd = &cobra.Command{
+		Use:   "parse [flags] <file>...",
+		Short: "Print the parsed specs and concepts of files",
+		Long: `Print the specs and concepts parsed from spec and concept files, with their headings, scenarios, steps,
+args, tables, tags, line spans and priorities. Steps using concepts are resolved with the concepts of the project.
+With --json, tools can analyze specs without linking the parser.`,
+		Example: `  gauge parse specs/example.spec
+  gauge parse --json specs/example.spec concepts/login.cpt`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			dictionary, res, err := parser.CreateConceptsDictionary()
+			if err != nil {
+				exit(err, "")
+			}
+			for _, e := range res.ParseErrors {
+				logger.Error(true, e.Error())
+			}
+			var files []*parsedFileJSON
+			failed := false
+			for _, file := range args {
+				text, err := common.ReadFileContents(util.LongPath(file))
+				if err != nil {
+					exit(err, "")
+				}
+				f := parseFile(text, file, dictionary)
+				for _, e := range f.Errors {
+					logger.Errorf(true, "%s:%d %s", file, e.Line, e.Message)
+					failed = true
+				}
+				files = append(files, f)
+			}
+			if parseJSONFlag {
+				printJSON(files)
+			} else {
+				for _, f := range files {
+					printParsedFile(f)
+				}
+			}
+			if failed {
+				exit(fmt.Errorf("Failed to parse some files"), "")
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+	parseJSONFlag bool
+)
+
+func init() {
+	GaugeCmd.AddCommand(parseCmd)
+	parseCmd.Flags().BoolVarP(&parseJSONFlag, "json", "", false, "Print the parsed specs and concepts as JSON")
+}
+
+type parsedFileJSON struct {
+	File     string               `json:"file"`
+	Spec     *parsedSpecJSON      `json:"spec,omitempty"`
+	Concepts []*parsedStepJSON    `json:"concepts,omitempty"`
+	Errors   []*parsedMessageJSON `json:"errors"`
+	Warnings []*parsedMessageJSON `json:"warnings"`
+}
+
+type parsedMessageJSON struct {
+	Line    int    `json:"line"`
+	LineEnd int    `json:"lineEnd"`
+	Message string `json:"message"`
+}
+
+type parsedHeadingJSON struct {
+	Value   string `json:"value"`
+	Line    int    `json:"line"`
+	LineEnd int    `json:"lineEnd"`
+}
+
+type parsedSpecJSON struct {
+	Heading       *parsedHeadingJSON     `json:"heading"`
+	ID            string                 `json:"id,omitempty"`
+	Tags          []string               `json:"tags"`
+	Annotations   gauge.Annotations      `json:"annotations,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+	Comments      []string               `json:"comments"`
+	DataTable     *parsedDataTableJSON   `json:"dataTable,omitempty"`
+	Contexts      []*parsedStepJSON      `json:"contexts"`
+	Scenarios     []*parsedScenarioJSON  `json:"scenarios"`
+	TearDownSteps []*parsedStepJSON      `json:"tearDownSteps"`
+	Deprecated    bool                   `json:"deprecated"`
+	Deprecation   string                 `json:"deprecation,omitempty"`
+	WIP           bool                   `json:"wip"`
+}
+
+type parsedScenarioJSON struct {
+	Heading       *parsedHeadingJSON   `json:"heading"`
+	ID            string               `json:"id,omitempty"`
+	Line          int                  `json:"line"`
+	LineEnd       int                  `json:"lineEnd"`
+	Priority      *int                 `json:"priority,omitempty"`
+	Tags          []string             `json:"tags"`
+	Annotations   gauge.Annotations    `json:"annotations,omitempty"`
+	Comments      []string             `json:"comments"`
+	DataTable     *parsedDataTableJSON `json:"dataTable,omitempty"`
+	Steps         []*parsedStepJSON    `json:"steps"`
+	TearDownSteps []*parsedStepJSON    `json:"tearDownSteps"`
+	Deprecated    bool                 `json:"deprecated"`
+	Deprecation   string               `json:"deprecation,omitempty"`
+	WIP           bool                 `json:"wip"`
+}
+
+type parsedStepJSON struct {
+	Text      string               `json:"text"`
+	Value     string               `json:"value"`
+	Line      int                  `json:"line"`
+	LineEnd   int                  `json:"lineEnd"`
+	Args      []*parsedArgJSON     `json:"args"`
+	IsConcept bool                 `json:"isConcept"`
+	Namespace string               `json:"namespace,omitempty"`
+	Tags      []string             `json:"tags"`
+	DataTable *parsedDataTableJSON `json:"dataTable,omitempty"`
+	Steps     []*parsedStepJSON    `json:"steps,omitempty"`
+}
+
+type parsedArgJSON struct {
+	Type      string           `json:"type"`
+	Name      string           `json:"name,omitempty"`
+	Value     string           `json:"value"`
+	ParamType string           `json:"paramType,omitempty"`
+	Table     *parsedTableJSON `json:"table,omitempty"`
+}
+
+type parsedDataTableJSON struct {
+	Line     int              `json:"line"`
+	External bool             `json:"external"`
+	Source   string           `json:"source,omitempty"`
+	Table    *parsedTableJSON `json:"table"`
+}
+
+type parsedTableJSON struct {
+	Line    int        `json:"line"`
+	Headers []string   `json:"headers"`
+	Rows    [][]string `json:"rows"`
+}
+
+// parseFile parses a concept file into its concepts, and any other file into its spec, resolving the concepts its
+// steps use with the dictionary
+func parseFile(text, file string, dictionary *gauge.ConceptDictionary) *parsedFileJSON {
+	f := &parsedFileJSON{File: file, Errors: []*parsedMessageJSON{}, Warnings: []*parsedMessageJSON{}}
+	var res *parser.ParseResult
+	if util.IsConcept(file) {
+		var concepts []*gauge.Step
+		concepts, res = new(parser.ConceptParser).Parse(text, file)
+		f.Concepts = newStepsJSON(concepts)
+	} else {
+		spec, r, err := new(parser.SpecParser).Parse(text, dictionary, file)
+		if err != nil {
+			f.Errors = append(f.Errors, &parsedMessageJSON{Message: err.Error()})
+		}
+		res = r
+		if spec != nil {
+			f.Spec = newSpecJSON(spec)
+		}
+	}
+	if res == nil {
+		return f
+	}
+	for _, e := range res.ParseErrors {
+		f.Errors = append(f.Errors, &parsedMessageJSON{Line: e.LineNo, LineEnd: lineEnd(e.LineNo, e.SpanEnd), Message: e.Message})
+	}
+	for _, w := range res.Warnings {
+		f.Warnings = append(f.Warnings, &parsedMessageJSON{Line: w.LineNo, LineEnd: lineEnd(w.LineNo, w.LineSpanEnd), Message: w.Message})
+	}
+	return f
+}
+
+func newSpecJSON(spec *gauge.Specification) *parsedSpecJSON {
+	s := &parsedSpecJSON{
+		ID:            spec.ID,
+		Tags:          tagValues(spec.Tags),
+		Annotations:   spec.Annotations,
+		Metadata:      spec.Metadata,
+		Comments:      commentValues(spec.Comments),
+		DataTable:     newDataTableJSON(&spec.DataTable),
+		Contexts:      newStepsJSON(spec.Contexts),
+		Scenarios:     make([]*parsedScenarioJSON, 0, len(spec.Scenarios)),
+		TearDownSteps: newStepsJSON(spec.TearDownSteps),
+		WIP:           spec.WIP,
+	}
+	s.Deprecated, s.Deprecation = deprecation(spec.Deprecation)
+	if spec.Heading != nil {
+		s.Heading = &parsedHeadingJSON{Value: spec.Heading.Value, Line: spec.Heading.LineNo, LineEnd: lineEnd(spec.Heading.LineNo, spec.Heading.SpanEnd)}
+	}
+	for _, scenario := range spec.Scenarios {
+		s.Scenarios = append(s.Scenarios, newScenarioJSON(scenario))
+	}
+	return s
+}
+
+func newScenarioJSON(scenario *gauge.Scenario) *parsedScenarioJSON {
+	s := &parsedScenarioJSON{
+		ID:            scenario.ID,
+		Tags:          tagValues(scenario.Tags),
+		Annotations:   scenario.Annotations,
+		Comments:      commentValues(scenario.Comments),
+		DataTable:     newDataTableJSON(&scenario.DataTable),
+		Steps:         newStepsJSON(scenario.Steps),
+		TearDownSteps: newStepsJSON(scenario.TearDownSteps),
+		WIP:           scenario.WIP,
+	}
+	s.Deprecated, s.Deprecation = deprecation(scenario.Deprecation)
+	if scenario.Heading != nil {
+		s.Heading = &parsedHeadingJSON{Value: scenario.Heading.Value, Line: scenario.Heading.LineNo, LineEnd: lineEnd(scenario.Heading.LineNo, scenario.Heading.SpanEnd)}
+	}
+	if scenario.Span != nil {
+		s.Line, s.LineEnd = scenario.Span.Start, scenario.Span.End
+	}
+	if scenario.Priority != nil {
+		p := int(*scenario.Priority)
+		s.Priority = &p
+	}
+	return s
+}
+
+func newStepsJSON(steps []*gauge.Step) []*parsedStepJSON {
+	s := make([]*parsedStepJSON, 0, len(steps))
+	for _, step := range steps {
+		s = append(s, newStepJSON(step))
+	}
+	return s
+}
+
+func newStepJSON(step *gauge.Step) *parsedStepJSON {
+	s := &parsedStepJSON{
+		Text:      step.LineText,
+		Value:     step.Value,
+		Line:      step.LineNo,
+		LineEnd:   lineEnd(step.LineNo, step.LineSpanEnd),
+		Args:      make([]*parsedArgJSON, 0, len(step.Args)),
+		IsConcept: step.IsConcept,
+		Namespace: step.Namespace,
+		Tags:      tagValues(step.Tags),
+		DataTable: newDataTableJSON(step.DataTable),
+	}
+	for _, arg := range step.Args {
+		a := &parsedArgJSON{Type: string(arg.ArgType), Name: arg.Name, Value: arg.Value, ParamType: arg.ParamType}
+		if arg.ArgType == gauge.TableArg || arg.ArgType == gauge.SpecialTable {
+			a.Table = newTableJSON(&arg.Table)
+		}
+		s.Args = append(s.Args, a)
+	}
+	if step.IsConcept {
+		s.Steps = newStepsJSON(step.ConceptSteps)
+	}
+	return s
+}
+
+func newDataTableJSON(dataTable *gauge.DataTable) *parsedDataTableJSON {
+	if dataTable == nil || !dataTable.IsInitialized() {
+		return nil
+	}
+	return &parsedDataTableJSON{Line: dataTable.LineNo, External: dataTable.IsExternal, Source: dataTable.Value, Table: newTableJSON(dataTable.Table)}
+}
+
+func newTableJSON(table *gauge.Table) *parsedTableJSON {
+	t := &parsedTableJSON{Headers: []string{}, Rows: [][]string{}}
+	if table == nil || !table.IsInitialized() {
+		return t
+	}
+	t.Line = table.LineNo
+	t.Headers = append(t.Headers, table.Headers...)
+	t.Rows = append(t.Rows, table.Rows()...)
+	return t
+}
+
+func tagValues(tags *gauge.Tags) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return append([]string{}, tags.Values()...)
+}
+
+func commentValues(comments []*gauge.Comment) []string {
+	values := make([]string, 0, len(comments))
+	for _, c := range comments {
+		values = append(values, c.Value)
+	}
+	return values
+}
+
+func deprecation(d *gauge.Deprecation) (bool, string) {
+	if d == nil {
+		return false, ""
+	}
+	return true, d.Message
+}
+
+func lineEnd(line, end int) int {
+	if end < line {
+		return line
+	}
+	return end
+}
+
+func printParsedFile(f *parsedFileJSON) {
+	logger.Infof(true, "[%s]", f.File)
+	if f.Spec != nil {
+		if f.Spec.Heading != nil {
+			logger.Infof(true, "%d\tspec\t%q%s", f.Spec.Heading.Line, f.Spec.Heading.Value, printedTags(f.Spec.Tags))
+		}
+		printParsedSteps(f.Spec.Contexts, "  ")
+		for _, s := range f.Spec.Scenarios {
+			priority := ""
+			if s.Priority != nil {
+				priority = fmt.Sprintf("\tpriority: %d", *s.Priority)
+			}
+			heading := ""
+			if s.Heading != nil {
+				heading = s.Heading.Value
+			}
+			logger.Infof(true, "%d-%d\tscenario\t%q%s%s", s.Line, s.LineEnd, heading, printedTags(s.Tags), priority)
+			printParsedSteps(s.Steps, "  ")
+			printParsedSteps(s.TearDownSteps, "  ")
+		}
+		printParsedSteps(f.Spec.TearDownSteps, "  ")
+	}
+	for _, c := range f.Concepts {
+		logger.Infof(true, "%d\tconcept\t%q%s", c.Line, c.Text, printedTags(c.Tags))
+		printParsedSteps(c.Steps, "  ")
+	}
+}
+
+func printParsedSteps(steps []*parsedStepJSON, indent string) {
+	for _, s := range steps {
+		kind := "step"
+		if s.IsConcept {
+			kind = "concept"
+		}
+		logger.Infof(true, "%d-%d\t%s%s\t%q", s.Line, s.LineEnd, indent, kind, s.Text)
+		if s.IsConcept {
+			printParsedSteps(s.Steps, indent+"  ")
+		}
+	}
+}
+
+func printedTags(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\ttags: %s", strings.Join(tags, ", "))
+}
@@ -0,0 +1,81 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+const parsedSpec = `# Login
+tags: auth
+
+* Open "home"
+
+## Admin logs in
+tags: admin, priority:2
+* Log in as "admin" with
+   |field|value|
+   |-----|-----|
+   |user |root |
+* Log in as user "bob"
+`
+
+func TestParsedSpecHasItsScenariosStepsArgsAndTables(t *testing.T) {
+	dictionary := gauge.NewConceptDictionary()
+	concepts, _ := new(parser.ConceptParser).Parse("# Log in as user <name>\n* Type <name>\n", "login.cpt")
+	if _, err := parser.AddConcept(concepts, "login.cpt", dictionary); err != nil {
+		t.Fatal(err)
+	}
+
+	f := parseFile(parsedSpec, "login.spec", dictionary)
+
+	if len(f.Errors) != 0 {
+		t.Fatalf("Expected no errors, got %v", f.Errors[0].Message)
+	}
+	if f.Spec.Heading.Value != "Login" || !reflect.DeepEqual(f.Spec.Tags, []string{"auth"}) {
+		t.Errorf("Unexpected spec heading %q and tags %v", f.Spec.Heading.Value, f.Spec.Tags)
+	}
+	if len(f.Spec.Contexts) != 1 || f.Spec.Contexts[0].Value != "Open {}" || f.Spec.Contexts[0].Args[0].Value != "home" {
+		t.Errorf("Unexpected contexts %v", f.Spec.Contexts)
+	}
+	scenario := f.Spec.Scenarios[0]
+	if scenario.Line != 6 || scenario.LineEnd != 12 || scenario.Priority == nil || *scenario.Priority != 2 {
+		t.Errorf("Unexpected scenario span %d-%d or priority %v", scenario.Line, scenario.LineEnd, scenario.Priority)
+	}
+	table := scenario.Steps[0].Args[1].Table
+	if !reflect.DeepEqual(table.Headers, []string{"field", "value"}) || !reflect.DeepEqual(table.Rows, [][]string{{"user", "root"}}) {
+		t.Errorf("Unexpected table %v %v", table.Headers, table.Rows)
+	}
+	step := scenario.Steps[1]
+	if !step.IsConcept || len(step.Steps) != 1 || step.Steps[0].Value != "Type {}" {
+		t.Errorf("Expected the concept step with its steps, got %v", step)
+	}
+}
+
+func TestParsedConceptFileHasItsConcepts(t *testing.T) {
+	f := parseFile("# Log in as user <name>\ntags: login\n* Type <name>\n", "login.cpt", gauge.NewConceptDictionary())
+
+	if len(f.Errors) != 0 || f.Spec != nil || len(f.Concepts) != 1 {
+		t.Fatalf("Expected a concept, got %v errors and %d concepts", len(f.Errors), len(f.Concepts))
+	}
+	concept := f.Concepts[0]
+	if concept.Text != "Log in as user <name>" || !reflect.DeepEqual(concept.Tags, []string{"login"}) || len(concept.Steps) != 1 {
+		t.Errorf("Unexpected concept %q with tags %v and %d steps", concept.Text, concept.Tags, len(concept.Steps))
+	}
+}
+
+func TestParseErrorsOfTheFileAreReported(t *testing.T) {
+	f := parseFile("* Open \"home\"\n", "login.spec", gauge.NewConceptDictionary())
+
+	if len(f.Errors) == 0 || f.Errors[0].Line != 1 {
+		t.Errorf("Expected a parse error on line 1, got %v", f.Errors)
+	}
+}
@@ -9,6 +9,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	gauge "github.com/getgauge/gauge/gauge"
@@ -18,49 +19,71 @@ import (
 	"github.com/getgauge/gauge/execution/rerun"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/plugin/install"
+	"github.com/getgauge/gauge/testPack"
 	"github.com/getgauge/gauge/util"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
 const (
-	verboseDefault         = false
-	simpleConsoleDefault   = false
-	failedDefault          = false
-	repeatDefault          = false
-	parallelDefault        = false
-	sortDefault            = false
-	installPluginsDefault  = true
-	environmentDefault     = "default"
-	tagsDefault            = ""
-	rowsDefault            = ""
-	strategyDefault        = "lazy"
-	onlyDefault            = ""
-	groupDefault           = -1
-	maxRetriesCountDefault = 1
-	retryOnlyTagsDefault   = ""
-	failSafeDefault        = false
-	skipCommandSaveDefault = false
+	verboseDefault           = false
+	simpleConsoleDefault     = false
+	failedDefault            = false
+	repeatDefault            = false
+	resumeDefault            = false
+	parallelDefault          = false
+	sortDefault              = false
+	installPluginsDefault    = true
+	environmentDefault       = "default"
+	tagsDefault              = ""
+	rowsDefault              = ""
+	strategyDefault          = "lazy"
+	onlyDefault              = ""
+	groupDefault             = -1
+	maxRetriesCountDefault   = 1
+	retryOnlyTagsDefault     = ""
+	retryInfraDefault        = false
+	skipStepTagsDefault      = ""
+	maxFailuresDefault       = 0
+	failSafeDefault          = false
+	skipCommandSaveDefault   = false
+	skipDeprecatedDefault    = false
+	includeWIPDefault        = false
+	githubAnnotationsDefault = false
+	strictDefault            = false
+	priorityOrderDefault     = false
+	maxPriorityDefault       = -1
 
-	verboseName         = "verbose"
-	simpleConsoleName   = "simple-console"
-	failedName          = "failed"
-	repeatName          = "repeat"
-	parallelName        = "parallel"
-	sortName            = "sort"
-	installPluginsName  = "install-plugins"
-	environmentName     = "env"
-	tagsName            = "tags"
-	rowsName            = "table-rows"
-	strategyName        = "strategy"
-	groupName           = "group"
-	maxRetriesCountName = "max-retries-count"
-	retryOnlyTagsName   = "retry-only"
-	streamsName         = "n"
-	onlyName            = "only"
-	failSafeName        = "fail-safe"
-	skipCommandSaveName = "skip-save"
-	scenarioName        = "scenario"
+	verboseName           = "verbose"
+	simpleConsoleName     = "simple-console"
+	failedName            = "failed"
+	repeatName            = "repeat"
+	resumeName            = "resume"
+	parallelName          = "parallel"
+	sortName              = "sort"
+	installPluginsName    = "install-plugins"
+	environmentName       = "env"
+	tagsName              = "tags"
+	rowsName              = "table-rows"
+	strategyName          = "strategy"
+	groupName             = "group"
+	maxRetriesCountName   = "max-retries-count"
+	retryOnlyTagsName     = "retry-only"
+	retryInfraName        = "retry-infra-failures"
+	skipStepTagsName      = "skip-steps"
+	maxFailuresName       = "max-failures"
+	streamsName           = "n"
+	onlyName              = "only"
+	failSafeName          = "fail-safe"
+	skipCommandSaveName   = "skip-save"
+	scenarioName          = "scenario"
+	skipDeprecatedName    = "skip-deprecated"
+	includeWIPName        = "include-wip"
+	githubAnnotationsName = "github-annotations"
+	strictName            = "strict"
+	reportFormatsName     = "report-formats"
+	priorityOrderName     = "priority-order"
+	maxPriorityName       = "max-priority"
 )
 
 var overrideRerunFlags = []string{verboseName, simpleConsoleName, machineReadableName, dirName, logLevelName}
@@ -70,11 +93,21 @@ var (
 	runCmd = &cobra.Command{
 		Use:   "run [flags] [args]",
 		Short: "Run specs",
-		Long:  `Run specs.`,
+		Long: `Run specs.
+
+The first argument can be a test pack, a zip of a Gauge project local or at an http(s) URL, which is extracted to
+a temporary workspace and run. The specs which follow are relative to the pack. Reports and logs are written to
+the current directory.`,
 		Example: `  gauge run specs/
-  gauge run --tags "login" -s -p specs/`,
+  gauge run --tags "login" -s -p specs/
+  gauge run specs/example.spec:12:3
+  gauge run specs/example.spec#CHK-102
+  gauge run https://example.com/checkout-specs.zip specs/cart.spec`,
 		Run: func(cmd *cobra.Command, args []string) {
 			logger.Debugf(true, "gauge %s %v", cmd.Name(), strings.Join(args, " "))
+			if len(args) > 0 && testPack.IsTestPack(args[0]) {
+				args = openTestPack(args)
+			}
 			if err := config.SetProjectRoot(args); err != nil {
 				exit(err, "")
 			}
@@ -85,6 +118,8 @@ var (
 				repeatLastExecution(cmd)
 			} else if failed {
 				executeFailed(cmd)
+			} else if resume {
+				resumeLastExecution(cmd)
 			} else {
 				addFlagsToExecutionArgs(cmd.Flags())
 				execute(cmd, args)
@@ -96,6 +131,7 @@ var (
 	simpleConsole              bool
 	failed                     bool
 	repeat                     bool
+	resume                     bool
 	parallel                   bool
 	sort                       bool
 	installPlugins             bool
@@ -107,11 +143,22 @@ var (
 	streams                    int
 	maxRetriesCount            int
 	retryOnlyTags              string
+	retryInfraFailures         bool
+	skipStepTags               string
+	maxFailures                int
 	group                      int
 	failSafe                   bool
 	skipCommandSave            bool
+	skipDeprecated             bool
+	includeWIP                 bool
+	githubAnnotations          bool
+	strict                     bool
+	priorityOrder              bool
+	maxPriority                int
 	scenarios                  []string
 	scenarioNameDefault        []string
+	reportFormats              []string
+	reportFormatsDefault       []string
 )
 
 func init() {
@@ -120,23 +167,27 @@ func init() {
 	f.BoolVarP(&verbose, verboseName, "v", verboseDefault, "Enable step level reporting on console, default being scenario level")
 	f.BoolVarP(&simpleConsole, simpleConsoleName, "", simpleConsoleDefault, "Removes colouring and simplifies the console output")
 	f.StringVarP(&environment, environmentName, "e", environmentDefault, "Specifies the environment to use")
-	f.StringVarP(&tags, tagsName, "t", tagsDefault, "Executes the specs and scenarios tagged with given tags")
-	f.StringVarP(&rows, rowsName, "r", rowsDefault, "Executes the specs and scenarios only for the selected rows. It can be specified by range as 2-4 or as list 2,4")
+	f.StringVarP(&tags, tagsName, "t", tagsDefault, "Executes the specs and scenarios tagged with given tags. Use a trailing * to match tags by prefix, e.g. team:*")
+	f.StringVarP(&rows, rowsName, "r", rowsDefault, "Executes the specs and scenarios only for the selected rows. It can be specified by range as 2-4, as list 2,4 or by the values of columns as \"country=IN & tier!=gold\"")
 	f.BoolVarP(&parallel, parallelName, "p", parallelDefault, "Execute specs in parallel")
 	f.IntVarP(&streams, streamsName, "n", streamsDefault, "Specify number of parallel execution streams")
 	f.IntVarP(&maxRetriesCount, maxRetriesCountName, "c", maxRetriesCountDefault, "Max count of iterations for failed scenario")
 	f.StringVarP(&retryOnlyTags, retryOnlyTagsName, "", retryOnlyTagsDefault, "Retries the specs and scenarios tagged with given tags")
+	f.BoolVarP(&retryInfraFailures, retryInfraName, "", retryInfraDefault, "Retries once, on a fresh runner, the scenarios which failed as the runner died or could not be reached")
+	f.StringVarP(&skipStepTags, skipStepTagsName, "", skipStepTagsDefault, "Skips the steps tagged with given tags, like @slow at the end of a step. Needs allow_step_tags")
+	f.IntVarP(&maxFailures, maxFailuresName, "", maxFailuresDefault, "Skips the scenarios left once this many scenarios have failed. No scenario is skipped when it is 0")
 	f.StringVarP(&tagsToFilterForParallelRun, onlyName, "o", onlyDefault, "Execute only the specs and scenarios tagged with given tags in parallel, rest will be run in serial. Applicable only if run in parallel.")
 	err := f.MarkHidden(onlyName)
 	if err != nil {
 		logger.Errorf(false, fmt.Sprintf("Unable to mark '%s' flag as hidden: %s", onlyName, err.Error()))
 	}
 	f.IntVarP(&group, groupName, "g", groupDefault, "Specify which group of specification to execute based on -n flag")
-	f.StringVarP(&strategy, strategyName, "", strategyDefault, "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`")
+	f.StringVarP(&strategy, strategyName, "", strategyDefault, "Set the parallelization strategy for execution. Possible options are: `eager`, `lazy`, `scenario`")
 	f.BoolVarP(&sort, sortName, "s", sortDefault, "Run specs in Alphabetical Order")
 	f.BoolVarP(&installPlugins, installPluginsName, "i", installPluginsDefault, "Install All Missing Plugins")
 	f.BoolVarP(&failed, failedName, "f", failedDefault, "Run only the scenarios failed in previous run. This cannot be used in conjunction with any other argument")
 	f.BoolVarP(&repeat, repeatName, "", repeatDefault, "Repeat last run. This cannot be used in conjunction with any other argument")
+	f.BoolVarP(&resume, resumeName, "", resumeDefault, "Resume the last run which stopped before its end, running the specs it did not finish. This cannot be used in conjunction with any other argument")
 	f.BoolVarP(&hideSuggestion, hideSuggestionName, "", hideSuggestionDefault, "Hide step implementation stub for every unimplemented step")
 	f.BoolVarP(&failSafe, failSafeName, "", failSafeDefault, "Force return 0 exit code, even in case of failures.")
 	f.BoolVarP(&skipCommandSave, skipCommandSaveName, "", skipCommandSaveDefault, "Skip saving last command in lastRunCmd.json")
@@ -146,6 +197,13 @@ func init() {
 	}
 
 	f.StringArrayVar(&scenarios, scenarioName, scenarioNameDefault, "Set scenarios for running specs with scenario name")
+	f.BoolVarP(&skipDeprecated, skipDeprecatedName, "", skipDeprecatedDefault, "Skip the specs and scenarios marked as deprecated")
+	f.BoolVarP(&includeWIP, includeWIPName, "", includeWIPDefault, "Execute the specs and scenarios marked as work in progress. Their failures do not fail the run")
+	f.BoolVarP(&githubAnnotations, githubAnnotationsName, "", githubAnnotationsDefault, "Print failed scenarios and parse errors as GitHub Actions error annotations")
+	f.BoolVarP(&strict, strictName, "", strictDefault, "Treat the warnings of parsing specs and concepts as errors. Same as strict_parsing")
+	f.BoolVarP(&priorityOrder, priorityOrderName, "", priorityOrderDefault, "Run the scenarios in the order of their priority tags, like priority:1, across specs. Overrides gauge_scenario_priority_ordering")
+	f.IntVarP(&maxPriority, maxPriorityName, "", maxPriorityDefault, "Executes only the scenarios with a priority from 0 to the given level, like 1 for the scenarios tagged priority:0 or priority:1")
+	f.StringSliceVar(&reportFormats, reportFormatsName, reportFormatsDefault, "Write the result of the run in these formats, out of junit, json, tap and allure. Overrides gauge_report_formats")
 }
 
 func executeFailed(cmd *cobra.Command) {
@@ -168,6 +226,21 @@ func executeFailed(cmd *cobra.Command) {
 	}
 }
 
+// resumeLastExecution runs again the last run, which stopped before its end as gauge crashed or was interrupted, with
+// the specs it did not finish
+var resumeLastExecution = func(cmd *cobra.Command) {
+	args, err := execution.ResumeArgs()
+	if err != nil {
+		exit(err, "")
+	}
+	handleFlags(cmd, append([]string{"gauge"}, args...))
+	logger.Debugf(true, "Executing => %s\n", strings.Join(os.Args, " "))
+	err = cmd.Execute()
+	if err != nil {
+		logger.Errorf(true, fmt.Sprintf("Unable to execute command %s: %s", cmd.Name(), err.Error()))
+	}
+}
+
 func handleFlags(cmd *cobra.Command, args []string) {
 	cmd.Flags().Visit(func(flag *pflag.Flag) {
 		if !util.ListContains(overrideRerunFlags, flag.Name) && flag.Changed {
@@ -238,13 +311,38 @@ func execute(cmd *cobra.Command, args []string) {
 		rerun.WritePrevArgs(os.Args)
 	}
 	installMissingPlugins(installPlugins, false)
-	exitCode := execution.ExecuteSpecs(specs)
-	if failSafe && exitCode != execution.ParseFailed {
+	ctx, cancel := interruptContext()
+	exitCode := execution.ExecuteSpecs(ctx, specs)
+	cancel()
+	testPack.Close()
+	if failSafe && exitCode != execution.ParseFailed && exitCode != execution.Interrupted {
 		exitCode = 0
 	}
 	os.Exit(exitCode)
 }
 
+// openTestPack makes the test pack of the first argument the project of the run and gives the specs to run in it.
+// Reports and logs go to the current directory rather than to the workspace of the pack, which is removed.
+func openTestPack(args []string) []string {
+	wd, err := os.Getwd()
+	if err != nil {
+		exit(err, "")
+	}
+	for name, dir := range map[string]string{env.GaugeReportsDir: "reports", env.LogsDirectory: "logs"} {
+		if os.Getenv(name) == "" {
+			os.Setenv(name, filepath.Join(wd, dir))
+		}
+	}
+	root, err := testPack.Open(args[0], machineReadable)
+	if err != nil {
+		testPack.Close()
+		exit(err, "")
+	}
+	util.SetWorkingDir(root)
+	config.ProjectRoot = root
+	return args[1:]
+}
+
 var repeatLastExecution = func(cmd *cobra.Command) {
 	lastState := rerun.ReadPrevArgs()
 	handleFlags(cmd, lastState)
@@ -268,6 +366,9 @@ func handleConflictingParams(setFlags *pflag.FlagSet, args []string) error {
 	if failed && len(args)+flagDiffCount > 1 {
 		return fmt.Errorf("Invalid Command. Usage: gauge run --failed")
 	}
+	if resume && len(args)+flagDiffCount > 1 {
+		return fmt.Errorf("Invalid Command. Usage: gauge run --resume")
+	}
 	if !parallel && tagsToFilterForParallelRun != "" {
 		return fmt.Errorf("Invalid Command. flag --only can be used only with --parallel")
 	}
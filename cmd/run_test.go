@@ -8,6 +8,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -71,7 +72,7 @@ func TestExecuteWritesPrevCommandArgs(t *testing.T) {
 		args := []string{"gauge", "run", "specs"}
 
 		installPlugins = false
-		execution.ExecuteSpecs = func(s []string) int { return 0 }
+		execution.ExecuteSpecs = func(ctx context.Context, s []string) int { return 0 }
 		cmd := &cobra.Command{}
 
 		os.Args = args
@@ -120,7 +121,7 @@ func TestRepeatShouldPreservePreviousArgs(t *testing.T) {
 
 func TestSaveCommandArgsForFailed(t *testing.T) {
 	if os.Getenv("TEST_EXITS") == "1" {
-		execution.ExecuteSpecs = func(s []string) int { return 0 }
+		execution.ExecuteSpecs = func(ctx context.Context, s []string) int { return 0 }
 		rerun.GetLastFailedState = func() ([]string, error) {
 			return []string{"run", "specs"}, nil
 		}
@@ -336,7 +337,7 @@ func TestNoExitCodeShouldForceReturnZero(t *testing.T) {
 	if os.Getenv("TEST_EXITS") == "1" {
 		installPlugins = false
 		// simulate failure
-		execution.ExecuteSpecs = func(s []string) int { return execution.ExecutionFailed }
+		execution.ExecuteSpecs = func(ctx context.Context, s []string) int { return execution.ExecutionFailed }
 
 		os.Args = []string{"gauge", "run", "--fail-safe", "specs"}
 
@@ -360,7 +361,7 @@ func TestNoExitCodeShouldForceReturnZero(t *testing.T) {
 func TestFailureShouldReturnExitCodeForParseErrors(t *testing.T) {
 	if os.Getenv("TEST_EXITS") == "1" {
 		// simulate parse failure
-		execution.ExecuteSpecs = func(s []string) int { return execution.ParseFailed }
+		execution.ExecuteSpecs = func(ctx context.Context, s []string) int { return execution.ParseFailed }
 
 		os.Args = []string{"gauge", "run", "--fail-safe", "specs"}
 		failSafe = true
@@ -382,7 +383,7 @@ func TestFailureShouldReturnExitCodeForParseErrors(t *testing.T) {
 func TestFailureShouldReturnExitCode(t *testing.T) {
 	if os.Getenv("TEST_EXITS") == "1" {
 		// simulate execution failure
-		execution.ExecuteSpecs = func(s []string) int { return execution.ExecutionFailed }
+		execution.ExecuteSpecs = func(ctx context.Context, s []string) int { return execution.ExecutionFailed }
 		os.Args = []string{"gauge", "run", "specs"}
 		err := runCmd.Execute()
 		if err != nil {
@@ -404,7 +405,7 @@ func TestFailureShouldReturnExitCode(t *testing.T) {
 func TestLogLevelCanBeOverriddenForFailed(t *testing.T) {
 	if os.Getenv("TEST_EXITS") == "1" {
 		// expect log level to be overridden
-		execution.ExecuteSpecs = func(s []string) int {
+		execution.ExecuteSpecs = func(ctx context.Context, s []string) int {
 			f, err := runCmd.Flags().GetString(logLevelName)
 			if err != nil {
 				fmt.Printf("Error parsing flags. %s\n", err.Error())
@@ -450,7 +451,7 @@ func TestLogLevelCanBeOverriddenForFailed(t *testing.T) {
 func TestLogLevelCanBeOverriddenForRepeat(t *testing.T) {
 	if os.Getenv("TEST_EXITS") == "1" {
 		// expect log level to be overridden
-		execution.ExecuteSpecs = func(s []string) int {
+		execution.ExecuteSpecs = func(ctx context.Context, s []string) int {
 			f, err := runCmd.Flags().GetString(logLevelName)
 			if err != nil {
 				fmt.Printf("Error parsing flags. %s\n", err.Error())
@@ -493,7 +494,7 @@ func TestCorrectFlagsAreSetForRepeat(t *testing.T) {
 			t.Error(err)
 		}
 
-		execution.ExecuteSpecs = func(s []string) int {
+		execution.ExecuteSpecs = func(ctx context.Context, s []string) int {
 			f, err := runCmd.Flags().GetString(environmentName)
 			if err != nil {
 				fmt.Printf("Error parsing flags. %s\n", err.Error())
@@ -531,7 +532,7 @@ func TestCorrectFlagsAreSetForRepeat(t *testing.T) {
 func TestCorrectFlagsAreSetForFailed(t *testing.T) {
 	if os.Getenv("TEST_EXITS") == "1" {
 		// expect "env" to be set to "test"
-		execution.ExecuteSpecs = func(s []string) int {
+		execution.ExecuteSpecs = func(ctx context.Context, s []string) int {
 			f, err := runCmd.Flags().GetString(environmentName)
 			if err != nil {
 				fmt.Printf("Error parsing flags. %s\n", err.Error())
@@ -7,19 +7,70 @@
 package cmd
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/track"
 	"github.com/spf13/cobra"
 )
 
-func init() {
-	// This command is deprecated, it's going to be removed in the future
-	telemetryCmd := &cobra.Command{
-		Use: "telemetry [command]",
+var (
+	telemetryCmd = &cobra.Command{
+		Use:   "telemetry [command]",
+		Short: "Show the telemetry settings and the events recorded locally",
+		Long: fmt.Sprintf(`Show the telemetry settings and the events recorded locally.
+
+Gauge never sends telemetry. With telemetry set to local, events are written to a file of the Gauge home directory
+for you to inspect. Categories of events, %s, are opted out of with telemetry_opt_out.`, strings.Join(track.Categories, ", ")),
+		Example: `  gauge config telemetry local
+  gauge config telemetry_opt_out command,install
+  gauge telemetry show`,
+		Run: func(cmd *cobra.Command, args []string) {
+			file, err := track.File()
+			if err != nil {
+				exit(err, "")
+			}
+			logger.Infof(true, "Telemetry: %s", config.Telemetry())
+			logger.Infof(true, "Opted out categories: %s", strings.Join(config.TelemetryOptOut(), ", "))
+			logger.Infof(true, "Events file: %s", file)
+		},
+		DisableAutoGenTag: true,
+	}
+	telemetryShowCmd = &cobra.Command{
+		Use:     "show [flags]",
+		Short:   "Print the telemetry events recorded locally",
+		Long:    `Print the telemetry events recorded locally, oldest first.`,
+		Example: "  gauge telemetry show --json",
 		Run: func(cmd *cobra.Command, args []string) {
-			logger.Infof(true, "Gauge does not gather telemetry data. This command exists to notify this deprecation and shall be removed in the future")
+			file, err := track.File()
+			if err != nil {
+				exit(err, "")
+			}
+			events, err := track.Events(file)
+			if err != nil {
+				exit(fmt.Errorf("Unable to read telemetry events from %s. %s", file, err.Error()), "")
+			}
+			if telemetryJSONFlag {
+				printJSON(events)
+				return
+			}
+			if len(events) == 0 {
+				logger.Infof(true, "No telemetry events recorded in %s.", file)
+				return
+			}
+			for _, e := range events {
+				fmt.Println(e.String())
+			}
 		},
-		Hidden: true,
+		DisableAutoGenTag: true,
 	}
+	telemetryJSONFlag bool
+)
 
+func init() {
 	GaugeCmd.AddCommand(telemetryCmd)
+	telemetryCmd.AddCommand(telemetryShowCmd)
+	telemetryShowCmd.Flags().BoolVarP(&telemetryJSONFlag, "json", "", false, "Print the events as JSON")
 }
@@ -0,0 +1,113 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+	"github.com/spf13/cobra"
+)
+
+var (
+	tokensCmd = &cobra.Command{
+		Use:   "tokens [flags] <file>...",
+		Short: "Print the tokens of spec and concept files",
+		Long: `Print the tokens of spec and concept files, as the parser reads them before building the specs and
+concepts. This helps building tools on the syntax of specs, and finding out how a line is read.`,
+		Example: `  gauge tokens specs/example.spec
+  gauge tokens --json specs/example.spec concepts/login.cpt`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := config.SetProjectRoot(args); err != nil {
+				exit(err, cmd.UsageString())
+			}
+			loadEnvAndReinitLogger(cmd)
+			var files []*tokensJSON
+			failed := false
+			for _, file := range args {
+				text, err := common.ReadFileContents(util.LongPath(file))
+				if err != nil {
+					exit(err, "")
+				}
+				tokens, errs := parser.Tokenize(text, file)
+				for _, e := range errs {
+					logger.Error(true, e.Error())
+					failed = true
+				}
+				files = append(files, newTokensJSON(file, tokens))
+			}
+			if tokensJSONFlag {
+				printJSON(files)
+			} else {
+				for _, f := range files {
+					printTokens(f)
+				}
+			}
+			if failed {
+				exit(fmt.Errorf("Failed to read the tokens of some files"), "")
+			}
+		},
+		DisableAutoGenTag: true,
+	}
+	tokensJSONFlag bool
+)
+
+func init() {
+	GaugeCmd.AddCommand(tokensCmd)
+	tokensCmd.Flags().BoolVarP(&tokensJSONFlag, "json", "", false, "Print the tokens as JSON")
+}
+
+type tokensJSON struct {
+	File   string       `json:"file"`
+	Tokens []*tokenJSON `json:"tokens"`
+}
+
+type tokenJSON struct {
+	Kind    string   `json:"kind"`
+	Line    int      `json:"line"`
+	LineEnd int      `json:"lineEnd"`
+	Value   string   `json:"value"`
+	Args    []string `json:"args"`
+	Suffix  string   `json:"suffix,omitempty"`
+	Lines   []string `json:"lines"`
+}
+
+func newTokensJSON(file string, tokens []*parser.Token) *tokensJSON {
+	f := &tokensJSON{File: file, Tokens: make([]*tokenJSON, 0, len(tokens))}
+	for _, t := range tokens {
+		args := t.Args
+		if args == nil {
+			args = []string{}
+		}
+		lineEnd := t.SpanEnd
+		if lineEnd < t.LineNo {
+			lineEnd = t.LineNo
+		}
+		f.Tokens = append(f.Tokens, &tokenJSON{Kind: t.Kind.String(), Line: t.LineNo, LineEnd: lineEnd, Value: t.Value, Args: args, Suffix: t.Suffix, Lines: t.Lines})
+	}
+	return f
+}
+
+func printTokens(f *tokensJSON) {
+	logger.Infof(true, "[%s]", f.File)
+	for _, t := range f.Tokens {
+		line := fmt.Sprintf("%d-%d\t%s\t%q", t.Line, t.LineEnd, t.Kind, t.Value)
+		if len(t.Args) > 0 {
+			line += fmt.Sprintf("\targs: %s", strings.Join(t.Args, ", "))
+		}
+		if strings.TrimSpace(t.Suffix) != "" {
+			line += fmt.Sprintf("\tsuffix: %q", t.Suffix)
+		}
+		logger.Info(true, line)
+	}
+}
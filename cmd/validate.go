@@ -7,7 +7,11 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/validation"
 	"github.com/spf13/cobra"
 )
@@ -25,19 +29,43 @@ var (
 		Example: "  gauge validate specs/",
 		Run: func(cmd *cobra.Command, args []string) {
 			validation.HideSuggestion = hideSuggestion
+			validation.CheckLinks = checkLinks
+			validation.ReportUnusedConcepts = true
+			validation.FailOnUnusedConcepts = failOnUnusedConcepts
+			if validateFormat != validation.TextFormat && validateFormat != validation.JSONFormat {
+				exit(fmt.Errorf("Unsupported format %s, it should be one of text or json", validateFormat), cmd.UsageString())
+			}
+			validation.Format = validateFormat
+			if validateFormat == validation.JSONFormat {
+				// the errors are printed as JSON alone
+				logLevel = "critical"
+			}
+			parser.GithubAnnotations = githubAnnotations
+			parser.StrictMode = strict
+			filter.IncludeWIP = true
 			if err := config.SetProjectRoot(args); err != nil {
 				exit(err, cmd.UsageString())
 			}
 			loadEnvAndReinitLogger(cmd)
 			installMissingPlugins(installPlugins, true)
-			validation.Validate(args)
+			ctx, cancel := interruptContext()
+			defer cancel()
+			validation.Validate(ctx, args)
 		},
 		DisableAutoGenTag: true,
 	}
-	hideSuggestion bool
+	hideSuggestion       bool
+	checkLinks           bool
+	failOnUnusedConcepts bool
+	validateFormat       string
 )
 
 func init() {
 	GaugeCmd.AddCommand(validateCmd)
 	validateCmd.Flags().BoolVarP(&hideSuggestion, "hide-suggestion", "", false, "Prints a step implementation stub for every unimplemented step")
+	validateCmd.Flags().BoolVarP(&checkLinks, "check-links", "", false, "Check that the URLs in the comments of the specs resolve. URLs starting with a prefix in gauge_link_check_allowlist are not checked")
+	validateCmd.Flags().BoolVarP(&failOnUnusedConcepts, "fail-on-unused-concepts", "", false, "Fail validation when a concept is not used by any spec or concept")
+	validateCmd.Flags().BoolVarP(&githubAnnotations, githubAnnotationsName, "", githubAnnotationsDefault, "Print parse errors as GitHub Actions error annotations")
+	validateCmd.Flags().StringVarP(&validateFormat, "format", "", validation.TextFormat, "Format to print the parse and validation errors in, text or json")
+	validateCmd.Flags().BoolVarP(&strict, strictName, "", strictDefault, "Treat the warnings of parsing specs and concepts as errors. Same as strict_parsing")
 }
@@ -9,9 +9,11 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/plugin/install"
 	"github.com/getgauge/gauge/plugin/pluginInfo"
 	"github.com/getgauge/gauge/version"
 	"github.com/spf13/cobra"
@@ -21,19 +23,29 @@ var (
 	versionCmd = &cobra.Command{
 		Use:   "version [flags]",
 		Short: "Print Gauge and plugin versions",
-		Long:  `Print Gauge and plugin versions.`,
+		Long: `Print Gauge and plugin versions.
+
+With --check, the installed plugins are checked against the installed Gauge. The plugin repository is queried for
+the plugins which do not support it, and the fewest upgrades which make them all compatible are proposed.`,
 		Example: `  gauge version
-  gauge version -m`,
+  gauge version -m
+  gauge version --check`,
 		Run: func(cmd *cobra.Command, args []string) {
+			if checkCompatibility {
+				printCompatibility()
+				return
+			}
 			printVersion()
 		},
 		PersistentPostRun: func(cmd *cobra.Command, args []string) { /* noop */ },
 		DisableAutoGenTag: true,
 	}
+	checkCompatibility bool
 )
 
 func init() {
 	GaugeCmd.AddCommand(versionCmd)
+	versionCmd.Flags().BoolVarP(&checkCompatibility, "check", "", false, "Check that the installed plugins are compatible with Gauge")
 }
 
 func printVersion() {
@@ -89,3 +101,31 @@ func printTextVersion() {
 		logger.Infof(true, "%s (%s)", pluginInfo.Name, filepath.Base(pluginInfo.Path))
 	}
 }
+
+func printCompatibility() {
+	report, err := install.CheckCompatibility()
+	if err != nil {
+		exit(err, "")
+	}
+	if machineReadable {
+		printJSON(report)
+	} else {
+		logger.Infof(true, "Gauge version: %s", report.GaugeVersion)
+		for _, p := range report.Plugins {
+			if p.Compatible {
+				logger.Infof(true, "%s (%s): compatible", p.Name, p.Version)
+			} else {
+				logger.Infof(true, "%s (%s): incompatible, %s", p.Name, p.Version, p.Reason)
+			}
+		}
+		if upgrades := report.Upgrades(); len(upgrades) > 0 {
+			logger.Infof(true, "\nUpgrades\n--------")
+			for _, u := range upgrades {
+				logger.Infof(true, u)
+			}
+		}
+	}
+	if !report.Compatible() {
+		os.Exit(1)
+	}
+}
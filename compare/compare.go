@@ -0,0 +1,209 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package compare maps the results of an external test suite, in the JUnit XML format, onto the scenarios of a project.
+It shows which tests and scenarios cover the same behaviour and where their results disagree, which helps to
+consolidate a parallel suite into gauge.
+
+A test case matches a scenario when its name is the stable ID of the scenario, or else when its name and the
+scenario heading are the same, ignoring casing, punctuation and spacing.
+*/
+package compare
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	byID      = "id"
+	byHeading = "heading"
+)
+
+// TestCase is a test case of the external suite
+type TestCase struct {
+	Name      string
+	ClassName string
+	Failed    bool
+	Skipped   bool
+}
+
+func (t *TestCase) String() string {
+	if t.ClassName == "" {
+		return t.Name
+	}
+	return fmt.Sprintf("%s.%s", t.ClassName, t.Name)
+}
+
+type junitSuite struct {
+	Suites []*junitSuite `xml:"testsuite"`
+	Cases  []*junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string    `xml:"name,attr"`
+	ClassName string    `xml:"classname,attr"`
+	Failure   *struct{} `xml:"failure"`
+	Error     *struct{} `xml:"error"`
+	Skipped   *struct{} `xml:"skipped"`
+}
+
+// ParseJUnit reads the test cases of a JUnit XML report, whose root is either testsuites or a single testsuite
+func ParseJUnit(content []byte) ([]*TestCase, error) {
+	root := &junitSuite{}
+	if err := xml.Unmarshal(content, root); err != nil {
+		return nil, fmt.Errorf("invalid JUnit report. %s", err.Error())
+	}
+	var cases []*TestCase
+	var collect func(s *junitSuite)
+	collect = func(s *junitSuite) {
+		for _, c := range s.Cases {
+			cases = append(cases, &TestCase{Name: c.Name, ClassName: c.ClassName, Failed: c.Failure != nil || c.Error != nil, Skipped: c.Skipped != nil})
+		}
+		for _, child := range s.Suites {
+			collect(child)
+		}
+	}
+	collect(root)
+	return cases, nil
+}
+
+// Scenario is a scenario of the project
+type Scenario struct {
+	Spec     *gauge.Specification
+	Scenario *gauge.Scenario
+}
+
+func (s *Scenario) String() string {
+	return fmt.Sprintf("%s:%d %s", util.RelPathToProjectRoot(s.Spec.FileName), s.Scenario.Heading.LineNo, s.Scenario.Heading.Value)
+}
+
+// Match is a test case which covers the same behaviour as a scenario
+type Match struct {
+	Case     *TestCase
+	Scenario *Scenario
+	By       string
+	// Failed is the result of the scenario in the last run, Known is false when it did not run
+	Failed, Known bool
+}
+
+// Result lists how the external suite and the scenarios overlap
+type Result struct {
+	Matches     []*Match
+	OnlyInJUnit []*TestCase
+	OnlyInGauge []*Scenario
+}
+
+// Divergences returns the matches whose test case and scenario had different results
+func (r *Result) Divergences() []*Match {
+	var d []*Match
+	for _, m := range r.Matches {
+		if m.Known && !m.Case.Skipped && m.Case.Failed != m.Failed {
+			d = append(d, m)
+		}
+	}
+	return d
+}
+
+// Compare maps the test cases onto the scenarios of the specs. lastResult tells whether a scenario failed in the
+// last run, and whether it ran at all.
+func Compare(specs []*gauge.Specification, cases []*TestCase, lastResult func(*Scenario) (failed bool, known bool)) *Result {
+	ids := make(map[string]*Scenario)
+	headings := make(map[string]*Scenario)
+	var scenarios []*Scenario
+	for _, spec := range specs {
+		for _, scn := range spec.Scenarios {
+			s := &Scenario{Spec: spec, Scenario: scn}
+			scenarios = append(scenarios, s)
+			if scn.ID != "" {
+				ids[scn.ID] = s
+			}
+			if h := normalize(scn.Heading.Value); headings[h] == nil {
+				headings[h] = s
+			}
+		}
+	}
+	r := &Result{}
+	matched := make(map[*Scenario]bool)
+	for _, c := range cases {
+		m := &Match{Case: c}
+		if s, ok := ids[c.Name]; ok {
+			m.Scenario, m.By = s, byID
+		} else if s, ok := headings[normalize(c.Name)]; ok {
+			m.Scenario, m.By = s, byHeading
+		} else {
+			r.OnlyInJUnit = append(r.OnlyInJUnit, c)
+			continue
+		}
+		matched[m.Scenario] = true
+		m.Failed, m.Known = lastResult(m.Scenario)
+		r.Matches = append(r.Matches, m)
+	}
+	for _, s := range scenarios {
+		if !matched[s] {
+			r.OnlyInGauge = append(r.OnlyInGauge, s)
+		}
+	}
+	return r
+}
+
+// normalize turns a test name like payWithCard or pay_with_card, and a heading like "Pay with card", into the same text
+func normalize(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// Print writes the overlap and divergences in a readable form
+func (r *Result) Print(w io.Writer, nScenarios int) {
+	nByID := 0
+	for _, m := range r.Matches {
+		if m.By == byID {
+			nByID++
+		}
+	}
+	nCases := len(r.Matches) + len(r.OnlyInJUnit)
+	fmt.Fprintf(w, "Matched %d of %d JUnit test cases to scenarios (%d by ID, %d by heading)\n", len(r.Matches), nCases, nByID, len(r.Matches)-nByID)
+	fmt.Fprintf(w, "%d of %d scenarios are covered by the JUnit suite\n", nScenarios-len(r.OnlyInGauge), nScenarios)
+	if len(r.OnlyInJUnit) > 0 {
+		fmt.Fprintln(w, "\nOnly in JUnit:")
+		for _, c := range r.OnlyInJUnit {
+			fmt.Fprintf(w, "  %s\n", c)
+		}
+	}
+	if len(r.OnlyInGauge) > 0 {
+		fmt.Fprintln(w, "\nOnly in gauge:")
+		for _, s := range r.OnlyInGauge {
+			fmt.Fprintf(w, "  %s\n", s)
+		}
+	}
+	if d := r.Divergences(); len(d) > 0 {
+		fmt.Fprintln(w, "\nDivergent results:")
+		t := &util.ConsoleTable{Headers: []string{"Scenario", "JUnit", "Test case", "Gauge"}, Indent: 2}
+		for _, m := range d {
+			t.Rows = append(t.Rows, []string{m.Scenario.String(), status(m.Case.Failed), m.Case.String(), status(m.Failed)})
+		}
+		fmt.Fprint(w, t.String())
+	}
+}
+
+func status(failed bool) string {
+	if failed {
+		return "failed"
+	}
+	return "passed"
+}
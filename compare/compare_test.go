@@ -0,0 +1,110 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package compare
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+const report = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+	<testsuite name="checkout">
+		<testcase classname="shop.CheckoutTest" name="payWithCard"><failure message="declined"/></testcase>
+		<testcase classname="shop.CheckoutTest" name="checkout-voucher"/>
+	</testsuite>
+	<testsuite name="search">
+		<testsuite name="nested">
+			<testcase classname="shop.SearchTest" name="searchByName"><skipped/></testcase>
+		</testsuite>
+	</testsuite>
+</testsuites>`
+
+func TestParseJUnit(t *testing.T) {
+	cases, err := ParseJUnit([]byte(report))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) != 3 {
+		t.Fatalf("expected 3 test cases, got %d", len(cases))
+	}
+	if !cases[0].Failed || cases[1].Failed || !cases[2].Skipped {
+		t.Errorf("unexpected results %v %v %v", cases[0], cases[1], cases[2])
+	}
+	if cases[0].String() != "shop.CheckoutTest.payWithCard" {
+		t.Errorf("unexpected name %s", cases[0])
+	}
+}
+
+func TestParseJUnitWithSingleSuite(t *testing.T) {
+	cases, err := ParseJUnit([]byte(`<testsuite><testcase name="a"/><testcase name="b"><error/></testcase></testsuite>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) != 2 || !cases[1].Failed {
+		t.Errorf("unexpected test cases %v", cases)
+	}
+}
+
+func TestParseInvalidJUnit(t *testing.T) {
+	if _, err := ParseJUnit([]byte("<testsuite>")); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	card := &gauge.Scenario{Heading: &gauge.Heading{Value: "Pay with card", LineNo: 3}}
+	voucher := &gauge.Scenario{Heading: &gauge.Heading{Value: "Pay with a voucher", LineNo: 8}, ID: "checkout-voucher"}
+	refund := &gauge.Scenario{Heading: &gauge.Heading{Value: "Refund", LineNo: 12}}
+	spec := &gauge.Specification{FileName: "checkout.spec", Scenarios: []*gauge.Scenario{card, voucher, refund}}
+	cases, _ := ParseJUnit([]byte(report))
+
+	r := Compare([]*gauge.Specification{spec}, cases, func(s *Scenario) (bool, bool) {
+		return false, true
+	})
+
+	if len(r.Matches) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(r.Matches))
+	}
+	if r.Matches[0].Scenario.Scenario != card || r.Matches[0].By != byHeading {
+		t.Errorf("expected payWithCard to match the heading of %s", r.Matches[0].Scenario)
+	}
+	if r.Matches[1].Scenario.Scenario != voucher || r.Matches[1].By != byID {
+		t.Errorf("expected checkout-voucher to match the ID of %s", r.Matches[1].Scenario)
+	}
+	if len(r.OnlyInJUnit) != 1 || r.OnlyInJUnit[0].Name != "searchByName" {
+		t.Errorf("unexpected test cases only in JUnit %v", r.OnlyInJUnit)
+	}
+	if len(r.OnlyInGauge) != 1 || r.OnlyInGauge[0].Scenario != refund {
+		t.Errorf("unexpected scenarios only in gauge %v", r.OnlyInGauge)
+	}
+	if d := r.Divergences(); len(d) != 1 || d[0].Scenario.Scenario != card {
+		t.Errorf("expected pay with card to diverge, got %v", d)
+	}
+}
+
+func TestCompareWithoutLastRun(t *testing.T) {
+	card := &gauge.Scenario{Heading: &gauge.Heading{Value: "Pay with card", LineNo: 3}}
+	spec := &gauge.Specification{FileName: "checkout.spec", Scenarios: []*gauge.Scenario{card}}
+	cases, _ := ParseJUnit([]byte(report))
+
+	r := Compare([]*gauge.Specification{spec}, cases, func(s *Scenario) (bool, bool) {
+		return false, false
+	})
+
+	if d := r.Divergences(); len(d) != 0 {
+		t.Errorf("expected no divergences, got %v", d)
+	}
+	var b bytes.Buffer
+	r.Print(&b, 1)
+	if !strings.HasPrefix(b.String(), "Matched 1 of 3 JUnit test cases to scenarios (0 by ID, 1 by heading)\n1 of 1 scenarios are covered") {
+		t.Errorf("unexpected output\n%s", b.String())
+	}
+}
@@ -7,12 +7,14 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/version"
 	logging "github.com/op/go-logging"
 )
 
@@ -25,6 +27,9 @@ const (
 	ideRequestTimeout       = "ide_request_timeout"
 	checkUpdates            = "check_updates"
 	allowInsecureDownload   = "allow_insecure_download"
+	telemetry               = "telemetry"
+	telemetryOptOut         = "telemetry_opt_out"
+	updateChannel           = "update_channel"
 
 	defaultRunnerConnectionTimeout = time.Second * 25
 	defaultPluginConnectionTimeout = time.Second * 10
@@ -91,6 +96,46 @@ func AllowInsecureDownload() bool {
 	return convertToBool(allow, allowInsecureDownload, false)
 }
 
+// Telemetry gives where telemetry events are recorded: off, or local to write them to a file of the Gauge home
+// directory. Events are never sent anywhere.
+func Telemetry() string {
+	return strings.ToLower(strings.TrimSpace(getFromConfig(telemetry)))
+}
+
+// TelemetryOptOut gives the categories of telemetry events which are not recorded
+func TelemetryOptOut() []string {
+	var categories []string
+	for _, c := range strings.Split(getFromConfig(telemetryOptOut), ",") {
+		if c = strings.ToLower(strings.TrimSpace(c)); c != "" {
+			categories = append(categories, c)
+		}
+	}
+	return categories
+}
+
+// UpdateChannel gives the channel gauge and plugin updates are taken from: stable, beta or nightly. An invalid
+// channel falls back to stable.
+func UpdateChannel() string {
+	channel := strings.ToLower(strings.TrimSpace(getFromConfig(updateChannel)))
+	if channel == "" {
+		return version.Stable
+	}
+	if err := validUpdateChannel(channel); err != nil {
+		APILog.Warningf("%s. Using %s.", err.Error(), version.Stable)
+		return version.Stable
+	}
+	return channel
+}
+
+func validUpdateChannel(channel string) error {
+	for _, c := range version.Channels {
+		if c == channel {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid %s '%s', should be one of %s", updateChannel, channel, strings.Join(version.Channels, ", "))
+}
+
 // GaugeRepositoryUrl fetches the repository URL to locate plugins
 func GaugeRepositoryUrl() string {
 	return getFromConfig(gaugeRepositoryURL)
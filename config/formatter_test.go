@@ -24,6 +24,9 @@ func TestJSONFormatter(t *testing.T) {
 		"plugin_kill_timeout           	4000                               ",
 		"runner_connection_timeout     	30000                              ",
 		"runner_request_timeout        	30000                              ",
+		"telemetry                     	off                                ",
+		"telemetry_opt_out             	                                   ",
+		"update_channel                	stable                             ",
 	}
 	p := defaults()
 	var properties []Property
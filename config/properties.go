@@ -97,6 +97,9 @@ func defaults() *properties {
 		runnerRequestTimeout:    NewProperty(runnerRequestTimeout, "30000", "Timeout in milliseconds for requests from the language runner."),
 		ideRequestTimeout:       NewProperty(ideRequestTimeout, "30000", "Timeout in milliseconds for requests from runner when invoked for ide."),
 		checkUpdates:            NewProperty(checkUpdates, "true", "Allow Gauge and its plugin updates to be notified."),
+		telemetry:               NewProperty(telemetry, "off", "Record telemetry events: off, or local to write them to a file which gauge telemetry show prints. Events are never sent."),
+		telemetryOptOut:         NewProperty(telemetryOptOut, "", "Comma separated categories of telemetry events not to record: command, execution or install."),
+		updateChannel:           NewProperty(updateChannel, "stable", "Channel of gauge and plugin updates: stable, beta to get pre-releases as well, or nightly to get every build."),
 	}}
 }
 
@@ -119,6 +122,11 @@ func mergedProperties() (*properties, error) {
 }
 
 func Update(name, value string) error {
+	if name == updateChannel {
+		if err := validUpdateChannel(strings.ToLower(strings.TrimSpace(value))); err != nil {
+			return err
+		}
+	}
 	p, err := mergedProperties()
 	if err != nil {
 		return err
@@ -128,6 +128,15 @@ runner_connection_timeout = 30000
 
 # Timeout in milliseconds for requests from the language runner.
 runner_request_timeout = 30000
+
+# Record telemetry events: off, or local to write them to a file which gauge telemetry show prints. Events are never sent.
+telemetry = off
+
+# Comma separated categories of telemetry events not to record: command, execution or install.
+telemetry_opt_out = 
+
+# Channel of gauge and plugin updates: stable, beta to get pre-releases as well, or nightly to get every build.
+update_channel = stable
 `
 
 func TestPropertiesString(t *testing.T) {
@@ -24,6 +24,11 @@ type messageHandler interface {
 	MessageBytesReceived([]byte, net.Conn)
 }
 
+// connectionCloser is a message handler which is told when a connection closes
+type connectionCloser interface {
+	ConnectionClosed(net.Conn)
+}
+
 type GaugeConnectionHandler struct {
 	tcpListener    *net.TCPListener
 	messageHandler messageHandler
@@ -105,6 +110,9 @@ func (connectionHandler *GaugeConnectionHandler) handleConnectionMessages(conn n
 				logger.Debugf(false, "Connection already closed, %s", e.Error())
 			}
 			logger.Infof(false, "Closing connection [%s] cause: %s", conn.RemoteAddr(), err.Error())
+			if c, ok := connectionHandler.messageHandler.(connectionCloser); ok {
+				c.ConnectionClosed(conn)
+			}
 			return
 		}
 
@@ -14,6 +14,7 @@ import (
 	"strconv"
 
 	"strings"
+	"time"
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
@@ -36,19 +37,65 @@ const (
 	OverwriteReports = "overwrite_reports"
 	// ScreenshotOnFailure indicates if failure should invoke screenshot
 	ScreenshotOnFailure = "screenshot_on_failure"
-	saveExecutionResult = "save_execution_result"
+	// GaugeScreenshotStrategy tells when screenshots are taken, one of on-failure, always, never or on-step-tags
+	GaugeScreenshotStrategy = "gauge_screenshot_strategy"
+	saveExecutionResult     = "save_execution_result"
 	// CsvDelimiter holds delimiter used to parse csv files
 	CsvDelimiter                   = "csv_delimiter"
 	allowCaseSensitiveTags         = "allow_case_sensitive_tags"
 	allowMultilineStep             = "allow_multiline_step"
 	allowScenarioDatatable         = "allow_scenario_datatable"
+	allowFrontMatter               = "allow_front_matter"
+	allowMultilineText             = "allow_multiline_text"
+	allowStepTags                  = "allow_step_tags"
+	allowConceptDatatable          = "allow_concept_datatable"
+	uniqueScenarioHeadings         = "unique_scenario_headings"
+	strictParsing                  = "strict_parsing"
 	allowFilteredParallelExecution = "allow_filtered_parallel_execution"
+	allowParallelDatatableRows     = "allow_parallel_datatable_rows"
 	enableMultithreading           = "enable_multithreading"
+	// GaugeReportLogo holds the path of the logo which report plugins show, gauge passes it on as an absolute path
+	GaugeReportLogo = "gauge_report_logo"
+	// GaugeReportFieldsJSON holds the extra summary fields of reports as a JSON array of name and value pairs, for report plugins
+	GaugeReportFieldsJSON = "gauge_report_fields_json"
 	// GaugeScreenshotsDir holds the location of screenshots dir
 	GaugeScreenshotsDir     = "gauge_screenshots_dir"
 	gaugeSpecFileExtensions = "gauge_spec_file_extensions"
 	gaugeDataDir            = "gauge_data_dir"
 	envDirEnvVar            = "gauge_env_dir"
+	requiredAnnotations     = "gauge_required_annotations"
+	linkCheckAllowlist      = "gauge_link_check_allowlist"
+	formatMaxLineWidth      = "gauge_format_max_line_width"
+	formatHeadingStyle      = "gauge_format_heading_style"
+	formatTableAlignment    = "gauge_format_table_alignment"
+	formatTableCellPadding  = "gauge_format_table_cell_padding"
+	formatTagPlacement      = "gauge_format_tag_placement"
+	consoleTableCellWidth   = "gauge_console_table_cell_width"
+	webhookURLs             = "gauge_webhook_urls"
+	webhookEvents           = "gauge_webhook_events"
+	webhookFailureThreshold = "gauge_webhook_failure_threshold"
+	reportURL               = "gauge_report_url"
+	artifacts               = "gauge_artifacts"
+	artifactsUploader       = "gauge_artifacts_uploader"
+	screenshotTags          = "gauge_screenshot_tags"
+	attachmentsDir          = "gauge_attachments_dir"
+	reportTitle             = "gauge_report_title"
+	reportFields            = "gauge_report_fields"
+	reportFormats           = "gauge_report_formats"
+	traceabilityAnnotation  = "gauge_traceability_annotation"
+	lintCommand             = "gauge_lint_command"
+	lintDictionaries        = "gauge_lint_dictionaries"
+	lintGlossary            = "gauge_lint_glossary"
+	followSymlinks          = "gauge_follow_symlinks"
+	shutdownGracePeriod     = "gauge_shutdown_grace_period"
+	parallelScenariosTag    = "gauge_parallel_scenarios_tag"
+	scenarioPriorityOrder   = "gauge_scenario_priority_ordering"
+	daemonMaxRunners        = "gauge_daemon_max_runners"
+	maskedEnvParams         = "gauge_masked_env_params"
+	conceptCache            = "gauge_concept_cache"
+	lazyTableRows           = "gauge_lazy_table_rows"
+	reportFilteredScenarios = "gauge_report_filtered_scenarios"
+	dataTableKeyColumns     = "gauge_data_table_key_columns"
 )
 
 var envVars map[string]string
@@ -110,14 +157,21 @@ func loadDefaultEnvVars() {
 	addEnvVar(GaugeEnvironment, common.DefaultEnvDir)
 	addEnvVar(LogsDirectory, "logs")
 	addEnvVar(OverwriteReports, "true")
+	if strategy, ok := envVars[GaugeScreenshotStrategy]; ok {
+		// runners only know screenshot_on_failure, so the strategy decides it
+		envVars[ScreenshotOnFailure] = strconv.FormatBool(strings.TrimSpace(strategy) != "never")
+	}
 	addEnvVar(ScreenshotOnFailure, "true")
 	addEnvVar(saveExecutionResult, "false")
 	addEnvVar(CsvDelimiter, ",")
 	addEnvVar(allowMultilineStep, "false")
 	addEnvVar(allowScenarioDatatable, "false")
+	addEnvVar(allowFrontMatter, "true")
 	addEnvVar(allowFilteredParallelExecution, "false")
+	addEnvVar(allowParallelDatatableRows, "false")
 	defaultScreenshotDir := filepath.Join(config.ProjectRoot, common.DotGauge, "screenshots")
 	addEnvVar(GaugeScreenshotsDir, defaultScreenshotDir)
+	addEnvVar(attachmentsDir, defaultAttachmentsDir())
 	addEnvVar(gaugeSpecFileExtensions, ".spec, .md")
 	addEnvVar(allowCaseSensitiveTags, "false")
 	err := os.MkdirAll(defaultScreenshotDir, 0750)
@@ -299,11 +353,46 @@ func convertToBool(property string, defaultValue bool) bool {
 	return boolValue
 }
 
+// convertToChoice gives the value of the property, lower cased, if it is one of the choices and the default value otherwise
+func convertToChoice(property string, defaultValue string, choices ...string) string {
+	v := strings.ToLower(strings.TrimSpace(os.Getenv(property)))
+	if v == "" {
+		return defaultValue
+	}
+	for _, c := range choices {
+		if v == c {
+			return v
+		}
+	}
+	logger.Warningf(true, "Incorrect value for %s in property file. %s should be one of %s.", property, v, strings.Join(choices, ", "))
+	logger.Warningf(true, "Using default value %v for property %s.", defaultValue, property)
+	return defaultValue
+}
+
+func convertToInt(property string, defaultValue int) int {
+	v := strings.TrimSpace(os.Getenv(property))
+	if v == "" {
+		return defaultValue
+	}
+	intValue, err := strconv.Atoi(v)
+	if err != nil {
+		logger.Warningf(true, "Incorrect value for %s in property file. Cannot convert %s to integer.", property, v)
+		logger.Warningf(true, "Using default value %v for property %s.", defaultValue, property)
+		return defaultValue
+	}
+	return intValue
+}
+
 // AllowFilteredParallelExecution - feature toggle for filtered parallel execution
 var AllowFilteredParallelExecution = func() bool {
 	return convertToBool(allowFilteredParallelExecution, false)
 }
 
+// AllowParallelDatatableRows - feature toggle for distributing the rows of a scenario data table across parallel streams
+var AllowParallelDatatableRows = func() bool {
+	return convertToBool(allowParallelDatatableRows, false)
+}
+
 // AllowScenarioDatatable -feature toggle for datatables in scenario
 var AllowScenarioDatatable = func() bool {
 	return convertToBool(allowScenarioDatatable, false)
@@ -314,6 +403,105 @@ var AllowMultiLineStep = func() bool {
 	return convertToBool(allowMultilineStep, false)
 }
 
+// AllowMultilineText - feature toggle for the fenced multiline text written after a step as its last parameter
+var AllowMultilineText = func() bool {
+	return convertToBool(allowMultilineText, false)
+}
+
+// AllowStepTags - feature toggle for the tags written at the end of a step, like @slow
+var AllowStepTags = func() bool {
+	return convertToBool(allowStepTags, false)
+}
+
+// AllowConceptDatatable - feature toggle for the data table written under a concept heading, whose rows the concept
+// repeats its steps for
+var AllowConceptDatatable = func() bool {
+	return convertToBool(allowConceptDatatable, false)
+}
+
+// UniqueScenarioHeadings determines if a scenario heading used in more than one spec is a parse error. Headings are
+// always unique within a spec.
+var UniqueScenarioHeadings = func() bool {
+	return convertToBool(uniqueScenarioHeadings, false)
+}
+
+// StrictParsing determines if the warnings of parsing specs and concepts are errors
+var StrictParsing = func() bool {
+	return convertToBool(strictParsing, false)
+}
+
+// ConceptCache tells if the parsed concept files are kept in the .gauge directory of the project, so that the ones
+// which did not change are not parsed again
+var ConceptCache = func() bool {
+	return convertToBool(conceptCache, true)
+}
+
+// AllowFrontMatter - feature toggle for a YAML front matter, as used by pandoc, at the start of spec files
+var AllowFrontMatter = func() bool {
+	return convertToBool(allowFrontMatter, true)
+}
+
+// ScenarioPriorityOrdering tells if the scenarios are run in the order of their priority tags rather than in the order
+// they are written
+var ScenarioPriorityOrdering = func() bool {
+	return convertToBool(scenarioPriorityOrder, false)
+}
+
+// FormatMaxLineWidth gives the width beyond which the formatter wraps steps onto continuation lines, 0 turns wrapping off
+var FormatMaxLineWidth = func() int {
+	return convertToInt(formatMaxLineWidth, 0)
+}
+
+// FormatHeadingStyle gives how the formatter writes headings, hash for # and ## or underline for headings underlined
+// with = and -
+var FormatHeadingStyle = func() string {
+	return convertToChoice(formatHeadingStyle, "hash", "hash", "underline")
+}
+
+// FormatTableAlignment gives how the formatter aligns the cells of tables in their columns, left, right or none to
+// write cells without padding them to the width of the column
+var FormatTableAlignment = func() string {
+	return convertToChoice(formatTableAlignment, "left", "left", "right", "none")
+}
+
+// FormatTableCellPadding gives the number of spaces the formatter writes on each side of the cells of tables
+var FormatTableCellPadding = func() int {
+	if p := convertToInt(formatTableCellPadding, 0); p > 0 {
+		return p
+	}
+	return 0
+}
+
+// FormatTagPlacement gives where the formatter writes the tags of specs and scenarios, blank-line to separate them from
+// the heading by a blank line or under-heading to write them right under it
+var FormatTagPlacement = func() string {
+	return convertToChoice(formatTagPlacement, "blank-line", "blank-line", "under-heading")
+}
+
+// ConsoleTableCellWidth gives the width beyond which the cells of tables shown on the console are truncated, 0 turns
+// truncation off
+var ConsoleTableCellWidth = func() int {
+	return convertToInt(consoleTableCellWidth, 0)
+}
+
+// LazyTableRows gives the number of rows beyond which the rows of a CSV data table or table: special param are read
+// from the file as they are needed rather than held in memory, 10000 by default. 0 holds every table in memory.
+var LazyTableRows = func() int {
+	return convertToInt(lazyTableRows, 10000)
+}
+
+// DataTableKeyColumns gives the headers of the data table columns whose values identify a row in the results, like
+// id,region
+var DataTableKeyColumns = func() []string {
+	return commaSeparated(dataTableKeyColumns)
+}
+
+// ReportFilteredScenarios tells if the scenarios the --tags expression excludes from a spec which runs are reported
+// as skipped rather than left out of the results
+var ReportFilteredScenarios = func() bool {
+	return convertToBool(reportFilteredScenarios, false)
+}
+
 // SaveExecutionResult determines if last run result should be saved
 var SaveExecutionResult = func() bool {
 	return convertToBool(saveExecutionResult, false)
@@ -341,11 +529,191 @@ var GaugeSpecFileExtensions = func() []string {
 	return allowedExts
 }
 
+// RequiredAnnotations gives the keys of the @key: value annotations every scenario must have, either itself or through its spec
+var RequiredAnnotations = func() []string {
+	var keys []string
+	for _, k := range commaSeparated(requiredAnnotations) {
+		if k = strings.TrimPrefix(k, "@"); k != "" {
+			keys = append(keys, strings.ToLower(k))
+		}
+	}
+	return keys
+}
+
+// LinkCheckAllowlist gives the URL prefixes which the link checker does not verify
+var LinkCheckAllowlist = func() []string {
+	return commaSeparated(linkCheckAllowlist)
+}
+
+// WebhookURLs gives the URL templates of the webhooks which are notified about runs
+var WebhookURLs = func() []string {
+	return commaSeparated(webhookURLs)
+}
+
+// WebhookEvents gives the events of a run which are sent to the webhooks, out of start, end and threshold
+var WebhookEvents = func() []string {
+	if events := commaSeparated(webhookEvents); len(events) > 0 {
+		return events
+	}
+	return []string{"end", "threshold"}
+}
+
+// WebhookFailureThreshold gives the number of failed scenarios after which the webhooks are notified during a run, 0 turns it off
+var WebhookFailureThreshold = func() int {
+	return convertToInt(webhookFailureThreshold, 0)
+}
+
+// ShutdownGracePeriod gives the time the scenarios running when a run is interrupted have to finish, along with the
+// teardown hooks, before the runner and plugins are stopped. It is set in seconds, 30 by default.
+var ShutdownGracePeriod = func() time.Duration {
+	return time.Duration(convertToInt(shutdownGracePeriod, 30)) * time.Second
+}
+
+// ParallelScenariosTag gives the tag of the specs whose scenarios are independent, which the scenario strategy of
+// parallel runs spreads across streams. It is parallel-scenarios by default.
+var ParallelScenariosTag = func() string {
+	if tag := strings.TrimSpace(os.Getenv(parallelScenariosTag)); tag != "" {
+		return tag
+	}
+	return "parallel-scenarios"
+}
+
+// DaemonMaxRunners gives the number of runners the daemon starts at the same time for the requests of its clients,
+// the others waiting their turn. It is 1 by default.
+var DaemonMaxRunners = func() int {
+	if n := convertToInt(daemonMaxRunners, 1); n > 0 {
+		return n
+	}
+	return 1
+}
+
+// ReportURL gives the template of the link to the reports of a run, which notifications point to
+var ReportURL = func() string {
+	return strings.TrimSpace(os.Getenv(reportURL))
+}
+
+// Artifacts gives the paths, relative to the project root, of the files which are archived after every run
+var Artifacts = func() []string {
+	return commaSeparated(artifacts)
+}
+
+// ArtifactsUploader gives the command which uploads the archive of artifacts after every run
+var ArtifactsUploader = func() string {
+	return strings.TrimSpace(os.Getenv(artifactsUploader))
+}
+
+// ScreenshotStrategy gives when screenshots are taken. Without gauge_screenshot_strategy, it follows screenshot_on_failure.
+var ScreenshotStrategy = func() string {
+	if strategy := strings.ToLower(strings.TrimSpace(os.Getenv(GaugeScreenshotStrategy))); strategy != "" {
+		return strategy
+	}
+	if convertToBool(ScreenshotOnFailure, true) {
+		return "on-failure"
+	}
+	return "never"
+}
+
+// ScreenshotTags gives the tags of the scenarios whose steps are screenshot with the on-step-tags strategy
+var ScreenshotTags = func() []string {
+	return commaSeparated(screenshotTags)
+}
+
+// ReportTitle gives the title of the reports, which replaces the project name in the suite result
+var ReportTitle = func() string {
+	return strings.TrimSpace(os.Getenv(reportTitle))
+}
+
+// ReportLogo gives the absolute path of the logo shown in the reports
+var ReportLogo = func() string {
+	logo := strings.TrimSpace(os.Getenv(GaugeReportLogo))
+	if logo == "" || filepath.IsAbs(logo) {
+		return logo
+	}
+	return filepath.Join(config.ProjectRoot, logo)
+}
+
+// ReportField is an extra name and value shown in the summary of the reports
+type ReportField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ReportFields gives the extra summary fields of the reports, written as "Build: 42, Team: Payments"
+var ReportFields = func() []ReportField {
+	var fields []ReportField
+	for _, f := range commaSeparated(reportFields) {
+		parts := strings.SplitN(f, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			logger.Warningf(true, "Ignoring report field '%s' in %s, it should be written as name: value", f, reportFields)
+			continue
+		}
+		fields = append(fields, ReportField{Name: strings.TrimSpace(parts[0]), Value: strings.TrimSpace(parts[1])})
+	}
+	return fields
+}
+
+// ReportFormats gives the formats, like junit or tap, in which gauge writes the result of every run
+var ReportFormats = func() []string {
+	return commaSeparated(reportFormats)
+}
+
+// TraceabilityAnnotation gives the key of the annotation, like requirement, whose values are traced to scenarios after every run
+var TraceabilityAnnotation = func() string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(os.Getenv(traceabilityAnnotation)), "@"))
+}
+
+// LintCommand gives the command which gauge lint runs on the spec files to check the rules of the project
+var LintCommand = func() string {
+	return strings.TrimSpace(os.Getenv(lintCommand))
+}
+
+// LintDictionaries gives the word lists, one word per line, which the spelling lint rule checks specs against
+var LintDictionaries = func() []string {
+	return commaSeparated(lintDictionaries)
+}
+
+// LintGlossary gives the file of approved domain terms, and of terms to replace, of the spelling lint rule
+var LintGlossary = func() string {
+	return strings.TrimSpace(os.Getenv(lintGlossary))
+}
+
+// MaskedEnvParams gives the environment variables whose values are masked in reports when steps use them as <env:NAME>
+var MaskedEnvParams = func() []string {
+	return commaSeparated(maskedEnvParams)
+}
+
+func commaSeparated(property string) []string {
+	var values []string
+	for _, v := range strings.Split(os.Getenv(property), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// FollowSymlinks determines if the discovery of spec and concept files follows symlinks, which are ignored otherwise
+var FollowSymlinks = func() bool {
+	return convertToBool(followSymlinks, false)
+}
+
 // AllowCaseSensitiveTags determines if the casing is ignored in tags filtering
 var AllowCaseSensitiveTags = func() bool {
 	return convertToBool(allowCaseSensitiveTags, false)
 }
 
+// GaugeAttachmentsDir gives the directory where the files attached by steps and hooks are stored, next to the screenshots
+var GaugeAttachmentsDir = func() string {
+	if d := os.Getenv(attachmentsDir); d != "" {
+		return d
+	}
+	return defaultAttachmentsDir()
+}
+
+func defaultAttachmentsDir() string {
+	return filepath.Join(config.ProjectRoot, common.DotGauge, "attachments")
+}
+
 // GaugeDataDir gets the data files location. This location should be relative to GAUGE_PROJECT_ROOT
 var GaugeDataDir = func() string {
 	d := os.Getenv(gaugeDataDir)
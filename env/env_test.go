@@ -452,3 +452,21 @@ func (s *MySuite) TestLoadEnvWithAcyclicProperties(c *C) {
 	c.Assert(os.Getenv("e"), Equals, "foo")
 	c.Assert(os.Getenv("f"), Equals, "foo")
 }
+
+func (s *MySuite) TestScreenshotStrategyFollowsScreenshotOnFailure(c *C) {
+	os.Clearenv()
+	c.Assert(ScreenshotStrategy(), Equals, "on-failure")
+
+	os.Setenv(ScreenshotOnFailure, "false")
+	c.Assert(ScreenshotStrategy(), Equals, "never")
+
+	os.Setenv(GaugeScreenshotStrategy, " Always ")
+	c.Assert(ScreenshotStrategy(), Equals, "always")
+}
+
+func (s *MySuite) TestReportFieldsSkipFieldsWithoutName(c *C) {
+	os.Clearenv()
+	os.Setenv("gauge_report_fields", "Build: 42, Team, : none, URL: http://ci.example.com/42")
+
+	c.Assert(ReportFields(), DeepEquals, []ReportField{{"Build", "42"}, {"URL", "http://ci.example.com/42"}})
+}
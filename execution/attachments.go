@@ -0,0 +1,76 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+// The runner protocol has no dedicated field for attachments, so steps and hooks attach a file, like a video, a HAR
+// file or a log, by writing a message "gauge:attach <mime type> <file>". Gauge copies the file to gauge_attachments_dir
+// and replaces the message with "gauge:attachment <mime type> <stored file>", which report plugins read. The attachments
+// of every scenario are collected on the suite result and written to the json report format, those of the failed
+// ones to failures.json as well.
+const attachMessagePrefix = "gauge:attach "
+
+// storeAttachments stores the files attached in the messages of the result and points the messages to the stored copies
+func storeAttachments(res *gauge_messages.ProtoExecutionResult) {
+	for i, m := range res.GetMessage() {
+		if !strings.HasPrefix(m, attachMessagePrefix) {
+			continue
+		}
+		a, err := result.ParseAttachment(strings.TrimPrefix(m, attachMessagePrefix))
+		if err == nil {
+			a.File, err = storeAttachment(a.File)
+		}
+		if err != nil {
+			logger.Warningf(true, "Failed to attach %s. %s", strings.TrimPrefix(m, attachMessagePrefix), err.Error())
+			continue
+		}
+		res.Message[i] = fmt.Sprintf("%s%s %s", result.AttachmentMessagePrefix, a.MimeType, a.File)
+	}
+}
+
+// storeAttachment copies the file to the attachments directory under a unique name, unless it is already there
+func storeAttachment(file string) (string, error) {
+	dir, err := filepath.Abs(env.GaugeAttachmentsDir())
+	if err != nil {
+		return "", err
+	}
+	if abs, err := filepath.Abs(file); err == nil && filepath.Dir(abs) == dir {
+		return abs, nil
+	}
+	if err = os.MkdirAll(util.LongPath(dir), common.NewDirectoryPermissions); err != nil {
+		return "", err
+	}
+	src, err := os.Open(util.LongPath(file))
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+	dst, err := ioutil.TempFile(util.LongPath(dir), "*-"+filepath.Base(file))
+	if err != nil {
+		return "", err
+	}
+	defer dst.Close()
+	if _, err = io.Copy(dst, src); err != nil {
+		return "", err
+	}
+	return util.NormalizePath(dst.Name()), nil
+}
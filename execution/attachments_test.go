@@ -0,0 +1,52 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestStoreAttachmentsCopiesTheFileAndRewritesTheMessage(c *C) {
+	tmp, err := ioutil.TempDir("", "attachments")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(tmp)
+	old := env.GaugeAttachmentsDir
+	env.GaugeAttachmentsDir = func() string { return filepath.Join(tmp, "attachments") }
+	defer func() { env.GaugeAttachmentsDir = old }()
+	video := filepath.Join(tmp, "run video.mp4")
+	c.Assert(ioutil.WriteFile(video, []byte("video"), 0644), IsNil)
+	res := &gauge_messages.ProtoExecutionResult{Message: []string{"logged in", "gauge:attach video/mp4 " + video}}
+
+	storeAttachments(res)
+
+	c.Assert(res.Message[0], Equals, "logged in")
+	a := result.Attachments(res.Message)
+	c.Assert(len(a), Equals, 1)
+	c.Assert(a[0].MimeType, Equals, "video/mp4")
+	c.Assert(filepath.Dir(a[0].File), Equals, filepath.Join(tmp, "attachments"))
+	c.Assert(strings.HasSuffix(a[0].File, "-run video.mp4"), Equals, true)
+	content, err := ioutil.ReadFile(a[0].File)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, "video")
+}
+
+func (s *MySuite) TestStoreAttachmentsKeepsInvalidRequests(c *C) {
+	res := &gauge_messages.ProtoExecutionResult{Message: []string{"gauge:attach trace.har", "gauge:attach application/json /does/not/exist.har"}}
+
+	storeAttachments(res)
+
+	c.Assert(res.Message, DeepEquals, []string{"gauge:attach trace.har", "gauge:attach application/json /does/not/exist.har"})
+	c.Assert(result.Attachments(res.Message), IsNil)
+}
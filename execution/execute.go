@@ -18,11 +18,14 @@
    Strategy
     	- Lazy : Lazy is a parallelization strategy for execution. In this case tests assignment will be dynamic during execution, i.e. assign the next spec in line to the stream that has completed it’s previous execution and is waiting for more work.
     	- Eager : Eager is a parallelization strategy for execution. In this case tests are distributed before execution, thus making them an equal number based distribution.
+    	- Scenario : Scenario is a lazy parallelization strategy which runs the scenarios of the specs tagged as having independent scenarios on different streams.
 */
 package execution
 
 import (
+	"context"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/getgauge/gauge/skel"
@@ -38,17 +41,22 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"path/filepath"
+	"sort"
 
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/artifacts"
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/rerun"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/i18n"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/plugin/install"
+	"github.com/getgauge/gauge/reportWriter"
 	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/track"
 	"github.com/getgauge/gauge/validation"
 )
 
@@ -62,6 +70,15 @@ var MaxRetriesCount int
 // Tags to filter specs/scenarios to retry
 var RetryOnlyTags string
 
+// SkipStepTags is the tag expression of the steps which are skipped
+var SkipStepTags string
+
+// MaxFailures skips the scenarios left once this many scenarios have failed, none being skipped when it is 0
+var MaxFailures int
+
+// RetryInfraFailures retries once, on a fresh runner, the scenarios which failed due to an infrastructure error
+var RetryInfraFailures bool
+
 // NumberOfExecutionStreams shows the number of execution streams, in parallel execution.
 var NumberOfExecutionStreams int
 
@@ -78,6 +95,9 @@ var MachineReadable bool
 
 var ExecutionArgs []*gauge.ExecutionArg
 
+// GithubAnnotations makes failed scenarios also print as GitHub Actions error annotations
+var GithubAnnotations bool
+
 type suiteExecutor interface {
 	run() *result.SuiteResult
 }
@@ -88,8 +108,17 @@ type executor interface {
 
 // ExecuteSpecs : Check for updates, validates the specs (by invoking the respective language runners), initiates the registry which is needed for console reporting, execution API and Rerunning of specs
 // and finally saves the execution result as binary in .gauge folder.
-var ExecuteSpecs = func(specDirs []string) int {
-	err := validateFlags()
+// When the context is done, the run is interrupted: the scenarios left are skipped, while the running ones and the
+// teardown hooks are given the shutdown grace period to finish before the runner and plugins are stopped.
+var ExecuteSpecs = func(ctx context.Context, specDirs []string) int {
+	exitCode, _ := Execute(ctx, specDirs)
+	return exitCode
+}
+
+// Execute runs the specs as ExecuteSpecs does, and gives the result of the suite as well, which is nil when the
+// specs did not get to run. The result is marked interrupted, and partial, when the context is done during the run.
+func Execute(stop context.Context, specDirs []string) (int, *result.SuiteResult) {
+	err := ValidateFlags()
 	if err != nil {
 		logger.Fatalf(true, err.Error())
 	}
@@ -99,42 +128,64 @@ var ExecuteSpecs = func(specDirs []string) int {
 		defer i.PrintUpdateBuffer()
 	}
 	skel.SetupPlugins(MachineReadable)
+	atomic.StoreInt32(&failedScenarios, 0)
 	err = os.Setenv(gaugeParallelStreamCountEnv, strconv.Itoa(NumberOfExecutionStreams))
 	if err != nil {
 		logger.Fatalf(true, "failed to set env %s. %s", gaugeParallelStreamCountEnv, err.Error())
 	}
 
-	res := validation.ValidateSpecs(specDirs, false)
+	ctx, cancel := withGracePeriod(stop, env.ShutdownGracePeriod())
+	defer cancel()
+	res := validation.ValidateSpecs(ctx, specDirs, false)
+	if interrupted(stop) {
+		logger.Errorf(true, "Execution interrupted before the specifications ran.")
+		if res.Runner != nil {
+			if err := res.Runner.Kill(); err != nil {
+				logger.Errorf(false, "unable to kill runner: %s", err.Error())
+			}
+		}
+		return Interrupted, nil
+	}
 	if len(res.Errs) > 0 {
 		if res.ParseOk {
-			return ParseFailed
+			return ParseFailed, nil
 		}
-		return ValidationFailed
+		return ValidationFailed, nil
 	}
 	if res.SpecCollection.Size() < 1 {
-		logger.Infof(true, "No specifications found in %s.", strings.Join(specDirs, ", "))
+		logger.Info(true, i18n.Sprintf("No specifications found in %s.", strings.Join(specDirs, ", ")))
 		err := res.Runner.Kill()
 		if err != nil {
 			logger.Errorf(false, "unable to kill runner: %s", err.Error())
 		}
 		if res.ParseOk {
-			return Success
+			return Success, nil
 		}
-		return ExecutionFailed
+		return ExecutionFailed, nil
 	}
 	event.InitRegistry()
 	wg := &sync.WaitGroup{}
 	reporter.ListenExecutionEvents(wg)
 	rerun.ListenFailedScenarios(wg, specDirs)
+	ListenSuiteEndAndSaveFailureSummary(wg)
+	ListenExecutionEventsAndNotify(wg)
+	ListenExecutionEventsAndRecordMetrics(wg)
+	ListenSuiteEndAndWriteReports(wg)
+	ListenSuiteEndAndSaveTraceabilityMatrix(wg)
 	if env.SaveExecutionResult() {
 		ListenSuiteEndAndSaveResult(wg)
 	}
-	defer wg.Wait()
-	ei := newExecutionInfo(res.SpecCollection, res.Runner, nil, res.ErrMap, InParallel, 0)
+	ei := newExecutionInfo(ctx, res.SpecCollection, res.Runner, nil, res.ErrMap, InParallel, 0)
+	ei.stop = stop
 
 	e := ei.getExecutor()
 	logger.Debug(true, "Run started")
-	return printExecutionResult(e.run(), res.ParseOk)
+	suiteResult := e.run()
+	queue.end(suiteResult.Interrupted)
+	exitCode := printExecutionResult(suiteResult, res.ParseOk)
+	wg.Wait()
+	artifacts.CollectAfterRun(failureSummaryDir())
+	return exitCode, suiteResult
 }
 
 func writeExecutionResult(content string) {
@@ -165,52 +216,66 @@ func ReadLastExecutionResult() (interface{}, error) {
 }
 
 func printExecutionResult(suiteResult *result.SuiteResult, isParsingOk bool) int {
-	nSkippedSpecs := suiteResult.SpecsSkippedCount
-	var nExecutedSpecs int
-	if len(suiteResult.SpecResults) != 0 {
-		nExecutedSpecs = len(suiteResult.SpecResults) - nSkippedSpecs
+	status := newExecutionStatus(suiteResult)
+	logger.Info(true, i18n.Sprintf("Specifications:\t%d executed\t%d passed\t%d failed\t%d skipped", status.SpecsExecuted, status.SpecsPassed, status.SpecsFailed, status.SpecsSkipped))
+	logger.Info(true, i18n.Sprintf("Scenarios:\t%d executed\t%d passed\t%d failed\t%d skipped", status.SceExecuted, status.ScePassed, status.SceFailed, status.SceSkipped))
+	if status.SceInfraFailed > 0 || status.SceInfraRetried > 0 {
+		logger.Info(true, i18n.Sprintf("Infrastructure:\t%d failed\t%d retried on a fresh runner", status.SceInfraFailed, status.SceInfraRetried))
 	}
-	nFailedSpecs := suiteResult.SpecsFailedCount
-	nPassedSpecs := nExecutedSpecs - nFailedSpecs
-
-	nExecutedScenarios := 0
-	nFailedScenarios := 0
-	nPassedScenarios := 0
-	nSkippedScenarios := 0
-	for _, specResult := range suiteResult.SpecResults {
-		nExecutedScenarios += specResult.ScenarioCount
-		nFailedScenarios += specResult.ScenarioFailedCount
-		nSkippedScenarios += specResult.ScenarioSkippedCount
-	}
-	nExecutedScenarios -= nSkippedScenarios
-	nPassedScenarios = nExecutedScenarios - nFailedScenarios
-	if nExecutedScenarios < 0 {
-		nExecutedScenarios = 0
+	printStatsByPriority(status.Priorities)
+	if status.SceFailed > 0 {
+		printFailuresByOwner(suiteResult.FailureSummary.GetFailuresByOwner())
+		if GithubAnnotations {
+			printGithubAnnotations(suiteResult.FailureSummary.GetFailures())
+		}
 	}
-
-	if nPassedScenarios < 0 {
-		nPassedScenarios = 0
+	logger.Info(true, i18n.Sprintf("\nTotal time taken: %s", time.Millisecond*time.Duration(suiteResult.ExecutionTime)))
+	track.Execution(status.SpecsExecuted, status.SceExecuted, status.SceFailed, InParallel, time.Millisecond*time.Duration(suiteResult.ExecutionTime))
+	s, err := status.getJSON()
+	if err != nil {
+		logger.Fatalf(true, "Unable to parse execution status information : %v", err.Error())
 	}
-
-	s := statusJSON(nExecutedSpecs, nPassedSpecs, nFailedSpecs, nSkippedSpecs, nExecutedScenarios, nPassedScenarios, nFailedScenarios, nSkippedScenarios)
-	logger.Infof(true, "Specifications:\t%d executed\t%d passed\t%d failed\t%d skipped", nExecutedSpecs, nPassedSpecs, nFailedSpecs, nSkippedSpecs)
-	logger.Infof(true, "Scenarios:\t%d executed\t%d passed\t%d failed\t%d skipped", nExecutedScenarios, nPassedScenarios, nFailedScenarios, nSkippedScenarios)
-	logger.Infof(true, "\nTotal time taken: %s", time.Millisecond*time.Duration(suiteResult.ExecutionTime))
 	writeExecutionResult(s)
 
 	if !isParsingOk {
 		return ParseFailed
 	}
+	if suiteResult.Interrupted {
+		logger.Info(true, i18n.T("The run was interrupted, the result is partial."))
+		return Interrupted
+	}
 	if suiteResult.IsFailed {
 		return ExecutionFailed
 	}
 	return Success
 }
 
-func validateFlags() error {
+func printFailuresByOwner(failures map[string]int) {
+	if len(failures) == 0 {
+		return
+	}
+	names := make([]string, 0, len(failures))
+	for n := range failures {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	logger.Info(true, i18n.T("Failures by owner:"))
+	for _, n := range names {
+		logger.Info(true, i18n.Sprintf("\t%s\t%d failed", n, failures[n]))
+	}
+}
+
+// ValidateFlags checks the options of the run set on the package
+func ValidateFlags() error {
 	if MaxRetriesCount < 1 {
 		return fmt.Errorf("invalid input(%s) to --max-retries-count flag", strconv.Itoa(MaxRetriesCount))
 	}
+	if MaxFailures < 0 {
+		return fmt.Errorf("invalid input(%s) to --max-failures flag", strconv.Itoa(MaxFailures))
+	}
+	if err := reportWriter.Validate(reportFormats()); err != nil {
+		return err
+	}
 	if !InParallel {
 		return nil
 	}
@@ -35,7 +35,7 @@ func createSpecsList(number int) []*gauge.Specification {
 
 func (s *MySuite) TestValidateFlagsIfNotParallel(c *C) {
 	InParallel = false
-	err := validateFlags()
+	err := ValidateFlags()
 	c.Assert(err, Equals, nil)
 }
 
@@ -43,7 +43,7 @@ func (s *MySuite) TestValidateFlagsWithStartegyEager(c *C) {
 	InParallel = true
 	Strategy = "eager"
 	NumberOfExecutionStreams = 1
-	err := validateFlags()
+	err := ValidateFlags()
 	c.Assert(err, Equals, nil)
 }
 
@@ -51,21 +51,30 @@ func (s *MySuite) TestValidateFlagsWithStartegyLazy(c *C) {
 	InParallel = true
 	Strategy = "lazy"
 	NumberOfExecutionStreams = 1
-	err := validateFlags()
+	err := ValidateFlags()
 	c.Assert(err, Equals, nil)
 }
 
+func (s *MySuite) TestValidateFlagsWithStrategyScenario(c *C) {
+	InParallel = true
+	Strategy = "scenario"
+	NumberOfExecutionStreams = 1
+	err := ValidateFlags()
+	c.Assert(err, Equals, nil)
+	c.Assert(isLazy(), Equals, true)
+}
+
 func (s *MySuite) TestValidateFlagsWithInvalidStrategy(c *C) {
 	InParallel = true
 	Strategy = "sdf"
 	NumberOfExecutionStreams = 1
-	err := validateFlags()
+	err := ValidateFlags()
 	c.Assert(err.Error(), Equals, "invalid input(sdf) to --strategy flag")
 }
 
 func (s *MySuite) TestValidateFlagsWithInvalidStream(c *C) {
 	InParallel = true
 	NumberOfExecutionStreams = -1
-	err := validateFlags()
+	err := ValidateFlags()
 	c.Assert(err.Error(), Equals, "invalid input(-1) to --n flag")
 }
@@ -7,6 +7,8 @@
 package execution
 
 import (
+	"context"
+
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
@@ -15,6 +17,8 @@ import (
 )
 
 type executionInfo struct {
+	ctx             context.Context
+	stop            context.Context
 	manifest        *manifest.Manifest
 	specs           *gauge.SpecCollection
 	runner          runner.Runner
@@ -26,12 +30,13 @@ type executionInfo struct {
 	stream          int
 }
 
-func newExecutionInfo(s *gauge.SpecCollection, r runner.Runner, ph plugin.Handler, e *gauge.BuildErrors, p bool, stream int) *executionInfo {
+func newExecutionInfo(ctx context.Context, s *gauge.SpecCollection, r runner.Runner, ph plugin.Handler, e *gauge.BuildErrors, p bool, stream int) *executionInfo {
 	m, err := manifest.ProjectManifest()
 	if err != nil {
 		logger.Fatalf(true, err.Error())
 	}
 	return &executionInfo{
+		ctx:             ctx,
 		manifest:        m,
 		specs:           s,
 		runner:          r,
@@ -44,6 +49,14 @@ func newExecutionInfo(s *gauge.SpecCollection, r runner.Runner, ph plugin.Handle
 	}
 }
 
+// context gives the context of the run, which stops the execution when it is done
+func (executionInfo *executionInfo) context() context.Context {
+	if executionInfo.ctx == nil {
+		return context.Background()
+	}
+	return executionInfo.ctx
+}
+
 func (executionInfo *executionInfo) getExecutor() suiteExecutor {
 	if executionInfo.inParallel {
 		return newParallelExecution(executionInfo)
@@ -3,19 +3,26 @@ package execution
 import (
 	"encoding/json"
 
-	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/execution/result"
 )
 
 type executionStatus struct {
-	Type          string `json:"type"`
-	SpecsExecuted int    `json:"specsExecuted"`
-	SpecsPassed   int    `json:"specsPassed"`
-	SpecsFailed   int    `json:"specsFailed"`
-	SpecsSkipped  int    `json:"specsSkipped"`
-	SceExecuted   int    `json:"sceExecuted"`
-	ScePassed     int    `json:"scePassed"`
-	SceFailed     int    `json:"sceFailed"`
-	SceSkipped    int    `json:"sceSkipped"`
+	Type          string                        `json:"type"`
+	SpecsExecuted int                           `json:"specsExecuted"`
+	SpecsPassed   int                           `json:"specsPassed"`
+	SpecsFailed   int                           `json:"specsFailed"`
+	SpecsSkipped  int                           `json:"specsSkipped"`
+	SceExecuted   int                           `json:"sceExecuted"`
+	ScePassed     int                           `json:"scePassed"`
+	SceFailed     int                           `json:"sceFailed"`
+	SceSkipped    int                           `json:"sceSkipped"`
+	TagNamespaces map[string][]*result.TagStats `json:"tagNamespaces,omitempty"`
+	Interrupted   bool                          `json:"interrupted,omitempty"`
+	// Priorities are the scenarios by priority level, when some have a priority
+	Priorities []*priorityStats `json:"priorities,omitempty"`
+	// SceInfraFailed are the scenarios which failed due to an infrastructure error, they are counted as failed too
+	SceInfraFailed  int `json:"sceInfraFailed,omitempty"`
+	SceInfraRetried int `json:"sceInfraRetried,omitempty"`
 }
 
 func (status *executionStatus) getJSON() (string, error) {
@@ -26,20 +33,30 @@ func (status *executionStatus) getJSON() (string, error) {
 	return string(j), nil
 }
 
-func statusJSON(executedSpecs, passedSpecs, failedSpecs, skippedSpecs, executedScenarios, passedScenarios, failedScenarios, skippedScenarios int) string {
-	executionStatus := &executionStatus{}
-	executionStatus.Type = "out"
-	executionStatus.SpecsExecuted = executedSpecs
-	executionStatus.SpecsPassed = passedSpecs
-	executionStatus.SpecsFailed = failedSpecs
-	executionStatus.SpecsSkipped = skippedSpecs
-	executionStatus.SceExecuted = executedScenarios
-	executionStatus.ScePassed = passedScenarios
-	executionStatus.SceFailed = failedScenarios
-	executionStatus.SceSkipped = skippedScenarios
-	s, err := executionStatus.getJSON()
-	if err != nil {
-		logger.Fatalf(true, "Unable to parse execution status information : %v", err.Error())
+func newExecutionStatus(suiteResult *result.SuiteResult) *executionStatus {
+	executionStatus := &executionStatus{Type: "out", Interrupted: suiteResult.Interrupted}
+	executionStatus.SpecsSkipped = suiteResult.SpecsSkippedCount
+	if len(suiteResult.SpecResults) != 0 {
+		executionStatus.SpecsExecuted = len(suiteResult.SpecResults) - executionStatus.SpecsSkipped
+	}
+	executionStatus.SpecsFailed = suiteResult.SpecsFailedCount
+	executionStatus.SpecsPassed = executionStatus.SpecsExecuted - executionStatus.SpecsFailed
+	for _, specResult := range suiteResult.SpecResults {
+		executionStatus.SceExecuted += specResult.ScenarioCount
+		executionStatus.SceFailed += specResult.ScenarioFailedCount
+		executionStatus.SceSkipped += specResult.ScenarioSkippedCount
+		executionStatus.SceInfraFailed += specResult.ScenarioInfraFailedCount
+		executionStatus.SceInfraRetried += specResult.ScenarioInfraRetriedCount
+	}
+	executionStatus.SceExecuted -= executionStatus.SceSkipped
+	executionStatus.ScePassed = executionStatus.SceExecuted - executionStatus.SceFailed
+	if executionStatus.SceExecuted < 0 {
+		executionStatus.SceExecuted = 0
+	}
+	if executionStatus.ScePassed < 0 {
+		executionStatus.ScePassed = 0
 	}
-	return s
+	executionStatus.TagNamespaces = suiteResult.StatsByTagNamespace()
+	executionStatus.Priorities = statsByPriority(suiteResult)
+	return executionStatus
 }
@@ -15,4 +15,6 @@ const (
 	ParseFailed = 2
 	// ValidationFailed indicates one or more validation errors
 	ValidationFailed = 3
+	// Interrupted indicates the run was interrupted, like with Ctrl-C, before all the specs ran
+	Interrupted = 4
 )
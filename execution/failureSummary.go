@@ -0,0 +1,267 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/owners"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	failureSummaryFile = "failures.json"
+
+	stepFailure             = "step"
+	beforeScenarioHookError = "before_scenario_hook"
+	afterScenarioHookError  = "after_scenario_hook"
+
+	unowned = "unowned"
+)
+
+// ListenSuiteEndAndSaveFailureSummary listens to the suite end event and writes a summary of failed scenarios to a JSON file
+func ListenSuiteEndAndSaveFailureSummary(wg *sync.WaitGroup) {
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			if e.Topic == event.SuiteEnd {
+				writeFailureSummary(e.Result.(*result.SuiteResult))
+				wg.Done()
+			}
+		}
+	}()
+}
+
+func writeFailureSummary(res *result.SuiteResult) {
+	reportsDir := failureSummaryDir()
+	summaryFile := filepath.Join(reportsDir, failureSummaryFile)
+	if err := os.MkdirAll(util.LongPath(reportsDir), common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", reportsDir, err.Error())
+		return
+	}
+	b, err := json.MarshalIndent(res.FailureSummary, "", "\t")
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal failure summary, skipping save. %s", err.Error())
+		return
+	}
+	if err = ioutil.WriteFile(util.LongPath(summaryFile), b, common.NewFilePermissions); err != nil {
+		logger.Errorf(true, "Failed to write to %s. Reason: %s", summaryFile, err.Error())
+	} else {
+		logger.Debugf(true, "Failure summary saved to %s", summaryFile)
+	}
+}
+
+func failureSummaryDir() string {
+	dir := os.Getenv(env.GaugeReportsDir)
+	if dir == "" {
+		dir = "reports"
+	}
+	if filepath.IsAbs(dir) {
+		return dir
+	}
+	return filepath.Join(config.ProjectRoot, dir)
+}
+
+// LastRunFailures reads the failure summary of the last run and returns the headings of the failed scenarios by spec file
+func LastRunFailures() (map[string]map[string]bool, error) {
+	b, err := ioutil.ReadFile(filepath.Join(failureSummaryDir(), failureSummaryFile))
+	if err != nil {
+		return nil, err
+	}
+	summary := &result.FailureSummary{}
+	if err = json.Unmarshal(b, summary); err != nil {
+		return nil, fmt.Errorf("invalid failure summary. %s", err.Error())
+	}
+	failures := make(map[string]map[string]bool)
+	for _, f := range summary.Failures {
+		if failures[f.File] == nil {
+			failures[f.File] = make(map[string]bool)
+		}
+		failures[f.File][f.Heading] = true
+	}
+	return failures, nil
+}
+
+func loadOwners() *owners.Owners {
+	o, err := owners.Load(config.ProjectRoot)
+	if err != nil {
+		logger.Warningf(true, "Failures will not be grouped by owner. %s", err.Error())
+	}
+	return o
+}
+
+// setFailureSummary summarises the failed scenarios of the run on its result, reading SPECOWNERS once for all the
+// reports of the failures
+func setFailureSummary(res *result.SuiteResult) {
+	res.FailureSummary = newFailureSummary(res, loadOwners())
+}
+
+func newFailureSummary(res *result.SuiteResult, o *owners.Owners) *result.FailureSummary {
+	summary := &result.FailureSummary{Failures: make([]*result.ScenarioFailure, 0), Interrupted: res.Interrupted}
+	for _, specRes := range res.SpecResults {
+		if specRes.ProtoSpec == nil {
+			continue
+		}
+		for _, item := range specRes.ProtoSpec.GetItems() {
+			scn := item.GetScenario()
+			var row int32
+			if item.GetItemType() == gauge_messages.ProtoItem_TableDrivenScenario {
+				scn = item.GetTableDrivenScenario().GetScenario()
+				row = tableDrivenScenarioRow(item.GetTableDrivenScenario())
+			}
+			if scn == nil || scn.GetExecutionStatus() != gauge_messages.ExecutionStatus_FAILED {
+				continue
+			}
+			f := newScenarioFailure(specRes.ProtoSpec.GetFileName(), scn)
+			f.Row = row
+			if f.Deprecated == nil {
+				f.Deprecated = deprecationMessage(specRes.ProtoSpec.GetItems())
+			}
+			f.WIP = hasWIPMarker(scn.GetScenarioItems()) || hasWIPMarker(specRes.ProtoSpec.GetItems())
+			if o != nil {
+				tags := append(append([]string{}, specRes.ProtoSpec.GetTags()...), scn.GetTags()...)
+				f.Owners = o.For(util.RelPathToProjectRoot(f.File), tags)
+				addOwners(summary, f.Owners)
+			}
+			summary.Failures = append(summary.Failures, f)
+		}
+	}
+	return summary
+}
+
+func addOwners(s *result.FailureSummary, names []string) {
+	if s.FailuresByOwner == nil {
+		s.FailuresByOwner = make(map[string]int)
+	}
+	if len(names) == 0 {
+		s.FailuresByOwner[unowned]++
+	}
+	for _, n := range names {
+		s.FailuresByOwner[n]++
+	}
+}
+
+func printGithubAnnotations(failures []*result.ScenarioFailure) {
+	for _, f := range failures {
+		fmt.Println(util.GithubErrorCommand(f.File, int(f.Line), annotationMessage(f)))
+	}
+}
+
+func annotationMessage(f *result.ScenarioFailure) string {
+	msg := fmt.Sprintf("Scenario '%s' failed", f.Heading)
+	if f.Row > 0 {
+		msg = fmt.Sprintf("%s for row %d", msg, f.Row)
+	}
+	switch f.Category {
+	case stepFailure:
+		msg = fmt.Sprintf("%s at step '%s'", msg, f.Step)
+	case beforeScenarioHookError:
+		msg += " in the before scenario hook"
+	case afterScenarioHookError:
+		msg += " in the after scenario hook"
+	}
+	if f.ErrorMessage != "" {
+		msg = fmt.Sprintf("%s.\n%s", msg, f.ErrorMessage)
+	}
+	return msg
+}
+
+// tableDrivenScenarioRow returns the 1-based number of the data table row which drove the scenario
+func tableDrivenScenarioRow(t *gauge_messages.ProtoTableDrivenScenario) int32 {
+	if t.GetIsScenarioTableDriven() {
+		return t.GetScenarioTableRowIndex() + 1
+	}
+	return t.GetTableRowIndex() + 1
+}
+
+func newScenarioFailure(file string, scn *gauge_messages.ProtoScenario) *result.ScenarioFailure {
+	f := &result.ScenarioFailure{File: file, Line: scn.GetSpan().GetStart(), Heading: scn.GetScenarioHeading(), Screenshots: make([]string, 0)}
+	for _, item := range scn.GetScenarioItems() {
+		if id := gauge.StableID(item.GetComment().GetText()); id != "" {
+			f.ID = id
+			break
+		}
+	}
+	f.Deprecated = deprecationMessage(scn.GetScenarioItems())
+	f.Attachments = result.ProtoScenarioAttachments(scn)
+	if h := scn.GetPreHookFailure(); h != nil {
+		setHookFailure(f, beforeScenarioHookError, h)
+		return f
+	}
+	var items []*gauge_messages.ProtoItem
+	items = append(items, scn.GetContexts()...)
+	items = append(items, scn.GetScenarioItems()...)
+	items = append(items, scn.GetTearDownSteps()...)
+	if step, res := failedStep(items); res != nil {
+		f.Category = stepFailure
+		f.Step = step.GetActualText()
+		f.ErrorMessage = res.GetErrorMessage()
+		f.StackTrace = res.GetStackTrace()
+		if res.GetFailureScreenshotFile() != "" {
+			f.Screenshots = append(f.Screenshots, res.GetFailureScreenshotFile())
+		}
+		f.Screenshots = append(f.Screenshots, res.GetScreenshotFiles()...)
+		return f
+	}
+	if h := scn.GetPostHookFailure(); h != nil {
+		setHookFailure(f, afterScenarioHookError, h)
+	}
+	return f
+}
+
+func setHookFailure(f *result.ScenarioFailure, category string, h *gauge_messages.ProtoHookFailure) {
+	f.Category = category
+	f.ErrorMessage = h.GetErrorMessage()
+	f.StackTrace = h.GetStackTrace()
+	if h.GetFailureScreenshotFile() != "" {
+		f.Screenshots = append(f.Screenshots, h.GetFailureScreenshotFile())
+	}
+}
+
+// deprecationMessage returns the message of the deprecation marker among the comments, or nil if there is none
+func deprecationMessage(items []*gauge_messages.ProtoItem) *string {
+	for _, item := range items {
+		if d := gauge.DeprecationOf(item.GetComment().GetText()); d != nil {
+			return &d.Message
+		}
+	}
+	return nil
+}
+
+func failedStep(items []*gauge_messages.ProtoItem) (*gauge_messages.ProtoStep, *gauge_messages.ProtoExecutionResult) {
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Step:
+			res := item.GetStep().GetStepExecutionResult().GetExecutionResult()
+			if res.GetFailed() {
+				return item.GetStep(), res
+			}
+		case gauge_messages.ProtoItem_Concept:
+			if step, res := failedStep(item.GetConcept().GetSteps()); res != nil {
+				return step, res
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,127 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/owners"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestFailureSummaryContainsFailedScenarios(c *C) {
+	failedStep := &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Step, Step: &gauge_messages.ProtoStep{
+		ActualText: "Step that fails",
+		StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{ExecutionResult: &gauge_messages.ProtoExecutionResult{
+			Failed:                true,
+			ErrorMessage:          "expected 1 got 2",
+			StackTrace:            "at foo.go:12",
+			FailureScreenshotFile: "shot.png",
+		}},
+	}}
+	concept := &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Concept, Concept: &gauge_messages.ProtoConcept{Steps: []*gauge_messages.ProtoItem{failedStep}}}
+	failed := &gauge_messages.ProtoScenario{ScenarioHeading: "failing", ExecutionStatus: gauge_messages.ExecutionStatus_FAILED, Span: &gauge_messages.Span{Start: 4}, ScenarioItems: []*gauge_messages.ProtoItem{concept}}
+	passed := &gauge_messages.ProtoScenario{ScenarioHeading: "passing", ExecutionStatus: gauge_messages.ExecutionStatus_PASSED}
+	idComment := &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Comment, Comment: &gauge_messages.ProtoComment{Text: "<!-- id: CHK-102 -->"}}
+	hookFailed := &gauge_messages.ProtoScenario{ScenarioHeading: "hook", ExecutionStatus: gauge_messages.ExecutionStatus_FAILED, PreHookFailure: &gauge_messages.ProtoHookFailure{ErrorMessage: "hook failed"}, ScenarioItems: []*gauge_messages.ProtoItem{idComment}}
+	spec := &gauge_messages.ProtoSpec{FileName: "foo.spec", Items: []*gauge_messages.ProtoItem{
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: failed},
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: passed},
+		{ItemType: gauge_messages.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gauge_messages.ProtoTableDrivenScenario{Scenario: hookFailed}},
+	}}
+	res := &result.SuiteResult{SpecResults: []*result.SpecResult{{ProtoSpec: spec}}}
+
+	summary := newFailureSummary(res, nil)
+
+	c.Assert(len(summary.Failures), Equals, 2)
+	c.Assert(summary.Failures[0], DeepEquals, &result.ScenarioFailure{
+		File:         "foo.spec",
+		Line:         4,
+		Heading:      "failing",
+		Step:         "Step that fails",
+		ErrorMessage: "expected 1 got 2",
+		StackTrace:   "at foo.go:12",
+		Screenshots:  []string{"shot.png"},
+		Category:     stepFailure,
+	})
+	c.Assert(summary.Failures[1].Row, Equals, int32(1))
+	c.Assert(summary.Failures[1].Category, Equals, beforeScenarioHookError)
+	c.Assert(summary.Failures[1].ErrorMessage, Equals, "hook failed")
+	c.Assert(summary.Failures[1].ID, Equals, "CHK-102")
+	c.Assert(summary.Failures[0].Deprecated, IsNil)
+}
+
+func (s *MySuite) TestFailureSummaryIsEmptyWhenNothingFailed(c *C) {
+	summary := newFailureSummary(&result.SuiteResult{}, nil)
+
+	c.Assert(len(summary.Failures), Equals, 0)
+}
+
+func (s *MySuite) TestFailureSummaryGroupsFailuresByOwner(c *C) {
+	o, err := owners.Parse([]byte("specs/ @qa\ntag:checkout @payments\n"))
+	c.Assert(err, IsNil)
+	failed := func(tags ...string) *gauge_messages.ProtoItem {
+		return &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{Tags: tags, ExecutionStatus: gauge_messages.ExecutionStatus_FAILED}}
+	}
+	res := &result.SuiteResult{SpecResults: []*result.SpecResult{
+		{ProtoSpec: &gauge_messages.ProtoSpec{FileName: "specs/foo.spec", Items: []*gauge_messages.ProtoItem{failed(), failed("checkout")}}},
+		{ProtoSpec: &gauge_messages.ProtoSpec{FileName: "other/bar.spec", Items: []*gauge_messages.ProtoItem{failed()}}},
+	}}
+
+	summary := newFailureSummary(res, o)
+
+	c.Assert(summary.Failures[0].Owners, DeepEquals, []string{"@qa"})
+	c.Assert(summary.Failures[1].Owners, DeepEquals, []string{"@payments"})
+	c.Assert(summary.Failures[2].Owners, IsNil)
+	c.Assert(summary.FailuresByOwner, DeepEquals, map[string]int{"@qa": 1, "@payments": 1, unowned: 1})
+}
+
+func (s *MySuite) TestSetFailureSummaryPutsTheOwnersOnTheSuiteResult(c *C) {
+	tmp, err := ioutil.TempDir("", "owners")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(tmp)
+	root := config.ProjectRoot
+	config.ProjectRoot = tmp
+	defer func() { config.ProjectRoot = root }()
+	c.Assert(ioutil.WriteFile(filepath.Join(tmp, owners.File), []byte("specs/ @qa\n"), 0644), IsNil)
+	failed := &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED}}
+	res := &result.SuiteResult{SpecResults: []*result.SpecResult{
+		{ProtoSpec: &gauge_messages.ProtoSpec{FileName: filepath.Join(tmp, "specs", "foo.spec"), Items: []*gauge_messages.ProtoItem{failed}}},
+	}}
+
+	setFailureSummary(res)
+
+	c.Assert(res.FailureSummary.GetFailures()[0].Owners, DeepEquals, []string{"@qa"})
+	c.Assert(res.FailureSummary.GetFailuresByOwner(), DeepEquals, map[string]int{"@qa": 1})
+}
+
+func (s *MySuite) TestFailureSummaryMarksDeprecatedScenarios(c *C) {
+	deprecated := &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Comment, Comment: &gauge_messages.ProtoComment{Text: "<!-- deprecated: use the v2 flow -->"}}
+	failed := &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED}
+	spec := &gauge_messages.ProtoSpec{FileName: "foo.spec", Items: []*gauge_messages.ProtoItem{deprecated, {ItemType: gauge_messages.ProtoItem_Scenario, Scenario: failed}}}
+
+	summary := newFailureSummary(&result.SuiteResult{SpecResults: []*result.SpecResult{{ProtoSpec: spec}}}, nil)
+
+	c.Assert(*summary.Failures[0].Deprecated, Equals, "use the v2 flow")
+}
+
+func (s *MySuite) TestAnnotationMessageOfFailedScenario(c *C) {
+	f := &result.ScenarioFailure{Heading: "Checkout", Row: 2, Category: stepFailure, Step: "Pay with card", ErrorMessage: "card declined"}
+
+	c.Assert(annotationMessage(f), Equals, "Scenario 'Checkout' failed for row 2 at step 'Pay with card'.\ncard declined")
+}
+
+func (s *MySuite) TestAnnotationMessageOfHookFailure(c *C) {
+	f := &result.ScenarioFailure{Heading: "Checkout", Category: beforeScenarioHookError}
+
+	c.Assert(annotationMessage(f), Equals, "Scenario 'Checkout' failed in the before scenario hook")
+}
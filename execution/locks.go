@@ -0,0 +1,133 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+// Scenarios hold named locks on the resources they share with other scenarios while they run, so that conflicting
+// scenarios never run at the same time, even on different streams of a parallel run, while the rest of the suite
+// stays parallel. The locks of a scenario are given by:
+//   - the @locks annotation of the scenario or its spec, like @locks: inventory-db, payment-sandbox:read. A lock is a
+//     write lock, held by one scenario at a time, unless it has the read mode, in which case scenarios which only
+//     read the resource hold it together.
+//   - the serialize: tags of the scenario or its spec, like serialize:database, which are write locks of the group.
+const (
+	locksAnnotation    = "locks"
+	serializeTagPrefix = "serialize:"
+	readLockMode       = "read"
+	writeLockMode      = "write"
+)
+
+var (
+	locksMu sync.Mutex
+	locks   = make(map[string]*sync.RWMutex)
+)
+
+// resourceLock is a named lock which a scenario holds while it runs
+type resourceLock struct {
+	name string
+	read bool
+}
+
+// scenarioLocks gives the locks of a scenario. They are sorted by name, so that scenarios which share several
+// resources lock them in the same order. A resource locked both to read and to write is locked to write.
+func scenarioLocks(spec *gauge.Specification, scn *gauge.Scenario) []resourceLock {
+	modes := make(map[string]bool)
+	add := func(name string, read bool) {
+		if r, ok := modes[name]; !ok || r {
+			modes[name] = read
+		}
+	}
+	for _, a := range append(append([]string{}, scn.Annotations[locksAnnotation]...), spec.Annotations[locksAnnotation]...) {
+		for _, l := range strings.Split(a, ",") {
+			if name, read, ok := parseLock(l); ok {
+				add(name, read)
+			}
+		}
+	}
+	for _, t := range append(getTagValue(scn.Tags), getTagValue(spec.Tags)...) {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if !strings.HasPrefix(t, serializeTagPrefix) {
+			continue
+		}
+		if name := strings.TrimSpace(strings.TrimPrefix(t, serializeTagPrefix)); name != "" {
+			add(name, false)
+		}
+	}
+	var names []string
+	for name := range modes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var res []resourceLock
+	for _, name := range names {
+		res = append(res, resourceLock{name: name, read: modes[name]})
+	}
+	return res
+}
+
+// parseLock parses a lock of the @locks annotation, the name of a resource optionally followed by its mode, like
+// payment-sandbox:read
+func parseLock(l string) (string, bool, bool) {
+	l = strings.ToLower(strings.TrimSpace(l))
+	if l == "" {
+		return "", false, false
+	}
+	name, mode := l, writeLockMode
+	if i := strings.LastIndex(l, ":"); i >= 0 {
+		name, mode = strings.TrimSpace(l[:i]), strings.TrimSpace(l[i+1:])
+	}
+	if name == "" {
+		return "", false, false
+	}
+	switch mode {
+	case readLockMode:
+		return name, true, true
+	case writeLockMode:
+		return name, false, true
+	}
+	logger.Warningf(true, "Invalid mode '%s' of lock %s, the mode should be read or write. Locking it to write.", mode, name)
+	return name, false, true
+}
+
+// acquireLocks waits until the scenario can hold the locks, and holds them until the returned func is called
+func acquireLocks(ls []resourceLock) (release func()) {
+	var held []func()
+	for _, l := range ls {
+		m := lockOf(l.name)
+		if l.read {
+			m.RLock()
+			held = append(held, m.RUnlock)
+		} else {
+			m.Lock()
+			held = append(held, m.Unlock)
+		}
+	}
+	return func() {
+		for i := len(held) - 1; i >= 0; i-- {
+			held[i]()
+		}
+	}
+}
+
+func lockOf(name string) *sync.RWMutex {
+	locksMu.Lock()
+	defer locksMu.Unlock()
+	m, ok := locks[name]
+	if !ok {
+		m = &sync.RWMutex{}
+		locks[name] = m
+	}
+	return m
+}
@@ -0,0 +1,106 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+func TestScenarioLocksFromAnnotationsAndSerializeTags(t *testing.T) {
+	spec := &gauge.Specification{
+		Tags:        &gauge.Tags{RawValues: [][]string{{"serialize:Database"}}},
+		Annotations: gauge.Annotations{"locks": {"payment-sandbox:read"}},
+	}
+	scn := &gauge.Scenario{
+		Tags:        &gauge.Tags{RawValues: [][]string{{"smoke", "serialize:"}}},
+		Annotations: gauge.Annotations{"locks": {"inventory-db, payment-sandbox:read , fixtures:read"}},
+	}
+
+	got := scenarioLocks(spec, scn)
+
+	want := []resourceLock{{name: "database"}, {name: "fixtures", read: true}, {name: "inventory-db"}, {name: "payment-sandbox", read: true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected locks %v, got %v", want, got)
+	}
+}
+
+func TestScenarioLocksPrefersWriteMode(t *testing.T) {
+	spec := &gauge.Specification{Annotations: gauge.Annotations{"locks": {"inventory-db:write"}}}
+	scn := &gauge.Scenario{Annotations: gauge.Annotations{"locks": {"inventory-db:read"}}}
+
+	got := scenarioLocks(spec, scn)
+
+	want := []resourceLock{{name: "inventory-db"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected locks %v, got %v", want, got)
+	}
+}
+
+func TestScenarioLocksWithoutLocks(t *testing.T) {
+	if got := scenarioLocks(&gauge.Specification{}, &gauge.Scenario{}); len(got) != 0 {
+		t.Errorf("Expected no locks, got %v", got)
+	}
+}
+
+// maxConcurrent runs the scenarios, each holding the locks, and gives how many of them ran at the same time
+func maxConcurrent(ls []resourceLock, scenarios int) int {
+	var mu sync.Mutex
+	running, max := 0, 0
+	wg := &sync.WaitGroup{}
+	for i := 0; i < scenarios; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release := acquireLocks(ls)
+			defer release()
+			mu.Lock()
+			running++
+			if running > max {
+				max = running
+			}
+			mu.Unlock()
+			time.Sleep(20 * time.Millisecond)
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return max
+}
+
+func TestAcquireLocksRunsWritersOneAtATime(t *testing.T) {
+	if got := maxConcurrent([]resourceLock{{name: "test-write"}}, 5); got != 1 {
+		t.Errorf("Expected scenarios writing the resource to run one at a time, %d ran at the same time", got)
+	}
+}
+
+func TestAcquireLocksRunsReadersTogether(t *testing.T) {
+	if got := maxConcurrent([]resourceLock{{name: "test-read", read: true}}, 3); got < 2 {
+		t.Errorf("Expected scenarios reading the resource to run together, %d ran at the same time", got)
+	}
+}
+
+func TestAcquireLocksWithoutLocksDoesNotWait(t *testing.T) {
+	release := acquireLocks(nil)
+	defer release()
+	done := make(chan bool)
+	go func() {
+		acquireLocks(nil)()
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected scenarios without locks not to wait")
+	}
+}
@@ -13,11 +13,13 @@ import (
 
 	m "github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
 )
 
 func mergeDataTableSpecResults(sResult *result.SuiteResult) *result.SuiteResult {
 	suiteRes := result.NewSuiteResult(sResult.Tags, time.Now())
 	suiteRes.IsFailed = sResult.IsFailed
+	suiteRes.Interrupted = sResult.Interrupted
 	suiteRes.ExecutionTime = sResult.ExecutionTime
 	suiteRes.PostSuite = sResult.PostSuite
 	suiteRes.PreSuite = sResult.PreSuite
@@ -70,11 +72,14 @@ func mergeResults(results []*result.SpecResult) *result.SpecResult {
 	var scnResults []*m.ProtoItem
 	table := &m.ProtoTable{}
 	dataTableScnResults := make(map[string][]*m.ProtoTableDrivenScenario)
-	includedTableRowIndexMap := make(map[int32]bool)
+	includedTableRowIndexMap := make(map[int32]int32)
+	specWIP := hasWIPMarker(results[0].ProtoSpec.Items)
 	max := results[0].ExecutionTime
 	for _, res := range results {
 		specResult.ExecutionTime += res.ExecutionTime
 		specResult.Errors = res.Errors
+		specResult.ScenarioInfraFailedCount += res.ScenarioInfraFailedCount
+		specResult.ScenarioInfraRetriedCount += res.ScenarioInfraRetriedCount
 		if res.ExecutionTime > max {
 			max = res.ExecutionTime
 		}
@@ -88,14 +93,17 @@ func mergeResults(results []*result.SpecResult) *result.SpecResult {
 			switch item.ItemType {
 			case m.ProtoItem_Scenario:
 				scnResults = append(scnResults, item)
-				modifySpecStats(item.Scenario, specResult)
+				modifySpecStats(item.Scenario, specResult, specWIP)
 			case m.ProtoItem_TableDrivenScenario:
-				tableRowIndex := item.TableDrivenScenario.TableRowIndex
-				if _, ok := includedTableRowIndexMap[tableRowIndex]; !ok {
-					table.Rows = append(table.Rows, tableRows...)
-					includedTableRowIndexMap[tableRowIndex] = true
+				// rows of a scenario data table which were split across streams do not belong to a spec table row
+				if !item.TableDrivenScenario.IsScenarioTableDriven || item.TableDrivenScenario.IsSpecTableDriven {
+					tableRowIndex := item.TableDrivenScenario.TableRowIndex
+					if _, ok := includedTableRowIndexMap[tableRowIndex]; !ok {
+						table.Rows = append(table.Rows, tableRows...)
+						includedTableRowIndexMap[tableRowIndex] = int32(len(table.Rows) - 1)
+					}
+					item.TableDrivenScenario.TableRowIndex = includedTableRowIndexMap[tableRowIndex]
 				}
-				item.TableDrivenScenario.TableRowIndex = int32(len(table.Rows) - 1)
 				scnResults = append(scnResults, item)
 				heading := item.TableDrivenScenario.Scenario.ScenarioHeading
 				dataTableScnResults[heading] = append(dataTableScnResults[heading], item.TableDrivenScenario)
@@ -113,7 +121,7 @@ func mergeResults(results []*result.SpecResult) *result.SpecResult {
 	if InParallel {
 		specResult.ExecutionTime = max
 	}
-	aggregateDataTableScnStats(dataTableScnResults, specResult)
+	aggregateDataTableScnStats(dataTableScnResults, specResult, specWIP)
 	specResult.ProtoSpec.FileName = results[0].ProtoSpec.FileName
 	specResult.ProtoSpec.Tags = results[0].ProtoSpec.Tags
 	specResult.ProtoSpec.SpecHeading = results[0].ProtoSpec.SpecHeading
@@ -145,12 +153,14 @@ func getItems(table *m.ProtoTable, scnResults []*m.ProtoItem, results []*result.
 	return
 }
 
-func aggregateDataTableScnStats(results map[string][]*m.ProtoTableDrivenScenario, specResult *result.SpecResult) {
+func aggregateDataTableScnStats(results map[string][]*m.ProtoTableDrivenScenario, specResult *result.SpecResult, specWIP bool) {
 	for _, dResult := range results {
 		for _, res := range dResult {
 			isTableIndicesExcluded := false
 			if res.Scenario.ExecutionStatus == m.ExecutionStatus_FAILED {
-				specResult.ScenarioFailedCount++
+				if !specWIP && !hasWIPMarker(res.Scenario.ScenarioItems) {
+					specResult.ScenarioFailedCount++
+				}
 			} else if res.Scenario.ExecutionStatus == m.ExecutionStatus_SKIPPED &&
 				!strings.Contains(res.Scenario.SkipErrors[0], "--table-rows") {
 				specResult.ScenarioSkippedCount++
@@ -166,12 +176,24 @@ func aggregateDataTableScnStats(results map[string][]*m.ProtoTableDrivenScenario
 	}
 }
 
-func modifySpecStats(scn *m.ProtoScenario, specRes *result.SpecResult) {
+func modifySpecStats(scn *m.ProtoScenario, specRes *result.SpecResult, specWIP bool) {
 	switch scn.ExecutionStatus {
 	case m.ExecutionStatus_SKIPPED:
 		specRes.ScenarioSkippedCount++
 	case m.ExecutionStatus_FAILED:
-		specRes.ScenarioFailedCount++
+		if !specWIP && !hasWIPMarker(scn.ScenarioItems) {
+			specRes.ScenarioFailedCount++
+		}
 	}
 	specRes.ScenarioCount++
 }
+
+// hasWIPMarker tells if the items have a comment marking their spec or scenario as work in progress
+func hasWIPMarker(items []*m.ProtoItem) bool {
+	for _, item := range items {
+		if gauge.IsWIP(item.GetComment().GetText()) {
+			return true
+		}
+	}
+	return false
+}
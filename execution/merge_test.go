@@ -31,11 +31,23 @@ var statsTests = []struct {
 	{gm.ExecutionStatus_PASSED, stat{total: 1}, "Scenario Passed"},
 }
 
+func TestModifySpecStatsIgnoresFailuresOfWIPScenarios(t *testing.T) {
+	wip := &gm.ProtoItem{ItemType: gm.ProtoItem_Comment, Comment: &gm.ProtoComment{Text: "<!-- wip -->"}}
+	res := &result.SpecResult{}
+
+	modifySpecStats(&gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_FAILED, ScenarioItems: []*gm.ProtoItem{wip}}, res, false)
+	modifySpecStats(&gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_FAILED}, res, true)
+
+	if res.ScenarioFailedCount != 0 || res.ScenarioCount != 2 {
+		t.Errorf("Expected WIP failures not to be counted. Got %d failed of %d", res.ScenarioFailedCount, res.ScenarioCount)
+	}
+}
+
 func TestModifySpecStats(t *testing.T) {
 	for _, test := range statsTests {
 		res := &result.SpecResult{}
 
-		modifySpecStats(&gm.ProtoScenario{ExecutionStatus: test.status}, res)
+		modifySpecStats(&gm.ProtoScenario{ExecutionStatus: test.status}, res, false)
 		got := stat{failed: res.ScenarioFailedCount, skipped: res.ScenarioSkippedCount, total: res.ScenarioCount}
 
 		if !reflect.DeepEqual(got, test.want) {
@@ -63,7 +75,7 @@ func TestAggregateDataTableScnStats(t *testing.T) {
 		"heading4": {{Scenario: &gm.ProtoScenario{ExecutionStatus: gm.ExecutionStatus_FAILED}}},
 	}
 
-	aggregateDataTableScnStats(scns, res)
+	aggregateDataTableScnStats(scns, res, false)
 
 	got := stat{failed: res.ScenarioFailedCount, skipped: res.ScenarioSkippedCount, total: res.ScenarioCount}
 	want := stat{failed: 2, skipped: 1, total: 5}
@@ -443,3 +455,26 @@ func TestMergeResultWithMesages(t *testing.T) {
 		t.Errorf("Merge data table spec results failed.\n\tWant: %v\n\tGot: %v", want, got)
 	}
 }
+
+func TestMergeResultsOfSplitScenarioDataTableRows(t *testing.T) {
+	row := func(i int32, status gm.ExecutionStatus) *gm.ProtoItem {
+		return &gm.ProtoItem{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{
+			Scenario:              &gm.ProtoScenario{ExecutionStatus: status, ScenarioHeading: "scenario"},
+			IsScenarioTableDriven: true,
+			ScenarioTableRowIndex: i,
+		}}
+	}
+	got := mergeResults([]*result.SpecResult{
+		{ProtoSpec: &gm.ProtoSpec{SpecHeading: "heading", FileName: "filename", Items: []*gm.ProtoItem{row(1, gm.ExecutionStatus_FAILED)}}, ExecutionTime: int64(1)},
+		{ProtoSpec: &gm.ProtoSpec{SpecHeading: "heading", FileName: "filename", Items: []*gm.ProtoItem{row(0, gm.ExecutionStatus_PASSED)}}, ExecutionTime: int64(2)},
+	})
+
+	if got.ScenarioCount != 2 || got.ScenarioFailedCount != 1 {
+		t.Errorf("Want 2 scenarios with 1 failure. Got %d scenarios with %d failures", got.ScenarioCount, got.ScenarioFailedCount)
+	}
+	for _, item := range got.ProtoSpec.Items {
+		if item.TableDrivenScenario.TableRowIndex != 0 {
+			t.Errorf("Want spec table row index to be left untouched. Got %d", item.TableDrivenScenario.TableRowIndex)
+		}
+	}
+}
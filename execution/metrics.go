@@ -0,0 +1,71 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sync"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/metrics"
+)
+
+const (
+	passed = "passed"
+	failed = "failed"
+)
+
+// ListenExecutionEventsAndRecordMetrics records the outcome of the run and its scenarios, when metrics are enabled
+func ListenExecutionEventsAndRecordMetrics(wg *sync.WaitGroup) {
+	if !metrics.Enabled() {
+		return
+	}
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.ScenarioEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			recordMetrics(e)
+			if e.Topic == event.SuiteEnd {
+				wg.Done()
+			}
+		}
+	}()
+}
+
+func recordMetrics(e event.ExecutionEvent) {
+	switch e.Topic {
+	case event.ScenarioEnd:
+		r, ok := e.Result.(*result.ScenarioResult)
+		if !ok || r.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+			return
+		}
+		metrics.ScenarioDuration.Observe(float64(r.ExecTime())/1000, outcome(r.GetFailed()))
+		if !r.GetFailed() {
+			return
+		}
+		tags := r.ProtoScenario.GetTags()
+		if len(tags) == 0 {
+			metrics.ScenarioFailures.Inc("")
+		}
+		for _, t := range tags {
+			metrics.ScenarioFailures.Inc(t)
+		}
+	case event.SuiteEnd:
+		metrics.Runs.Inc(outcome(e.Result.GetFailed()))
+	}
+}
+
+func outcome(isFailed bool) string {
+	if isFailed {
+		return failed
+	}
+	return passed
+}
@@ -0,0 +1,158 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+)
+
+const (
+	runStarted          = "start"
+	runEnded            = "end"
+	thresholdBreached   = "threshold"
+	notificationTimeout = 10 * time.Second
+)
+
+// notification is the payload sent to the webhooks. Text holds a readable message, which chat tools like Slack and Teams display.
+type notification struct {
+	Event     string                    `json:"event"`
+	Project   string                    `json:"project"`
+	Text      string                    `json:"text"`
+	Summary   *executionStatus          `json:"summary,omitempty"`
+	Failures  []*result.ScenarioFailure `json:"failures,omitempty"`
+	ReportURL string                    `json:"reportUrl,omitempty"`
+}
+
+type notifier struct {
+	urls      []string
+	events    map[string]bool
+	threshold int
+	failed    int
+	send      func(url string, body []byte) error
+}
+
+func newNotifier() *notifier {
+	n := &notifier{urls: env.WebhookURLs(), events: make(map[string]bool), threshold: env.WebhookFailureThreshold(), send: postNotification}
+	for _, e := range env.WebhookEvents() {
+		n.events[strings.ToLower(e)] = true
+	}
+	return n
+}
+
+// ListenExecutionEventsAndNotify listens to the start and end of the run, and to failed scenarios, and notifies the configured webhooks
+func ListenExecutionEventsAndNotify(wg *sync.WaitGroup) {
+	n := newNotifier()
+	if len(n.urls) == 0 {
+		return
+	}
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SuiteStart, event.ScenarioEnd, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			n.handle(e)
+			if e.Topic == event.SuiteEnd {
+				wg.Done()
+			}
+		}
+	}()
+}
+
+func (n *notifier) handle(e event.ExecutionEvent) {
+	switch e.Topic {
+	case event.SuiteStart:
+		n.notify(&notification{Event: runStarted, Text: fmt.Sprintf("Gauge run started for %s", projectName())})
+	case event.ScenarioEnd:
+		if r, ok := e.Result.(*result.ScenarioResult); !ok || r.WIP || !r.GetFailed() {
+			return
+		}
+		n.failed++
+		if n.threshold > 0 && n.failed == n.threshold {
+			n.notify(&notification{Event: thresholdBreached, Text: fmt.Sprintf("%d scenarios have failed so far in the Gauge run for %s", n.failed, projectName())})
+		}
+	case event.SuiteEnd:
+		res := e.Result.(*result.SuiteResult)
+		s := newExecutionStatus(res)
+		text := fmt.Sprintf("Gauge run passed for %s. %d scenarios executed", projectName(), s.SceExecuted)
+		if res.IsFailed {
+			text = fmt.Sprintf("Gauge run failed for %s. %d of %d scenarios failed", projectName(), s.SceFailed, s.SceExecuted)
+		}
+		n.notify(&notification{Event: runEnded, Text: text, Summary: s, Failures: res.FailureSummary.GetFailures()})
+	}
+}
+
+func (n *notifier) notify(msg *notification) {
+	if !n.events[msg.Event] {
+		return
+	}
+	msg.Project = projectName()
+	if reportURL := env.ReportURL(); reportURL != "" {
+		msg.ReportURL = expandTemplate(reportURL, msg)
+	}
+	if msg.ReportURL != "" {
+		msg.Text = fmt.Sprintf("%s. Report: %s", msg.Text, msg.ReportURL)
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal notification, skipping it. %s", err.Error())
+		return
+	}
+	for _, u := range n.urls {
+		u = expandTemplate(u, msg)
+		if err := n.send(u, body); err != nil {
+			logger.Warningf(true, "Failed to notify webhook %s. %s", u, err.Error())
+		}
+	}
+}
+
+// expandTemplate fills in the fields of the notification, like {{.Event}} or {{.Project}}, used in the text
+func expandTemplate(text string, msg *notification) string {
+	t, err := template.New("notification").Parse(text)
+	if err != nil {
+		logger.Warningf(true, "Invalid template %s. %s", text, err.Error())
+		return text
+	}
+	var b bytes.Buffer
+	if err = t.Execute(&b, msg); err != nil {
+		logger.Warningf(true, "Invalid template %s. %s", text, err.Error())
+		return text
+	}
+	return b.String()
+}
+
+func postNotification(url string, body []byte) error {
+	client := &http.Client{Timeout: notificationTimeout}
+	res, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("Got status %s", res.Status)
+	}
+	return nil
+}
+
+func projectName() string {
+	return filepath.Base(config.ProjectRoot)
+}
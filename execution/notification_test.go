@@ -0,0 +1,86 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	. "gopkg.in/check.v1"
+)
+
+type sentNotification struct {
+	url string
+	msg *notification
+}
+
+func newTestNotifier(threshold int, events ...string) (*notifier, *[]sentNotification) {
+	var sent []sentNotification
+	n := &notifier{urls: []string{"http://chat.example.com/hooks/{{.Project}}"}, events: make(map[string]bool), threshold: threshold}
+	for _, e := range events {
+		n.events[e] = true
+	}
+	n.send = func(url string, body []byte) error {
+		msg := &notification{}
+		err := json.Unmarshal(body, msg)
+		sent = append(sent, sentNotification{url: url, msg: msg})
+		return err
+	}
+	return n, &sent
+}
+
+func failedScenarioEvent(wip bool) event.ExecutionEvent {
+	res := result.NewScenarioResult(&gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED, Failed: true})
+	res.WIP = wip
+	return event.NewExecutionEvent(event.ScenarioEnd, nil, res, 0, nil)
+}
+
+func (s *MySuite) TestNotifierSendsOnlyConfiguredEvents(c *C) {
+	config.ProjectRoot = filepath.Join("home", "shop")
+	n, sent := newTestNotifier(0, runEnded)
+
+	n.handle(event.NewExecutionEvent(event.SuiteStart, nil, nil, 0, nil))
+	n.handle(event.NewExecutionEvent(event.SuiteEnd, nil, &result.SuiteResult{}, 0, nil))
+
+	c.Assert(len(*sent), Equals, 1)
+	c.Assert((*sent)[0].url, Equals, "http://chat.example.com/hooks/shop")
+	c.Assert((*sent)[0].msg.Event, Equals, runEnded)
+	c.Assert((*sent)[0].msg.Text, Equals, "Gauge run passed for shop. 0 scenarios executed")
+	c.Assert((*sent)[0].msg.Summary, NotNil)
+}
+
+func (s *MySuite) TestNotifierSendsOnceWhenFailureThresholdIsReached(c *C) {
+	config.ProjectRoot = filepath.Join("home", "shop")
+	n, sent := newTestNotifier(2, thresholdBreached)
+
+	n.handle(failedScenarioEvent(false))
+	n.handle(failedScenarioEvent(true))
+	c.Assert(len(*sent), Equals, 0)
+	n.handle(failedScenarioEvent(false))
+	n.handle(failedScenarioEvent(false))
+
+	c.Assert(len(*sent), Equals, 1)
+	c.Assert((*sent)[0].msg.Text, Equals, "2 scenarios have failed so far in the Gauge run for shop")
+}
+
+func (s *MySuite) TestNotificationLinksToReport(c *C) {
+	config.ProjectRoot = filepath.Join("home", "shop")
+	old := env.ReportURL
+	env.ReportURL = func() string { return "https://ci.example.com/{{.Project}}/report" }
+	defer func() { env.ReportURL = old }()
+	n, sent := newTestNotifier(0, runStarted)
+
+	n.handle(event.NewExecutionEvent(event.SuiteStart, nil, nil, 0, nil))
+
+	c.Assert((*sent)[0].msg.ReportURL, Equals, "https://ci.example.com/shop/report")
+	c.Assert((*sent)[0].msg.Text, Equals, "Gauge run started for shop. Report: https://ci.example.com/shop/report")
+}
@@ -7,6 +7,7 @@
 package execution
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
@@ -26,11 +27,12 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/plugin"
 	"github.com/getgauge/gauge/runner"
 )
 
-// Strategy for execution, can be either 'Eager' or 'Lazy'
+// Strategy for execution, can be either 'Eager', 'Lazy' or 'Scenario'
 var Strategy string
 
 // Eager is a parallelization strategy for execution. In this case tests are distributed before execution, thus making them an equal number based distribution.
@@ -39,12 +41,20 @@ const Eager string = "eager"
 // Lazy is a parallelization strategy for execution. In this case tests assignment will be dynamic during execution, i.e. assign the next spec in line to the stream that has completed it’s previous execution and is waiting for more work.
 const Lazy string = "lazy"
 
+// Scenario is a parallelization strategy for execution which, as lazy, assigns the next spec in line to the stream
+// that is waiting for more work, but splits the specs tagged with the parallel scenarios tag so that each of their
+// scenarios is a spec of its own. Independent scenarios of a large spec can then run on different streams, with the
+// before and after spec hooks run for each of them.
+const Scenario string = "scenario"
+
 const (
 	gaugeAPIPortsEnv            = "GAUGE_API_PORTS"
 	gaugeParallelStreamCountEnv = "GAUGE_PARALLEL_STREAMS_COUNT"
 )
 
 type parallelExecution struct {
+	ctx                      context.Context
+	stop                     context.Context
 	wg                       sync.WaitGroup
 	manifest                 *manifest.Manifest
 	specCollection           *gauge.SpecCollection
@@ -60,6 +70,8 @@ type parallelExecution struct {
 
 func newParallelExecution(e *executionInfo) *parallelExecution {
 	return &parallelExecution{
+		ctx:                      e.context(),
+		stop:                     e.stop,
 		manifest:                 e.manifest,
 		specCollection:           e.specs,
 		runners:                  []runner.Runner{e.runner},
@@ -96,7 +108,7 @@ func (e *parallelExecution) numberOfStreams() int {
 func (e *parallelExecution) start() {
 	e.startTime = time.Now()
 	event.Notify(event.NewExecutionEvent(event.SuiteStart, nil, nil, 0, &gauge_messages.ExecutionInfo{}))
-	e.pluginHandler = plugin.StartPlugins(e.manifest)
+	e.pluginHandler = plugin.StartPlugins(e.ctx, e.manifest)
 }
 
 func (e *parallelExecution) startRunnersForRemainingStreams() {
@@ -120,6 +132,16 @@ func (e *parallelExecution) startRunnersForRemainingStreams() {
 func (e *parallelExecution) run() *result.SuiteResult {
 	e.start()
 	var res []*result.SuiteResult
+	if env.AllowParallelDatatableRows() {
+		e.specCollection = gauge.NewSpecCollection(parser.SplitScenarioDataTableRows(e.specCollection.Specs(), e.errMaps), false)
+	}
+	if isScenarioStrategy() {
+		e.specCollection = gauge.NewSpecCollection(parser.SplitScenarios(e.specCollection.Specs(), env.ParallelScenariosTag(), e.errMaps), false)
+	}
+	if specs := e.specCollection.Specs(); parser.PriorityOrderEnabled() && hasPrioritizedScenarios(specs) {
+		e.specCollection = gauge.NewSpecCollection(scheduleByPriority(specs, e.errMaps), false)
+	}
+	startQueue(e.specCollection.Specs())
 	if env.AllowFilteredParallelExecution() && e.tagsToFilter != "" {
 		parallesSpecs, serialSpecs := filter.FilterSpecForParallelRun(e.specCollection.Specs(), e.tagsToFilter)
 		if Verbose {
@@ -195,7 +217,7 @@ func (e *parallelExecution) executeLegacyMultithreaded() {
 	}
 	os.Setenv(gaugeAPIPortsEnv, strings.Join(ports, ","))
 	writer := logger.NewLogWriter(e.manifest.Language, true, 0)
-	r, err := runner.StartLegacyRunner(e.manifest, "0", writer, make(chan bool), false)
+	r, err := runner.StartLegacyRunner(e.ctx, e.manifest, "0", writer, make(chan bool), false)
 	if err != nil {
 		logger.Fatalf(true, "failed to start runner. %s", err.Error())
 	}
@@ -241,7 +263,7 @@ func (e *parallelExecution) startRunner(s *gauge.SpecCollection, stream int) (ru
 	if os.Getenv("GAUGE_CUSTOM_BUILD_PATH") == "" {
 		os.Setenv("GAUGE_CUSTOM_BUILD_PATH", path.Join(os.Getenv("GAUGE_PROJECT_ROOT"), "gauge_bin"))
 	}
-	runner, err := runner.Start(e.manifest, stream, make(chan bool), false)
+	runner, err := runner.Start(e.ctx, e.manifest, stream, make(chan bool), false)
 	if err != nil {
 		logger.Errorf(true, "Failed to start runner. %s", err.Error())
 		logger.Debugf(true, "Skipping %d specifications", s.Size())
@@ -254,10 +276,11 @@ func (e *parallelExecution) startRunner(s *gauge.SpecCollection, stream int) (ru
 }
 
 func (e *parallelExecution) startSpecsExecutionWithRunner(s *gauge.SpecCollection, runner runner.Runner, stream int) {
-	executionInfo := newExecutionInfo(s, runner, e.pluginHandler, e.errMaps, false, stream)
+	executionInfo := newExecutionInfo(e.ctx, s, runner, e.pluginHandler, e.errMaps, false, stream)
+	executionInfo.stop = e.stop
 	se := newSimpleExecution(executionInfo, false, false)
 	se.execute()
-	err := runner.Kill()
+	err := se.runner.Kill()
 	if err != nil {
 		logger.Errorf(true, "Failed to kill runner. %s", err.Error())
 	}
@@ -269,10 +292,11 @@ func (e *parallelExecution) executeSpecsInSerial(s *gauge.SpecCollection) *resul
 	if err != nil {
 		return &result.SuiteResult{UnhandledErrors: err}
 	}
-	executionInfo := newExecutionInfo(s, runner, e.pluginHandler, e.errMaps, false, 1)
+	executionInfo := newExecutionInfo(e.ctx, s, runner, e.pluginHandler, e.errMaps, false, 1)
+	executionInfo.stop = e.stop
 	se := newSimpleExecution(executionInfo, false, false)
 	se.execute()
-	er := runner.Kill()
+	er := se.runner.Kill()
 	if er != nil {
 		logger.Errorf(true, "Failed to kill runner. %s", er.Error())
 	}
@@ -282,6 +306,8 @@ func (e *parallelExecution) executeSpecsInSerial(s *gauge.SpecCollection) *resul
 
 func (e *parallelExecution) finish() {
 	e.suiteResult = mergeDataTableSpecResults(e.suiteResult)
+	e.suiteResult.SetAttachments()
+	setFailureSummary(e.suiteResult)
 	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, e.suiteResult, 0, &gauge_messages.ExecutionInfo{}))
 	message := &gauge_messages.Message{
 		MessageType: gauge_messages.Message_SuiteExecutionResult,
@@ -301,6 +327,9 @@ func (e *parallelExecution) aggregateResults(suiteResults []*result.SuiteResult)
 		if result.IsFailed {
 			r.IsFailed = true
 		}
+		if result.Interrupted {
+			r.Interrupted = true
+		}
 		if result.PreSuite != nil {
 			r.PreSuite = result.PreSuite
 		}
@@ -317,12 +346,16 @@ func (e *parallelExecution) aggregateResults(suiteResults []*result.SuiteResult)
 }
 
 func isLazy() bool {
-	return strings.ToLower(Strategy) == Lazy
+	return strings.ToLower(Strategy) == Lazy || isScenarioStrategy()
+}
+
+func isScenarioStrategy() bool {
+	return strings.ToLower(Strategy) == Scenario
 }
 
 func isValidStrategy(strategy string) bool {
 	strategy = strings.ToLower(strategy)
-	return strategy == Lazy || strategy == Eager
+	return strategy == Lazy || strategy == Eager || strategy == Scenario
 }
 
 func (e *parallelExecution) isMultithreaded() bool {
@@ -30,7 +30,8 @@ func (e *parallelExecution) executeGrpcMultithreaded() {
 	for i := 1; i <= totalStreams; i++ {
 		go func(stream int) {
 			defer e.wg.Done()
-			executionInfo := newExecutionInfo(e.specCollection, r, e.pluginHandler, e.errMaps, false, stream)
+			executionInfo := newExecutionInfo(e.ctx, e.specCollection, r, e.pluginHandler, e.errMaps, false, stream)
+			executionInfo.stop = e.stop
 			se := newSimpleExecution(executionInfo, false, true)
 			se.execute()
 			e.resultChan <- se.suiteResult
@@ -0,0 +1,150 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sort"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/i18n"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+)
+
+// PrioritizedScenario is a scenario of the suite with the priority level it is scheduled by, parser.NoPriority for a
+// scenario without priority
+type PrioritizedScenario struct {
+	Spec     *gauge.Specification
+	Scenario *gauge.Scenario
+	Priority int
+}
+
+// PrioritizedScenarios are the scenarios of a suite in the order they are scheduled
+type PrioritizedScenarios []*PrioritizedScenario
+
+// NewPrioritizedScenarios gives the scenarios of the specs in the order the suite runs them: by priority level across
+// the specs, the scenarios of a level in the order of their specs, as parser.PriorityLess orders the levels
+func NewPrioritizedScenarios(specs []*gauge.Specification) PrioritizedScenarios {
+	var scenarios PrioritizedScenarios
+	for _, spec := range specs {
+		for _, scn := range spec.Scenarios {
+			scenarios = append(scenarios, &PrioritizedScenario{Spec: spec, Scenario: scn, Priority: parser.ScenarioPriority(scn)})
+		}
+	}
+	sort.SliceStable(scenarios, func(i, j int) bool {
+		return parser.PriorityLess(scenarios[i].Priority, scenarios[j].Priority)
+	})
+	return scenarios
+}
+
+// scheduleByPriority orders the specs of the suite so that the scenarios of a priority level run before those of the
+// next, whichever spec they are in. The specs with scenarios of several levels are split into a spec per level, the
+// before and after spec hooks then running for each part. As streams pick the specs in order, the levels are run in
+// order across the parallel streams too.
+func scheduleByPriority(specs []*gauge.Specification, errMap *gauge.BuildErrors) []*gauge.Specification {
+	specs = parser.SplitScenariosByPriority(specs, errMap)
+	sort.SliceStable(specs, func(i, j int) bool {
+		return parser.PriorityLess(specPriority(specs[i]), specPriority(specs[j]))
+	})
+	return specs
+}
+
+func hasPrioritizedScenarios(specs []*gauge.Specification) bool {
+	for _, spec := range specs {
+		for _, scn := range spec.Scenarios {
+			if parser.ScenarioPriority(scn) != parser.NoPriority {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// specPriority is the level of the spec's scenario which runs first
+func specPriority(spec *gauge.Specification) int {
+	priority := parser.NoPriority
+	for i, scn := range spec.Scenarios {
+		if p := parser.ScenarioPriority(scn); i == 0 || parser.PriorityLess(p, priority) {
+			priority = p
+		}
+	}
+	return priority
+}
+
+// priorityStats holds the number of scenarios of a priority level, by status
+type priorityStats struct {
+	Priority string `json:"priority"`
+	Passed   int    `json:"passed"`
+	Failed   int    `json:"failed"`
+	Skipped  int    `json:"skipped"`
+	level    int
+}
+
+// statsByPriority aggregates the scenario results by their priority level, in the order the levels run. Scenarios
+// inherit the priority of their spec. There are no stats when no scenario has a priority.
+func statsByPriority(res *result.SuiteResult) []*priorityStats {
+	stats := make(map[int]*priorityStats)
+	for _, specRes := range res.SpecResults {
+		if specRes.ProtoSpec == nil {
+			continue
+		}
+		specPriority := parser.TagsPriority(&gauge.Tags{RawValues: [][]string{specRes.ProtoSpec.GetTags()}})
+		for _, item := range specRes.ProtoSpec.GetItems() {
+			scn := item.GetScenario()
+			if item.GetItemType() == gauge_messages.ProtoItem_TableDrivenScenario {
+				scn = item.GetTableDrivenScenario().GetScenario()
+			}
+			if scn == nil {
+				continue
+			}
+			p := parser.TagsPriority(&gauge.Tags{RawValues: [][]string{scn.GetTags()}})
+			if p == parser.NoPriority {
+				p = specPriority
+			}
+			if _, ok := stats[p]; !ok {
+				stats[p] = &priorityStats{Priority: parser.PriorityName(p), level: p}
+			}
+			stats[p].add(scn.GetExecutionStatus())
+		}
+	}
+	if _, ok := stats[parser.NoPriority]; ok && len(stats) == 1 {
+		return nil
+	}
+	var levels []*priorityStats
+	for _, s := range stats {
+		levels = append(levels, s)
+	}
+	sort.Slice(levels, func(i, j int) bool { return parser.PriorityLess(levels[i].level, levels[j].level) })
+	return levels
+}
+
+func (s *priorityStats) add(status gauge_messages.ExecutionStatus) {
+	switch status {
+	case gauge_messages.ExecutionStatus_PASSED:
+		s.Passed++
+	case gauge_messages.ExecutionStatus_FAILED:
+		s.Failed++
+	case gauge_messages.ExecutionStatus_SKIPPED:
+		s.Skipped++
+	}
+}
+
+func printStatsByPriority(stats []*priorityStats) {
+	if len(stats) == 0 {
+		return
+	}
+	logger.Info(true, i18n.T("Scenarios by priority:"))
+	for _, s := range stats {
+		name := s.Priority
+		if s.level == parser.NoPriority {
+			name = i18n.T(name)
+		}
+		logger.Info(true, i18n.Sprintf("\t%s\t%d passed\t%d failed\t%d skipped", name, s.Passed, s.Failed, s.Skipped))
+	}
+}
@@ -0,0 +1,142 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+func prioritizedSpec(file string, scenarios ...string) *gauge.Specification {
+	spec := &gauge.Specification{FileName: file, Heading: &gauge.Heading{Value: file}}
+	for i := 0; i < len(scenarios); i += 2 {
+		scn := &gauge.Scenario{Heading: &gauge.Heading{Value: scenarios[i]}}
+		if scenarios[i+1] != "" {
+			scn.Tags = &gauge.Tags{RawValues: [][]string{{scenarios[i+1]}}}
+		}
+		spec.AddScenario(scn)
+	}
+	return spec
+}
+
+func scenarioNames(specs []*gauge.Specification) (names []string) {
+	for _, spec := range specs {
+		for _, scn := range spec.Scenarios {
+			names = append(names, spec.FileName+":"+scn.Heading.Value)
+		}
+	}
+	return
+}
+
+func TestScheduleByPriorityOrdersScenariosAcrossSpecs(t *testing.T) {
+	specs := []*gauge.Specification{
+		prioritizedSpec("a.spec", "a1", "Priority1", "a2", "", "a3", "priority:0"),
+		prioritizedSpec("b.spec", "b1", ""),
+		prioritizedSpec("c.spec", "c1", "Priority1", "c2", "Priority0"),
+	}
+
+	got := scheduleByPriority(specs, gauge.NewBuildErrors())
+
+	want := []string{"a.spec:a3", "c.spec:c2", "a.spec:a1", "c.spec:c1", "a.spec:a2", "b.spec:b1"}
+	if !reflect.DeepEqual(scenarioNames(got), want) {
+		t.Errorf("Expected scenarios in order %v, got %v", want, scenarioNames(got))
+	}
+	if len(got) != 6 {
+		t.Errorf("Expected the specs to be split into 6 specs, got %d", len(got))
+	}
+}
+
+func TestScheduleByPriorityRunsLastScenariosAfterAllOthers(t *testing.T) {
+	specs := []*gauge.Specification{
+		prioritizedSpec("a.spec", "a1", "priority:last", "a2", "Priority1"),
+		prioritizedSpec("b.spec", "b1", ""),
+		prioritizedSpec("c.spec", "c1", "priority:-3"),
+	}
+
+	got := scheduleByPriority(specs, gauge.NewBuildErrors())
+
+	want := []string{"a.spec:a2", "b.spec:b1", "c.spec:c1", "a.spec:a1"}
+	if !reflect.DeepEqual(scenarioNames(got), want) {
+		t.Errorf("Expected scenarios in order %v, got %v", want, scenarioNames(got))
+	}
+}
+
+func TestHasPrioritizedScenarios(t *testing.T) {
+	if hasPrioritizedScenarios([]*gauge.Specification{prioritizedSpec("a.spec", "a1", "smoke")}) {
+		t.Error("Expected no prioritized scenarios")
+	}
+	if !hasPrioritizedScenarios([]*gauge.Specification{prioritizedSpec("a.spec", "a1", ""), prioritizedSpec("b.spec", "b1", "priority:3")}) {
+		t.Error("Expected prioritized scenarios")
+	}
+}
+
+func TestNewPrioritizedScenarios(t *testing.T) {
+	a := prioritizedSpec("a.spec", "a1", "", "a2", "Priority2")
+	b := prioritizedSpec("b.spec", "b1", "Priority1")
+
+	got := NewPrioritizedScenarios([]*gauge.Specification{a, b})
+
+	if len(got) != 3 {
+		t.Fatalf("Expected 3 scenarios, got %d", len(got))
+	}
+	want := []*PrioritizedScenario{
+		{Spec: b, Scenario: b.Scenarios[0], Priority: 1},
+		{Spec: a, Scenario: a.Scenarios[1], Priority: 2},
+		{Spec: a, Scenario: a.Scenarios[0], Priority: parser.NoPriority},
+	}
+	for i, p := range got {
+		if !reflect.DeepEqual(p, want[i]) {
+			t.Errorf("Expected scenario %d to be %s with priority %d, got %s with priority %d", i, want[i].Scenario.Heading.Value, want[i].Priority, p.Scenario.Heading.Value, p.Priority)
+		}
+	}
+}
+
+func protoScenarioItem(status gauge_messages.ExecutionStatus, tags ...string) *gauge_messages.ProtoItem {
+	return &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ExecutionStatus: status, Tags: tags}}
+}
+
+func TestStatsByPriority(t *testing.T) {
+	passed, failed, skipped := gauge_messages.ExecutionStatus_PASSED, gauge_messages.ExecutionStatus_FAILED, gauge_messages.ExecutionStatus_SKIPPED
+	res := &result.SuiteResult{SpecResults: []*result.SpecResult{
+		{ProtoSpec: &gauge_messages.ProtoSpec{Items: []*gauge_messages.ProtoItem{
+			protoScenarioItem(passed, "priority:1"),
+			protoScenarioItem(failed, "Priority0"),
+			protoScenarioItem(passed),
+			protoScenarioItem(skipped, "priority:last"),
+		}}},
+		{ProtoSpec: &gauge_messages.ProtoSpec{Tags: []string{"priority:0"}, Items: []*gauge_messages.ProtoItem{
+			protoScenarioItem(passed),
+		}}},
+	}}
+
+	got := statsByPriority(res)
+
+	want := []*priorityStats{
+		{Priority: "P0", Passed: 1, Failed: 1, level: 0},
+		{Priority: "P1", Passed: 1, level: 1},
+		{Priority: "unprioritized", Passed: 1, level: parser.NoPriority},
+		{Priority: "last", Skipped: 1, level: parser.LastPriority},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected stats %v, got %v", want, got)
+	}
+}
+
+func TestStatsByPriorityWithoutPrioritizedScenarios(t *testing.T) {
+	res := &result.SuiteResult{SpecResults: []*result.SpecResult{
+		{ProtoSpec: &gauge_messages.ProtoSpec{Items: []*gauge_messages.ProtoItem{protoScenarioItem(gauge_messages.ExecutionStatus_PASSED, "smoke")}}},
+	}}
+
+	if got := statsByPriority(res); got != nil {
+		t.Errorf("Expected no stats, got %v", got)
+	}
+}
@@ -0,0 +1,167 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/execution/rerun"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const executionQueueFile = "execution_queue.json"
+
+// executionQueue keeps, in the .gauge directory, the specs of a run which are yet to be run and those which the
+// streams are running. When gauge stops before the end of the run, as when it crashes or is killed, the run is
+// resumed with gauge run --resume, which runs the specs left and those of the streams which did not finish them.
+type executionQueue struct {
+	mu   sync.Mutex
+	file string
+	// Args are the arguments of the run, without its specs
+	Args []string `json:"args"`
+	// Specs are the spec files of the run which have not finished, in the order they are run
+	Specs []string `json:"specs"`
+	// Assigned are the spec files each stream is running
+	Assigned map[string][]string `json:"assigned"`
+	// parts is the number of specifications of each spec file which have not finished, a file being split into
+	// several of them by its data table rows or scenarios
+	parts map[string]int
+}
+
+// queue is the execution queue of the current run, nil when there is none
+var queue *executionQueue
+
+// startQueue persists the queue of the specs of the run
+func startQueue(specs []*gauge.Specification) {
+	q := &executionQueue{
+		file:     filepath.Join(config.ProjectRoot, common.DotGauge, executionQueueFile),
+		Args:     rerun.Args(),
+		Assigned: make(map[string][]string),
+		parts:    make(map[string]int),
+	}
+	for _, s := range specs {
+		f := util.RelPathToProjectRoot(s.FileName)
+		if q.parts[f] == 0 {
+			q.Specs = append(q.Specs, f)
+		}
+		q.parts[f]++
+	}
+	q.save()
+	queue = q
+}
+
+// assign records that the stream started the specifications
+func (q *executionQueue) assign(stream int, specs []*gauge.Specification) {
+	if q == nil || len(specs) == 0 {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	s := strconv.Itoa(stream)
+	q.Assigned[s] = append(q.Assigned[s], util.RelPathToProjectRoot(specs[0].FileName))
+	q.save()
+}
+
+// finish records that the stream finished the specifications, their file leaving the queue once all of its
+// specifications have finished
+func (q *executionQueue) finish(stream int, specs []*gauge.Specification) {
+	if q == nil || len(specs) == 0 {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	f := util.RelPathToProjectRoot(specs[0].FileName)
+	s := strconv.Itoa(stream)
+	q.Assigned[s] = remove(q.Assigned[s], f)
+	if len(q.Assigned[s]) == 0 {
+		delete(q.Assigned, s)
+	}
+	q.parts[f] -= len(specs)
+	if q.parts[f] <= 0 {
+		delete(q.parts, f)
+		q.Specs = remove(q.Specs, f)
+	}
+	q.save()
+}
+
+// end removes the queue of a run which got to its end. The queue of an interrupted run is kept, so that it can be
+// resumed.
+func (q *executionQueue) end(interrupted bool) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	queue = nil
+	if interrupted {
+		return
+	}
+	if err := os.Remove(q.file); err != nil && !os.IsNotExist(err) {
+		logger.Debugf(true, "Failed to remove %s. %s", q.file, err.Error())
+	}
+}
+
+func (q *executionQueue) save() {
+	b, err := json.MarshalIndent(q, "", "\t")
+	if err != nil {
+		logger.Debugf(true, "Failed to save the execution queue. %s", err.Error())
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(q.file), common.NewDirectoryPermissions); err != nil {
+		logger.Debugf(true, "Failed to create directory %s. %s", filepath.Dir(q.file), err.Error())
+		return
+	}
+	// the queue is written to a temporary file first, so that it is never left half written when gauge dies
+	tmp := q.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, common.NewFilePermissions); err != nil {
+		logger.Debugf(true, "Failed to write %s. %s", tmp, err.Error())
+		return
+	}
+	if err := os.Rename(tmp, q.file); err != nil {
+		logger.Debugf(true, "Failed to write %s. %s", q.file, err.Error())
+	}
+}
+
+func remove(values []string, value string) []string {
+	for i, v := range values {
+		if v == value {
+			return append(values[:i:i], values[i+1:]...)
+		}
+	}
+	return values
+}
+
+// ResumeArgs gives the arguments which resume the last run, when it stopped before its end: the arguments of the
+// run followed by the spec files it did not finish, those the streams were running being run again
+func ResumeArgs() ([]string, error) {
+	contents, err := common.ReadFileContents(filepath.Join(config.ProjectRoot, common.DotGauge, executionQueueFile))
+	if err != nil {
+		return nil, errors.New("No run to resume, the last run got to its end.")
+	}
+	q := &executionQueue{}
+	if err := json.Unmarshal([]byte(contents), q); err != nil {
+		return nil, fmt.Errorf("Invalid execution queue %s. %s", executionQueueFile, err.Error())
+	}
+	if len(q.Specs) == 0 {
+		return nil, errors.New("No specs left to run in the last run.")
+	}
+	for stream, specs := range q.Assigned {
+		logger.Infof(true, "Requeuing %d specs which stream %s did not finish.", len(specs), stream)
+	}
+	return append(q.Args, q.Specs...), nil
+}
@@ -0,0 +1,89 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+)
+
+func withProjectRoot(t *testing.T) func() {
+	tmp, err := ioutil.TempDir("", "queue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := config.ProjectRoot
+	config.ProjectRoot = tmp
+	return func() {
+		config.ProjectRoot = root
+		os.RemoveAll(tmp)
+	}
+}
+
+func queueSpecs() []*gauge.Specification {
+	return []*gauge.Specification{
+		{FileName: filepath.Join(config.ProjectRoot, "specs", "cart.spec")},
+		{FileName: filepath.Join(config.ProjectRoot, "specs", "orders.spec")},
+		{FileName: filepath.Join(config.ProjectRoot, "specs", "orders.spec")},
+		{FileName: filepath.Join(config.ProjectRoot, "specs", "payment.spec")},
+	}
+}
+
+func TestResumeArgsGivesSpecsTheRunDidNotFinish(t *testing.T) {
+	defer withProjectRoot(t)()
+	specs := queueSpecs()
+	startQueue(specs)
+	queue.Args = []string{"run", "--tags", "smoke"}
+	queue.assign(1, specs[:1])
+	queue.finish(1, specs[:1])
+	queue.assign(1, specs[1:2])
+	queue.finish(1, specs[1:2])
+	queue.assign(2, specs[3:])
+
+	got, err := ResumeArgs()
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err.Error())
+	}
+	want := []string{"run", "--tags", "smoke", filepath.Join("specs", "orders.spec"), filepath.Join("specs", "payment.spec")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected resume args %v, got %v", want, got)
+	}
+	queue.end(true)
+}
+
+func TestQueueOfInterruptedRunIsKept(t *testing.T) {
+	defer withProjectRoot(t)()
+	startQueue(queueSpecs())
+
+	queue.end(true)
+
+	if queue != nil {
+		t.Error("Expected no queue after the end of the run")
+	}
+	if !common.FileExists(filepath.Join(config.ProjectRoot, common.DotGauge, executionQueueFile)) {
+		t.Error("Expected the queue of the interrupted run to be kept")
+	}
+}
+
+func TestQueueOfRunWhichGotToItsEndIsRemoved(t *testing.T) {
+	defer withProjectRoot(t)()
+	startQueue(queueSpecs())
+
+	queue.end(false)
+
+	if _, err := ResumeArgs(); err == nil {
+		t.Error("Expected no run to resume")
+	}
+}
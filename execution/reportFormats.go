@@ -0,0 +1,49 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"sync"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/reportWriter"
+)
+
+// ReportFormats are the formats the result of the run is written in. When empty, gauge_report_formats is used.
+var ReportFormats []string
+
+func reportFormats() []string {
+	if len(ReportFormats) > 0 {
+		return ReportFormats
+	}
+	return env.ReportFormats()
+}
+
+// ListenSuiteEndAndWriteReports listens to the suite end event and writes the suite result in every report format
+func ListenSuiteEndAndWriteReports(wg *sync.WaitGroup) {
+	formats := reportFormats()
+	if len(formats) == 0 {
+		return
+	}
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			if e.Topic == event.SuiteEnd {
+				res := gauge.ConvertToProtoSuiteResult(e.Result.(*result.SuiteResult))
+				reportWriter.WriteAll(formats, res, failureSummaryDir())
+				wg.Done()
+			}
+		}
+	}()
+}
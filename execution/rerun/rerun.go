@@ -100,8 +100,15 @@ func ListenFailedScenarios(wg *sync.WaitGroup, specDirs []string) {
 func prepareScenarioFailedMetadata(res *result.ScenarioResult, sce *gauge.Scenario, executionInfo *gauge_messages.ExecutionInfo) {
 	if res.GetFailed() {
 		specPath := executionInfo.GetCurrentSpec().GetFileName()
-		failedScenario := util.RelPathToProjectRoot(specPath)
-		failedMeta.addFailedItem(specPath, fmt.Sprintf("%s:%v", failedScenario, sce.Span.Start))
+		failedScenario := fmt.Sprintf("%s:%v", util.RelPathToProjectRoot(specPath), sce.Span.Start)
+		if sce.ID != "" {
+			failedScenario = fmt.Sprintf("%s#%s", util.RelPathToProjectRoot(specPath), sce.ID)
+		}
+		if _, index := sce.DataTableRow(); index >= 0 {
+			failedMeta.addFailedItem(specPath, fmt.Sprintf("%s:%v", failedScenario, index+1))
+			return
+		}
+		failedMeta.addFailedItem(specPath, failedScenario)
 	}
 }
 
@@ -173,6 +180,11 @@ func SaveState(args []string, specs []string) {
 	}
 }
 
+// Args gives the arguments of the current run without its specs, as saved by SaveState
+func Args() []string {
+	return append([]string{}, failedMeta.Args...)
+}
+
 func readLastFailedState() *failedMetadata {
 	contents, err := common.ReadFileContents(filepath.Join(config.ProjectRoot, common.DotGauge, failedFile))
 	if err != nil {
@@ -63,6 +63,31 @@ func (s *MySuite) TestGetScenarioFailedMetadata(c *C) {
 	c.Assert(failedMeta.failedItemsMap[spec1Abs][spec1Rel+":2"], Equals, true)
 }
 
+func (s *MySuite) TestGetScenarioFailedMetadataUsesStableID(c *C) {
+	spec1Rel := filepath.Join("specs", "example1.spec")
+	spec1Abs := filepath.Join(config.ProjectRoot, spec1Rel)
+	sce := &gauge.Scenario{Span: &gauge.Span{Start: 2}, ID: "CHK-102"}
+	sr1 := &result.ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED}}
+
+	prepareScenarioFailedMetadata(sr1, sce, &gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{FileName: spec1Abs}})
+
+	c.Assert(len(failedMeta.failedItemsMap[spec1Abs]), Equals, 1)
+	c.Assert(failedMeta.failedItemsMap[spec1Abs][spec1Rel+"#CHK-102"], Equals, true)
+}
+
+func (s *MySuite) TestGetScenarioFailedMetadataForDataTableRow(c *C) {
+	spec1Rel := filepath.Join("specs", "example1.spec")
+	spec1Abs := filepath.Join(config.ProjectRoot, spec1Rel)
+	row := gauge.NewTable([]string{"name"}, [][]gauge.TableCell{{{Value: "john", CellType: gauge.Static}}}, 0)
+	sce := &gauge.Scenario{Span: &gauge.Span{Start: 2}, SpecDataTableRow: *row, SpecDataTableRowIndex: 6}
+	sr1 := &result.ScenarioResult{ProtoScenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED}}
+
+	prepareScenarioFailedMetadata(sr1, sce, &gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{FileName: spec1Abs}})
+
+	c.Assert(len(failedMeta.failedItemsMap[spec1Abs]), Equals, 1)
+	c.Assert(failedMeta.failedItemsMap[spec1Abs][spec1Rel+":2:7"], Equals, true)
+}
+
 func (s *MySuite) TestAddSpecPreHookFailedMetadata(c *C) {
 	spec1Rel := filepath.Join("specs", "example1.spec")
 	spec1Abs := filepath.Join(config.ProjectRoot, spec1Rel)
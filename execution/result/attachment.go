@@ -0,0 +1,80 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+)
+
+// AttachmentMessagePrefix starts the messages "gauge:attachment <mime type> <file>" which point to an attachment
+// stored in gauge_attachments_dir. The runner protocol has no dedicated field for attachments.
+const AttachmentMessagePrefix = "gauge:attachment "
+
+// Attachment is a file, like a video, a HAR file or a log, attached by a step or a hook
+type Attachment struct {
+	File     string `json:"file"`
+	MimeType string `json:"mimeType"`
+}
+
+// ScenarioAttachments holds the attachments of a scenario
+type ScenarioAttachments struct {
+	File        string        `json:"file"`
+	Heading     string        `json:"heading"`
+	Row         int32         `json:"row,omitempty"`
+	Attachments []*Attachment `json:"attachments"`
+}
+
+// ParseAttachment reads an attachment written as "<mime type> <file>"
+func ParseAttachment(text string) (*Attachment, error) {
+	parts := strings.SplitN(strings.TrimSpace(text), " ", 2)
+	if len(parts) != 2 || !strings.Contains(parts[0], "/") || strings.TrimSpace(parts[1]) == "" {
+		return nil, fmt.Errorf("expected a MIME type and a file")
+	}
+	return &Attachment{MimeType: parts[0], File: strings.TrimSpace(parts[1])}, nil
+}
+
+// Attachments gives the stored attachments of the messages
+func Attachments(messages []string) []*Attachment {
+	var all []*Attachment
+	for _, m := range messages {
+		if !strings.HasPrefix(m, AttachmentMessagePrefix) {
+			continue
+		}
+		if a, err := ParseAttachment(strings.TrimPrefix(m, AttachmentMessagePrefix)); err == nil {
+			all = append(all, a)
+		}
+	}
+	return all
+}
+
+// ProtoScenarioAttachments gives the attachments of the hooks and steps of a scenario, in the order they ran
+func ProtoScenarioAttachments(scn *gauge_messages.ProtoScenario) []*Attachment {
+	all := Attachments(scn.GetPreHookMessages())
+	for _, items := range [][]*gauge_messages.ProtoItem{scn.GetContexts(), scn.GetScenarioItems(), scn.GetTearDownSteps()} {
+		all = append(all, itemAttachments(items)...)
+	}
+	return append(all, Attachments(scn.GetPostHookMessages())...)
+}
+
+func itemAttachments(items []*gauge_messages.ProtoItem) []*Attachment {
+	var all []*Attachment
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Step:
+			step := item.GetStep()
+			all = append(all, Attachments(step.GetPreHookMessages())...)
+			all = append(all, Attachments(step.GetStepExecutionResult().GetExecutionResult().GetMessage())...)
+			all = append(all, Attachments(step.GetPostHookMessages())...)
+		case gauge_messages.ProtoItem_Concept:
+			all = append(all, itemAttachments(item.GetConcept().GetSteps())...)
+		}
+	}
+	return all
+}
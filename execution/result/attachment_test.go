@@ -0,0 +1,61 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+import (
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	gc "gopkg.in/check.v1"
+)
+
+func stepItemWithMessages(messages ...string) *gauge_messages.ProtoItem {
+	return &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Step, Step: &gauge_messages.ProtoStep{
+		StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{ExecutionResult: &gauge_messages.ProtoExecutionResult{Message: messages}},
+	}}
+}
+
+func (s *MySuite) TestProtoScenarioAttachmentsAreInExecutionOrder(c *gc.C) {
+	scn := &gauge_messages.ProtoScenario{
+		PreHookMessages: []string{"gauge:attachment text/plain before.log"},
+		ScenarioItems: []*gauge_messages.ProtoItem{
+			stepItemWithMessages("gauge:attachment application/json trace.har"),
+			{ItemType: gauge_messages.ProtoItem_Concept, Concept: &gauge_messages.ProtoConcept{Steps: []*gauge_messages.ProtoItem{
+				stepItemWithMessages("done", "gauge:attachment video/mp4 run.mp4"),
+			}}},
+		},
+		PostHookMessages: []string{"gauge:attachment text/plain after.log"},
+	}
+
+	a := ProtoScenarioAttachments(scn)
+
+	c.Assert(len(a), gc.Equals, 4)
+	c.Assert(a[0].File, gc.Equals, "before.log")
+	c.Assert(a[1].MimeType, gc.Equals, "application/json")
+	c.Assert(a[2].File, gc.Equals, "run.mp4")
+	c.Assert(a[3].File, gc.Equals, "after.log")
+}
+
+func (s *MySuite) TestSetAttachmentsCollectsTheAttachmentsOfPassedScenarios(c *gc.C) {
+	spec := &gauge_messages.ProtoSpec{FileName: "checkout.spec", Items: []*gauge_messages.ProtoItem{
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: "Pay with card",
+			ScenarioItems: []*gauge_messages.ProtoItem{stepItemWithMessages("gauge:attachment video/mp4 pay.mp4")}}},
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: "Pay later",
+			ScenarioItems: []*gauge_messages.ProtoItem{stepItemWithMessages("paid")}}},
+		{ItemType: gauge_messages.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gauge_messages.ProtoTableDrivenScenario{TableRowIndex: 1,
+			Scenario: &gauge_messages.ProtoScenario{ScenarioHeading: "Refund", Failed: true,
+				ScenarioItems: []*gauge_messages.ProtoItem{stepItemWithMessages("gauge:attachment text/plain refund.log")}}}},
+	}}
+	sr := &SuiteResult{SpecResults: []*SpecResult{{ProtoSpec: spec}}}
+
+	sr.SetAttachments()
+
+	c.Assert(len(sr.Attachments), gc.Equals, 2)
+	c.Assert(sr.Attachments[0].File, gc.Equals, "checkout.spec")
+	c.Assert(sr.Attachments[0].Heading, gc.Equals, "Pay with card")
+	c.Assert(sr.Attachments[0].Attachments[0].File, gc.Equals, "pay.mp4")
+	c.Assert(sr.Attachments[1].Heading, gc.Equals, "Refund")
+	c.Assert(sr.Attachments[1].Row, gc.Equals, int32(2))
+}
@@ -0,0 +1,48 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+// FailureSummary summarises the failed scenarios of a run and their owners in SPECOWNERS
+type FailureSummary struct {
+	Failures        []*ScenarioFailure `json:"failures"`
+	FailuresByOwner map[string]int     `json:"failuresByOwner,omitempty"`
+	Interrupted     bool               `json:"interrupted,omitempty"`
+}
+
+// ScenarioFailure tells where and why a scenario failed, and who owns it
+type ScenarioFailure struct {
+	File         string        `json:"file"`
+	Line         int64         `json:"line"`
+	Row          int32         `json:"row,omitempty"`
+	Heading      string        `json:"heading"`
+	Step         string        `json:"step,omitempty"`
+	ErrorMessage string        `json:"errorMessage"`
+	StackTrace   string        `json:"stackTrace"`
+	Screenshots  []string      `json:"screenshots"`
+	Attachments  []*Attachment `json:"attachments,omitempty"`
+	Category     string        `json:"category"`
+	Owners       []string      `json:"owners,omitempty"`
+	ID           string        `json:"id,omitempty"`
+	Deprecated   *string       `json:"deprecated,omitempty"`
+	WIP          bool          `json:"wip,omitempty"`
+}
+
+// GetFailures gives the failed scenarios, or none if the summary is nil
+func (s *FailureSummary) GetFailures() []*ScenarioFailure {
+	if s == nil {
+		return nil
+	}
+	return s.Failures
+}
+
+// GetFailuresByOwner gives the count of failed scenarios by owner, or none if the summary is nil
+func (s *FailureSummary) GetFailuresByOwner() map[string]int {
+	if s == nil {
+		return nil
+	}
+	return s.FailuresByOwner
+}
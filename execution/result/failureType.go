@@ -0,0 +1,30 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+// FailureType represents why a scenario failed
+type FailureType int
+
+const (
+	// NotFailed is the type of a scenario which did not fail
+	NotFailed FailureType = iota
+	// AssertionFailure indicates that a step or hook of the scenario failed
+	AssertionFailure
+	// InfrastructureFailure indicates that the scenario failed because the runner died or could not be reached,
+	// rather than because of the code under test
+	InfrastructureFailure
+)
+
+var failureTypes = map[FailureType]string{
+	NotFailed:             "",
+	AssertionFailure:      "assertion",
+	InfrastructureFailure: "infrastructure",
+}
+
+func (t FailureType) String() string {
+	return failureTypes[t]
+}
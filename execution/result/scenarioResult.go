@@ -15,6 +15,13 @@ type ScenarioResult struct {
 	ScenarioDataTableRow      *gauge_messages.ProtoTable
 	ScenarioDataTableRowIndex int
 	ScenarioDataTable         *gauge_messages.ProtoTable
+	SpecDataTableRow          *gauge_messages.ProtoTable
+	SpecDataTableRowIndex     int
+	SkipReason                SkipReason
+	SkipMessage               string
+	FailureType               FailureType
+	// WIP is set for work in progress scenarios, whose failures are not counted
+	WIP bool
 }
 
 func NewScenarioResult(sce *gauge_messages.ProtoScenario) *ScenarioResult {
@@ -26,6 +33,22 @@ func (s ScenarioResult) SetFailure() {
 	s.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_FAILED
 }
 
+// SetSkipped marks the scenario as skipped with the given reason. A scenario which has already failed stays failed.
+func (s *ScenarioResult) SetSkipped(reason SkipReason, message string) {
+	s.SetSkipReason(reason, message)
+	if s.GetFailed() {
+		return
+	}
+	s.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_SKIPPED
+	s.ProtoScenario.SkipErrors = append(s.ProtoScenario.SkipErrors, message)
+}
+
+// SetSkipReason records why the scenario was skipped
+func (s *ScenarioResult) SetSkipReason(reason SkipReason, message string) {
+	s.SkipReason = reason
+	s.SkipMessage = message
+}
+
 // GetFailed returns the state of the scenario result
 func (s ScenarioResult) GetFailed() bool {
 	return s.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED
@@ -0,0 +1,47 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+// SkipReason represents why a scenario was not executed
+type SkipReason int
+
+const (
+	// NotSkipped is the reason of a scenario which was not skipped
+	NotSkipped SkipReason = iota
+	// ValidationFailure indicates that the scenario has parse or validation errors
+	ValidationFailure
+	// DependencyFailure indicates that something the scenario depends on, like a datastore, failed to initialize
+	DependencyFailure
+	// RunnerNotAlive indicates that the runner died before the scenario could be executed
+	RunnerNotAlive
+	// TableRowsFilter indicates that the data table row was excluded by the --table-rows flag
+	TableRowsFilter
+	// SkipRequested indicates that a step implementation asked to skip the rest of the scenario
+	SkipRequested
+	// Interrupted indicates that the run was interrupted before the scenario started
+	Interrupted
+	// TagFilter indicates that the scenario does not match the --tags expression, see gauge_report_filtered_scenarios
+	TagFilter
+	// MaxFailures indicates that the --max-failures count of scenarios had failed before the scenario started
+	MaxFailures
+)
+
+var skipReasons = map[SkipReason]string{
+	NotSkipped:        "",
+	ValidationFailure: "validation_failure",
+	DependencyFailure: "dependency_failure",
+	RunnerNotAlive:    "runner_not_alive",
+	TableRowsFilter:   "table_rows_filter",
+	SkipRequested:     "skip_requested",
+	Interrupted:       "interrupted",
+	TagFilter:         "tag_filter",
+	MaxFailures:       "max_failures",
+}
+
+func (r SkipReason) String() string {
+	return skipReasons[r]
+}
@@ -0,0 +1,33 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+import (
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	gc "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestSetSkippedRecordsTheSkipReason(c *gc.C) {
+	res := NewScenarioResult(&gauge_messages.ProtoScenario{ScenarioHeading: "Login"})
+
+	res.SetSkipped(TagFilter, "skipped Reason: Doesn't satisfy --tags smoke")
+
+	c.Assert(res.SkipReason, gc.Equals, TagFilter)
+	c.Assert(res.SkipMessage, gc.Equals, "skipped Reason: Doesn't satisfy --tags smoke")
+	c.Assert(res.ProtoScenario.ExecutionStatus, gc.Equals, gauge_messages.ExecutionStatus_SKIPPED)
+	c.Assert(res.ProtoScenario.SkipErrors, gc.DeepEquals, []string{"skipped Reason: Doesn't satisfy --tags smoke"})
+	c.Assert(res.ProtoScenario.ProtoReflect().GetUnknown(), gc.HasLen, 0)
+}
+
+func (s *MySuite) TestSkipReasonIsReplaced(c *gc.C) {
+	res := NewScenarioResult(&gauge_messages.ProtoScenario{})
+
+	res.SetSkipReason(RunnerNotAlive, "")
+	res.SetSkipReason(MaxFailures, "")
+
+	c.Assert(res.SkipReason, gc.Equals, MaxFailures)
+}
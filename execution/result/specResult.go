@@ -21,6 +21,11 @@ type SpecResult struct {
 	Skipped              bool
 	ScenarioSkippedCount int
 	Errors               []*gauge_messages.Error
+
+	// ScenarioInfraFailedCount is the number of scenarios which failed due to an infrastructure error
+	ScenarioInfraFailedCount int
+	// ScenarioInfraRetriedCount is the number of scenarios retried on a fresh runner after an infrastructure error
+	ScenarioInfraRetriedCount int
 }
 
 // SetFailure sets the result to failed
@@ -39,7 +44,7 @@ func (specResult *SpecResult) AddSpecItems(resolvedItems []*gauge_messages.Proto
 // AddScenarioResults adds the scenario result to the spec result.
 func (specResult *SpecResult) AddScenarioResults(scenarioResults []Result) {
 	for _, scenarioResult := range scenarioResults {
-		if scenarioResult.GetFailed() {
+		if countsAsFailure(scenarioResult) {
 			specResult.IsFailed = true
 			specResult.ScenarioFailedCount++
 		}
@@ -50,7 +55,7 @@ func (specResult *SpecResult) AddScenarioResults(scenarioResults []Result) {
 }
 
 func (specResult *SpecResult) AddTableDrivenScenarioResult(r *ScenarioResult, t *gauge_messages.ProtoTable, scenarioRowIndex int, specRowIndex int, specTableDriven bool) {
-	if r.GetFailed() {
+	if countsAsFailure(r) {
 		specResult.IsFailed = true
 		specResult.ScenarioFailedCount++
 	}
@@ -79,7 +84,7 @@ func (specResult *SpecResult) AddTableRelatedScenarioResult(scenarioResults [][]
 		for _, eachRow := range scenarioResults {
 			protoScenario := eachRow[scenarioIndex].Item().(*gauge_messages.ProtoScenario)
 			specResult.AddExecTime(protoScenario.GetExecutionTime())
-			if protoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_FAILED {
+			if countsAsFailure(eachRow[scenarioIndex]) {
 				scenarioFailed = true
 				specResult.FailedDataTableRows = append(specResult.FailedDataTableRows, int32(index))
 			}
@@ -100,6 +105,14 @@ func (specResult *SpecResult) AddTableRelatedScenarioResult(scenarioResults [][]
 	specResult.ScenarioCount += numberOfScenarios
 }
 
+// countsAsFailure tells if a failed result fails the spec. Failures of work in progress scenarios do not.
+func countsAsFailure(r Result) bool {
+	if s, ok := r.(*ScenarioResult); ok && s.WIP {
+		return false
+	}
+	return r.GetFailed()
+}
+
 func (specResult *SpecResult) AddExecTime(execTime int64) {
 	specResult.ExecutionTime += execTime
 }
@@ -69,3 +69,15 @@ func (s *MySuite) TestAddTableRelatedScenarioResult(c *gc.C) {
 	c.Assert(specResult.ScenarioFailedCount, gc.Equals, 0)
 	c.Assert(specResult.ExecutionTime, gc.Equals, int64(0))
 }
+
+func (s *MySuite) TestAddScenarioResultsIgnoresFailuresOfWIPScenarios(c *gc.C) {
+	specResult := SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{}}
+	failed := NewScenarioResult(&gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_FAILED})
+	failed.WIP = true
+
+	specResult.AddScenarioResults([]Result{failed})
+
+	c.Assert(specResult.GetFailed(), gc.Equals, false)
+	c.Assert(specResult.ScenarioCount, gc.Equals, 1)
+	c.Assert(specResult.ScenarioFailedCount, gc.Equals, 0)
+}
@@ -6,12 +6,44 @@
 
 package result
 
-import "github.com/getgauge/gauge-proto/go/gauge_messages"
+import (
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
 
 // StepResult represents the result of step execution
 type StepResult struct {
 	ProtoStep  *gauge_messages.ProtoStep
 	StepFailed bool
+	// SkipScenario is set when the step implementation asked to skip the rest of its scenario, see SkipScenarioRequest
+	SkipScenario bool
+	// SkipScenarioReason is the reason the step implementation gave for skipping the rest of its scenario
+	SkipScenarioReason string
+}
+
+// The fields of gauge_messages.ProtoExecutionResult a runner sets when a step implementation asks to skip the rest
+// of its scenario. The gauge-proto version gauge builds with does not declare them, so they are read from the unknown
+// fields of the result.
+const (
+	skipScenarioField       protowire.Number = 13
+	skipScenarioReasonField protowire.Number = 14
+)
+
+// SkipScenarioRequest tells if the step implementation asked to skip the rest of its scenario, and the reason it gave
+func SkipScenarioRequest(res *gauge_messages.ProtoExecutionResult) (reason string, skip bool) {
+	eachUnknownField(res, func(num protowire.Number, typ protowire.Type, value []byte) {
+		switch {
+		case num == skipScenarioField && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(value)
+			skip = n > 0 && v != 0
+		case num == skipScenarioReasonField && typ == protowire.BytesType:
+			if v, n := protowire.ConsumeString(value); n > 0 {
+				reason = v
+			}
+		}
+	})
+	return reason, skip
 }
 
 // NewStepResult is a constructor for StepResult
@@ -103,3 +135,44 @@ func (s *StepResult) ProtoStepExecResult() *gauge_messages.ProtoStepExecutionRes
 func (s *StepResult) SetProtoExecResult(r *gauge_messages.ProtoExecutionResult) {
 	s.ProtoStep.StepExecutionResult.ExecutionResult = r
 }
+
+// SetSkipped marks the step as skipped, without being executed, for the reason
+func (s *StepResult) SetSkipped(reason string) {
+	if s.ProtoStep.StepExecutionResult == nil {
+		s.ProtoStep.StepExecutionResult = &gauge_messages.ProtoStepExecutionResult{}
+	}
+	if s.ProtoStep.StepExecutionResult.ExecutionResult == nil {
+		s.ProtoStep.StepExecutionResult.ExecutionResult = &gauge_messages.ProtoExecutionResult{}
+	}
+	s.ProtoStep.StepExecutionResult.Skipped = true
+	s.ProtoStep.StepExecutionResult.SkippedReason = reason
+}
+
+// GetSkipped tells if the step was skipped
+func (s *StepResult) GetSkipped() bool {
+	return s.ProtoStep.GetStepExecutionResult().GetSkipped()
+}
+
+// SetSkipScenario marks the step as having asked to skip the rest of its scenario for the reason
+func (s *StepResult) SetSkipScenario(reason string) {
+	s.SkipScenario = true
+	s.SkipScenarioReason = reason
+}
+
+// eachUnknownField calls f with the number, type and encoded value of each unknown field of the message
+func eachUnknownField(m protoreflect.ProtoMessage, f func(protowire.Number, protowire.Type, []byte)) {
+	b := m.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return
+		}
+		b = b[n:]
+		m := protowire.ConsumeFieldValue(num, typ, b)
+		if m < 0 {
+			return
+		}
+		f(num, typ, b[:m])
+		b = b[m:]
+	}
+}
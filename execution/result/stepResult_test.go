@@ -0,0 +1,22 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+import (
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	gc "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestSkippedStepIsNotFailed(c *gc.C) {
+	res := NewStepResult(&gauge_messages.ProtoStep{ActualText: "slow step"})
+
+	res.SetSkipped("Step is tagged with slow")
+
+	c.Assert(res.GetSkipped(), gc.Equals, true)
+	c.Assert(res.ProtoStepExecResult().GetSkippedReason(), gc.Equals, "Step is tagged with slow")
+	c.Assert(res.GetFailed(), gc.Equals, false)
+}
@@ -35,6 +35,12 @@ type SuiteResult struct {
 	PostHookScreenshotFiles []string
 	PreHookScreenshots      [][]byte
 	PostHookScreenshots     [][]byte
+	// Interrupted tells if the run was interrupted, in which case the result is partial
+	Interrupted bool
+	// Attachments are the attachments of every scenario which has some, passed or not
+	Attachments []*ScenarioAttachments
+	// FailureSummary summarises the failed scenarios with their owners, set once at the end of the run
+	FailureSummary *FailureSummary
 }
 
 // NewSuiteResult is a constructor for SuitResult
@@ -43,6 +49,9 @@ func NewSuiteResult(tags string, startTime time.Time) *SuiteResult {
 	result.SpecResults = make([]*SpecResult, 0)
 	result.Timestamp = startTime.Format(config.LayoutForTimeStamp)
 	result.ProjectName = filepath.Base(config.ProjectRoot)
+	if title := env.ReportTitle(); title != "" {
+		result.ProjectName = title
+	}
 	result.Environment = env.CurrentEnvironments()
 	result.Tags = tags
 	return result
@@ -63,6 +72,39 @@ func (sr *SuiteResult) SetSpecsSkippedCount() {
 	}
 }
 
+// SetAttachments collects the attachments of the scenarios of all the specs
+func (sr *SuiteResult) SetAttachments() {
+	sr.Attachments = nil
+	for _, specRes := range sr.SpecResults {
+		for _, item := range specRes.ProtoSpec.GetItems() {
+			var scn *gauge_messages.ProtoScenario
+			var row int32
+			switch item.GetItemType() {
+			case gauge_messages.ProtoItem_Scenario:
+				scn = item.GetScenario()
+			case gauge_messages.ProtoItem_TableDrivenScenario:
+				t := item.GetTableDrivenScenario()
+				scn, row = t.GetScenario(), t.GetTableRowIndex()+1
+				if t.GetIsScenarioTableDriven() {
+					row = t.GetScenarioTableRowIndex() + 1
+				}
+			default:
+				continue
+			}
+			if a := ProtoScenarioAttachments(scn); len(a) > 0 {
+				sr.Attachments = append(sr.Attachments, &ScenarioAttachments{File: specRes.ProtoSpec.GetFileName(), Heading: scn.GetScenarioHeading(), Row: row, Attachments: a})
+			}
+		}
+	}
+}
+
+// SetInterrupted marks the result as partial, the run having been interrupted before all the specs ran. The suite
+// is failed as well, since specs were left out.
+func (sr *SuiteResult) SetInterrupted() {
+	sr.Interrupted = true
+	sr.IsFailed = true
+}
+
 // AddUnhandledError adds the unhandled error to suit result.
 func (sr *SuiteResult) AddUnhandledError(err error) {
 	sr.UnhandledErrors = append(sr.UnhandledErrors, err)
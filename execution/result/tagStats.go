@@ -0,0 +1,98 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+)
+
+// TagNamespaceSeparator separates the namespace of a tag from its value, as in team:payments
+const TagNamespaceSeparator = ":"
+
+// TagStats holds the number of scenarios with a tag, by status
+type TagStats struct {
+	Tag     string `json:"tag"`
+	Passed  int    `json:"passed"`
+	Failed  int    `json:"failed"`
+	Skipped int    `json:"skipped"`
+}
+
+// TagNamespace returns the namespace of a tag, or an empty string if the tag has none
+func TagNamespace(tag string) string {
+	i := strings.Index(tag, TagNamespaceSeparator)
+	if i <= 0 {
+		return ""
+	}
+	return tag[:i]
+}
+
+// StatsByTagNamespace aggregates the scenario results by the namespaces of their tags.
+// Scenarios inherit the tags of their spec, and tags without a namespace are left out.
+func (sr *SuiteResult) StatsByTagNamespace() map[string][]*TagStats {
+	stats := make(map[string]map[string]*TagStats)
+	for _, specRes := range sr.SpecResults {
+		if specRes.ProtoSpec == nil {
+			continue
+		}
+		for _, item := range specRes.ProtoSpec.GetItems() {
+			scn := item.GetScenario()
+			if item.GetItemType() == gauge_messages.ProtoItem_TableDrivenScenario {
+				scn = item.GetTableDrivenScenario().GetScenario()
+			}
+			if scn == nil {
+				continue
+			}
+			for _, tag := range uniqueTags(append(scn.GetTags(), specRes.ProtoSpec.GetTags()...)) {
+				ns := TagNamespace(tag)
+				if ns == "" {
+					continue
+				}
+				if _, ok := stats[ns]; !ok {
+					stats[ns] = make(map[string]*TagStats)
+				}
+				if _, ok := stats[ns][tag]; !ok {
+					stats[ns][tag] = &TagStats{Tag: tag}
+				}
+				stats[ns][tag].add(scn.GetExecutionStatus())
+			}
+		}
+	}
+	namespaces := make(map[string][]*TagStats)
+	for ns, tags := range stats {
+		for _, s := range tags {
+			namespaces[ns] = append(namespaces[ns], s)
+		}
+		sort.Slice(namespaces[ns], func(i, j int) bool { return namespaces[ns][i].Tag < namespaces[ns][j].Tag })
+	}
+	return namespaces
+}
+
+func (s *TagStats) add(status gauge_messages.ExecutionStatus) {
+	switch status {
+	case gauge_messages.ExecutionStatus_PASSED:
+		s.Passed++
+	case gauge_messages.ExecutionStatus_FAILED:
+		s.Failed++
+	case gauge_messages.ExecutionStatus_SKIPPED:
+		s.Skipped++
+	}
+}
+
+func uniqueTags(tags []string) (unique []string) {
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if !seen[tag] {
+			seen[tag] = true
+			unique = append(unique, tag)
+		}
+	}
+	return
+}
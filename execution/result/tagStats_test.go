@@ -0,0 +1,41 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package result
+
+import (
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	gc "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestStatsByTagNamespace(c *gc.C) {
+	scenario := func(status gauge_messages.ExecutionStatus, tags ...string) *gauge_messages.ProtoItem {
+		return &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: &gauge_messages.ProtoScenario{ExecutionStatus: status, Tags: tags}}
+	}
+	res := &SuiteResult{SpecResults: []*SpecResult{
+		{ProtoSpec: &gauge_messages.ProtoSpec{Tags: []string{"team:payments"}, Items: []*gauge_messages.ProtoItem{
+			scenario(gauge_messages.ExecutionStatus_PASSED, "component:checkout/cart", "smoke"),
+			scenario(gauge_messages.ExecutionStatus_FAILED, "component:checkout/cart", "team:payments"),
+		}}},
+		{ProtoSpec: &gauge_messages.ProtoSpec{Items: []*gauge_messages.ProtoItem{
+			{ItemType: gauge_messages.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gauge_messages.ProtoTableDrivenScenario{
+				Scenario: &gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_SKIPPED, Tags: []string{"team:search"}},
+			}},
+		}}},
+	}}
+
+	stats := res.StatsByTagNamespace()
+
+	c.Assert(len(stats), gc.Equals, 2)
+	c.Assert(stats["team"], gc.DeepEquals, []*TagStats{{Tag: "team:payments", Passed: 1, Failed: 1}, {Tag: "team:search", Skipped: 1}})
+	c.Assert(stats["component"], gc.DeepEquals, []*TagStats{{Tag: "component:checkout/cart", Passed: 1, Failed: 1}})
+}
+
+func (s *MySuite) TestTagNamespace(c *gc.C) {
+	c.Assert(TagNamespace("component:checkout/cart"), gc.Equals, "component")
+	c.Assert(TagNamespace("smoke"), gc.Equals, "")
+	c.Assert(TagNamespace(":smoke"), gc.Equals, "")
+}
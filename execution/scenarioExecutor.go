@@ -14,6 +14,7 @@ import (
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/plugin"
@@ -29,6 +30,8 @@ type scenarioExecutor struct {
 	stream               int
 	contexts             []*gauge.Step
 	teardowns            []*gauge.Step
+	// conceptTags are the step tags of the concepts being executed, which apply to their steps
+	conceptTags []string
 }
 
 func newScenarioExecutor(r runner.Runner, ph plugin.Handler, ei *gauge_messages.ExecutionInfo, errMap *gauge.BuildErrors, contexts []*gauge.Step, teardowns []*gauge.Step, stream int) *scenarioExecutor {
@@ -48,12 +51,17 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 	scenarioResult := r.(*result.ScenarioResult)
 	scenarioResult.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_PASSED
 	if e.runner.Info().Killed {
-		e.errMap.ScenarioErrs[scenario] = append([]error{errors.New("skipped Reason: Runner is not alive")}, e.errMap.ScenarioErrs[scenario]...)
+		e.errMap.ScenarioErrs[scenario] = append([]error{skipError{errors.New("skipped Reason: Runner is not alive"), result.RunnerNotAlive}}, e.errMap.ScenarioErrs[scenario]...)
 		setSkipInfoInResult(scenarioResult, scenario, e.errMap)
 		return
 	}
-	if scenario.SpecDataTableRow.IsInitialized() && !shouldExecuteForRow(scenario.SpecDataTableRowIndex) {
-		e.errMap.ScenarioErrs[scenario] = append([]error{errors.New("skipped Reason: Doesn't satisfy --table-rows flag condition")}, e.errMap.ScenarioErrs[scenario]...)
+	if scenario.ExcludedByTags != "" {
+		e.errMap.ScenarioErrs[scenario] = append([]error{skipError{fmt.Errorf("skipped Reason: Doesn't satisfy --tags %s", scenario.ExcludedByTags), result.TagFilter}}, e.errMap.ScenarioErrs[scenario]...)
+		setSkipInfoInResult(scenarioResult, scenario, e.errMap)
+		return
+	}
+	if scenario.SpecDataTableRow.IsInitialized() && !shouldExecuteForRow(&scenario.SpecDataTableRow, scenario.SpecDataTableRowIndex) {
+		e.errMap.ScenarioErrs[scenario] = append([]error{skipError{errors.New("skipped Reason: Doesn't satisfy --table-rows flag condition"), result.TableRowsFilter}}, e.errMap.ScenarioErrs[scenario]...)
 		setSkipInfoInResult(scenarioResult, scenario, e.errMap)
 		return
 	}
@@ -82,7 +90,10 @@ func (e *scenarioExecutor) execute(i gauge.Item, r result.Result) {
 			e.executeSteps(scenario.Steps, protoScenItems, scenarioResult)
 		}
 		// teardowns are not appended to previous call to executeSteps to ensure they are run irrespective of context/step failure
-		e.executeSteps(e.teardowns, scenarioResult.ProtoScenario.GetTearDownSteps(), scenarioResult)
+		// and the teardowns of the spec are run even when a teardown step of the scenario fails
+		protoTearDowns := scenarioResult.ProtoScenario.GetTearDownSteps()
+		e.executeSteps(scenario.TearDownSteps, protoTearDowns[:len(scenario.TearDownSteps)], scenarioResult)
+		e.executeSteps(e.teardowns, protoTearDowns[len(scenario.TearDownSteps):], scenarioResult)
 	}
 
 	e.notifyAfterScenarioHook(scenarioResult)
@@ -99,10 +110,16 @@ func (e *scenarioExecutor) handleScenarioDataStoreFailure(scenarioResult *result
 	logger.Errorf(true, err.Error())
 	validationError := validation.NewStepValidationError(&gauge.Step{LineNo: scenario.Heading.LineNo, LineText: scenario.Heading.Value},
 		err.Error(), e.currentExecutionInfo.CurrentSpec.GetFileName(), nil, "")
-	e.errMap.ScenarioErrs[scenario] = []error{validationError}
+	e.errMap.ScenarioErrs[scenario] = []error{skipError{validationError, result.DependencyFailure}}
 	setSkipInfoInResult(scenarioResult, scenario, e.errMap)
 }
 
+// skipError is an error which causes a scenario to be skipped for a reason other than a validation failure
+type skipError struct {
+	error
+	reason result.SkipReason
+}
+
 func setSkipInfoInResult(scenarioResult *result.ScenarioResult, scenario *gauge.Scenario, errMap *gauge.BuildErrors) {
 	scenarioResult.ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_SKIPPED
 	var errs []string
@@ -110,6 +127,19 @@ func setSkipInfoInResult(scenarioResult *result.ScenarioResult, scenario *gauge.
 		errs = append(errs, err.Error())
 	}
 	scenarioResult.ProtoScenario.SkipErrors = errs
+	scenarioResult.SetSkipReason(skipReason(errMap.ScenarioErrs[scenario]))
+}
+
+func skipReason(errs []error) (result.SkipReason, string) {
+	for _, err := range errs {
+		if e, ok := err.(skipError); ok {
+			return e.reason, e.Error()
+		}
+	}
+	if len(errs) == 0 {
+		return result.ValidationFailure, ""
+	}
+	return result.ValidationFailure, errs[0].Error()
 }
 
 func (e *scenarioExecutor) notifyBeforeScenarioHook(scenarioResult *result.ScenarioResult) {
@@ -137,6 +167,7 @@ func (e *scenarioExecutor) notifyAfterScenarioHook(scenarioResult *result.Scenar
 		setScenarioFailure(e.currentExecutionInfo)
 		handleHookFailure(scenarioResult, res, result.AddPostHook)
 	}
+	applyScreenshotStrategy(scenarioResult.ProtoScenario, e.currentExecutionInfo.GetCurrentSpec().GetTags())
 	message.ScenarioExecutionEndingRequest.ScenarioResult = gauge.ConvertToProtoScenarioResult(scenarioResult)
 	e.pluginHandler.NotifyPlugins(message)
 }
@@ -145,7 +176,7 @@ func (e *scenarioExecutor) executeSteps(steps []*gauge.Step, protoItems []*gauge
 	var stepsIndex int
 	for _, protoItem := range protoItems {
 		if protoItem.GetItemType() == gauge_messages.ProtoItem_Concept || protoItem.GetItemType() == gauge_messages.ProtoItem_Step {
-			failed, recoverable := e.executeStep(steps[stepsIndex], protoItem, scenarioResult)
+			failed, recoverable, skipped := e.executeStep(steps[stepsIndex], protoItem, scenarioResult)
 			stepsIndex++
 			if failed {
 				scenarioResult.SetFailure()
@@ -153,30 +184,65 @@ func (e *scenarioExecutor) executeSteps(steps []*gauge.Step, protoItems []*gauge
 					return false
 				}
 			}
+			if skipped {
+				return false
+			}
 		}
 	}
 	return true
 }
 
-func (e *scenarioExecutor) executeStep(step *gauge.Step, protoItem *gauge_messages.ProtoItem, scenarioResult *result.ScenarioResult) (bool, bool) {
-	var failed, recoverable bool
+func (e *scenarioExecutor) executeStep(step *gauge.Step, protoItem *gauge_messages.ProtoItem, scenarioResult *result.ScenarioResult) (bool, bool, bool) {
+	var failed, recoverable, skipped bool
 	if protoItem.GetItemType() == gauge_messages.ProtoItem_Concept {
 		protoConcept := protoItem.GetConcept()
-		res := e.executeConcept(step, protoConcept, scenarioResult)
+		var res *result.ConceptResult
+		res, skipped = e.executeConcept(step, protoConcept, scenarioResult)
 		failed = res.GetFailed()
 		recoverable = res.GetRecoverable()
 
 	} else if protoItem.GetItemType() == gauge_messages.ProtoItem_Step {
-		se := &stepExecutor{runner: e.runner, pluginHandler: e.pluginHandler, currentExecutionInfo: e.currentExecutionInfo, stream: e.stream}
+		tags := e.stepTags(step)
+		if SkipStepTags != "" && len(tags) > 0 && filter.TagsMatch(tags, SkipStepTags) {
+			e.skipStep(step, protoItem.GetStep())
+			return false, false, false
+		}
+		se := &stepExecutor{runner: e.runner, pluginHandler: e.pluginHandler, currentExecutionInfo: e.currentExecutionInfo, stream: e.stream, tags: tags}
 		res := se.executeStep(step, protoItem.GetStep())
 		protoItem.GetStep().StepExecutionResult = res.ProtoStepExecResult()
 		failed = res.GetFailed()
 		recoverable = res.ProtoStepExecResult().GetExecutionResult().GetRecoverableError()
+		if res.SkipScenario && !failed {
+			skipped = true
+			scenarioResult.SetSkipped(result.SkipRequested, res.SkipScenarioReason)
+		}
+	}
+	return failed, recoverable, skipped
+}
+
+// skipStep reports the step as skipped for its tags, which match --skip-steps, without executing it
+func (e *scenarioExecutor) skipStep(step *gauge.Step, protoStep *gauge_messages.ProtoStep) {
+	event.Notify(event.NewExecutionEvent(event.StepStart, step, nil, e.stream, e.currentExecutionInfo))
+	res := result.NewStepResult(protoStep)
+	res.SetSkipped(fmt.Sprintf("Step is tagged with %s", SkipStepTags))
+	event.Notify(event.NewExecutionEvent(event.StepEnd, *step, res, e.stream, e.currentExecutionInfo))
+}
+
+// stepTags gives the step tags of the step and of the concepts it is executed in
+func (e *scenarioExecutor) stepTags(step *gauge.Step) []string {
+	tags := append([]string{}, e.conceptTags...)
+	if step.StepTags != nil {
+		tags = append(tags, step.StepTags.Values()...)
 	}
-	return failed, recoverable
+	return tags
 }
 
-func (e *scenarioExecutor) executeConcept(item *gauge.Step, protoConcept *gauge_messages.ProtoConcept, scenarioResult *result.ScenarioResult) *result.ConceptResult {
+func (e *scenarioExecutor) executeConcept(item *gauge.Step, protoConcept *gauge_messages.ProtoConcept, scenarioResult *result.ScenarioResult) (*result.ConceptResult, bool) {
+	if item.StepTags != nil {
+		conceptTags := e.conceptTags
+		e.conceptTags = e.stepTags(item)
+		defer func() { e.conceptTags = conceptTags }()
+	}
 	cptResult := result.NewConceptResult(protoConcept)
 	event.Notify(event.NewExecutionEvent(event.ConceptStart, item, nil, e.stream, e.currentExecutionInfo))
 	defer event.Notify(event.NewExecutionEvent(event.ConceptEnd, nil, cptResult, e.stream, e.currentExecutionInfo))
@@ -184,7 +250,7 @@ func (e *scenarioExecutor) executeConcept(item *gauge.Step, protoConcept *gauge_
 	var conceptStepIndex int
 	for _, protoStep := range protoConcept.Steps {
 		if protoStep.GetItemType() == gauge_messages.ProtoItem_Concept || protoStep.GetItemType() == gauge_messages.ProtoItem_Step {
-			failed, recoverable := e.executeStep(item.ConceptSteps[conceptStepIndex], protoStep, scenarioResult)
+			failed, recoverable, skipped := e.executeStep(item.ConceptSteps[conceptStepIndex], protoStep, scenarioResult)
 			conceptStepIndex++
 			if failed {
 				scenarioResult.SetFailure()
@@ -192,12 +258,16 @@ func (e *scenarioExecutor) executeConcept(item *gauge.Step, protoConcept *gauge_
 				if recoverable {
 					continue
 				}
-				return cptResult
+				return cptResult, false
+			}
+			if skipped {
+				cptResult.UpdateConceptExecResult()
+				return cptResult, true
 			}
 		}
 	}
 	cptResult.UpdateConceptExecResult()
-	return cptResult
+	return cptResult, false
 }
 
 func setStepFailure(executionInfo *gauge_messages.ExecutionInfo) {
@@ -7,6 +7,7 @@
 package execution
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/getgauge/gauge/execution/result"
@@ -146,3 +147,147 @@ func TestNotifyAfterScenarioShouldAddAfterScenarioHookScreenshots(t *testing.T)
 		}
 	}
 }
+
+func TestExecuteStepSkipsStepsWithSkippedTags(t *testing.T) {
+	old := SkipStepTags
+	SkipStepTags = "slow"
+	defer func() { SkipStepTags = old }()
+	r := &mockRunner{}
+	var executed []string
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		if m.MessageType == gauge_messages.Message_ExecuteStep {
+			executed = append(executed, m.GetExecuteStepRequest().GetParsedStepText())
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	sce := newScenarioExecutor(r, h, &gauge_messages.ExecutionInfo{}, nil, nil, nil, 0)
+	slow := &gauge.Step{Value: "slow step", LineText: "slow step", StepTags: &gauge.Tags{RawValues: [][]string{{"slow"}}}}
+	fast := &gauge.Step{Value: "fast step", LineText: "fast step"}
+	var items []*gauge_messages.ProtoItem
+	for _, s := range []*gauge.Step{slow, fast} {
+		s.PopulateFragments()
+		item := gauge.ConvertToProtoItem(s)
+		item.GetStep().StepExecutionResult = &gauge_messages.ProtoStepExecutionResult{}
+		items = append(items, item)
+	}
+	scenarioResult := result.NewScenarioResult(&gauge_messages.ProtoScenario{})
+
+	sce.executeSteps([]*gauge.Step{slow, fast}, items, scenarioResult)
+
+	if len(executed) != 1 || executed[0] != "fast step" {
+		t.Errorf("Expected only `fast step` to be executed, got : %v", executed)
+	}
+	stepResult := items[0].GetStep().GetStepExecutionResult()
+	if !stepResult.GetSkipped() || stepResult.GetSkippedReason() != "Step is tagged with slow" {
+		t.Errorf("Expected `slow step` to be skipped for its tags, got %v", stepResult)
+	}
+	if scenarioResult.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
+		t.Errorf("Expected the scenario not to be skipped")
+	}
+}
+
+func TestExecuteRunsScenarioTearDownStepsWhenAStepFails(t *testing.T) {
+	r := &mockRunner{}
+	var executed []string
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		if m.MessageType == gauge_messages.Message_ExecuteStep {
+			executed = append(executed, m.GetExecuteStepRequest().GetParsedStepText())
+			if m.GetExecuteStepRequest().GetParsedStepText() == "failing step" {
+				return &gauge_messages.ProtoExecutionResult{Failed: true}
+			}
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	failing := &gauge.Step{Value: "failing step", LineText: "failing step"}
+	next := &gauge.Step{Value: "next step", LineText: "next step"}
+	cleanup := &gauge.Step{Value: "cleanup step", LineText: "cleanup step"}
+	specCleanup := &gauge.Step{Value: "spec cleanup step", LineText: "spec cleanup step"}
+	protoItems := func(steps ...*gauge.Step) []*gauge_messages.ProtoItem {
+		var items []*gauge_messages.ProtoItem
+		for _, s := range steps {
+			s.PopulateFragments()
+			item := gauge.ConvertToProtoItem(s)
+			item.GetStep().StepExecutionResult = &gauge_messages.ProtoStepExecutionResult{}
+			items = append(items, item)
+		}
+		return items
+	}
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "Scenario"}, Steps: []*gauge.Step{failing, next}, TearDownSteps: []*gauge.Step{cleanup}}
+	scenarioResult := result.NewScenarioResult(&gauge_messages.ProtoScenario{ScenarioItems: protoItems(failing, next)})
+	scenarioResult.AddTearDownSteps(protoItems(cleanup, specCleanup))
+	errMap := &gauge.BuildErrors{ScenarioErrs: map[*gauge.Scenario][]error{}}
+	ei := &gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{}, CurrentScenario: &gauge_messages.ScenarioInfo{}}
+	sce := newScenarioExecutor(r, h, ei, errMap, nil, []*gauge.Step{specCleanup}, 0)
+
+	sce.execute(scenario, scenarioResult)
+
+	want := []string{"failing step", "cleanup step", "spec cleanup step"}
+	if !reflect.DeepEqual(executed, want) {
+		t.Errorf("Expected the steps %v to be executed, got : %v", want, executed)
+	}
+	if !scenarioResult.GetFailed() {
+		t.Errorf("Expected the scenario to fail")
+	}
+}
+
+func TestExecuteSkipsScenarioExcludedByTags(t *testing.T) {
+	r := &mockRunner{}
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		t.Errorf("Expected nothing to be executed, got %s", m.MessageType)
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	sce := newScenarioExecutor(r, h, &gauge_messages.ExecutionInfo{}, gauge.NewBuildErrors(), nil, nil, 0)
+	scenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "Logout"}, ExcludedByTags: "smoke"}
+	scenarioResult := result.NewScenarioResult(&gauge_messages.ProtoScenario{})
+
+	sce.execute(scenario, scenarioResult)
+
+	if scenarioResult.ProtoScenario.GetExecutionStatus() != gauge_messages.ExecutionStatus_SKIPPED {
+		t.Errorf("Expected the scenario to be skipped, got %s", scenarioResult.ProtoScenario.GetExecutionStatus())
+	}
+	if scenarioResult.SkipReason != result.TagFilter {
+		t.Errorf("Expected skip reason %s, got %s", result.TagFilter, scenarioResult.SkipReason)
+	}
+}
+
+func TestExecuteStepsSkipsTheRestOfTheScenarioWhenAStepAsksTo(t *testing.T) {
+	r := &mockRunner{}
+	var executed []string
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		if m.MessageType == gauge_messages.Message_ExecuteStep {
+			executed = append(executed, m.GetExecuteStepRequest().GetParsedStepText())
+			return skipScenarioResult("missing test data")
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	sce := newScenarioExecutor(r, h, &gauge_messages.ExecutionInfo{}, nil, nil, nil, 0)
+	first := &gauge.Step{Value: "first step", LineText: "first step"}
+	second := &gauge.Step{Value: "second step", LineText: "second step"}
+	var items []*gauge_messages.ProtoItem
+	for _, s := range []*gauge.Step{first, second} {
+		s.PopulateFragments()
+		item := gauge.ConvertToProtoItem(s)
+		item.GetStep().StepExecutionResult = &gauge_messages.ProtoStepExecutionResult{}
+		items = append(items, item)
+	}
+	scenarioResult := result.NewScenarioResult(&gauge_messages.ProtoScenario{})
+
+	sce.executeSteps([]*gauge.Step{first, second}, items, scenarioResult)
+
+	if len(executed) != 1 || executed[0] != "first step" {
+		t.Errorf("Expected only `first step` to be executed, got : %v", executed)
+	}
+	if scenarioResult.ProtoScenario.GetExecutionStatus() != gauge_messages.ExecutionStatus_SKIPPED {
+		t.Errorf("Expected the scenario to be skipped, got %s", scenarioResult.ProtoScenario.GetExecutionStatus())
+	}
+	if scenarioResult.SkipReason != result.SkipRequested || scenarioResult.SkipMessage != "missing test data" {
+		t.Errorf("Expected skip reason %s with `missing test data`, got %s with %s", result.SkipRequested, scenarioResult.SkipReason, scenarioResult.SkipMessage)
+	}
+	if items[0].GetStep().GetStepExecutionResult().GetSkipped() {
+		t.Errorf("Expected `first step` not to be marked as skipped")
+	}
+}
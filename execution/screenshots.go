@@ -0,0 +1,133 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"strings"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/logger"
+)
+
+// Screenshot strategies, set in gauge_screenshot_strategy or per scenario with a tag like screenshot:never.
+// Runners take the screenshots: they learn the strategy from gauge_screenshot_strategy and screenshot_on_failure,
+// and gauge drops the screenshots of the scenarios whose strategy does not allow any.
+const (
+	screenshotOnFailure  = "on-failure"
+	screenshotAlways     = "always"
+	screenshotNever      = "never"
+	screenshotOnStepTags = "on-step-tags"
+
+	screenshotTagPrefix = "screenshot:"
+)
+
+func isScreenshotStrategy(strategy string) bool {
+	switch strategy {
+	case screenshotOnFailure, screenshotAlways, screenshotNever, screenshotOnStepTags:
+		return true
+	}
+	return false
+}
+
+// screenshotStrategy gives the strategy of a scenario, from its screenshot: tag, else the tag of its spec, else the configured one
+func screenshotStrategy(scenarioTags, specTags []string) string {
+	for _, tags := range [][]string{scenarioTags, specTags} {
+		for _, t := range tags {
+			t = strings.ToLower(strings.TrimSpace(t))
+			if !strings.HasPrefix(t, screenshotTagPrefix) {
+				continue
+			}
+			if s := strings.TrimPrefix(t, screenshotTagPrefix); isScreenshotStrategy(s) {
+				return s
+			}
+			logger.Warningf(true, "Ignoring tag %s, the screenshot strategy should be one of on-failure, always, never or on-step-tags", t)
+		}
+	}
+	s := env.ScreenshotStrategy()
+	if !isScreenshotStrategy(s) {
+		logger.Warningf(true, "Invalid %s '%s', using on-failure", env.GaugeScreenshotStrategy, s)
+		return screenshotOnFailure
+	}
+	return s
+}
+
+// applyScreenshotStrategy drops the screenshots of a scenario when its strategy is never, or when it is on-step-tags
+// and the scenario and its spec have none of the tags in gauge_screenshot_tags
+func applyScreenshotStrategy(scn *gauge_messages.ProtoScenario, specTags []string) {
+	tags := append(append([]string{}, scn.GetTags()...), specTags...)
+	switch screenshotStrategy(scn.GetTags(), specTags) {
+	case screenshotNever:
+		dropScenarioScreenshots(scn)
+	case screenshotOnStepTags:
+		if !hasAnyTag(tags, env.ScreenshotTags()) {
+			dropScenarioScreenshots(scn)
+		}
+	}
+}
+
+func hasAnyTag(tags, wanted []string) bool {
+	for _, w := range wanted {
+		for _, t := range tags {
+			if strings.EqualFold(strings.TrimSpace(t), w) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func dropScenarioScreenshots(scn *gauge_messages.ProtoScenario) {
+	scn.PreHookScreenshots, scn.PreHookScreenshotFiles = nil, nil
+	scn.PostHookScreenshots, scn.PostHookScreenshotFiles = nil, nil
+	dropHookFailureScreenshot(scn.GetPreHookFailure())
+	dropHookFailureScreenshot(scn.GetPostHookFailure())
+	for _, items := range [][]*gauge_messages.ProtoItem{scn.GetContexts(), scn.GetScenarioItems(), scn.GetTearDownSteps()} {
+		dropItemScreenshots(items)
+	}
+}
+
+func dropItemScreenshots(items []*gauge_messages.ProtoItem) {
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gauge_messages.ProtoItem_Step:
+			dropStepScreenshots(item.GetStep())
+		case gauge_messages.ProtoItem_Concept:
+			dropStepScreenshots(item.GetConcept().GetConceptStep())
+			dropStepResultScreenshots(item.GetConcept().GetConceptExecutionResult())
+			dropItemScreenshots(item.GetConcept().GetSteps())
+		}
+	}
+}
+
+func dropStepScreenshots(step *gauge_messages.ProtoStep) {
+	if step == nil {
+		return
+	}
+	step.PreHookScreenshots, step.PreHookScreenshotFiles = nil, nil
+	step.PostHookScreenshots, step.PostHookScreenshotFiles = nil, nil
+	dropStepResultScreenshots(step.GetStepExecutionResult())
+}
+
+func dropStepResultScreenshots(res *gauge_messages.ProtoStepExecutionResult) {
+	if res == nil {
+		return
+	}
+	if r := res.GetExecutionResult(); r != nil {
+		r.ScreenShot, r.FailureScreenshot, r.FailureScreenshotFile = nil, nil, ""
+		r.Screenshots, r.ScreenshotFiles = nil, nil
+	}
+	dropHookFailureScreenshot(res.GetPreHookFailure())
+	dropHookFailureScreenshot(res.GetPostHookFailure())
+}
+
+func dropHookFailureScreenshot(f *gauge_messages.ProtoHookFailure) {
+	if f == nil {
+		return
+	}
+	f.ScreenShot, f.FailureScreenshot, f.FailureScreenshotFile = nil, nil, ""
+}
@@ -0,0 +1,109 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
+	. "gopkg.in/check.v1"
+)
+
+func scenarioWithScreenshots(tags ...string) *gauge_messages.ProtoScenario {
+	step := &gauge_messages.ProtoStep{
+		PreHookScreenshotFiles: []string{"before.png"},
+		StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{
+			ExecutionResult: &gauge_messages.ProtoExecutionResult{Failed: true, FailureScreenshotFile: "failure.png", ScreenshotFiles: []string{"custom.png"}},
+		},
+	}
+	conceptStep := &gauge_messages.ProtoStep{
+		StepExecutionResult: &gauge_messages.ProtoStepExecutionResult{
+			ExecutionResult: &gauge_messages.ProtoExecutionResult{ScreenshotFiles: []string{"concept.png"}},
+		},
+	}
+	return &gauge_messages.ProtoScenario{
+		Tags:                    tags,
+		PostHookScreenshotFiles: []string{"after.png"},
+		PostHookFailure:         &gauge_messages.ProtoHookFailure{FailureScreenshotFile: "hook.png"},
+		ScenarioItems: []*gauge_messages.ProtoItem{
+			{ItemType: gauge_messages.ProtoItem_Step, Step: step},
+			{ItemType: gauge_messages.ProtoItem_Concept, Concept: &gauge_messages.ProtoConcept{
+				Steps: []*gauge_messages.ProtoItem{{ItemType: gauge_messages.ProtoItem_Step, Step: conceptStep}},
+			}},
+		},
+	}
+}
+
+func withScreenshotStrategy(strategy string, tags ...string) func() {
+	oldStrategy, oldTags := env.ScreenshotStrategy, env.ScreenshotTags
+	env.ScreenshotStrategy = func() string { return strategy }
+	env.ScreenshotTags = func() []string { return tags }
+	return func() {
+		env.ScreenshotStrategy, env.ScreenshotTags = oldStrategy, oldTags
+	}
+}
+
+func (s *MySuite) TestScreenshotsAreKeptOnFailureStrategy(c *C) {
+	defer withScreenshotStrategy(screenshotOnFailure)()
+	scn := scenarioWithScreenshots()
+
+	applyScreenshotStrategy(scn, nil)
+
+	c.Assert(scn.ScenarioItems[0].Step.StepExecutionResult.ExecutionResult.FailureScreenshotFile, Equals, "failure.png")
+	c.Assert(scn.PostHookFailure.FailureScreenshotFile, Equals, "hook.png")
+}
+
+func (s *MySuite) TestScreenshotsAreDroppedWithNeverStrategy(c *C) {
+	defer withScreenshotStrategy(screenshotNever)()
+	scn := scenarioWithScreenshots()
+
+	applyScreenshotStrategy(scn, nil)
+
+	step := scn.ScenarioItems[0].Step
+	c.Assert(step.PreHookScreenshotFiles, IsNil)
+	c.Assert(step.StepExecutionResult.ExecutionResult.FailureScreenshotFile, Equals, "")
+	c.Assert(step.StepExecutionResult.ExecutionResult.ScreenshotFiles, IsNil)
+	c.Assert(scn.ScenarioItems[1].Concept.Steps[0].Step.StepExecutionResult.ExecutionResult.ScreenshotFiles, IsNil)
+	c.Assert(scn.PostHookScreenshotFiles, IsNil)
+	c.Assert(scn.PostHookFailure.FailureScreenshotFile, Equals, "")
+	c.Assert(step.StepExecutionResult.ExecutionResult.Failed, Equals, true)
+}
+
+func (s *MySuite) TestScenarioTagOverridesScreenshotStrategy(c *C) {
+	defer withScreenshotStrategy(screenshotOnFailure)()
+	scn := scenarioWithScreenshots("smoke", "Screenshot:Never")
+
+	applyScreenshotStrategy(scn, nil)
+
+	c.Assert(scn.PostHookFailure.FailureScreenshotFile, Equals, "")
+}
+
+func (s *MySuite) TestScenarioTagOverridesSpecTagForScreenshotStrategy(c *C) {
+	defer withScreenshotStrategy(screenshotOnFailure)()
+	scn := scenarioWithScreenshots("screenshot:always")
+
+	applyScreenshotStrategy(scn, []string{"screenshot:never"})
+
+	c.Assert(scn.PostHookFailure.FailureScreenshotFile, Equals, "hook.png")
+}
+
+func (s *MySuite) TestScreenshotsAreKeptForTaggedScenariosWithOnStepTagsStrategy(c *C) {
+	defer withScreenshotStrategy(screenshotOnStepTags, "ui")()
+	tagged := scenarioWithScreenshots()
+	untagged := scenarioWithScreenshots()
+
+	applyScreenshotStrategy(tagged, []string{"UI"})
+	applyScreenshotStrategy(untagged, []string{"api"})
+
+	c.Assert(tagged.PostHookFailure.FailureScreenshotFile, Equals, "hook.png")
+	c.Assert(untagged.PostHookFailure.FailureScreenshotFile, Equals, "")
+}
+
+func (s *MySuite) TestInvalidScreenshotStrategyFallsBackToOnFailure(c *C) {
+	defer withScreenshotStrategy("sometimes")()
+
+	c.Assert(screenshotStrategy(nil, nil), Equals, screenshotOnFailure)
+}
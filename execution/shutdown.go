@@ -0,0 +1,42 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"context"
+	"time"
+)
+
+// withGracePeriod gives a context which is done the grace period after the stop context is. When a run is
+// interrupted, no more scenarios are started once the stop context is done, while the runner and plugins, which
+// are given the returned context, are left the grace period to finish the running scenarios and teardown hooks.
+func withGracePeriod(stop context.Context, grace time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	if stop.Done() == nil {
+		return ctx, cancel
+	}
+	go func() {
+		select {
+		case <-stop.Done():
+		case <-ctx.Done():
+			return
+		}
+		t := time.NewTimer(grace)
+		defer t.Stop()
+		select {
+		case <-t.C:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// interrupted tells if the run was interrupted, which is when its stop context is done
+func interrupted(stop context.Context) bool {
+	return stop != nil && stop.Err() != nil
+}
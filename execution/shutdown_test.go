@@ -0,0 +1,55 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWithGracePeriodIsDoneAfterTheGracePeriod(t *testing.T) {
+	stop, interrupt := context.WithCancel(context.Background())
+	ctx, cancel := withGracePeriod(stop, 50*time.Millisecond)
+	defer cancel()
+
+	interrupt()
+	if ctx.Err() != nil {
+		t.Fatal("Expected the context to be left running during the grace period")
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the context to be done after the grace period")
+	}
+}
+
+func TestWithGracePeriodIsNotDoneWhileNotInterrupted(t *testing.T) {
+	stop, interrupt := context.WithCancel(context.Background())
+	defer interrupt()
+	ctx, cancel := withGracePeriod(stop, time.Millisecond)
+
+	time.Sleep(20 * time.Millisecond)
+	if ctx.Err() != nil {
+		t.Fatal("Expected the context not to be done while the run is not interrupted")
+	}
+	cancel()
+	if ctx.Err() == nil {
+		t.Fatal("Expected the context to be done once cancelled")
+	}
+}
+
+func TestInterrupted(t *testing.T) {
+	stop, interrupt := context.WithCancel(context.Background())
+	if interrupted(nil) || interrupted(stop) {
+		t.Error("Expected the run not to be interrupted")
+	}
+	interrupt()
+	if !interrupted(stop) {
+		t.Error("Expected the run to be interrupted")
+	}
+}
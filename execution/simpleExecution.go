@@ -7,6 +7,7 @@
 package execution
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/plugin"
 	"github.com/getgauge/gauge/runner"
 )
@@ -26,12 +28,23 @@ import (
 var ExecuteTags = ""
 var tableRowsIndexes []int
 
-// SetTableRows is used to limit data driven execution to specific rows
+// tableRowsPredicate selects the rows to execute when the rows are given by the values of their columns
+var tableRowsPredicate *gauge.RowPredicate
+
+// SetTableRows is used to limit data driven execution to specific rows, given by their numbers or by a predicate on
+// their columns like country=IN & tier!=gold
 func SetTableRows(tableRows string) {
+	tableRowsIndexes, tableRowsPredicate = nil, nil
+	if gauge.IsRowPredicate(tableRows) {
+		tableRowsPredicate, _ = gauge.ParseRowPredicate(tableRows)
+		return
+	}
 	tableRowsIndexes = getDataTableRows(tableRows)
 }
 
 type simpleExecution struct {
+	ctx                  context.Context
+	stop                 context.Context
 	manifest             *manifest.Manifest
 	runner               runner.Runner
 	specCollection       *gauge.SpecCollection
@@ -56,6 +69,8 @@ func newSimpleExecution(executionInfo *executionInfo, combineDataTableSpecs, ski
 	}
 
 	return &simpleExecution{
+		ctx:                  executionInfo.context(),
+		stop:                 executionInfo.stop,
 		manifest:             executionInfo.manifest,
 		specCollection:       executionInfo.specs,
 		runner:               executionInfo.runner,
@@ -68,6 +83,10 @@ func newSimpleExecution(executionInfo *executionInfo, combineDataTableSpecs, ski
 }
 
 func (e *simpleExecution) run() *result.SuiteResult {
+	if specs := e.specCollection.Specs(); parser.PriorityOrderEnabled() && hasPrioritizedScenarios(specs) {
+		e.specCollection = gauge.NewSpecCollection(scheduleByPriority(specs, e.errMaps), false)
+	}
+	startQueue(e.specCollection.Specs())
 	e.start()
 	e.execute()
 	e.finish()
@@ -79,6 +98,9 @@ func (e *simpleExecution) execute() {
 	defer func() {
 		e.suiteResult.UpdateExecTime(e.startTime)
 		e.suiteResult.SetSpecsSkippedCount()
+		if interrupted(e.stop) {
+			e.suiteResult.SetInterrupted()
+		}
 	}()
 	if !e.skipSuiteEvents {
 		logger.Debug(true, "Initialising suite data store.")
@@ -103,11 +125,13 @@ func (e *simpleExecution) execute() {
 func (e *simpleExecution) start() {
 	e.startTime = time.Now()
 	event.Notify(event.NewExecutionEvent(event.SuiteStart, nil, nil, 0, &gauge_messages.ExecutionInfo{}))
-	e.pluginHandler = plugin.StartPlugins(e.manifest)
+	e.pluginHandler = plugin.StartPlugins(e.ctx, e.manifest)
 }
 
 func (e *simpleExecution) finish() {
 	e.suiteResult = mergeDataTableSpecResults(e.suiteResult)
+	e.suiteResult.SetAttachments()
+	setFailureSummary(e.suiteResult)
 	event.Notify(event.NewExecutionEvent(event.SuiteEnd, nil, e.suiteResult, 0, &gauge_messages.ExecutionInfo{}))
 	e.notifyExecutionResult()
 	e.stopAllPlugins()
@@ -122,7 +146,12 @@ func (e *simpleExecution) stopAllPlugins() {
 
 func (e *simpleExecution) executeSpecs(sc *gauge.SpecCollection) (results []*result.SpecResult) {
 	for sc.HasNext() {
+		if interrupted(e.stop) || e.ctx.Err() != nil {
+			logger.Debugf(true, "Execution interrupted, not running the remaining specifications.")
+			return results
+		}
 		specs := sc.Next()
+		queue.assign(e.stream, specs)
 		var preHookFailures, postHookFailures []*gauge_messages.ProtoHookFailure
 		var specResults []*result.SpecResult
 		var before, after = true, false
@@ -130,7 +159,12 @@ func (e *simpleExecution) executeSpecs(sc *gauge.SpecCollection) (results []*res
 			if i == len(specs)-1 {
 				after = true
 			}
-			res := newSpecExecutor(spec, e.runner, e.pluginHandler, e.errMaps, e.stream).execute(before, preHookFailures == nil, after)
+			se := newSpecExecutor(spec, e.runner, e.pluginHandler, e.errMaps, e.stream)
+			se.stop = e.stop
+			if !e.skipSuiteEvents && e.manifest != nil {
+				se.restartRunner = e.restartRunner
+			}
+			res := se.execute(before, preHookFailures == nil, after)
 			before = false
 			specResults = append(specResults, res)
 			preHookFailures = append(preHookFailures, res.GetPreHook()...)
@@ -156,10 +190,42 @@ func (e *simpleExecution) executeSpecs(sc *gauge.SpecCollection) (results []*res
 			}
 			results = append(results, res)
 		}
+		// the specs of an interrupted run stay in the queue, their scenarios left having been skipped
+		if !interrupted(e.stop) {
+			queue.finish(e.stream, specs)
+		}
 	}
 	return results
 }
 
+// restartRunner starts a fresh runner in place of the one which died, and sets it up as at the start of the suite.
+// Plugins are not notified, the suite having started for them already.
+func (e *simpleExecution) restartRunner() (runner.Runner, error) {
+	if err := e.runner.Kill(); err != nil {
+		logger.Debugf(true, "Failed to kill the runner which died. %s", err.Error())
+	}
+	r, err := runner.Start(e.ctx, e.manifest, e.stream, make(chan bool), false)
+	if err != nil {
+		return nil, err
+	}
+	messages := []*gauge_messages.Message{
+		{MessageType: gauge_messages.Message_SuiteDataStoreInit,
+			SuiteDataStoreInitRequest: &gauge_messages.SuiteDataStoreInitRequest{Stream: int32(e.stream)}},
+		{MessageType: gauge_messages.Message_ExecutionStarting,
+			ExecutionStartingRequest: &gauge_messages.ExecutionStartingRequest{CurrentExecutionInfo: e.currentExecutionInfo, Stream: int32(e.stream)}},
+	}
+	for _, m := range messages {
+		if res := r.ExecuteAndGetStatus(m); res.GetFailed() {
+			if err := r.Kill(); err != nil {
+				logger.Debugf(true, "Failed to kill runner. %s", err.Error())
+			}
+			return nil, fmt.Errorf("failed to set up the runner for the suite. %s", res.GetErrorMessage())
+		}
+	}
+	e.runner = r
+	return r, nil
+}
+
 func (e *simpleExecution) notifyBeforeSuite() {
 	m := &gauge_messages.Message{MessageType: gauge_messages.Message_ExecutionStarting,
 		ExecutionStartingRequest: &gauge_messages.ExecutionStartingRequest{CurrentExecutionInfo: e.currentExecutionInfo, Stream: int32(e.stream)}}
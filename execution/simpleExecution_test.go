@@ -7,6 +7,7 @@
 package execution
 
 import (
+	"context"
 	"testing"
 
 	"github.com/getgauge/gauge/execution/result"
@@ -199,3 +200,45 @@ func createSpecCollection() *gauge.SpecCollection {
 	})
 	return gauge.NewSpecCollection(specs, false)
 }
+
+func TestExecuteSpecsRunsNoSpecsWhenCancelled(t *testing.T) {
+	r := &mockRunner{}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		t.Errorf("Expected no message to the runner, got %s", m.MessageType)
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	spec := &gauge.Specification{Heading: &gauge.Heading{Value: "Spec"}, FileName: "spec.spec"}
+	ei := &executionInfo{ctx: ctx, runner: r, pluginHandler: h, errMaps: gauge.NewBuildErrors()}
+	simpleExecution := newSimpleExecution(ei, false, false)
+
+	results := simpleExecution.executeSpecs(gauge.NewSpecCollection([]*gauge.Specification{spec}, false))
+
+	if len(results) != 0 {
+		t.Errorf("Expected no spec results, got %d", len(results))
+	}
+}
+
+func TestExecuteMarksSuiteResultInterrupted(t *testing.T) {
+	r := &mockRunner{}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	stop, interrupt := context.WithCancel(context.Background())
+	interrupt()
+	spec := &gauge.Specification{Heading: &gauge.Heading{Value: "Spec"}, FileName: "spec.spec"}
+	ei := &executionInfo{stop: stop, runner: r, pluginHandler: h, errMaps: gauge.NewBuildErrors(), specs: gauge.NewSpecCollection([]*gauge.Specification{spec}, false)}
+	simpleExecution := newSimpleExecution(ei, false, false)
+
+	simpleExecution.execute()
+
+	if !simpleExecution.suiteResult.Interrupted {
+		t.Error("Expected the suite result to be marked interrupted")
+	}
+	if len(simpleExecution.suiteResult.SpecResults) != 0 {
+		t.Errorf("Expected no spec results, got %d", len(simpleExecution.suiteResult.SpecResults))
+	}
+}
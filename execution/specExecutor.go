@@ -7,10 +7,12 @@
 package execution
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/config"
@@ -34,6 +36,11 @@ type specExecutor struct {
 	errMap               *gauge.BuildErrors
 	stream               int
 	scenarioExecutor     executor
+	// stop is done when the run is interrupted, the scenarios which have not started are skipped then
+	stop context.Context
+	// restartRunner starts a fresh runner, set up for the suite, in place of one which died. It is nil when the
+	// runner can not be restarted, like when the streams of a parallel run share it.
+	restartRunner func() (runner.Runner, error)
 }
 
 func newSpecExecutor(s *gauge.Specification, r runner.Runner, ph plugin.Handler, e *gauge.BuildErrors, stream int) *specExecutor {
@@ -205,7 +212,7 @@ func (e *specExecutor) skipSpecForError(err error) {
 	validationError := validation.NewStepValidationError(&gauge.Step{LineNo: e.specification.Heading.LineNo, LineText: e.specification.Heading.Value},
 		err.Error(), e.specification.FileName, nil, "")
 	for _, scenario := range e.specification.Scenarios {
-		e.errMap.ScenarioErrs[scenario] = []error{validationError}
+		e.errMap.ScenarioErrs[scenario] = []error{skipError{validationError, result.DependencyFailure}}
 	}
 	e.errMap.SpecErrs[e.specification] = []error{validationError}
 	e.specResult.Errors = e.convertErrors(e.errMap.SpecErrs[e.specification])
@@ -294,6 +301,7 @@ func (e *specExecutor) executeScenario(scenario *gauge.Scenario) (*result.Scenar
 		shouldRetry = !(specFilter.Filter(scenario))
 	}
 	retriesCount := 0
+	infraRetried := false
 	for i := 0; i < MaxRetriesCount; i++ {
 		e.currentExecutionInfo.CurrentScenario = &gauge_messages.ScenarioInfo{
 			Name:     scenario.Heading.Value,
@@ -306,35 +314,104 @@ func (e *specExecutor) executeScenario(scenario *gauge.Scenario) (*result.Scenar
 			ScenarioDataTableRow:      gauge.ConvertToProtoTable(&scenario.ScenarioDataTableRow),
 			ScenarioDataTableRowIndex: scenario.ScenarioDataTableRowIndex,
 			ScenarioDataTable:         gauge.ConvertToProtoTable(scenario.DataTable.Table),
+			SpecDataTableRow:          gauge.ConvertToProtoTable(&scenario.SpecDataTableRow),
+			SpecDataTableRowIndex:     scenario.SpecDataTableRowIndex,
+			WIP:                       e.specification.IsScenarioWIP(scenario),
 		}
 		if err := e.addAllItemsForScenarioExecution(scenario, scenarioResult); err != nil {
 			return nil, err
 		}
-		e.scenarioExecutor.execute(scenario, scenarioResult)
+		if interrupted(e.stop) {
+			scenarioResult.SetSkipped(result.Interrupted, "skipped Reason: Execution was interrupted")
+		} else if maxFailuresReached() {
+			scenarioResult.SetSkipped(result.MaxFailures, fmt.Sprintf("skipped Reason: %d scenarios failed, the --max-failures limit", MaxFailures))
+		} else {
+			release := acquireLocks(scenarioLocks(e.specification, scenario))
+			e.scenarioExecutor.execute(scenario, scenarioResult)
+			release()
+		}
 		retriesCount++
 		if scenarioResult.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED {
 			e.specResult.ScenarioSkippedCount++
 		}
+		if scenarioResult.GetFailed() {
+			scenarioResult.FailureType = e.failureType()
+			if scenarioResult.FailureType == result.InfrastructureFailure && RetryInfraFailures && !infraRetried && e.replaceRunner() {
+				logger.Infof(true, "Retrying scenario '%s' on a fresh runner.", scenario.Heading.Value)
+				infraRetried = true
+				e.specResult.ScenarioInfraRetriedCount++
+				// the run on a fresh runner is not one of the retries of --max-retries-count
+				i--
+				continue
+			}
+		}
 
 		if !(shouldRetry && scenarioResult.GetFailed()) {
 			break
 		}
 	}
 	scenarioResult.ProtoScenario.RetriesCount = int64(retriesCount)
+	if scenarioResult.GetFailed() && !scenarioResult.WIP {
+		atomic.AddInt32(&failedScenarios, 1)
+	}
+	if scenarioResult.FailureType == result.InfrastructureFailure {
+		e.specResult.ScenarioInfraFailedCount++
+	}
 	return scenarioResult, nil
 }
 
+// failedScenarios counts the scenarios failed in the run, across the execution streams, for --max-failures
+var failedScenarios int32
+
+// maxFailuresReached tells if the --max-failures count of scenarios have failed, the scenarios left being skipped
+func maxFailuresReached() bool {
+	return MaxFailures > 0 && int(atomic.LoadInt32(&failedScenarios)) >= MaxFailures
+}
+
+// failureType tells if a scenario failed because of the code under test, or because the runner died or could not
+// be reached
+func (e *specExecutor) failureType() result.FailureType {
+	if e.runner.Info().Killed {
+		return result.InfrastructureFailure
+	}
+	return result.AssertionFailure
+}
+
+// replaceRunner starts a fresh runner in place of the one which died and sets it up for the spec, telling if the
+// scenario can be run on it
+func (e *specExecutor) replaceRunner() bool {
+	if e.restartRunner == nil {
+		return false
+	}
+	r, err := e.restartRunner()
+	if err != nil {
+		logger.Errorf(true, "Failed to restart the runner. %s", err.Error())
+		return false
+	}
+	e.runner = r
+	if se, ok := e.scenarioExecutor.(*scenarioExecutor); ok {
+		se.runner = r
+	}
+	if res := e.initSpecDataStore(); res.GetFailed() {
+		logger.Errorf(true, "Failed to initialize spec datastore on the restarted runner. %s", res.GetErrorMessage())
+		return false
+	}
+	e.currentExecutionInfo.CurrentScenario = nil
+	m := &gauge_messages.Message{MessageType: gauge_messages.Message_SpecExecutionStarting,
+		SpecExecutionStartingRequest: &gauge_messages.SpecExecutionStartingRequest{CurrentExecutionInfo: e.currentExecutionInfo, Stream: int32(e.stream)}}
+	if res := e.runner.ExecuteAndGetStatus(m); res.GetFailed() {
+		logger.Errorf(true, "Before spec hook failed on the restarted runner. %s", res.GetErrorMessage())
+		return false
+	}
+	return true
+}
+
 func (e *specExecutor) addAllItemsForScenarioExecution(scenario *gauge.Scenario, scenarioResult *result.ScenarioResult) error {
 	contexts, err := e.getItemsForScenarioExecution(e.specification.Contexts)
 	if err != nil {
 		return err
 	}
 	scenarioResult.AddContexts(contexts)
-	tearDownSteps, err := e.getItemsForScenarioExecution(e.specification.TearDownSteps)
-	if err != nil {
-		return err
-	}
-	scenarioResult.AddTearDownSteps(tearDownSteps)
 	lookup, err := e.dataTableLookup()
 	if err != nil {
 		return err
@@ -345,11 +422,25 @@ func (e *specExecutor) addAllItemsForScenarioExecution(scenario *gauge.Scenario,
 			return err
 		}
 	}
-	items, err := resolveItems(scenario.Items, lookup, e.setSkipInfo)
+	items, err := resolveItems(scenario.ItemsBeforeTearDown(), lookup, e.setSkipInfo)
 	if err != nil {
 		return err
 	}
 	scenarioResult.AddItems(items)
+	var scenarioTearDownItems []gauge.Item
+	for _, step := range scenario.TearDownSteps {
+		scenarioTearDownItems = append(scenarioTearDownItems, step)
+	}
+	scenarioTearDownSteps, err := resolveItems(scenarioTearDownItems, lookup, e.setSkipInfo)
+	if err != nil {
+		return err
+	}
+	scenarioResult.AddTearDownSteps(scenarioTearDownSteps)
+	tearDownSteps, err := e.getItemsForScenarioExecution(e.specification.TearDownSteps)
+	if err != nil {
+		return err
+	}
+	scenarioResult.AddTearDownSteps(tearDownSteps)
 	return nil
 }
 
@@ -365,7 +456,10 @@ func setSpecFailure(executionInfo *gauge_messages.ExecutionInfo) {
 	executionInfo.CurrentSpec.IsFailed = true
 }
 
-func shouldExecuteForRow(i int) bool {
+func shouldExecuteForRow(row *gauge.Table, i int) bool {
+	if tableRowsPredicate != nil {
+		return tableRowsPredicate.Matches(row, 0)
+	}
 	if len(tableRowsIndexes) < 1 {
 		return true
 	}
@@ -400,6 +494,7 @@ func getDataTableRows(tableRows string) (tableRowIndexes []int) {
 
 func executeHook(message *gauge_messages.Message, execTimeTracker result.ExecTimeTracker, r runner.Runner) *gauge_messages.ProtoExecutionResult {
 	executionResult := r.ExecuteAndGetStatus(message)
+	storeAttachments(executionResult)
 	execTimeTracker.AddExecTime(executionResult.GetExecutionTime())
 	return executionResult
 }
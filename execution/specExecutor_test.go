@@ -7,6 +7,7 @@
 package execution
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"testing"
@@ -96,6 +97,18 @@ func (s *MySuite) TestToGetDataTableRowsRangeFromInputFlag(c *C) {
 	}
 }
 
+func (s *MySuite) TestShouldExecuteForRowMatchingTheTableRowsPredicate(c *C) {
+	defer SetTableRows("")
+	SetTableRows("country=IN & tier!=gold")
+	row := func(country, tier string) *gauge.Table {
+		return gauge.NewTable([]string{"country", "tier"}, [][]gauge.TableCell{{{Value: country}}, {{Value: tier}}}, 1)
+	}
+
+	c.Assert(shouldExecuteForRow(row("IN", "silver"), 3), Equals, true)
+	c.Assert(shouldExecuteForRow(row("IN", "gold"), 3), Equals, false)
+	c.Assert(shouldExecuteForRow(row("US", "silver"), 3), Equals, false)
+}
+
 func (s *MySuite) TestCreateSkippedSpecResult(c *C) {
 	spec := &gauge.Specification{Heading: &gauge.Heading{LineNo: 0, Value: "SPEC_HEADING"}, FileName: "FILE"}
 	r := &mockRunner{}
@@ -708,6 +721,129 @@ func newSpecExecutorForTestsWithRetry() *specExecutor {
 	return se
 }
 
+func TestExecuteScenarioSkipsScenarioWhenInterrupted(t *testing.T) {
+	MaxRetriesCount = 1
+	RetryOnlyTags = ""
+	errs := gauge.NewBuildErrors()
+	r := &mockRunner{}
+	se := newSpecExecutor(exampleSpecWithScenarios, r, nil, errs, 0)
+	se.specResult = gauge.NewSpecResult(exampleSpecWithScenarios)
+	se.scenarioExecutor = &mockExecutor{
+		executeFunc: func(i gauge.Item, r result.Result) {
+			t.Errorf("Expected no scenario to be executed, got %s", i.(*gauge.Scenario).Heading.Value)
+		},
+	}
+	stop, cancel := context.WithCancel(context.Background())
+	cancel()
+	se.stop = stop
+
+	sceResult, _ := se.executeScenario(exampleSpecWithScenarios.Scenarios[0])
+
+	if sceResult.ProtoScenario.GetExecutionStatus() != gauge_messages.ExecutionStatus_SKIPPED {
+		t.Errorf("Expected the scenario to be skipped, got %s", sceResult.ProtoScenario.GetExecutionStatus())
+	}
+	if sceResult.SkipReason != result.Interrupted {
+		t.Errorf("Expected skip reason %s, got %s", result.Interrupted, sceResult.SkipReason)
+	}
+	if se.specResult.ScenarioSkippedCount != 1 {
+		t.Errorf("Expected 1 skipped scenario, got %d", se.specResult.ScenarioSkippedCount)
+	}
+}
+
+type deadRunner struct {
+	mockRunner
+}
+
+func (r *deadRunner) Info() *runner.RunnerInfo {
+	return &runner.RunnerInfo{Killed: true}
+}
+
+func TestExecuteScenarioClassifiesFailureWhenRunnerDies(t *testing.T) {
+	MaxRetriesCount = 1
+	RetryOnlyTags = ""
+	RetryInfraFailures = false
+	se := newSpecExecutor(exampleSpecWithScenarios, &deadRunner{}, nil, gauge.NewBuildErrors(), 0)
+	se.specResult = gauge.NewSpecResult(exampleSpecWithScenarios)
+	se.scenarioExecutor = &mockExecutor{
+		executeFunc: func(i gauge.Item, r result.Result) {
+			r.SetFailure()
+		},
+	}
+
+	sceResult, _ := se.executeScenario(exampleSpecWithScenarios.Scenarios[0])
+
+	if sceResult.FailureType != result.InfrastructureFailure {
+		t.Errorf("Expected failure type %s, got %s", result.InfrastructureFailure, sceResult.FailureType)
+	}
+	if se.specResult.ScenarioInfraFailedCount != 1 {
+		t.Errorf("Expected 1 scenario failed due to an infrastructure error, got %d", se.specResult.ScenarioInfraFailedCount)
+	}
+}
+
+func TestExecuteScenarioClassifiesAssertionFailure(t *testing.T) {
+	MaxRetriesCount = 1
+	RetryOnlyTags = ""
+	se := newSpecExecutor(exampleSpecWithScenarios, &mockRunner{}, nil, gauge.NewBuildErrors(), 0)
+	se.specResult = gauge.NewSpecResult(exampleSpecWithScenarios)
+	se.scenarioExecutor = &mockExecutor{
+		executeFunc: func(i gauge.Item, r result.Result) {
+			r.SetFailure()
+		},
+	}
+
+	sceResult, _ := se.executeScenario(exampleSpecWithScenarios.Scenarios[0])
+
+	if sceResult.FailureType != result.AssertionFailure {
+		t.Errorf("Expected failure type %s, got %s", result.AssertionFailure, sceResult.FailureType)
+	}
+	if se.specResult.ScenarioInfraFailedCount != 0 {
+		t.Errorf("Expected no scenario failed due to an infrastructure error, got %d", se.specResult.ScenarioInfraFailedCount)
+	}
+}
+
+func TestExecuteScenarioRetriesInfraFailureOnFreshRunner(t *testing.T) {
+	MaxRetriesCount = 1
+	RetryOnlyTags = ""
+	RetryInfraFailures = true
+	defer func() { RetryInfraFailures = false }()
+	fresh := &mockRunner{ExecuteAndGetStatusFunc: func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		return &gauge_messages.ProtoExecutionResult{}
+	}}
+	se := newSpecExecutor(exampleSpecWithScenarios, &deadRunner{}, nil, gauge.NewBuildErrors(), 0)
+	se.specResult = gauge.NewSpecResult(exampleSpecWithScenarios)
+	restarts := 0
+	se.restartRunner = func() (runner.Runner, error) {
+		restarts++
+		return fresh, nil
+	}
+	runs := 0
+	se.scenarioExecutor = &mockExecutor{
+		executeFunc: func(i gauge.Item, r result.Result) {
+			runs++
+			if runs == 1 {
+				r.SetFailure()
+			} else {
+				r.(*result.ScenarioResult).ProtoScenario.ExecutionStatus = gauge_messages.ExecutionStatus_PASSED
+			}
+		},
+	}
+
+	sceResult, _ := se.executeScenario(exampleSpecWithScenarios.Scenarios[0])
+
+	if restarts != 1 || runs != 2 {
+		t.Fatalf("Expected the scenario to run again on one fresh runner, got %d runs and %d restarts", runs, restarts)
+	}
+	if sceResult.GetFailed() {
+		t.Error("Expected the scenario to pass on the fresh runner")
+	}
+	if se.runner != fresh {
+		t.Error("Expected the spec to go on with the fresh runner")
+	}
+	if se.specResult.ScenarioInfraRetriedCount != 1 || se.specResult.ScenarioInfraFailedCount != 0 {
+		t.Errorf("Expected 1 scenario retried and none failed, got %d retried and %d failed", se.specResult.ScenarioInfraRetriedCount, se.specResult.ScenarioInfraFailedCount)
+	}
+}
+
 func TestExecuteShouldMarkSpecAsSkippedWhenAllScenariosSkipped(t *testing.T) {
 	errs := gauge.NewBuildErrors()
 	r := &mockRunner{}
@@ -722,3 +858,44 @@ func TestExecuteShouldMarkSpecAsSkippedWhenAllScenariosSkipped(t *testing.T) {
 		t.Error("Expect SpecResult.Skipped = true, got false")
 	}
 }
+
+func (s *MySuite) TestSkipReasonIsValidationFailureForValidationErrors(c *C) {
+	reason, msg := skipReason([]error{fmt.Errorf("step not implemented")})
+
+	c.Assert(reason, Equals, result.ValidationFailure)
+	c.Assert(msg, Equals, "step not implemented")
+}
+
+func (s *MySuite) TestSkipReasonUsesReasonOfSkipError(c *C) {
+	reason, msg := skipReason([]error{fmt.Errorf("step not implemented"), skipError{fmt.Errorf("datastore init failed"), result.DependencyFailure}})
+
+	c.Assert(reason, Equals, result.DependencyFailure)
+	c.Assert(msg, Equals, "datastore init failed")
+}
+
+func TestExecuteScenarioSkipsScenarioWhenMaxFailuresReached(t *testing.T) {
+	MaxRetriesCount = 1
+	RetryOnlyTags = ""
+	MaxFailures = 1
+	defer func() { MaxFailures, failedScenarios = 0, 0 }()
+	failedScenarios = 0
+	se := newSpecExecutor(exampleSpecWithScenarios, &mockRunner{}, nil, gauge.NewBuildErrors(), 0)
+	se.specResult = gauge.NewSpecResult(exampleSpecWithScenarios)
+	executed := 0
+	se.scenarioExecutor = &mockExecutor{
+		executeFunc: func(i gauge.Item, r result.Result) {
+			executed++
+			r.SetFailure()
+		},
+	}
+
+	_, _ = se.executeScenario(exampleSpecWithScenarios.Scenarios[0])
+	sceResult, _ := se.executeScenario(exampleSpecWithScenarios.Scenarios[1])
+
+	if executed != 1 {
+		t.Errorf("Expected 1 scenario to be executed, got %d", executed)
+	}
+	if sceResult.SkipReason != result.MaxFailures {
+		t.Errorf("Expected skip reason %s, got %s", result.MaxFailures, sceResult.SkipReason)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/getgauge/gauge/execution/event"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/plugin"
 	"github.com/getgauge/gauge/runner"
 )
@@ -20,12 +21,21 @@ type stepExecutor struct {
 	pluginHandler        plugin.Handler
 	currentExecutionInfo *gauge_messages.ExecutionInfo
 	stream               int
+	// tags are the step tags of the step, which are given to the runner with the tags of the scenario
+	tags []string
 }
 
 // TODO: stepExecutor should not consume both gauge.Step and gauge_messages.ProtoStep. The usage of ProtoStep should be eliminated.
 func (e *stepExecutor) executeStep(step *gauge.Step, protoStep *gauge_messages.ProtoStep) *result.StepResult {
 	stepRequest := e.createStepRequest(protoStep)
 	e.currentExecutionInfo.CurrentStep = &gauge_messages.StepInfo{Step: stepRequest, IsFailed: false}
+	// The proto has no tags for a step, so its tags are added to the scenario tags while it runs. The runners filter
+	// the step hooks by them and the plugins see them.
+	if scn := e.currentExecutionInfo.GetCurrentScenario(); scn != nil && len(e.tags) > 0 {
+		scenarioTags := scn.Tags
+		scn.Tags = append(append([]string{}, scenarioTags...), e.tags...)
+		defer func() { scn.Tags = scenarioTags }()
+	}
 	stepResult := result.NewStepResult(protoStep)
 	for i := range step.GetFragments() {
 		stepFragmet := step.GetFragments()[i]
@@ -38,9 +48,11 @@ func (e *stepExecutor) executeStep(step *gauge.Step, protoStep *gauge_messages.P
 
 	e.notifyBeforeStepHook(stepResult)
 	if !stepResult.GetFailed() {
-		executeStepMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep, ExecuteStepRequest: stepRequest}
+		executeStepMessage := &gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep, ExecuteStepRequest: withEnvParamValues(stepRequest)}
 		stepExecutionStatus := e.runner.ExecuteAndGetStatus(executeStepMessage)
+		storeAttachments(stepExecutionStatus)
 		stepExecutionStatus.Message = append(stepResult.ProtoStepExecResult().GetExecutionResult().Message, stepExecutionStatus.Message...)
+		reason, skip := result.SkipScenarioRequest(stepExecutionStatus)
 		if stepExecutionStatus.GetFailed() {
 			e.currentExecutionInfo.CurrentStep.ErrorMessage = stepExecutionStatus.GetErrorMessage()
 			e.currentExecutionInfo.CurrentStep.StackTrace = stepExecutionStatus.GetStackTrace()
@@ -48,6 +60,9 @@ func (e *stepExecutor) executeStep(step *gauge.Step, protoStep *gauge_messages.P
 			stepResult.SetStepFailure()
 		}
 		stepResult.SetProtoExecResult(stepExecutionStatus)
+		if skip && !stepExecutionStatus.GetFailed() {
+			stepResult.SetSkipScenario(reason)
+		}
 	}
 	e.notifyAfterStepHook(stepResult)
 
@@ -62,6 +77,20 @@ func (e *stepExecutor) createStepRequest(protoStep *gauge_messages.ProtoStep) *g
 	return stepRequest
 }
 
+// withEnvParamValues gives the request with the values of the environment variables read by its <env:NAME>
+// parameters. The parameters of the step in the results keep the values reports show, which may be masked.
+func withEnvParamValues(stepRequest *gauge_messages.ExecuteStepRequest) *gauge_messages.ExecuteStepRequest {
+	var parameters []*gauge_messages.Parameter
+	for _, p := range stepRequest.GetParameters() {
+		if name := parser.EnvParamName(p.GetName()); name != "" && p.GetParameterType() == gauge_messages.Parameter_Special_String {
+			p = &gauge_messages.Parameter{ParameterType: p.GetParameterType(), Value: parser.EnvParamValue(name), Name: p.GetName(), Table: p.GetTable()}
+		}
+		parameters = append(parameters, p)
+	}
+	return &gauge_messages.ExecuteStepRequest{ActualStepText: stepRequest.GetActualStepText(), ParsedStepText: stepRequest.GetParsedStepText(),
+		ScenarioFailing: stepRequest.GetScenarioFailing(), Parameters: parameters, Stream: stepRequest.GetStream()}
+}
+
 func (e *stepExecutor) notifyBeforeStepHook(stepResult *result.StepResult) {
 	m := &gauge_messages.Message{
 		MessageType:                  gauge_messages.Message_StepExecutionStarting,
@@ -7,11 +7,13 @@
 package execution
 
 import (
+	"os"
 	"testing"
 
 	"github.com/getgauge/gauge/gauge"
 
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 func TestStepExecutionShouldAddBeforeStepHookMessages(t *testing.T) {
@@ -195,3 +197,107 @@ func TestStepExecutionShouldGetScreenshotsAfterStep(t *testing.T) {
 		}
 	}
 }
+
+func TestStepExecutionShouldRecordSkipScenarioRequest(t *testing.T) {
+	r := &mockRunner{}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		if m.MessageType == gauge_messages.Message_ExecuteStep {
+			return skipScenarioResult("missing test data", "fetching data")
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	ei := &gauge_messages.ExecutionInfo{
+		CurrentStep: &gauge_messages.StepInfo{
+			Step: &gauge_messages.ExecuteStepRequest{
+				ActualStepText: "a simple step",
+				ParsedStepText: "a simple step",
+			},
+		},
+	}
+	se := &stepExecutor{runner: r, pluginHandler: h, currentExecutionInfo: ei, stream: 0}
+	step := &gauge.Step{
+		Value:     "a simple step",
+		LineText:  "a simple step",
+		Fragments: []*gauge_messages.Fragment{{FragmentType: gauge_messages.Fragment_Text, Text: "a simple step"}},
+	}
+	protoStep := gauge.ConvertToProtoItem(step).GetStep()
+	protoStep.StepExecutionResult = &gauge_messages.ProtoStepExecutionResult{}
+
+	stepResult := se.executeStep(step, protoStep)
+
+	if stepResult.GetFailed() {
+		t.Errorf("Expected step not to be failed")
+	}
+	if !stepResult.SkipScenario {
+		t.Errorf("Expected step to request skipping the scenario")
+	}
+	if stepResult.SkipScenarioReason != "missing test data" {
+		t.Errorf("Expected skip reason `missing test data`, got : %s", stepResult.SkipScenarioReason)
+	}
+	if stepResult.ProtoStepExecResult().GetSkipped() {
+		t.Errorf("Expected the step not to be marked as skipped, which means its implementation was not found")
+	}
+	if msgs := stepResult.ProtoStepExecResult().GetExecutionResult().GetMessage(); len(msgs) != 1 || msgs[0] != "fetching data" {
+		t.Errorf("Expected the messages of the step to be kept, got : %v", msgs)
+	}
+}
+
+// skipScenarioResult gives the result a runner sends when the step implementation asks to skip the rest of its
+// scenario, with the skipScenario fields gauge-proto does not declare yet
+func skipScenarioResult(reason string, messages ...string) *gauge_messages.ProtoExecutionResult {
+	res := &gauge_messages.ProtoExecutionResult{Message: messages}
+	var fields []byte
+	fields = protowire.AppendTag(fields, 13, protowire.VarintType)
+	fields = protowire.AppendVarint(fields, 1)
+	fields = protowire.AppendTag(fields, 14, protowire.BytesType)
+	fields = protowire.AppendString(fields, reason)
+	res.ProtoReflect().SetUnknown(fields)
+	return res
+}
+
+func TestStepExecutionGivesStepTagsWithTheScenarioTags(t *testing.T) {
+	r := &mockRunner{}
+	h := &mockPluginHandler{NotifyPluginsfunc: func(m *gauge_messages.Message) {}, GracefullyKillPluginsfunc: func() {}}
+	var hookTags []string
+	r.ExecuteAndGetStatusFunc = func(m *gauge_messages.Message) *gauge_messages.ProtoExecutionResult {
+		if m.MessageType == gauge_messages.Message_StepExecutionStarting {
+			hookTags = m.GetStepExecutionStartingRequest().GetCurrentExecutionInfo().GetCurrentScenario().GetTags()
+		}
+		return &gauge_messages.ProtoExecutionResult{}
+	}
+	ei := &gauge_messages.ExecutionInfo{CurrentScenario: &gauge_messages.ScenarioInfo{Name: "A scenario", Tags: []string{"login"}}}
+	se := &stepExecutor{runner: r, pluginHandler: h, currentExecutionInfo: ei, stream: 0, tags: []string{"slow"}}
+	step := &gauge.Step{
+		Value:     "a simple step",
+		LineText:  "a simple step",
+		Fragments: []*gauge_messages.Fragment{{FragmentType: gauge_messages.Fragment_Text, Text: "a simple step"}},
+	}
+	protoStep := gauge.ConvertToProtoItem(step).GetStep()
+	protoStep.StepExecutionResult = &gauge_messages.ProtoStepExecutionResult{}
+
+	se.executeStep(step, protoStep)
+
+	if len(hookTags) != 2 || hookTags[0] != "login" || hookTags[1] != "slow" {
+		t.Errorf("Expected the before step hook to get tags [login slow], got : %v", hookTags)
+	}
+	if tags := ei.GetCurrentScenario().GetTags(); len(tags) != 1 || tags[0] != "login" {
+		t.Errorf("Expected the scenario tags to be restored after the step, got : %v", tags)
+	}
+}
+
+func TestEnvParamValuesAreGivenToTheRunnerButNotToTheResult(t *testing.T) {
+	os.Setenv("GAUGE_TEST_PASSWORD", "secret")
+	defer os.Unsetenv("GAUGE_TEST_PASSWORD")
+	p := &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_String, Name: "env:GAUGE_TEST_PASSWORD", Value: "******"}
+	request := &gauge_messages.ExecuteStepRequest{ActualStepText: "log in with {}", Parameters: []*gauge_messages.Parameter{p}}
+
+	got := withEnvParamValues(request)
+
+	if got.Parameters[0].Value != "secret" {
+		t.Errorf("Expected the runner to get the value of the variable, got %s", got.Parameters[0].Value)
+	}
+	if p.Value != "******" {
+		t.Errorf("Expected the parameter of the result to keep its masked value, got %s", p.Value)
+	}
+}
@@ -0,0 +1,224 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/event"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	traceabilityFile = "traceability"
+
+	scenarioPassed  = "passed"
+	scenarioFailed  = "failed"
+	scenarioSkipped = "skipped"
+)
+
+// traceabilityMatrix maps every requirement, given by an annotation like @requirement: JIRA-123, to the scenarios which
+// cover it and their result in the run
+type traceabilityMatrix struct {
+	Annotation   string         `json:"annotation"`
+	Requirements []*requirement `json:"requirements"`
+}
+
+type requirement struct {
+	ID        string                 `json:"id"`
+	Status    string                 `json:"status"`
+	Scenarios []*requirementScenario `json:"scenarios"`
+}
+
+type requirementScenario struct {
+	File    string `json:"file"`
+	Line    int64  `json:"line"`
+	Heading string `json:"heading"`
+	Status  string `json:"status"`
+}
+
+// ListenSuiteEndAndSaveTraceabilityMatrix listens to the suite end event and writes the traceability matrix of the
+// annotation set in gauge_traceability_annotation, as JSON and CSV, to the reports directory
+func ListenSuiteEndAndSaveTraceabilityMatrix(wg *sync.WaitGroup) {
+	annotation := env.TraceabilityAnnotation()
+	if annotation == "" {
+		return
+	}
+	ch := make(chan event.ExecutionEvent)
+	event.Register(ch, event.SuiteEnd)
+	wg.Add(1)
+
+	go func() {
+		for {
+			e := <-ch
+			if e.Topic == event.SuiteEnd {
+				writeTraceabilityMatrix(newTraceabilityMatrix(e.Result.(*result.SuiteResult), annotation))
+				wg.Done()
+			}
+		}
+	}()
+}
+
+func newTraceabilityMatrix(res *result.SuiteResult, annotation string) *traceabilityMatrix {
+	byID := make(map[string]*requirement)
+	for _, specRes := range res.SpecResults {
+		spec := specRes.ProtoSpec
+		if spec == nil {
+			continue
+		}
+		specIDs := annotationValues(spec.GetItems(), annotation)
+		// the rows of a table driven scenario add up to a single scenario, which fails if any row fails
+		scenarios := make(map[int64]*requirementScenario)
+		for _, item := range spec.GetItems() {
+			scn := item.GetScenario()
+			if item.GetItemType() == gauge_messages.ProtoItem_TableDrivenScenario {
+				scn = item.GetTableDrivenScenario().GetScenario()
+			}
+			if scn == nil {
+				continue
+			}
+			ids := append(append([]string{}, specIDs...), annotationValues(scn.GetScenarioItems(), annotation)...)
+			if len(ids) == 0 {
+				continue
+			}
+			s, ok := scenarios[scn.GetSpan().GetStart()]
+			if !ok {
+				s = &requirementScenario{File: util.RelPathToProjectRoot(spec.GetFileName()), Line: scn.GetSpan().GetStart(), Heading: scn.GetScenarioHeading()}
+				scenarios[s.Line] = s
+				for _, id := range ids {
+					r, ok := byID[id]
+					if !ok {
+						r = &requirement{ID: id}
+						byID[id] = r
+					}
+					if !containsScenario(r.Scenarios, s) {
+						r.Scenarios = append(r.Scenarios, s)
+					}
+				}
+			}
+			s.Status = combinedStatus([]string{s.Status, scenarioStatus(scn)})
+		}
+	}
+	m := &traceabilityMatrix{Annotation: annotation, Requirements: make([]*requirement, 0, len(byID))}
+	for _, r := range byID {
+		statuses := make([]string, 0, len(r.Scenarios))
+		for _, s := range r.Scenarios {
+			statuses = append(statuses, s.Status)
+		}
+		r.Status = combinedStatus(statuses)
+		m.Requirements = append(m.Requirements, r)
+	}
+	sort.Slice(m.Requirements, func(i, j int) bool { return m.Requirements[i].ID < m.Requirements[j].ID })
+	return m
+}
+
+// annotationValues gives the values of the annotation in the comments, where one annotation may list several values
+func annotationValues(items []*gauge_messages.ProtoItem, annotation string) []string {
+	var values []string
+	for _, item := range items {
+		key, value, ok := gauge.Annotation(item.GetComment().GetText())
+		if !ok || key != annotation {
+			continue
+		}
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+func containsScenario(scenarios []*requirementScenario, s *requirementScenario) bool {
+	for _, other := range scenarios {
+		if other == s {
+			return true
+		}
+	}
+	return false
+}
+
+func scenarioStatus(scn *gauge_messages.ProtoScenario) string {
+	switch scn.GetExecutionStatus() {
+	case gauge_messages.ExecutionStatus_FAILED:
+		return scenarioFailed
+	case gauge_messages.ExecutionStatus_PASSED:
+		return scenarioPassed
+	}
+	return scenarioSkipped
+}
+
+// combinedStatus is failed if any status failed, else passed if any passed, else skipped
+func combinedStatus(statuses []string) string {
+	status := scenarioSkipped
+	for _, s := range statuses {
+		if s == scenarioFailed {
+			return scenarioFailed
+		}
+		if s == scenarioPassed {
+			status = scenarioPassed
+		}
+	}
+	return status
+}
+
+func writeTraceabilityMatrix(m *traceabilityMatrix) {
+	reportsDir := failureSummaryDir()
+	if err := os.MkdirAll(util.LongPath(reportsDir), common.NewDirectoryPermissions); err != nil {
+		logger.Errorf(true, "Failed to create directory in %s. Reason: %s", reportsDir, err.Error())
+		return
+	}
+	b, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal traceability matrix, skipping save. %s", err.Error())
+		return
+	}
+	c, err := m.csv()
+	if err != nil {
+		logger.Errorf(true, "Unable to write traceability matrix as CSV, skipping save. %s", err.Error())
+		return
+	}
+	for ext, content := range map[string][]byte{".json": b, ".csv": c} {
+		file := filepath.Join(reportsDir, traceabilityFile+ext)
+		if err = ioutil.WriteFile(util.LongPath(file), content, common.NewFilePermissions); err != nil {
+			logger.Errorf(true, "Failed to write to %s. Reason: %s", file, err.Error())
+			continue
+		}
+		logger.Debugf(true, "Traceability matrix saved to %s", file)
+	}
+}
+
+// csv writes a row for every scenario of every requirement
+func (m *traceabilityMatrix) csv() ([]byte, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	rows := [][]string{{m.Annotation, "requirement status", "file", "line", "scenario", "scenario status"}}
+	for _, r := range m.Requirements {
+		for _, s := range r.Scenarios {
+			rows = append(rows, []string{r.ID, r.Status, s.File, strconv.FormatInt(s.Line, 10), s.Heading, s.Status})
+		}
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
@@ -0,0 +1,75 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package execution
+
+import (
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/result"
+	. "gopkg.in/check.v1"
+)
+
+func commentItem(text string) *gauge_messages.ProtoItem {
+	return &gauge_messages.ProtoItem{ItemType: gauge_messages.ProtoItem_Comment, Comment: &gauge_messages.ProtoComment{Text: text}}
+}
+
+func tracedScenario(heading string, line int64, status gauge_messages.ExecutionStatus, comments ...string) *gauge_messages.ProtoScenario {
+	scn := &gauge_messages.ProtoScenario{ScenarioHeading: heading, Span: &gauge_messages.Span{Start: line}, ExecutionStatus: status}
+	for _, c := range comments {
+		scn.ScenarioItems = append(scn.ScenarioItems, commentItem(c))
+	}
+	return scn
+}
+
+func tracedSuiteResult() *result.SuiteResult {
+	spec := &gauge_messages.ProtoSpec{FileName: "checkout.spec", Items: []*gauge_messages.ProtoItem{
+		commentItem("@requirement: SHOP-1"),
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: tracedScenario("Pay with card", 4, gauge_messages.ExecutionStatus_PASSED, "@requirement: SHOP-2, SHOP-3")},
+		{ItemType: gauge_messages.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gauge_messages.ProtoTableDrivenScenario{
+			Scenario: tracedScenario("Pay with voucher", 9, gauge_messages.ExecutionStatus_PASSED, "@Requirement: SHOP-2")}},
+		{ItemType: gauge_messages.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gauge_messages.ProtoTableDrivenScenario{
+			Scenario: tracedScenario("Pay with voucher", 9, gauge_messages.ExecutionStatus_FAILED, "@Requirement: SHOP-2")}},
+	}}
+	other := &gauge_messages.ProtoSpec{FileName: "search.spec", Items: []*gauge_messages.ProtoItem{
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: tracedScenario("Search", 3, gauge_messages.ExecutionStatus_SKIPPED, "@requirement: SHOP-4")},
+		{ItemType: gauge_messages.ProtoItem_Scenario, Scenario: tracedScenario("Untraced", 7, gauge_messages.ExecutionStatus_FAILED, "@owner: search")},
+	}}
+	return &result.SuiteResult{SpecResults: []*result.SpecResult{{ProtoSpec: spec}, {ProtoSpec: other}}}
+}
+
+func (s *MySuite) TestTraceabilityMatrix(c *C) {
+	m := newTraceabilityMatrix(tracedSuiteResult(), "requirement")
+
+	c.Assert(len(m.Requirements), Equals, 4)
+	shop1, shop2, shop3, shop4 := m.Requirements[0], m.Requirements[1], m.Requirements[2], m.Requirements[3]
+	c.Assert(shop1.ID, Equals, "SHOP-1")
+	c.Assert(shop1.Status, Equals, scenarioFailed)
+	c.Assert(len(shop1.Scenarios), Equals, 2)
+	c.Assert(shop2.ID, Equals, "SHOP-2")
+	c.Assert(shop2.Status, Equals, scenarioFailed)
+	c.Assert(len(shop2.Scenarios), Equals, 2)
+	c.Assert(shop2.Scenarios[1].Heading, Equals, "Pay with voucher")
+	c.Assert(shop2.Scenarios[1].Status, Equals, scenarioFailed)
+	c.Assert(shop3.Status, Equals, scenarioPassed)
+	c.Assert(*shop3.Scenarios[0], Equals, requirementScenario{File: "checkout.spec", Line: 4, Heading: "Pay with card", Status: scenarioPassed})
+	c.Assert(shop4.Status, Equals, scenarioSkipped)
+}
+
+func (s *MySuite) TestTraceabilityMatrixAsCSV(c *C) {
+	m := newTraceabilityMatrix(tracedSuiteResult(), "requirement")
+
+	b, err := m.csv()
+
+	c.Assert(err, IsNil)
+	c.Assert(string(b), Equals, `requirement,requirement status,file,line,scenario,scenario status
+SHOP-1,failed,checkout.spec,4,Pay with card,passed
+SHOP-1,failed,checkout.spec,9,Pay with voucher,failed
+SHOP-2,failed,checkout.spec,4,Pay with card,passed
+SHOP-2,failed,checkout.spec,9,Pay with voucher,failed
+SHOP-3,passed,checkout.spec,4,Pay with card,passed
+SHOP-4,skipped,search.spec,3,Search,skipped
+`)
+}
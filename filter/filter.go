@@ -11,6 +11,16 @@ var Distribute int
 var NumberOfExecutionStreams int
 var ScenariosName []string
 
+// SkipDeprecated excludes the deprecated specs and scenarios from execution
+var SkipDeprecated bool
+
+// IncludeWIP includes the work in progress specs and scenarios in the execution
+var IncludeWIP bool
+
+// MaxPriority selects only the scenarios with a priority from 0 to MaxPriority, all scenarios being selected when it
+// is negative
+var MaxPriority = -1
+
 func FilterSpecs(specs []*gauge.Specification) []*gauge.Specification {
 	specs = applyFilters(specs, specsFilters())
 	if ExecuteTags != "" && len(specs) > 0 {
@@ -28,7 +38,7 @@ func FilterSpecForParallelRun(specs []*gauge.Specification, tags string) ([]*gau
 }
 
 func specsFilters() []specsFilter {
-	return []specsFilter{&tagsFilter{ExecuteTags}, &specsGroupFilter{Distribute, NumberOfExecutionStreams}, &scenariosFilter{ScenariosName}}
+	return []specsFilter{&tagsFilter{ExecuteTags}, &specsGroupFilter{Distribute, NumberOfExecutionStreams}, &scenariosFilter{ScenariosName}, &deprecationFilter{SkipDeprecated}, &wipFilter{IncludeWIP}, &priorityFilter{MaxPriority}}
 }
 
 func applyFilters(specsToExecute []*gauge.Specification, filters []specsFilter) []*gauge.Specification {
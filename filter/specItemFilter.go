@@ -28,12 +28,25 @@ type scenarioFilterBasedOnSpan struct {
 type ScenarioFilterBasedOnTags struct {
 	specTags      []string
 	tagExpression string
+	spec          *gauge.Specification
 }
 
 type scenarioFilterBasedOnName struct {
 	scenariosName []string
 }
 
+type scenarioFilterBasedOnDeprecation struct {
+	spec *gauge.Specification
+}
+
+type scenarioFilterBasedOnWIP struct {
+	spec *gauge.Specification
+}
+
+type scenarioFilterBasedOnPriority struct {
+	maxPriority int
+}
+
 func NewScenarioFilterBasedOnSpan(lineNumbers []int) *scenarioFilterBasedOnSpan {
 	return &scenarioFilterBasedOnSpan{lineNumbers}
 }
@@ -48,15 +61,116 @@ func (filter *scenarioFilterBasedOnSpan) Filter(item gauge.Item) bool {
 }
 
 func NewScenarioFilterBasedOnTags(specTags []string, tagExp string) *ScenarioFilterBasedOnTags {
-	return &ScenarioFilterBasedOnTags{specTags, tagExp}
+	return &ScenarioFilterBasedOnTags{specTags: specTags, tagExpression: tagExp}
 }
 
 func (filter *ScenarioFilterBasedOnTags) Filter(item gauge.Item) bool {
-	tags := item.(*gauge.Scenario).Tags
-	if tags == nil {
-		return !filter.filterTags(filter.specTags)
+	scn := item.(*gauge.Scenario)
+	if table := filter.taggedRowsTable(scn); table != nil {
+		matched, _ := filter.partitionTableRows(scn, table)
+		return len(matched) == 0
+	}
+	return !filter.filterTags(filter.scenarioTags(scn))
+}
+
+// TagsMatch tells if the tags satisfy the tag expression
+func TagsMatch(tags []string, tagExpression string) bool {
+	return NewScenarioFilterBasedOnTags(nil, tagExpression).filterTags(tags)
+}
+
+func (filter *ScenarioFilterBasedOnTags) scenarioTags(scn *gauge.Scenario) []string {
+	tags := make([]string, 0)
+	if scn.Tags != nil {
+		tags = append(tags, scn.Tags.Values()...)
+	}
+	return append(tags, filter.specTags...)
+}
+
+// taggedRowsTable returns the data table driving the scenario if its rows are tagged
+func (filter *ScenarioFilterBasedOnTags) taggedRowsTable(scn *gauge.Scenario) *gauge.Table {
+	if scn.DataTable.IsInitialized() && env.AllowScenarioDatatable() {
+		if scn.DataTable.Table.HasRowTags() {
+			return scn.DataTable.Table
+		}
+		return nil
+	}
+	if filter.spec == nil || !filter.spec.DataTable.Table.HasRowTags() {
+		return nil
+	}
+	headers := filter.spec.DataTable.Table.Headers
+	if filter.spec.UsesArgsInContextTeardown(headers...) || scn.UsesArgsInSteps(headers...) {
+		return filter.spec.DataTable.Table
+	}
+	return nil
+}
+
+// partitionTableRows returns the indices of the table rows which match the tag expression and the ones which do not
+func (filter *ScenarioFilterBasedOnTags) partitionTableRows(scn *gauge.Scenario, table *gauge.Table) (matched, other []int) {
+	tags := filter.scenarioTags(scn)
+	for i := 0; i < table.GetRowCount(); i++ {
+		if !scn.ExecutesTableRow(i) {
+			continue
+		}
+		rowTags := append(append(make([]string, 0), tags...), table.RowTags(i)...)
+		if filter.filterTags(rowTags) {
+			matched = append(matched, i)
+		} else {
+			other = append(other, i)
+		}
+	}
+	return
+}
+
+// splitSpecByTaggedRows splits the spec into one holding the scenarios and data table rows which match the tag
+// expression, and one holding the rest. A scenario whose rows match only partially ends up in both.
+func (filter *ScenarioFilterBasedOnTags) splitSpecByTaggedRows(spec *gauge.Specification) (*gauge.Specification, *gauge.Specification) {
+	specWithFilteredItems, specWithOtherItems := new(gauge.Specification), new(gauge.Specification)
+	*specWithFilteredItems, *specWithOtherItems = *spec, *spec
+	specWithFilteredItems.Items, specWithFilteredItems.Scenarios = nil, nil
+	specWithOtherItems.Items, specWithOtherItems.Scenarios = nil, nil
+	for _, item := range spec.Items {
+		scn, ok := item.(*gauge.Scenario)
+		if !ok {
+			specWithFilteredItems.AddItem(item)
+			specWithOtherItems.AddItem(item)
+			continue
+		}
+		table := filter.taggedRowsTable(scn)
+		if table == nil {
+			if filter.Filter(scn) {
+				specWithOtherItems.AddScenario(scn)
+			} else {
+				specWithFilteredItems.AddScenario(scn)
+			}
+			continue
+		}
+		matched, other := filter.partitionTableRows(scn, table)
+		if len(matched) > 0 {
+			specWithFilteredItems.AddScenario(withTableRows(scn, matched, len(other) > 0))
+		}
+		if len(other) > 0 {
+			specWithOtherItems.AddScenario(withTableRows(scn, other, len(matched) > 0))
+		}
 	}
-	return !filter.filterTags(append(tags.Values(), filter.specTags...))
+	return specWithFilteredItems, specWithOtherItems
+}
+
+func withTableRows(scn *gauge.Scenario, rows []int, partial bool) *gauge.Scenario {
+	if !partial && len(scn.TableRowIndices) == 0 {
+		return scn
+	}
+	s := *scn
+	s.TableRowIndices = rows
+	return &s
+}
+
+func (filter *ScenarioFilterBasedOnTags) hasTaggedRows(spec *gauge.Specification) bool {
+	for _, scn := range spec.Scenarios {
+		if filter.taggedRowsTable(scn) != nil {
+			return true
+		}
+	}
+	return false
 }
 
 func newScenarioFilterBasedOnName(scenariosName []string) *scenarioFilterBasedOnName {
@@ -161,6 +275,15 @@ func (filter *ScenarioFilterBasedOnTags) evaluateExp(tagExpression string) (bool
 }
 
 func (filter *ScenarioFilterBasedOnTags) isTagPresent(tagsMap map[string]bool, tagName string) bool {
+	if strings.HasSuffix(tagName, "*") {
+		prefix := strings.TrimSuffix(tagName, "*")
+		for tag := range tagsMap {
+			if strings.HasPrefix(tag, prefix) {
+				return true
+			}
+		}
+		return false
+	}
 	_, ok := tagsMap[tagName]
 	return ok
 }
@@ -199,7 +322,14 @@ func filterSpecsByTags(specs []*gauge.Specification, tagExpression string) ([]*g
 		if spec.Tags != nil {
 			tagValues = spec.Tags.Values()
 		}
-		specWithFilteredItems, specWithOtherItems := spec.Filter(NewScenarioFilterBasedOnTags(tagValues, tagExpression))
+		tagFilter := NewScenarioFilterBasedOnTags(tagValues, tagExpression)
+		tagFilter.spec = spec
+		var specWithFilteredItems, specWithOtherItems *gauge.Specification
+		if tagFilter.hasTaggedRows(spec) {
+			specWithFilteredItems, specWithOtherItems = tagFilter.splitSpecByTaggedRows(spec)
+		} else {
+			specWithFilteredItems, specWithOtherItems = spec.Filter(tagFilter)
+		}
 		if len(specWithFilteredItems.Scenarios) != 0 {
 			filteredSpecs = append(filteredSpecs, specWithFilteredItems)
 		}
@@ -219,6 +349,67 @@ func validateTagExpression(tagExpression string) {
 	}
 }
 
+func (filter *scenarioFilterBasedOnDeprecation) Filter(item gauge.Item) bool {
+	return filter.spec.ScenarioDeprecation(item.(*gauge.Scenario)) != nil
+}
+
+func (filter *scenarioFilterBasedOnWIP) Filter(item gauge.Item) bool {
+	return filter.spec.IsScenarioWIP(item.(*gauge.Scenario))
+}
+
+// Filter matches the scenarios which do not have a priority from 0 to the max priority. Scenarios without priority
+// and those of a negative level, which run last, are matched.
+func (filter *scenarioFilterBasedOnPriority) Filter(item gauge.Item) bool {
+	p := item.(*gauge.Scenario).Priority
+	return p == nil || *p < 0 || int(*p) > filter.maxPriority
+}
+
+func filterDeprecatedSpecs(specs []*gauge.Specification) []*gauge.Specification {
+	return filterSpecsWith(specs, func(spec *gauge.Specification) gauge.SpecItemFilter {
+		return &scenarioFilterBasedOnDeprecation{spec}
+	})
+}
+
+// filterWIPSpecs removes the work in progress scenarios, leaving out the specs which only had such scenarios
+func filterWIPSpecs(specs []*gauge.Specification) []*gauge.Specification {
+	return filterSpecsWith(specs, func(spec *gauge.Specification) gauge.SpecItemFilter {
+		return &scenarioFilterBasedOnWIP{spec}
+	})
+}
+
+// filterSpecsByPriority keeps the scenarios with a priority up to the max priority, leaving out the specs which have
+// none
+func filterSpecsByPriority(specs []*gauge.Specification, maxPriority int) []*gauge.Specification {
+	return filterSpecsWith(specs, func(spec *gauge.Specification) gauge.SpecItemFilter {
+		return &scenarioFilterBasedOnPriority{maxPriority}
+	})
+}
+
+// filterSpecsWith removes the scenarios matched by the filter. Specs without any such scenario are kept as they are.
+func filterSpecsWith(specs []*gauge.Specification, newFilter func(*gauge.Specification) gauge.SpecItemFilter) []*gauge.Specification {
+	filteredSpecs := make([]*gauge.Specification, 0)
+	for _, spec := range specs {
+		f := newFilter(spec)
+		if !matchesAnyScenario(spec, f) {
+			filteredSpecs = append(filteredSpecs, spec)
+			continue
+		}
+		if s, _ := spec.Filter(f); len(s.Scenarios) != 0 {
+			filteredSpecs = append(filteredSpecs, s)
+		}
+	}
+	return filteredSpecs
+}
+
+func matchesAnyScenario(spec *gauge.Specification, f gauge.SpecItemFilter) bool {
+	for _, scn := range spec.Scenarios {
+		if f.Filter(scn) {
+			return true
+		}
+	}
+	return false
+}
+
 func filterSpecsByScenarioName(specs []*gauge.Specification, scenariosName []string) []*gauge.Specification {
 	filteredSpecs := make([]*gauge.Specification, 0)
 	scenarios := filterValidScenarios(specs, scenariosName)
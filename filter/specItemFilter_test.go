@@ -924,3 +924,120 @@ func (s *MySuite) TestScenarioTagFilterShouldNotRemoveNonScenarioKindItems(c *C)
 	c.Assert(len(specWithOtherItems), Equals, 1)
 	c.Assert(len(specWithOtherItems[0].Items), Equals, 4)
 }
+
+func (s *MySuite) TestFilterSpecsByTagsSelectsTaggedDataTableRows(c *C) {
+	table := gauge.NewTable([]string{"id", "tags"}, [][]gauge.TableCell{
+		{{Value: "1"}, {Value: "2"}, {Value: "3"}},
+		{{Value: "smoke, fast"}, {Value: ""}, {Value: "smoke"}},
+	}, 0)
+	tableScenario := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "Table Scenario"},
+		Steps:   []*gauge.Step{{Args: []*gauge.StepArg{{Value: "id", ArgType: gauge.Dynamic, Name: "id"}}}},
+	}
+	otherScenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "Other Scenario"}}
+	spec := &gauge.Specification{
+		DataTable: gauge.DataTable{Table: table},
+		Items:     []gauge.Item{tableScenario, otherScenario},
+		Scenarios: []*gauge.Scenario{tableScenario, otherScenario},
+	}
+
+	filteredSpecs, otherSpecs := filterSpecsByTags([]*gauge.Specification{spec}, "smoke")
+
+	c.Assert(len(filteredSpecs), Equals, 1)
+	c.Assert(len(filteredSpecs[0].Scenarios), Equals, 1)
+	c.Assert(filteredSpecs[0].Scenarios[0].Heading.Value, Equals, "Table Scenario")
+	c.Assert(filteredSpecs[0].Scenarios[0].TableRowIndices, DeepEquals, []int{0, 2})
+	c.Assert(len(filteredSpecs[0].Items), Equals, 1)
+
+	c.Assert(len(otherSpecs), Equals, 1)
+	c.Assert(len(otherSpecs[0].Scenarios), Equals, 2)
+	c.Assert(otherSpecs[0].Scenarios[0].TableRowIndices, DeepEquals, []int{1})
+	c.Assert(otherSpecs[0].Scenarios[1], Equals, otherScenario)
+	c.Assert(tableScenario.TableRowIndices, IsNil)
+}
+
+func (s *MySuite) TestFilterSpecsByTagsCombinesRowTagsWithScenarioTags(c *C) {
+	table := gauge.NewTable([]string{"id", "tags"}, [][]gauge.TableCell{
+		{{Value: "1"}, {Value: "2"}},
+		{{Value: "smoke"}, {Value: "full"}},
+	}, 0)
+	scenario := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "Table Scenario"},
+		Tags:    &gauge.Tags{RawValues: [][]string{{"checkout"}}},
+		Steps:   []*gauge.Step{{Args: []*gauge.StepArg{{Value: "id", ArgType: gauge.Dynamic, Name: "id"}}}},
+	}
+	spec := &gauge.Specification{
+		DataTable: gauge.DataTable{Table: table},
+		Items:     []gauge.Item{scenario},
+		Scenarios: []*gauge.Scenario{scenario},
+	}
+
+	filteredSpecs, _ := filterSpecsByTags([]*gauge.Specification{spec}, "checkout & full")
+
+	c.Assert(len(filteredSpecs), Equals, 1)
+	c.Assert(filteredSpecs[0].Scenarios[0].TableRowIndices, DeepEquals, []int{1})
+}
+
+func (s *MySuite) TestFilterTagsWithNamespacePrefix(c *C) {
+	tagFilter := NewScenarioFilterBasedOnTags(nil, "team:* & !component:checkout/*")
+
+	c.Assert(tagFilter.filterTags([]string{"team:payments", "component:search"}), Equals, true)
+	c.Assert(tagFilter.filterTags([]string{"team:payments", "component:checkout/cart"}), Equals, false)
+	c.Assert(tagFilter.filterTags([]string{"component:search"}), Equals, false)
+}
+
+func (s *MySuite) TestFilterDeprecatedSpecs(c *C) {
+	deprecated := &gauge.Scenario{Heading: &gauge.Heading{Value: "old"}, Deprecation: &gauge.Deprecation{}}
+	current := &gauge.Scenario{Heading: &gauge.Heading{Value: "new"}}
+	spec1 := &gauge.Specification{Items: []gauge.Item{deprecated, current}, Scenarios: []*gauge.Scenario{deprecated, current}}
+	spec2 := &gauge.Specification{Items: []gauge.Item{current}, Scenarios: []*gauge.Scenario{current}, Deprecation: &gauge.Deprecation{}}
+
+	specs := filterDeprecatedSpecs([]*gauge.Specification{spec1, spec2})
+
+	c.Assert(len(specs), Equals, 1)
+	c.Assert(specs[0].Scenarios, DeepEquals, []*gauge.Scenario{current})
+}
+
+func (s *MySuite) TestFilterWIPSpecs(c *C) {
+	wip := &gauge.Scenario{Heading: &gauge.Heading{Value: "draft"}, WIP: true}
+	done := &gauge.Scenario{Heading: &gauge.Heading{Value: "done"}}
+	spec1 := &gauge.Specification{Items: []gauge.Item{wip, done}, Scenarios: []*gauge.Scenario{wip, done}}
+	spec2 := &gauge.Specification{Items: []gauge.Item{done}, Scenarios: []*gauge.Scenario{done}, WIP: true}
+	spec3 := &gauge.Specification{Items: []gauge.Item{done}, Scenarios: []*gauge.Scenario{done}}
+
+	specs := filterWIPSpecs([]*gauge.Specification{spec1, spec2, spec3})
+
+	c.Assert(len(specs), Equals, 2)
+	c.Assert(specs[0].Scenarios, DeepEquals, []*gauge.Scenario{done})
+	c.Assert(specs[1], Equals, spec3)
+}
+
+func (s *MySuite) TestFilterSpecsByPriority(c *C) {
+	p0, p2, last := gauge.Priority(0), gauge.Priority(2), gauge.Priority(-1)
+	critical := &gauge.Scenario{Heading: &gauge.Heading{Value: "critical"}, Priority: &p0}
+	minor := &gauge.Scenario{Heading: &gauge.Heading{Value: "minor"}, Priority: &p2}
+	cleanup := &gauge.Scenario{Heading: &gauge.Heading{Value: "cleanup"}, Priority: &last}
+	plain := &gauge.Scenario{Heading: &gauge.Heading{Value: "plain"}}
+	spec1 := &gauge.Specification{Items: []gauge.Item{critical, minor, cleanup}, Scenarios: []*gauge.Scenario{critical, minor, cleanup}}
+	spec2 := &gauge.Specification{Items: []gauge.Item{plain}, Scenarios: []*gauge.Scenario{plain}}
+
+	specs := filterSpecsByPriority([]*gauge.Specification{spec1, spec2}, 1)
+
+	c.Assert(len(specs), Equals, 1)
+	c.Assert(specs[0].Scenarios, DeepEquals, []*gauge.Scenario{critical})
+}
+
+func (s *MySuite) TestPriorityFilterKeepsAllSpecsWithoutMaxPriority(c *C) {
+	plain := &gauge.Scenario{Heading: &gauge.Heading{Value: "plain"}}
+	spec := &gauge.Specification{Items: []gauge.Item{plain}, Scenarios: []*gauge.Scenario{plain}}
+
+	specs := (&priorityFilter{-1}).filter([]*gauge.Specification{spec})
+
+	c.Assert(specs, DeepEquals, []*gauge.Specification{spec})
+}
+
+func (s *MySuite) TestTagsMatch(c *C) {
+	c.Assert(TagsMatch([]string{"slow", "ui"}, "slow & !flaky"), Equals, true)
+	c.Assert(TagsMatch([]string{"slow", "flaky"}, "slow & !flaky"), Equals, false)
+	c.Assert(TagsMatch(nil, "slow"), Equals, false)
+}
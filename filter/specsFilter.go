@@ -9,6 +9,7 @@ package filter
 import (
 	"strings"
 
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 )
@@ -34,13 +35,64 @@ type scenariosFilter struct {
 	scenarios []string
 }
 
+type deprecationFilter struct {
+	skipDeprecated bool
+}
+
+type wipFilter struct {
+	includeWIP bool
+}
+
+type priorityFilter struct {
+	maxPriority int
+}
+
 func (tf *tagFilterForParallelRun) filter(specs []*gauge.Specification) ([]*gauge.Specification, []*gauge.Specification) {
 	return filterByTags(tf.tagExp, specs)
 }
 
 func (tagsFilter *tagsFilter) filter(specs []*gauge.Specification) []*gauge.Specification {
-	specs, _ = filterByTags(tagsFilter.tagExp, specs)
-	return specs
+	filtered, others := filterByTags(tagsFilter.tagExp, specs)
+	if tagsFilter.tagExp == "" || !env.ReportFilteredScenarios() {
+		return filtered
+	}
+	return withExcludedScenarios(filtered, others, tagsFilter.tagExp)
+}
+
+// withExcludedScenarios adds back to the specs which run the scenarios the tag expression excluded from them, marked
+// so that they are reported as skipped. They take their place in the spec again, so that the scenarios are in the
+// order they are written. Specs none of whose scenarios match are still left out.
+func withExcludedScenarios(filtered, others []*gauge.Specification, tagExp string) []*gauge.Specification {
+	excluded := make(map[string][]*gauge.Scenario)
+	for _, spec := range others {
+		excluded[spec.FileName] = append(excluded[spec.FileName], spec.Scenarios...)
+	}
+	for _, spec := range filtered {
+		scenarios := excluded[spec.FileName]
+		if len(scenarios) == 0 {
+			continue
+		}
+		items := make([]gauge.Item, 0, len(spec.Items)+len(scenarios))
+		all := make([]*gauge.Scenario, 0, len(spec.Scenarios)+len(scenarios))
+		addExcluded := func(before *gauge.Scenario) {
+			for len(scenarios) > 0 && (before == nil || scenarios[0].Heading.LineNo < before.Heading.LineNo) {
+				s := *scenarios[0]
+				s.ExcludedByTags = tagExp
+				items, all = append(items, &s), append(all, &s)
+				scenarios = scenarios[1:]
+			}
+		}
+		for _, item := range spec.Items {
+			if scn, ok := item.(*gauge.Scenario); ok {
+				addExcluded(scn)
+				all = append(all, scn)
+			}
+			items = append(items, item)
+		}
+		addExcluded(nil)
+		spec.Items, spec.Scenarios = items, all
+	}
+	return filtered
 }
 
 func filterByTags(tagExpression string, specs []*gauge.Specification) ([]*gauge.Specification, []*gauge.Specification) {
@@ -76,6 +128,29 @@ func (scenarioFilter *scenariosFilter) filter(specs []*gauge.Specification) []*g
 	return specs
 }
 
+func (f *deprecationFilter) filter(specs []*gauge.Specification) []*gauge.Specification {
+	if f.skipDeprecated {
+		logger.Debugf(true, "Skipping deprecated specs and scenarios")
+		specs = filterDeprecatedSpecs(specs)
+	}
+	return specs
+}
+
+func (f *wipFilter) filter(specs []*gauge.Specification) []*gauge.Specification {
+	if !f.includeWIP {
+		specs = filterWIPSpecs(specs)
+	}
+	return specs
+}
+
+func (f *priorityFilter) filter(specs []*gauge.Specification) []*gauge.Specification {
+	if f.maxPriority < 0 {
+		return specs
+	}
+	logger.Debugf(true, "Applying max priority filter: %d", f.maxPriority)
+	return filterSpecsByPriority(specs, f.maxPriority)
+}
+
 func DistributeSpecs(specifications []*gauge.Specification, distributions int) []*gauge.SpecCollection {
 	s := make([]*gauge.SpecCollection, distributions)
 	for i := 0; i < len(specifications); i++ {
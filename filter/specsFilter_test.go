@@ -9,6 +9,7 @@ package filter
 import (
 	"fmt"
 
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	. "gopkg.in/check.v1"
 )
@@ -141,3 +142,42 @@ func (s *MySuite) TestToRunSpecificSetOfSpecsGivesEmptySpecsIfDistributableNumbe
 	specsToExecute1 = groupFilter.filter(specs)
 	c.Assert(len(specsToExecute1), Equals, 0)
 }
+
+func (s *MySuite) TestScenariosExcludedByTagsAreKeptToBeReportedAsSkipped(c *C) {
+	old := env.ReportFilteredScenarios
+	env.ReportFilteredScenarios = func() bool { return true }
+	defer func() { env.ReportFilteredScenarios = old }()
+	signup := &gauge.Scenario{Heading: &gauge.Heading{Value: "Sign up", LineNo: 3}}
+	login := &gauge.Scenario{Heading: &gauge.Heading{Value: "Login", LineNo: 6}, Tags: &gauge.Tags{RawValues: [][]string{{"smoke"}}}}
+	logout := &gauge.Scenario{Heading: &gauge.Heading{Value: "Logout", LineNo: 10}}
+	comment := &gauge.Comment{Value: "Sessions", LineNo: 9}
+	spec := &gauge.Specification{FileName: "auth.spec", Items: []gauge.Item{signup, login, comment, logout}, Scenarios: []*gauge.Scenario{signup, login, logout}}
+	other := &gauge.Specification{FileName: "cart.spec", Items: []gauge.Item{logout}, Scenarios: []*gauge.Scenario{logout}}
+
+	specs := (&tagsFilter{"smoke"}).filter([]*gauge.Specification{spec, other})
+
+	c.Assert(specs, HasLen, 1)
+	c.Assert(specs[0].Scenarios, HasLen, 3)
+	var headings, excludedBy []string
+	for _, scn := range specs[0].Scenarios {
+		headings = append(headings, scn.Heading.Value)
+		excludedBy = append(excludedBy, scn.ExcludedByTags)
+	}
+	c.Assert(headings, DeepEquals, []string{"Sign up", "Login", "Logout"})
+	c.Assert(excludedBy, DeepEquals, []string{"smoke", "", "smoke"})
+	c.Assert(specs[0].Items, HasLen, 4)
+	c.Assert(specs[0].Items[1], Equals, gauge.Item(login))
+	c.Assert(specs[0].Items[2], Equals, gauge.Item(comment))
+	c.Assert(specs[0].Items[3], Equals, gauge.Item(specs[0].Scenarios[2]))
+	c.Assert(logout.ExcludedByTags, Equals, "")
+}
+
+func (s *MySuite) TestScenariosExcludedByTagsAreLeftOutByDefault(c *C) {
+	login := &gauge.Scenario{Heading: &gauge.Heading{Value: "Login"}, Tags: &gauge.Tags{RawValues: [][]string{{"smoke"}}}}
+	logout := &gauge.Scenario{Heading: &gauge.Heading{Value: "Logout"}}
+	spec := &gauge.Specification{FileName: "auth.spec", Items: []gauge.Item{login, logout}, Scenarios: []*gauge.Scenario{login, logout}}
+
+	specs := (&tagsFilter{"smoke"}).filter([]*gauge.Specification{spec})
+
+	c.Assert(specs[0].Scenarios, HasLen, 1)
+}
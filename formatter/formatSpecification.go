@@ -11,18 +11,22 @@ import (
 
 	"strings"
 
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 )
 
 type formatter struct {
 	buffer    bytes.Buffer
 	itemQueue *gauge.ItemQueue
+	// afterHeading tells if nothing but blank lines was written since the last heading
+	afterHeading bool
 }
 
 func (formatter *formatter) Specification(specification *gauge.Specification) {
 }
 
 func (formatter *formatter) Heading(heading *gauge.Heading) {
+	formatter.afterHeading = true
 	if heading.HeadingType == gauge.SpecHeading {
 		formatter.buffer.WriteString(FormatHeading(heading.Value, "#"))
 	} else if heading.HeadingType == gauge.ScenarioHeading {
@@ -31,10 +35,15 @@ func (formatter *formatter) Heading(heading *gauge.Heading) {
 }
 
 func (formatter *formatter) Tags(tags *gauge.Tags) {
-	if !strings.HasSuffix(formatter.buffer.String(), "\n\n") {
+	if formatter.afterHeading && env.FormatTagPlacement() == underHeadingTagPlacement {
+		text := strings.TrimRight(formatter.buffer.String(), "\n") + "\n"
+		formatter.buffer.Reset()
+		formatter.buffer.WriteString(text)
+	} else if !strings.HasSuffix(formatter.buffer.String(), "\n\n") {
 		formatter.buffer.WriteString("\n")
 	}
-	formatter.buffer.WriteString(FormatTags(tags))
+	formatter.afterHeading = false
+	formatter.buffer.WriteString(formatTags(tags))
 	if formatter.itemQueue.Peek() != nil && (formatter.itemQueue.Peek().Kind() != gauge.CommentKind || strings.TrimSpace(formatter.itemQueue.Peek().(*gauge.Comment).Value) != "") {
 		formatter.buffer.WriteString("\n")
 	}
@@ -45,6 +54,7 @@ func (formatter *formatter) Table(table *gauge.Table) {
 }
 
 func (formatter *formatter) DataTable(dataTable *gauge.DataTable) {
+	formatter.afterHeading = false
 	if !dataTable.IsExternal {
 		formatter.Table(dataTable.Table)
 	} else {
@@ -53,6 +63,7 @@ func (formatter *formatter) DataTable(dataTable *gauge.DataTable) {
 }
 
 func (formatter *formatter) TearDown(t *gauge.TearDown) {
+	formatter.afterHeading = false
 	formatter.buffer.WriteString(t.Value + "\n")
 }
 
@@ -60,9 +71,13 @@ func (formatter *formatter) Scenario(scenario *gauge.Scenario) {
 }
 
 func (formatter *formatter) Step(step *gauge.Step) {
-	formatter.buffer.WriteString(FormatStep(step))
+	formatter.afterHeading = false
+	formatter.buffer.WriteString(formatWrappedStep(step))
 }
 
 func (formatter *formatter) Comment(comment *gauge.Comment) {
+	if strings.TrimSpace(comment.Value) != "" {
+		formatter.afterHeading = false
+	}
 	formatter.buffer.WriteString(FormatComment(comment))
 }
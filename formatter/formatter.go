@@ -15,6 +15,7 @@ import (
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
@@ -23,9 +24,47 @@ import (
 
 const (
 	tableLeftSpacing = 3
+
+	underlineHeadingStyle    = "underline"
+	rightTableAlignment      = "right"
+	noTableAlignment         = "none"
+	underHeadingTagPlacement = "under-heading"
 )
 
+// Check makes gauge format report the spec files which are not formatted, rather than rewriting them
+var Check bool
+
 func FormatSpecFiles(specFiles ...string) []*parser.ParseResult {
+	return formatSpecs(specFiles, func(spec *gauge.Specification, formatted string) error {
+		if err := common.SaveFile(spec.FileName, formatted, true); err != nil {
+			return err
+		}
+		logger.Debugf(true, "Successfully formatted spec: %s", util.RelPathToProjectRoot(spec.FileName))
+		return nil
+	})
+}
+
+// CheckSpecFiles gives the spec files whose text is not the formatted one, without rewriting them
+func CheckSpecFiles(specFiles ...string) ([]string, []*parser.ParseResult) {
+	var unformatted []string
+	results := formatSpecs(specFiles, func(spec *gauge.Specification, formatted string) error {
+		text, err := common.ReadFileContents(util.LongPath(spec.FileName))
+		if err != nil {
+			return err
+		}
+		if strings.Replace(text, "\r\n", "\n", -1) != formatted {
+			unformatted = append(unformatted, spec.FileName)
+		}
+		return nil
+	})
+	return unformatted, results
+}
+
+// formatSpecs parses the spec files and gives the formatted text of the ones which parse to apply
+func formatSpecs(specFiles []string, apply func(spec *gauge.Specification, formatted string) error) []*parser.ParseResult {
+	if NormalizeTags {
+		loadTagRegistry()
+	}
 	specs, results := parser.ParseSpecFiles(specFiles, &gauge.ConceptDictionary{}, gauge.NewBuildErrors())
 	resultsMap := getParseResult(results)
 	filesSkipped := make([]string, 0)
@@ -35,10 +74,13 @@ func FormatSpecFiles(specFiles ...string) []*parser.ParseResult {
 			filesSkipped = append(filesSkipped, spec.FileName)
 			continue
 		}
-		if err := formatAndSave(spec); err != nil {
+		if parser.IsFeatureFile(spec.FileName) {
+			logger.Debugf(true, "Skipping feature file: %s, feature files are not formatted", util.RelPathToProjectRoot(spec.FileName))
+			continue
+		}
+		sortScenarios(spec, SortScenarios)
+		if err := apply(spec, FormatSpecification(spec)); err != nil {
 			result.ParseErrors = []parser.ParseError{parser.ParseError{Message: err.Error()}}
-		} else {
-			logger.Debugf(true, "Successfully formatted spec: %s", util.RelPathToProjectRoot(spec.FileName))
 		}
 	}
 	if len(filesSkipped) > 0 {
@@ -65,13 +107,22 @@ func FormatStep(step *gauge.Step) string {
 		if argument.ArgType == gauge.TableArg {
 			formattedArg = fmt.Sprintf("\n%s", FormatTable(&argument.Table))
 			stripBeforeArg = " "
+		} else if argument.ArgType == gauge.MultilineText {
+			formattedArg = fmt.Sprintf("\n%s", argument.FencedText())
+			stripBeforeArg = " "
 		} else if argument.ArgType == gauge.Dynamic || argument.ArgType == gauge.SpecialString || argument.ArgType == gauge.SpecialTable {
 			formattedArg = fmt.Sprintf("<%s>", parser.GetUnescapedString(argument.Name))
 		} else {
 			formattedArg = fmt.Sprintf("\"%s\"", parser.GetUnescapedString(argument.Value))
 		}
+		if argument.ParamType != "" {
+			formattedArg += fmt.Sprintf("{%s}", argument.ParamType)
+		}
 		text = strings.Replace(text, stripBeforeArg + gauge.ParameterPlaceholder, formattedArg, 1)
 	}
+	if step.StepTags != nil {
+		text = withStepTags(text, step.StepTags.Values())
+	}
 	stepText := ""
 	if strings.HasSuffix(text, "\n") {
 		stepText = fmt.Sprintf("* %s", text)
@@ -81,6 +132,88 @@ func FormatStep(step *gauge.Step) string {
 	return stepText
 }
 
+// withStepTags writes the tags at the end of the line of the step, before the table or multiline text following it
+func withStepTags(text string, tags []string) string {
+	if len(tags) == 0 {
+		return text
+	}
+	line := text
+	rest := ""
+	if i := strings.Index(text, "\n"); i >= 0 {
+		line, rest = text[:i], text[i:]
+	}
+	return fmt.Sprintf("%s @%s%s", line, strings.Join(tags, " @"), rest)
+}
+
+// formatWrappedStep formats the step, breaking its text onto continuation lines when it is wider than the
+// configured max line width. Continuation lines are only understood by the parser when multiline steps are allowed.
+func formatWrappedStep(step *gauge.Step) string {
+	stepText := FormatStep(step)
+	width := env.FormatMaxLineWidth()
+	if width <= 0 || !env.AllowMultiLineStep() {
+		return stepText
+	}
+	for _, arg := range step.Args {
+		if arg.ArgType == gauge.TableArg || arg.ArgType == gauge.MultilineText {
+			return stepText
+		}
+	}
+	i := strings.Index(stepText, "\n")
+	return wrapStepLine(stepText[:i], width) + stepText[i:]
+}
+
+// wrapStepLine breaks the line at spaces so that each line fits the width where possible. Parameters are never
+// split, and a line is not started with a word the parser would read as something other than step text.
+func wrapStepLine(line string, width int) string {
+	if len(line) <= width {
+		return line
+	}
+	words := stepWords(line)
+	lines := []string{words[0]}
+	for _, w := range words[1:] {
+		last := lines[len(lines)-1]
+		if len(last)+1+len(w) > width && !startsToken(w) {
+			lines = append(lines, w)
+		} else {
+			lines[len(lines)-1] = last + " " + w
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// stepWords splits the step text at spaces which are not inside a quoted or dynamic parameter
+func stepWords(text string) []string {
+	var words []string
+	var closing rune
+	start := 0
+	for i, r := range text {
+		switch {
+		case closing != 0:
+			if r == closing && (closing != '"' || text[i-1] != '\\') {
+				closing = 0
+			}
+		case r == '"':
+			closing = '"'
+		case r == '<':
+			closing = '>'
+		case r == ' ':
+			if i > start {
+				words = append(words, text[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(text) {
+		words = append(words, text[start:])
+	}
+	return words
+}
+
+func startsToken(word string) bool {
+	lower := strings.ToLower(word)
+	return strings.ContainsAny(word[:1], "#*|-=_") || strings.HasPrefix(lower, "tags") || strings.HasPrefix(lower, "table:")
+}
+
 func FormatStepWithResolvedArgs(step *gauge.Step) string {
 	text := step.Value
 	paramCount := strings.Count(text, gauge.ParameterPlaceholder)
@@ -109,17 +242,33 @@ func FormatStepWithResolvedArgs(step *gauge.Step) string {
 	return stepText
 }
 
+// FormatHeading gives the heading with the # or ## of its level, or underlined with = or - when gauge_format_heading_style
+// is underline
 func FormatHeading(heading, headingChar string) string {
 	trimmedHeading := strings.TrimSpace(heading)
+	if env.FormatHeadingStyle() == underlineHeadingStyle {
+		underline := "="
+		if headingChar != "#" {
+			underline = "-"
+		}
+		return fmt.Sprintf("%s\n%s\n", trimmedHeading, getRepeatedChars(underline, len([]rune(trimmedHeading))))
+	}
 	return fmt.Sprintf("%s %s\n", headingChar, trimmedHeading)
 }
 
+// FormatTable gives the table with its cells aligned and padded as set by gauge_format_table_alignment and
+// gauge_format_table_cell_padding
 func FormatTable(table *gauge.Table) string {
+	alignment := env.FormatTableAlignment()
+	padding := getRepeatedChars(" ", env.FormatTableCellPadding())
 	columnToWidthMap := make(map[int]int)
 	for i, header := range table.Headers {
 		//table.get(header) returns a list of cells in that particular column
 		cells, _ := table.Get(header)
-		columnToWidthMap[i] = findLongestCellWidth(cells, len(header))
+		columnToWidthMap[i] = findLongestCellWidth(cells, len([]rune(escapeTableCell(header))))
+	}
+	cellText := func(value string, i int) string {
+		return padding + alignCell(value, columnToWidthMap[i], alignment) + padding
 	}
 
 	var tableStringBuffer bytes.Buffer
@@ -128,24 +277,24 @@ func FormatTable(table *gauge.Table) string {
 
 	tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftSpacing)))
 	for i, header := range table.Headers {
-		width := columnToWidthMap[i]
-		tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(header, width)))
+		tableStringBuffer.WriteString(fmt.Sprintf("%s|", cellText(escapeTableCell(header), i)))
 	}
 
 	tableStringBuffer.WriteString("\n")
 	tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftSpacing)))
-	for i := range table.Headers {
+	for i, header := range table.Headers {
 		width := columnToWidthMap[i]
-		cell := getRepeatedChars("-", width)
-		tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(cell, width)))
+		if alignment == noTableAlignment {
+			width = len([]rune(escapeTableCell(header)))
+		}
+		tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars("-", width+2*len(padding))))
 	}
 
 	tableStringBuffer.WriteString("\n")
 	for _, row := range table.Rows() {
 		tableStringBuffer.WriteString(fmt.Sprintf("%s|", getRepeatedChars(" ", tableLeftSpacing)))
 		for i, cell := range row {
-			width := columnToWidthMap[i]
-			tableStringBuffer.WriteString(fmt.Sprintf("%s|", addPaddingToCell(cell, width)))
+			tableStringBuffer.WriteString(fmt.Sprintf("%s|", cellText(escapeTableCell(cell), i)))
 		}
 		tableStringBuffer.WriteString("\n")
 	}
@@ -153,6 +302,25 @@ func FormatTable(table *gauge.Table) string {
 	return tableStringBuffer.String()
 }
 
+// alignCell pads the cell to the width of its column on the right for left alignment, on the left for right
+// alignment and not at all without alignment
+func alignCell(value string, width int, alignment string) string {
+	switch alignment {
+	case noTableAlignment:
+		return value
+	case rightTableAlignment:
+		return getRepeatedChars(" ", width-len([]rune(value))) + value
+	}
+	return addPaddingToCell(value, width)
+}
+
+var tableCellEscaper = strings.NewReplacer(`\`, `\\`, "|", `\|`, "\n", `\n`)
+
+// escapeTableCell escapes the backslashes, pipes and line breaks of a table cell, which the parser reads back
+func escapeTableCell(value string) string {
+	return tableCellEscaper.Replace(value)
+}
+
 func addPaddingToCell(cellValue string, width int) string {
 	cellRunes := []rune(cellValue)
 	padding := getRepeatedChars(" ", width-len(cellRunes))
@@ -162,7 +330,7 @@ func addPaddingToCell(cellValue string, width int) string {
 func findLongestCellWidth(columnCells []gauge.TableCell, minValue int) int {
 	longestLength := minValue
 	for _, cellValue := range columnCells {
-		cellValueLen := len([]rune(cellValue.GetValue()))
+		cellValueLen := len([]rune(escapeTableCell(cellValue.GetValue())))
 		if cellValueLen > longestLength {
 			longestLength = cellValueLen
 		}
@@ -208,14 +376,6 @@ func formatExternalDataTable(dataTable *gauge.DataTable) string {
 	return b.String()
 }
 
-func formatAndSave(spec *gauge.Specification) error {
-	formatted := FormatSpecification(spec)
-	if err := common.SaveFile(spec.FileName, formatted, true); err != nil {
-		return err
-	}
-	return nil
-}
-
 func FormatSpecification(specification *gauge.Specification) string {
 	var formattedSpec bytes.Buffer
 	queue := &gauge.ItemQueue{Items: specification.AllItems()}
@@ -262,13 +422,13 @@ func formatItem(item gauge.Item) string {
 		return fmt.Sprintf("%s\n", comment.Value)
 	case gauge.StepKind:
 		step := item.(*gauge.Step)
-		return FormatStep(step)
+		return formatWrappedStep(step)
 	case gauge.DataTableKind:
 		dataTable := item.(*gauge.DataTable)
 		return FormatTable(dataTable.Table)
 	case gauge.TagKind:
 		tags := item.(*gauge.Tags)
-		return FormatTags(tags)
+		return formatTags(tags)
 	}
 	return ""
 }
@@ -283,6 +443,16 @@ func getRepeatedChars(character string, repeatCount int) string {
 
 func FormatSpecFilesIn(filesLocation string) {
 	specFiles := util.GetSpecFiles([]string{filesLocation})
+	if Check {
+		unformatted, parseResults := CheckSpecFiles(specFiles...)
+		for _, f := range unformatted {
+			logger.Errorf(true, "%s is not formatted", util.RelPathToProjectRoot(f))
+		}
+		if parser.HandleParseResult(parseResults...) || len(unformatted) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
 	parseResults := FormatSpecFiles(specFiles...)
 	if parser.HandleParseResult(parseResults...) {
 		os.Exit(1)
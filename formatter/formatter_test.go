@@ -7,6 +7,8 @@
 package formatter
 
 import (
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/getgauge/gauge/env"
@@ -14,6 +16,7 @@ import (
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/tagRegistry"
 	. "gopkg.in/check.v1"
 )
 
@@ -90,6 +93,50 @@ func (s *MySuite) TestFormatTableWithUmlautChars(c *C) {
 	c.Assert(got, Equals, want)
 }
 
+func (s *MySuite) TestFormatTableEscapesCells(c *C) {
+	cell1 := gauge.TableCell{Value: "a|b", CellType: gauge.Static}
+	cell2 := gauge.TableCell{Value: "first\nsecond", CellType: gauge.Static}
+
+	headers := []string{"pipe", "lines"}
+	cols := [][]gauge.TableCell{{cell1}, {cell2}}
+
+	table := gauge.NewTable(headers, cols, 10)
+
+	got := FormatTable(table)
+	want := `
+   |pipe|lines        |
+   |----|-------------|
+   |a\|b|first\nsecond|
+`
+
+	c.Assert(got, Equals, want)
+}
+
+func (s *MySuite) TestFormattingTableWithEscapedCellsIsStable(c *C) {
+	text := `# Spec
+
+## Scenario
+
+* step
+   |value          |
+   |---------------|
+   |a\|b           |
+   |first\nsecond  |
+   |back\\slash    |
+`
+	spec, res, err := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+
+	formatted := FormatSpecification(spec)
+	reparsed, res, err := new(parser.SpecParser).Parse(formatted, gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+
+	c.Assert(reparsed.Scenarios[0].Steps[0].Args[0].Table.Rows(), DeepEquals, [][]string{{"a|b"}, {"first\nsecond"}, {"back\\slash"}})
+	c.Assert(FormatSpecification(reparsed), Equals, formatted)
+}
+
 func (s *MySuite) TestFormatConcepts(c *C) {
 	dictionary := gauge.NewConceptDictionary()
 	step1 := &gauge.Step{Value: "sdsf", LineText: "sdsf", IsConcept: true, LineNo: 1, PreComments: []*gauge.Comment{&gauge.Comment{Value: "COMMENT", LineNo: 1}}}
@@ -495,3 +542,269 @@ func (s *MySuite) TestFormatShouldNotAddExtraNewLinesBeforeDataTable(c *C) {
    |Rhythm|0          |
 `)
 }
+
+func (s *MySuite) TestFormatConceptsWithTags(c *C) {
+	dictionary := gauge.NewConceptDictionary()
+	concepts, _ := new(parser.ConceptParser).Parse("# my concept\ntags: requires-db,  slow\n* first step\n", "file.cpt")
+	dictionary.ConceptsMap[concepts[0].Value] = &gauge.Concept{ConceptStep: concepts[0], FileName: "file.cpt"}
+
+	formatted := FormatConcepts(dictionary)
+	c.Assert(formatted["file.cpt"], Equals, `# my concept
+tags: requires-db, slow
+* first step
+`)
+}
+
+func (s *MySuite) TestFormatSpecificationWrapsLongSteps(c *C) {
+	env.AllowMultiLineStep = func() bool { return true }
+	env.FormatMaxLineWidth = func() int { return 30 }
+	defer func() {
+		env.AllowMultiLineStep = func() bool { return false }
+		env.FormatMaxLineWidth = func() int { return 0 }
+	}()
+	specText := `# Spec Heading
+## Scenario Heading
+* Transfer "one hundred dollars" from the savings account to <account> - checking
+`
+	spec, _, err := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+
+	formatted := FormatSpecification(spec)
+
+	c.Assert(formatted, Equals, `# Spec Heading
+## Scenario Heading
+* Transfer
+"one hundred dollars" from the
+savings account to <account> -
+checking
+`)
+	reparsed, _, err := new(parser.SpecParser).Parse(formatted, gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+	c.Assert(reparsed.Steps()[0].Value, Equals, spec.Steps()[0].Value)
+}
+
+func (s *MySuite) TestWrapStepLineKeepsParametersTogether(c *C) {
+	c.Assert(wrapStepLine(`* say "hello \" there" to <the user>`, 10), Equals, "* say\n\"hello \\\" there\"\nto\n<the user>")
+}
+
+func (s *MySuite) TestNormalizeTags(c *C) {
+	r, err := tagRegistry.Parse([]byte("tags:\n  - name: smoke\n  - name: API\n"))
+	c.Assert(err, IsNil)
+	tags := &gauge.Tags{RawValues: [][]string{{"Smoke", "zeta", "Priority2"}, {"api", "SMOKE", "Priority1", "alpha"}}}
+
+	c.Assert(FormatTags(normalizeTags(tags, r)), Equals, "tags: Priority1, Priority2, alpha, API, smoke, zeta\n")
+}
+
+func (s *MySuite) TestFormatSpecificationNormalizesTagsWhenAsked(c *C) {
+	NormalizeTags = true
+	defer func() { NormalizeTags = false }()
+	spec, _, err := new(parser.SpecParser).Parse("# Spec\ntags: b, a, B\n## Scenario\n* step\n", gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+
+	c.Assert(FormatSpecification(spec), Equals, "# Spec\n\ntags: a, b\n\n## Scenario\n* step\n")
+}
+
+func (s *MySuite) TestSortScenariosByName(c *C) {
+	specText := `# Spec
+* context step
+
+## b scenario
+* step
+
+## A scenario
+* step
+___
+* teardown step
+`
+	spec, _, err := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+
+	sortScenarios(spec, SortByName)
+
+	c.Assert(FormatSpecification(spec), Equals, `# Spec
+* context step
+
+## A scenario
+* step
+
+## b scenario
+* step
+
+___
+* teardown step
+`)
+}
+
+func (s *MySuite) TestSortScenariosByPriority(c *C) {
+	specText := "# Spec\n## none\ntags: smoke\n* step\n## second\ntags: Priority2\n* step\n## first\ntags: Priority1\n* step\n"
+	spec, _, err := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+
+	sortScenarios(spec, SortByPriority)
+
+	var headings []string
+	for _, item := range spec.Items {
+		if item.Kind() == gauge.ScenarioKind {
+			headings = append(headings, item.(*gauge.Scenario).Heading.Value)
+		}
+	}
+	c.Assert(headings, DeepEquals, []string{"first", "second", "none"})
+}
+
+func (s *MySuite) TestValidateScenarioOrder(c *C) {
+	c.Assert(ValidateScenarioOrder(""), IsNil)
+	c.Assert(ValidateScenarioOrder(SortByPriority), IsNil)
+	c.Assert(ValidateScenarioOrder("random"), ErrorMatches, "invalid scenario order 'random'. Use priority or name")
+}
+
+func (s *MySuite) TestFormatStepWithMultilineText(c *C) {
+	old := env.AllowMultilineText
+	env.AllowMultilineText = func() bool { return true }
+	defer func() { env.AllowMultilineText = old }()
+	text := "# Spec\n## Scenario\n* post to \"users\"\n\n  ```json\n  {\n    \"id\": 1\n  }\n  ```\n* run\n````\n```\n````\n"
+	spec, res, err := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+
+	formatted := FormatSpecification(spec)
+
+	c.Assert(formatted, Equals, "# Spec\n## Scenario\n* post to \"users\"\n```json\n{\n  \"id\": 1\n}\n```\n* run\n````\n```\n````\n")
+	reparsed, _, _ := new(parser.SpecParser).Parse(formatted, gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(FormatSpecification(reparsed), Equals, formatted)
+}
+
+func (s *MySuite) TestFormatStepWithStepTags(c *C) {
+	old := env.AllowStepTags
+	env.AllowStepTags = func() bool { return true }
+	defer func() { env.AllowStepTags = old }()
+	text := "# Spec\n## Scenario\n* open \"app\"   @slow  @ui\n* check\n   |a|\n   |-|\n   |1|\n* close @ui\n"
+	spec, res, err := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	spec.Scenarios[0].Steps[1].StepTags = &gauge.Tags{RawValues: [][]string{{"table"}}}
+
+	formatted := FormatSpecification(spec)
+
+	c.Assert(formatted, Equals, "# Spec\n## Scenario\n* open \"app\" @slow @ui\n* check @table\n\n   |a|\n   |-|\n   |1|\n* close @ui\n")
+	reparsed, _, _ := new(parser.SpecParser).Parse(formatted, gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(FormatSpecification(reparsed), Equals, formatted)
+}
+
+func (s *MySuite) TestFormatSpecificationWithScenarioTearDown(c *C) {
+	specText := "# Spec\n\n## Scenario\n* open the app\n\nteardown:\n* close the app\n\n## Another\n* step\n"
+	spec, _, err := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+
+	c.Assert(FormatSpecification(spec), Equals, specText)
+}
+
+func (s *MySuite) TestFormatStepWithTypedParams(c *C) {
+	text := "# Spec\n## Scenario\n* add \"5\"{int} items on <day>{date:02/01/2006}\n"
+	spec, _, err := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(err, IsNil)
+
+	formatted := FormatSpecification(spec)
+
+	c.Assert(formatted, Equals, text)
+}
+
+func (s *MySuite) TestFormatConceptsWithDataTable(c *C) {
+	old := env.AllowConceptDatatable
+	env.AllowConceptDatatable = func() bool { return true }
+	defer func() { env.AllowConceptDatatable = old }()
+	dictionary := gauge.NewConceptDictionary()
+	concepts, _ := new(parser.ConceptParser).Parse("# check prices\n|currency|amount|\n|---|---|\n|USD|10|\n|EUR|20|\n* convert <amount> to <currency>\n", "file.cpt")
+	dictionary.ConceptsMap[concepts[0].Value] = &gauge.Concept{ConceptStep: concepts[0], FileName: "file.cpt"}
+
+	formatted := FormatConcepts(dictionary)
+
+	c.Assert(formatted["file.cpt"], Equals, `# check prices
+
+   |currency|amount|
+   |--------|------|
+   |USD     |10    |
+   |EUR     |20    |
+* convert <amount> to <currency>
+`)
+}
+
+func (s *MySuite) TestCheckSpecFilesGivesTheFilesWhichAreNotFormatted(c *C) {
+	dir := c.MkDir()
+	formatted := filepath.Join(dir, "formatted.spec")
+	unformatted := filepath.Join(dir, "unformatted.spec")
+	c.Assert(ioutil.WriteFile(formatted, []byte("# Spec Heading\n\n## Scenario Heading\n\n* Example step\n"), 0644), IsNil)
+	text := "Spec Heading\n=====\ntags:  foo,bar\n|id|name|\n|--|--|\n|1|a|\n\nScenario Heading\n----\n* Example step\n"
+	c.Assert(ioutil.WriteFile(unformatted, []byte(text), 0644), IsNil)
+
+	files, results := CheckSpecFiles(formatted, unformatted)
+
+	c.Assert(files, DeepEquals, []string{unformatted})
+	c.Assert(results, HasLen, 2)
+	content, err := ioutil.ReadFile(unformatted)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Equals, text)
+}
+
+func (s *MySuite) TestFormatSpecificationWithUnderlinedHeadingsAndTagsUnderThem(c *C) {
+	oldStyle, oldPlacement := env.FormatHeadingStyle, env.FormatTagPlacement
+	defer func() { env.FormatHeadingStyle, env.FormatTagPlacement = oldStyle, oldPlacement }()
+	env.FormatHeadingStyle = func() string { return "underline" }
+	env.FormatTagPlacement = func() string { return "under-heading" }
+	specText := `# Spec Heading
+
+tags: foo, bar
+
+## Scenario Heading
+
+tags: baz
+
+* Example step
+`
+	spec, _, err := new(parser.SpecParser).Parse(specText, gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+
+	formatted := FormatSpecification(spec)
+
+	c.Assert(formatted, Equals, `Spec Heading
+============
+tags: foo, bar
+
+Scenario Heading
+----------------
+tags: baz
+
+* Example step
+`)
+	reparsed, res, err := new(parser.SpecParser).Parse(formatted, gauge.NewConceptDictionary(), "")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(reparsed.Tags.Values(), DeepEquals, []string{"foo", "bar"})
+	c.Assert(reparsed.Scenarios[0].Tags.Values(), DeepEquals, []string{"baz"})
+}
+
+func (s *MySuite) TestFormatTableWithAlignmentAndPadding(c *C) {
+	oldAlignment, oldPadding := env.FormatTableAlignment, env.FormatTableCellPadding
+	defer func() { env.FormatTableAlignment, env.FormatTableCellPadding = oldAlignment, oldPadding }()
+	table := gauge.NewTable([]string{"id", "name"}, [][]gauge.TableCell{
+		{{Value: "1", CellType: gauge.Static}, {Value: "1000", CellType: gauge.Static}},
+		{{Value: "john", CellType: gauge.Static}, {Value: "jo", CellType: gauge.Static}},
+	}, 1)
+
+	env.FormatTableAlignment = func() string { return "right" }
+	env.FormatTableCellPadding = func() int { return 1 }
+	c.Assert(FormatTable(table), Equals, `
+   |   id | name |
+   |------|------|
+   |    1 | john |
+   | 1000 |   jo |
+`)
+
+	env.FormatTableAlignment = func() string { return "none" }
+	env.FormatTableCellPadding = func() int { return 0 }
+	c.Assert(FormatTable(table), Equals, `
+   |id|name|
+   |--|----|
+   |1|john|
+   |1000|jo|
+`)
+}
@@ -0,0 +1,252 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package formatter
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+const gherkinIndent = "  "
+
+// gherkinWriter writes a spec as a Gherkin feature. The contexts are the background of the feature and the teardown
+// steps are added to the end of every scenario, as gauge runs them after each scenario. A scenario run for the rows
+// of a data table is a scenario outline, its examples being the rows of the spec and the scenario data tables.
+type gherkinWriter struct {
+	buffer          bytes.Buffer
+	flattenConcepts bool
+}
+
+// FormatGherkin gives the spec as a Gherkin feature. The steps of concepts are written in place of the concepts when
+// flattening them, otherwise the concepts are written as steps.
+func FormatGherkin(spec *gauge.Specification, flattenConcepts bool) string {
+	w := &gherkinWriter{flattenConcepts: flattenConcepts}
+	w.feature(spec)
+	return w.buffer.String()
+}
+
+func (w *gherkinWriter) feature(spec *gauge.Specification) {
+	w.tags("", spec.Tags)
+	w.line("", "Feature: "+spec.Heading.Value)
+	for _, comment := range spec.Comments {
+		if v := strings.TrimSpace(comment.Value); v != "" {
+			w.line(gherkinIndent, v)
+		}
+	}
+	if len(spec.Contexts) > 0 {
+		w.buffer.WriteString("\n")
+		w.line(gherkinIndent, "Background:")
+		w.steps(spec.Contexts, nil)
+	}
+	for _, scn := range spec.Scenarios {
+		w.scenario(spec, scn)
+	}
+}
+
+func (w *gherkinWriter) scenario(spec *gauge.Specification, scn *gauge.Scenario) {
+	headers, rows := examples(spec.DataTable.Table, scn.DataTable.Table)
+	w.buffer.WriteString("\n")
+	w.tags(gherkinIndent, scn.Tags)
+	keyword := "Scenario"
+	if len(rows) > 0 {
+		keyword = "Scenario Outline"
+	}
+	w.line(gherkinIndent, fmt.Sprintf("%s: %s", keyword, scn.Heading.Value))
+	for _, item := range scn.Items {
+		switch i := item.(type) {
+		case *gauge.Step:
+			w.steps([]*gauge.Step{i}, nil)
+		case *gauge.Comment:
+			if v := strings.TrimSpace(i.Value); v != "" {
+				w.line(gherkinIndent+gherkinIndent, "# "+v)
+			}
+		}
+	}
+	w.steps(spec.TearDownSteps, nil)
+	if len(rows) > 0 {
+		w.buffer.WriteString("\n")
+		w.line(gherkinIndent+gherkinIndent, "Examples:")
+		w.table(gherkinIndent+gherkinIndent+gherkinIndent, headers, rows)
+	}
+}
+
+// steps writes the steps, the concepts enclosing them being given innermost last to resolve their parameters
+func (w *gherkinWriter) steps(steps []*gauge.Step, concepts []*gauge.Step) {
+	for _, step := range steps {
+		if step.IsConcept && w.flattenConcepts {
+			w.steps(step.ConceptSteps, append(append([]*gauge.Step{}, concepts...), step))
+			continue
+		}
+		text := step.Value
+		var table *gauge.Table
+		var multilineText *gauge.StepArg
+		for _, arg := range step.Args {
+			arg = resolveConceptArg(arg, concepts)
+			switch arg.ArgType {
+			case gauge.TableArg:
+				text = strings.Replace(text, " "+gauge.ParameterPlaceholder, "", 1)
+				table = &arg.Table
+			case gauge.MultilineText:
+				text = strings.Replace(text, " "+gauge.ParameterPlaceholder, "", 1)
+				multilineText = arg
+			case gauge.Static:
+				text = strings.Replace(text, gauge.ParameterPlaceholder, fmt.Sprintf("\"%s\"", parser.GetUnescapedString(arg.Value)), 1)
+			case gauge.Dynamic:
+				text = strings.Replace(text, gauge.ParameterPlaceholder, fmt.Sprintf("<%s>", arg.Value), 1)
+			default:
+				text = strings.Replace(text, gauge.ParameterPlaceholder, fmt.Sprintf("<%s>", parser.GetUnescapedString(arg.Name)), 1)
+			}
+		}
+		w.line(gherkinIndent+gherkinIndent, "* "+strings.TrimSpace(text))
+		if table != nil {
+			var rows [][]string
+			for i := 0; i < table.GetRowCount(); i++ {
+				var row []string
+				for _, header := range table.Headers {
+					cells, _ := table.Get(header)
+					row = append(row, resolveConceptCell(cells[i], concepts))
+				}
+				rows = append(rows, row)
+			}
+			w.table(gherkinIndent+gherkinIndent+gherkinIndent, table.Headers, rows)
+		}
+		if multilineText != nil {
+			for _, line := range strings.Split(strings.TrimSuffix(multilineText.FencedText(), "\n"), "\n") {
+				if line == "" {
+					w.buffer.WriteString("\n")
+				} else {
+					w.line(gherkinIndent+gherkinIndent+gherkinIndent, line)
+				}
+			}
+		}
+	}
+}
+
+func (w *gherkinWriter) tags(indent string, tags *gauge.Tags) {
+	if tags == nil || len(tags.Values()) == 0 {
+		return
+	}
+	var values []string
+	for _, t := range tags.Values() {
+		values = append(values, "@"+strings.Join(strings.Fields(t), "_"))
+	}
+	w.line(indent, strings.Join(values, " "))
+}
+
+func (w *gherkinWriter) table(indent string, headers []string, rows [][]string) {
+	widths := make([]int, len(headers))
+	for _, row := range append([][]string{headers}, rows...) {
+		for i, cell := range row {
+			if l := len([]rune(escapeTableCell(cell))); l > widths[i] {
+				widths[i] = l
+			}
+		}
+	}
+	for _, row := range append([][]string{headers}, rows...) {
+		var b bytes.Buffer
+		b.WriteString("|")
+		for i, cell := range row {
+			b.WriteString(" " + addPaddingToCell(escapeTableCell(cell), widths[i]) + " |")
+		}
+		w.line(indent, b.String())
+	}
+}
+
+func (w *gherkinWriter) line(indent, text string) {
+	w.buffer.WriteString(indent + text + "\n")
+}
+
+// resolveConceptArg gives the argument a parameter of the concepts stands for, innermost concept first. A parameter
+// of a data table stays dynamic.
+func resolveConceptArg(arg *gauge.StepArg, concepts []*gauge.Step) *gauge.StepArg {
+	for i := len(concepts) - 1; i >= 0 && arg.ArgType == gauge.Dynamic; i-- {
+		a, err := concepts[i].Lookup.GetArg(arg.Value)
+		if err != nil || a == nil {
+			break
+		}
+		arg = a
+	}
+	return arg
+}
+
+func resolveConceptCell(cell gauge.TableCell, concepts []*gauge.Step) string {
+	if cell.CellType != gauge.Dynamic {
+		return cell.GetValue()
+	}
+	arg := resolveConceptArg(&gauge.StepArg{Value: cell.Value, ArgType: gauge.Dynamic}, concepts)
+	if arg.ArgType == gauge.Static {
+		return arg.Value
+	}
+	return fmt.Sprintf("<%s>", arg.Value)
+}
+
+// examples gives the rows a scenario is run for: the rows of the spec data table, of the scenario data table, or
+// every row of the spec data table with every row of the scenario data table when there are both
+func examples(specTable, scenarioTable *gauge.Table) ([]string, [][]string) {
+	var headers []string
+	rows := [][]string{nil}
+	for _, t := range []*gauge.Table{specTable, scenarioTable} {
+		if !t.IsInitialized() || t.GetRowCount() == 0 {
+			continue
+		}
+		headers = append(headers, t.Headers...)
+		var product [][]string
+		for _, row := range rows {
+			for _, r := range t.Rows() {
+				product = append(product, append(append([]string{}, row...), r...))
+			}
+		}
+		rows = product
+	}
+	if headers == nil {
+		return nil, nil
+	}
+	return headers, rows
+}
+
+// ExportGherkin writes the specs as Gherkin feature files in the out directory, at the path of the spec relative to
+// the project root. The specs that fail to parse are not exported.
+func ExportGherkin(specDirs []string, out string, flattenConcepts bool) ([]*parser.ParseResult, error) {
+	dict, res, err := parser.CreateConceptsDictionary()
+	if err != nil {
+		return nil, err
+	}
+	if !res.Ok {
+		return []*parser.ParseResult{res}, nil
+	}
+	specs, results := parser.ParseSpecFiles(util.GetSpecFiles(specDirs), dict, gauge.NewBuildErrors())
+	failed := make(map[string]bool)
+	for _, r := range results {
+		if !r.Ok {
+			failed[r.FileName] = true
+		}
+	}
+	for _, spec := range specs {
+		if failed[spec.FileName] {
+			continue
+		}
+		rel := util.RelPathToProjectRoot(spec.FileName)
+		path := filepath.Join(out, strings.TrimSuffix(rel, filepath.Ext(rel))+".feature")
+		if err := os.MkdirAll(filepath.Dir(path), common.NewDirectoryPermissions); err != nil {
+			return results, err
+		}
+		if err := common.SaveFile(path, FormatGherkin(spec, flattenConcepts), false); err != nil {
+			return results, err
+		}
+		logger.Debugf(true, "Exported %s to %s", rel, path)
+	}
+	return results, nil
+}
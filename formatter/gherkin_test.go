@@ -0,0 +1,132 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package formatter
+
+import (
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func gherkinConcepts(c *C, text string) *gauge.ConceptDictionary {
+	dict := gauge.NewConceptDictionary()
+	concepts, res := new(parser.ConceptParser).Parse(text, "concepts.cpt")
+	c.Assert(res.ParseErrors, HasLen, 0)
+	_, err := parser.AddConcept(concepts, "concepts.cpt", dict)
+	c.Assert(err, IsNil)
+	return dict
+}
+
+func (s *MySuite) TestFormatGherkin(c *C) {
+	text := `# Login
+Tags: smoke, priority:1
+
+Users sign in with their account.
+
+* open the application
+
+## Sign in
+Tags: fast
+* sign in as "jane"
+* the dashboard shows
+   |widget|count|
+   |------|-----|
+   |inbox |2    |
+
+___
+* close the application
+`
+	dict := gherkinConcepts(c, "# sign in as <user>\n* enter user <user>\n* submit\n")
+	spec, res, err := new(parser.SpecParser).Parse(text, dict, "login.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+
+	want := `@smoke @priority:1
+Feature: Login
+  Users sign in with their account.
+
+  Background:
+    * open the application
+
+  @fast
+  Scenario: Sign in
+    * enter user "jane"
+    * submit
+    * the dashboard shows
+      | widget | count |
+      | inbox  | 2     |
+    * close the application
+`
+	c.Assert(FormatGherkin(spec, true), Equals, want)
+}
+
+func (s *MySuite) TestFormatGherkinWithoutFlatteningConcepts(c *C) {
+	dict := gherkinConcepts(c, "# sign in as <user>\n* enter user <user>\n")
+	spec, _, _ := new(parser.SpecParser).Parse("# Login\n## Sign in\n* sign in as \"jane\"\n", dict, "login.spec")
+
+	c.Assert(FormatGherkin(spec, false), Equals, "Feature: Login\n\n  Scenario: Sign in\n    * sign in as \"jane\"\n")
+}
+
+func (s *MySuite) TestFormatGherkinWritesDataTablesAsExamples(c *C) {
+	old := env.AllowScenarioDatatable
+	env.AllowScenarioDatatable = func() bool { return true }
+	defer func() { env.AllowScenarioDatatable = old }()
+	text := `# Cukes
+|start|
+|-----|
+|12   |
+|20   |
+
+## Eating
+|eat|
+|---|
+|5  |
+* there are <start> cucumbers
+* I eat <eat> cucumbers
+`
+	dict := gherkinConcepts(c, "# I eat <count> cucumbers\n* eat <count>\n")
+	spec, res, err := new(parser.SpecParser).Parse(text, dict, "cukes.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+
+	want := `Feature: Cukes
+
+  Scenario Outline: Eating
+    * there are <start> cucumbers
+    * eat <eat>
+
+    Examples:
+      | start | eat |
+      | 12    | 5   |
+      | 20    | 5   |
+`
+	c.Assert(FormatGherkin(spec, true), Equals, want)
+}
+
+func (s *MySuite) TestExportedGherkinParsesBackToTheSameSteps(c *C) {
+	spec, _, _ := new(parser.SpecParser).Parse("# Spec\nTags: a tag\n## Scenario\n* step with \"value\" and |pipe\n", gauge.NewConceptDictionary(), "a.spec")
+
+	feature, res, err := new(parser.SpecParser).Parse(FormatGherkin(spec, true), gauge.NewConceptDictionary(), "a.feature")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(feature.Tags.Values(), DeepEquals, []string{"a_tag"})
+	c.Assert(feature.Scenarios[0].Steps[0].Value, Equals, spec.Scenarios[0].Steps[0].Value)
+	c.Assert(feature.Scenarios[0].Steps[0].Args[0].Value, Equals, "value")
+}
+
+func (s *MySuite) TestFormatGherkinWritesMultilineTextAsDocString(c *C) {
+	old := env.AllowMultilineText
+	env.AllowMultilineText = func() bool { return true }
+	defer func() { env.AllowMultilineText = old }()
+	spec, res, err := new(parser.SpecParser).Parse("# Api\n## Post\n* post\n```json\n{\n\n}\n```\n", gauge.NewConceptDictionary(), "api.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+
+	c.Assert(FormatGherkin(spec, true), Equals, "Feature: Api\n\n  Scenario: Post\n    * post\n      ```json\n      {\n\n      }\n      ```\n")
+}
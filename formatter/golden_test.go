@@ -0,0 +1,59 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package formatter
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+// Each spec or concept file in testdata is formatted and compared with the .golden file of the same name. Formatting
+// a golden file gives it back byte for byte.
+func (s *MySuite) TestFormatGoldenFiles(c *C) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*.*"))
+	c.Assert(err, IsNil)
+	count := 0
+	for _, file := range files {
+		ext := filepath.Ext(file)
+		if ext == ".golden" {
+			continue
+		}
+		count++
+		golden := readTestFile(c, strings.TrimSuffix(file, ext)+".golden")
+		formatted := formatTestFile(c, file, readTestFile(c, file))
+		c.Assert(formatted, Equals, golden, Commentf("formatting %s", file))
+		c.Assert(formatTestFile(c, file, golden), Equals, golden, Commentf("formatting the golden file of %s", file))
+	}
+	c.Assert(count > 0, Equals, true)
+}
+
+func readTestFile(c *C, file string) string {
+	bytes, err := ioutil.ReadFile(file)
+	c.Assert(err, IsNil)
+	return string(bytes)
+}
+
+func formatTestFile(c *C, file, text string) string {
+	if filepath.Ext(file) == ".cpt" {
+		concepts, res := new(parser.ConceptParser).Parse(text, file)
+		c.Assert(res.ParseErrors, HasLen, 0, Commentf("parsing %s", file))
+		dictionary := gauge.NewConceptDictionary()
+		for _, concept := range concepts {
+			dictionary.ConceptsMap[concept.Value] = &gauge.Concept{ConceptStep: concept, FileName: file}
+		}
+		return FormatConcepts(dictionary)[file]
+	}
+	spec, res, err := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary(), file)
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true, Commentf("parsing %s: %v", file, res.ParseErrors))
+	return FormatSpecification(spec)
+}
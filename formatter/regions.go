@@ -0,0 +1,123 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package formatter
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+// Region is a range of lines of a spec, from Start to End inclusive and counted from 1, with its formatted text
+type Region struct {
+	Start int
+	End   int
+	Text  string
+}
+
+// FormatRegions gives the formatted scenarios and data table of the spec which overlap the lines from start to end,
+// the lines that were changed. Only the regions whose text changes are given, from the last to the first, so that
+// they can be replaced in order without moving the lines of the others.
+func FormatRegions(spec *gauge.Specification, text string, start, end int) []*Region {
+	lines := strings.Split(text, newline(text))
+	var regions []*Region
+	if r := dataTableRegion(spec, lines); r != nil && overlaps(r, start, end) {
+		regions = append(regions, r)
+	}
+	tearDownLine := 0
+	for _, item := range spec.Items {
+		if item.Kind() == gauge.TearDownKind {
+			tearDownLine = item.(*gauge.TearDown).LineNo
+		}
+	}
+	for _, scn := range spec.Scenarios {
+		if scn.Span == nil {
+			continue
+		}
+		r := &Region{Start: scn.Span.Start, End: scn.Span.End}
+		if tearDownLine > r.Start && tearDownLine <= r.End {
+			r.End = tearDownLine - 1
+		}
+		if r.End > len(lines) || !overlaps(r, start, end) {
+			continue
+		}
+		r.Text = strings.TrimSuffix(FormatScenario(scn), "\n")
+		regions = append(regions, r)
+	}
+	var changed []*Region
+	for _, r := range regions {
+		if r.Text != strings.Join(lines[r.Start-1:r.End], "\n") {
+			changed = append(changed, r)
+		}
+	}
+	sort.Slice(changed, func(i, j int) bool { return changed[i].Start > changed[j].Start })
+	return changed
+}
+
+// FormatSpecificationRegions gives the text of the spec with only the scenarios and data table which overlap the
+// lines from start to end formatted. The rest of the text is left byte-identical.
+func FormatSpecificationRegions(spec *gauge.Specification, text string, start, end int) string {
+	nl := newline(text)
+	lines := strings.Split(text, nl)
+	for _, r := range FormatRegions(spec, text, start, end) {
+		formatted := strings.Split(r.Text, "\n")
+		lines = append(lines[:r.Start-1], append(formatted, lines[r.End:]...)...)
+	}
+	return strings.Join(lines, nl)
+}
+
+// FormatScenario gives the formatted text of the scenario, its heading and items, as it is written by
+// FormatSpecification
+func FormatScenario(scenario *gauge.Scenario) string {
+	queue := &gauge.ItemQueue{Items: scenario.Items}
+	f := &formatter{itemQueue: queue}
+	f.Heading(scenario.Heading)
+	for queue.Peek() != nil {
+		item := queue.Next()
+		switch item.Kind() {
+		case gauge.StepKind:
+			f.Step(item.(*gauge.Step))
+		case gauge.CommentKind:
+			f.Comment(item.(*gauge.Comment))
+		case gauge.TableKind:
+			f.Table(item.(*gauge.Table))
+		case gauge.TagKind:
+			f.Tags(item.(*gauge.Tags))
+		case gauge.DataTableKind:
+			f.DataTable(item.(*gauge.DataTable))
+		}
+	}
+	return f.buffer.String()
+}
+
+// dataTableRegion gives the lines of the inline data table of the spec, which run from its header while they are
+// table rows
+func dataTableRegion(spec *gauge.Specification, lines []string) *Region {
+	if !spec.DataTable.IsInitialized() || spec.DataTable.IsExternal || spec.DataTable.Table.LineNo < 1 {
+		return nil
+	}
+	r := &Region{Start: spec.DataTable.Table.LineNo}
+	for r.End = r.Start; r.End < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[r.End]), "|"); r.End++ {
+	}
+	if r.Start > len(lines) {
+		return nil
+	}
+	r.Text = strings.TrimSuffix(strings.TrimPrefix(FormatTable(spec.DataTable.Table), "\n"), "\n")
+	return r
+}
+
+func overlaps(r *Region, start, end int) bool {
+	return r.Start <= end && start <= r.End
+}
+
+func newline(text string) string {
+	if strings.Contains(text, "\r\n") {
+		return "\r\n"
+	}
+	return "\n"
+}
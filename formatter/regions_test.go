@@ -0,0 +1,112 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package formatter
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+const regionsSpecText = `# Spec
+  |id|name|
+  |--|----|
+  |1|foo|
+
+* context   step
+
+## First
+*   first    step
+* table step
+  |a|b|
+  |1|22|
+
+## Second
+*   second   step
+
+___
+*   teardown   step
+`
+
+func parseRegionsSpec(c *C, text string) *gauge.Specification {
+	spec, res, err := new(parser.SpecParser).Parse(text, gauge.NewConceptDictionary(), "regions.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	return spec
+}
+
+func (s *MySuite) TestFormatSpecificationRegionsFormatsOnlyTheChangedScenario(c *C) {
+	spec := parseRegionsSpec(c, regionsSpecText)
+
+	got := FormatSpecificationRegions(spec, regionsSpecText, 9, 9)
+
+	c.Assert(got, Equals, `# Spec
+  |id|name|
+  |--|----|
+  |1|foo|
+
+* context   step
+
+## First
+* first    step
+* table step
+
+   |a|b |
+   |-|--|
+   |1|22|
+
+## Second
+*   second   step
+
+___
+*   teardown   step
+`)
+}
+
+func (s *MySuite) TestFormatSpecificationRegionsFormatsTheChangedDataTable(c *C) {
+	spec := parseRegionsSpec(c, regionsSpecText)
+
+	got := FormatSpecificationRegions(spec, regionsSpecText, 3, 3)
+
+	c.Assert(got, Equals, `# Spec
+   |id|name|
+   |--|----|
+   |1 |foo |
+
+* context   step
+
+## First
+*   first    step
+* table step
+  |a|b|
+  |1|22|
+
+## Second
+*   second   step
+
+___
+*   teardown   step
+`)
+}
+
+func (s *MySuite) TestFormatRegionsLeavesTheTearDownOutOfTheLastScenario(c *C) {
+	spec := parseRegionsSpec(c, regionsSpecText)
+
+	regions := FormatRegions(spec, regionsSpecText, 15, 19)
+
+	c.Assert(len(regions), Equals, 1)
+	c.Assert(regions[0].Start, Equals, 14)
+	c.Assert(regions[0].End, Equals, 16)
+	c.Assert(regions[0].Text, Equals, "## Second\n* second   step\n")
+}
+
+func (s *MySuite) TestFormatRegionsGivesNothingForFormattedText(c *C) {
+	text := "# Spec\n\n## Scenario\n\n* step\n"
+	spec := parseRegionsSpec(c, text)
+
+	c.Assert(FormatRegions(spec, text, 1, 5), HasLen, 0)
+}
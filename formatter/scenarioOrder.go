@@ -0,0 +1,79 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package formatter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+)
+
+const (
+	// SortByPriority orders scenarios by the level of their priority tags, the way they are executed
+	SortByPriority = "priority"
+	// SortByName orders scenarios alphabetically by heading
+	SortByName = "name"
+)
+
+// SortScenarios is the order in which the formatter writes the scenarios of a spec. Scenarios keep their order when it is empty.
+var SortScenarios string
+
+// ValidateScenarioOrder returns an error if the order is not one the formatter can sort scenarios by
+func ValidateScenarioOrder(order string) error {
+	if order == "" || order == SortByPriority || order == SortByName {
+		return nil
+	}
+	return fmt.Errorf("invalid scenario order '%s'. Use %s or %s", order, SortByPriority, SortByName)
+}
+
+// sortScenarios reorders the scenarios among the items of the spec. Everything which is not part of a
+// scenario, like contexts and teardown steps, keeps its place.
+func sortScenarios(spec *gauge.Specification, order string) {
+	var scenarios []*gauge.Scenario
+	var positions []int
+	for i, item := range spec.Items {
+		if item.Kind() == gauge.ScenarioKind {
+			scenarios = append(scenarios, item.(*gauge.Scenario))
+			positions = append(positions, i)
+		}
+	}
+	switch order {
+	case SortByPriority:
+		priorities := make(map[*gauge.Scenario]int)
+		for _, scn := range scenarios {
+			priorities[scn] = parser.ScenarioPriority(scn)
+		}
+		sort.SliceStable(scenarios, func(i, j int) bool {
+			pi, pj := priorities[scenarios[i]], priorities[scenarios[j]]
+			return parser.PriorityLess(pi, pj)
+		})
+	case SortByName:
+		sort.SliceStable(scenarios, func(i, j int) bool {
+			return strings.ToLower(scenarios[i].Heading.Value) < strings.ToLower(scenarios[j].Heading.Value)
+		})
+	default:
+		return
+	}
+	for i, scn := range scenarios {
+		if i < len(scenarios)-1 && !endsWithBlankLine(scn) {
+			scn.Items = append(scn.Items, &gauge.Comment{Value: "\n"})
+		}
+		spec.Items[positions[i]] = scn
+	}
+	spec.Scenarios = scenarios
+}
+
+func endsWithBlankLine(scn *gauge.Scenario) bool {
+	if len(scn.Items) == 0 {
+		return false
+	}
+	c, ok := scn.Items[len(scn.Items)-1].(*gauge.Comment)
+	return ok && c.Value == "\n"
+}
@@ -0,0 +1,72 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package formatter
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/tagRegistry"
+)
+
+// NormalizeTags makes the formatter sort and deduplicate tags and use the casing declared in the tag registry
+var NormalizeTags bool
+
+var registry *tagRegistry.Registry
+
+func loadTagRegistry() {
+	r, err := tagRegistry.Load(config.ProjectRoot)
+	if err != nil {
+		logger.Warningf(true, "Tags will not be normalized to the casing of the registry. %s", err.Error())
+	}
+	registry = r
+}
+
+func formatTags(tags *gauge.Tags) string {
+	if NormalizeTags && tags != nil {
+		tags = normalizeTags(tags, registry)
+	}
+	return FormatTags(tags)
+}
+
+// normalizeTags returns the tags on a single line, without duplicates and with the casing of the registry.
+// Priority tags come first, lowest level first, followed by the other tags in alphabetical order.
+func normalizeTags(tags *gauge.Tags, r *tagRegistry.Registry) *gauge.Tags {
+	var values []string
+	seen := make(map[string]bool)
+	for _, t := range tags.Values() {
+		t = r.Canonical(t)
+		key := t
+		if !env.AllowCaseSensitiveTags() {
+			key = strings.ToLower(t)
+		}
+		if !seen[key] {
+			seen[key] = true
+			values = append(values, t)
+		}
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		pi, iok := tagPriority(values[i])
+		pj, jok := tagPriority(values[j])
+		if iok || jok {
+			return iok && (!jok || pi < pj)
+		}
+		return strings.ToLower(values[i]) < strings.ToLower(values[j])
+	})
+	return &gauge.Tags{RawValues: [][]string{values}}
+}
+
+// tagPriority gives the level of a priority tag, such as priority:1 or Priority1, the way the parser reads it to
+// order scenarios
+func tagPriority(tag string) (int, bool) {
+	return parser.PriorityOfTag(tag)
+}
@@ -0,0 +1,40 @@
+Comment above the heading
+
+# Search
+
+tags: search, smoke
+
+First comment of the specification
+Second comment of the specification
+
+
+Comment after two blank lines
+
+* Open the home page
+Comment between context steps
+* Sign in
+
+## Search for a product
+
+Comment before the steps
+
+* Search for "gauge"
+Comment between steps
+* Open the first result
+
+
+* Check the price
+Comment after the steps
+
+
+
+Comment after three blank lines
+
+## Search with no results
+* Search for "nothing"
+
+___
+Comment after the teardown marker
+* Sign out
+
+Comment at the end
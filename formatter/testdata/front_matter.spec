@@ -0,0 +1,11 @@
+---
+owner: checkout-team
+---
+# Checkout
+
+Comment under the heading
+
+## Pay with a card
+
+* Open the cart
+* Pay with card "4111"
@@ -0,0 +1,17 @@
+# Tables
+
+|Word|Count|
+|-|-|
+|gauge|3|
+Comment right after the data table
+
+## Table arguments
+tags: tables
+Comment right after the tags
+* Check the words
+|Word|Count|
+|---|---|
+|gauge|3|
+Comment right after the table argument
+
+* Check the rows
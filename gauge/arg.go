@@ -7,7 +7,9 @@
 package gauge
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 type ArgType string
@@ -18,6 +20,7 @@ const (
 	TableArg             ArgType = "table"
 	SpecialString        ArgType = "special_string"
 	SpecialTable         ArgType = "special_table"
+	MultilineText        ArgType = "multiline_text"
 	ParameterPlaceholder         = "{}"
 )
 
@@ -81,10 +84,13 @@ func (lookup *ArgLookup) ReadDataTableRow(datatable *Table, index int) error {
 	if !datatable.IsInitialized() {
 		return nil
 	}
-	for _, header := range datatable.Headers {
+	cells, err := datatable.Row(index)
+	if err != nil {
+		return err
+	}
+	for i, header := range datatable.Headers {
 		lookup.AddArgName(header)
-		tableCells, _ := datatable.Get(header)
-		err := lookup.AddArgValue(header, &StepArg{Value: tableCells[index].Value, ArgType: Static})
+		err := lookup.AddArgValue(header, &StepArg{Value: cells[i].Value, ArgType: Static})
 		if err != nil {
 			return err
 		}
@@ -105,6 +111,34 @@ func (lookup *ArgLookup) FromDataTables(tables ...*Table) *ArgLookup {
 	return dataTableLookup
 }
 
+// jsonParam is a param of a lookup as written in JSON, the lookup keeping its params unexported
+type jsonParam struct {
+	Name string
+	Arg  *StepArg
+}
+
+// MarshalJSON writes the params of the lookup in their order along with their values
+func (lookup ArgLookup) MarshalJSON() ([]byte, error) {
+	params := make([]jsonParam, len(lookup.paramValue))
+	for i, param := range lookup.paramValue {
+		params[i] = jsonParam{Name: param.name, Arg: param.stepArg}
+	}
+	return json.Marshal(params)
+}
+
+// UnmarshalJSON adds the params written by MarshalJSON to the lookup
+func (lookup *ArgLookup) UnmarshalJSON(b []byte) error {
+	var params []jsonParam
+	if err := json.Unmarshal(b, &params); err != nil {
+		return err
+	}
+	for _, param := range params {
+		lookup.AddArgName(param.Name)
+		lookup.paramValue[len(lookup.paramValue)-1].stepArg = param.Arg
+	}
+	return nil
+}
+
 type paramNameValue struct {
 	name    string
 	stepArg *StepArg
@@ -119,6 +153,9 @@ type StepArg struct {
 	Value   string
 	ArgType ArgType
 	Table   Table
+	// ParamType is the type declared after the arg in the step text, like the int of "5"{int}. The parameters sent to
+	// runners have no field for it, runners read it after the parameter in the actual text of the step.
+	ParamType string
 }
 
 func (stepArg *StepArg) String() string {
@@ -133,10 +170,24 @@ func (stepArg *StepArg) ArgValue() string {
 		return "table"
 	case SpecialString, SpecialTable:
 		return stepArg.Name
+	case MultilineText:
+		return string(MultilineText)
 	}
 	return ""
 }
 
+// FencedText gives the multiline text as written after a step, between fences of backticks. The fence is longer than
+// any run of backticks in the text and carries the info string of the text, which the name of the arg holds after
+// "text:", like the json of text:json.
+func (stepArg *StepArg) FencedText() string {
+	fence := "```"
+	for strings.Contains(stepArg.Value, fence) {
+		fence += "`"
+	}
+	info := strings.TrimPrefix(strings.TrimPrefix(stepArg.Name, "text"), ":")
+	return fmt.Sprintf("%s%s\n%s\n%s\n", fence, info, stepArg.Value, fence)
+}
+
 type ExecutionArg struct {
 	Name  string
 	Value []string
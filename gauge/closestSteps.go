@@ -0,0 +1,71 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import "sort"
+
+const maxClosestSteps = 3
+
+// ClosestStepValues gives up to three of the step values which are the fewest edits away from the given step value,
+// closest first. Step values have their parameters replaced by {}, so parameters are ignored. Step values which are
+// too far apart, more than a third of the length of the step value, are left out.
+func ClosestStepValues(stepValue string, stepValues []StepValue) []StepValue {
+	type candidate struct {
+		value    StepValue
+		distance int
+	}
+	maxDistance := len([]rune(stepValue)) / 3
+	seen := make(map[string]bool)
+	var candidates []candidate
+	for _, sv := range stepValues {
+		if seen[sv.StepValue] {
+			continue
+		}
+		seen[sv.StepValue] = true
+		if d := editDistance(stepValue, sv.StepValue); d > 0 && d <= maxDistance {
+			candidates = append(candidates, candidate{sv, d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+	closest := make([]StepValue, 0, maxClosestSteps)
+	for i := 0; i < len(candidates) && i < maxClosestSteps; i++ {
+		closest = append(closest, candidates[i].value)
+	}
+	return closest
+}
+
+// editDistance is the Levenshtein distance between two strings, counted in runes
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	prev := make([]int, len(t)+1)
+	cur := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		cur[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			cur[j] = minOf(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(t)]
+}
+
+func minOf(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,37 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import . "gopkg.in/check.v1"
+
+func (s *MySuite) TestClosestStepValues(c *C) {
+	stepValues := []StepValue{
+		{StepValue: "Pay with card {}", ParameterizedStepValue: "Pay with card <number>"},
+		{StepValue: "Pay with cards {}"},
+		{StepValue: "Pay with card {}"},
+		{StepValue: "Add {} items to the basket"},
+		{StepValue: "Pay with voucher {}"},
+	}
+
+	closest := ClosestStepValues("Pay with crad {}", stepValues)
+
+	c.Assert(len(closest), Equals, 2)
+	c.Assert(closest[0].ParameterizedStepValue, Equals, "Pay with card <number>")
+	c.Assert(closest[1].StepValue, Equals, "Pay with cards {}")
+}
+
+func (s *MySuite) TestClosestStepValuesLeavesOutExactAndDistantSteps(c *C) {
+	stepValues := []StepValue{{StepValue: "Open the shop"}, {StepValue: "Close the shop"}}
+
+	c.Assert(ClosestStepValues("Open the shop", stepValues), DeepEquals, []StepValue{})
+}
+
+func (s *MySuite) TestEditDistance(c *C) {
+	c.Assert(editDistance("kitten", "sitting"), Equals, 3)
+	c.Assert(editDistance("", "abc"), Equals, 3)
+	c.Assert(editDistance("café", "cafe"), Equals, 1)
+}
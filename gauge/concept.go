@@ -6,6 +6,12 @@
 
 package gauge
 
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
 type ConceptDictionary struct {
 	ConceptsMap     map[string]*Concept
 	constructionMap map[string][]*Step
@@ -16,23 +22,64 @@ type Concept struct {
 	FileName    string
 }
 
+// ConceptNamespaceSeparator separates the namespace of a concept from its step value in a qualified step, like the /
+// of "payments/refund order"
+const ConceptNamespaceSeparator = "/"
+
+// ConceptKey gives the key of the concept of the heading in a ConceptDictionary, which is its step value qualified by
+// the namespace of its concept file, if the file has one
+func ConceptKey(conceptStep *Step) string {
+	return QualifiedStepValue(conceptStep.Namespace, conceptStep.Value)
+}
+
+// QualifiedStepValue gives the step value prefixed by the namespace, like "payments/refund order"
+func QualifiedStepValue(namespace, stepValue string) string {
+	if namespace == "" {
+		return stepValue
+	}
+	return namespace + ConceptNamespaceSeparator + stepValue
+}
+
 func NewConceptDictionary() *ConceptDictionary {
 	return &ConceptDictionary{ConceptsMap: make(map[string]*Concept), constructionMap: make(map[string][]*Step)}
 }
 
+// Search gives the concept of the step value. A step value qualified by a namespace, like "payments/refund order",
+// gives the concept of that namespace. Any other step value gives the concept without a namespace, or else the concept
+// of the one namespace defining it, and nothing if several namespaces do.
 func (dict *ConceptDictionary) Search(stepValue string) *Concept {
 	if concept, ok := dict.ConceptsMap[stepValue]; ok {
 		return concept
 	}
-	return nil
+	var found *Concept
+	for _, concept := range dict.ConceptsMap {
+		if concept.ConceptStep.Namespace != "" && concept.ConceptStep.Value == stepValue {
+			if found != nil {
+				return nil
+			}
+			found = concept
+		}
+	}
+	return found
+}
+
+// SearchFrom gives the concept of a step used in a concept of the namespace, which is the concept of the same
+// namespace if there is one
+func (dict *ConceptDictionary) SearchFrom(namespace, stepValue string) *Concept {
+	if namespace != "" {
+		if concept, ok := dict.ConceptsMap[QualifiedStepValue(namespace, stepValue)]; ok {
+			return concept
+		}
+	}
+	return dict.Search(stepValue)
 }
 
 func (dict *ConceptDictionary) ReplaceNestedConceptSteps(conceptStep *Step) error {
-	if err := dict.updateStep(conceptStep); err != nil {
+	if err := dict.updateStep(ConceptKey(conceptStep), conceptStep); err != nil {
 		return err
 	}
 	for i, stepInsideConcept := range conceptStep.ConceptSteps {
-		if nestedConcept := dict.Search(stepInsideConcept.Value); nestedConcept != nil {
+		if nestedConcept := dict.SearchFrom(conceptStep.Namespace, stepInsideConcept.Value); nestedConcept != nil {
 			//replace step with actual concept
 			conceptStep.ConceptSteps[i].ConceptSteps = nestedConcept.ConceptStep.ConceptSteps
 			conceptStep.ConceptSteps[i].IsConcept = nestedConcept.ConceptStep.IsConcept
@@ -42,21 +89,25 @@ func (dict *ConceptDictionary) ReplaceNestedConceptSteps(conceptStep *Step) erro
 			}
 			conceptStep.ConceptSteps[i].Lookup = *lookupCopy
 		} else {
-			if err := dict.updateStep(stepInsideConcept); err != nil {
+			if err := dict.updateStep(stepInsideConcept.Value, stepInsideConcept); err != nil {
 				return err
 			}
-
+			if conceptStep.Namespace != "" {
+				if err := dict.updateStep(QualifiedStepValue(conceptStep.Namespace, stepInsideConcept.Value), stepInsideConcept); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
 }
 
 //mutates the step with concept steps so that anyone who is referencing the step will now refer a concept
-func (dict *ConceptDictionary) updateStep(step *Step) error {
-	dict.constructionMap[step.Value] = append(dict.constructionMap[step.Value], step)
-	if !dict.constructionMap[step.Value][0].IsConcept {
-		dict.constructionMap[step.Value] = append(dict.constructionMap[step.Value], step)
-		for _, allSteps := range dict.constructionMap[step.Value] {
+func (dict *ConceptDictionary) updateStep(key string, step *Step) error {
+	dict.constructionMap[key] = append(dict.constructionMap[key], step)
+	if !dict.constructionMap[key][0].IsConcept {
+		dict.constructionMap[key] = append(dict.constructionMap[key], step)
+		for _, allSteps := range dict.constructionMap[key] {
 			allSteps.IsConcept = step.IsConcept
 			allSteps.ConceptSteps = step.ConceptSteps
 			lookupCopy, err := step.Lookup.GetCopy()
@@ -73,7 +124,7 @@ func (dict *ConceptDictionary) UpdateLookupForNestedConcepts() error {
 	for _, concept := range dict.ConceptsMap {
 		for _, stepInsideConcept := range concept.ConceptStep.ConceptSteps {
 			stepInsideConcept.Parent = concept.ConceptStep
-			if nestedConcept := dict.Search(stepInsideConcept.Value); nestedConcept != nil {
+			if nestedConcept := dict.SearchFrom(concept.ConceptStep.Namespace, stepInsideConcept.Value); nestedConcept != nil {
 				for i, arg := range nestedConcept.ConceptStep.Args {
 					stepArg := StepArg{ArgType: stepInsideConcept.Args[i].ArgType, Value: stepInsideConcept.Args[i].Value, Table: stepInsideConcept.Args[i].Table}
 					if err := stepInsideConcept.Lookup.AddArgValue(arg.Value, &stepArg); err != nil {
@@ -86,9 +137,125 @@ func (dict *ConceptDictionary) UpdateLookupForNestedConcepts() error {
 	return nil
 }
 
-func (dict *ConceptDictionary) Remove(stepValue string) {
-	delete(dict.ConceptsMap, stepValue)
-	delete(dict.constructionMap, stepValue)
+// ConceptTags returns the tags of the concepts used by the given steps, including the ones of nested concepts
+func (dict *ConceptDictionary) ConceptTags(steps []*Step) []string {
+	return dict.conceptTags("", steps, make(map[string]bool))
+}
+
+func (dict *ConceptDictionary) conceptTags(namespace string, steps []*Step, visited map[string]bool) (tags []string) {
+	for _, step := range steps {
+		concept := dict.SearchFrom(namespace, step.Value)
+		if concept == nil || visited[ConceptKey(concept.ConceptStep)] {
+			continue
+		}
+		visited[ConceptKey(concept.ConceptStep)] = true
+		if concept.ConceptStep.Tags != nil {
+			tags = append(tags, concept.ConceptStep.Tags.Values()...)
+		}
+		tags = append(tags, dict.conceptTags(concept.ConceptStep.Namespace, concept.ConceptStep.ConceptSteps, visited)...)
+	}
+	return
+}
+
+// ConceptQuery selects concepts of a dictionary. A concept is selected when it matches all the fields which are set.
+type ConceptQuery struct {
+	// File is the concept file the concept is defined in
+	File string
+	// Tag is a tag of the concept heading, matched ignoring case
+	Tag string
+	// Param is the name of a parameter of the concept heading, like name for <name>
+	Param string
+	// Uses is the step value of a step or concept which the concept uses directly
+	Uses string
+}
+
+// Query gives the concepts matching the query, ordered by file and line number
+func (dict *ConceptDictionary) Query(q ConceptQuery) []*Concept {
+	var concepts []*Concept
+	for _, c := range dict.ConceptsMap {
+		if q.matches(c) {
+			concepts = append(concepts, c)
+		}
+	}
+	sort.Slice(concepts, func(i, j int) bool {
+		if concepts[i].FileName != concepts[j].FileName {
+			return concepts[i].FileName < concepts[j].FileName
+		}
+		return concepts[i].ConceptStep.LineNo < concepts[j].ConceptStep.LineNo
+	})
+	return concepts
+}
+
+// ConceptsInFile gives the concepts defined in the concept file
+func (dict *ConceptDictionary) ConceptsInFile(file string) []*Concept {
+	return dict.Query(ConceptQuery{File: file})
+}
+
+// ConceptsWithTag gives the concepts whose heading has the tag
+func (dict *ConceptDictionary) ConceptsWithTag(tag string) []*Concept {
+	return dict.Query(ConceptQuery{Tag: tag})
+}
+
+// ConceptsWithParam gives the concepts whose heading has the parameter
+func (dict *ConceptDictionary) ConceptsWithParam(name string) []*Concept {
+	return dict.Query(ConceptQuery{Param: name})
+}
+
+// ConceptsUsing gives the concepts which use the step or concept of the step value directly
+func (dict *ConceptDictionary) ConceptsUsing(stepValue string) []*Concept {
+	return dict.Query(ConceptQuery{Uses: stepValue})
+}
+
+func (q ConceptQuery) matches(c *Concept) bool {
+	if q.File != "" && filepath.Clean(q.File) != filepath.Clean(c.FileName) {
+		return false
+	}
+	if q.Tag != "" && !c.hasTag(q.Tag) {
+		return false
+	}
+	if q.Param != "" && !c.hasParam(q.Param) {
+		return false
+	}
+	if q.Uses != "" && !c.uses(q.Uses) {
+		return false
+	}
+	return true
+}
+
+func (c *Concept) hasTag(tag string) bool {
+	if c.ConceptStep.Tags == nil {
+		return false
+	}
+	for _, t := range c.ConceptStep.Tags.Values() {
+		if strings.EqualFold(strings.TrimSpace(t), strings.TrimSpace(tag)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Concept) hasParam(name string) bool {
+	for _, arg := range c.ConceptStep.Args {
+		if arg.ArgType == Dynamic && arg.Value == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Concept) uses(stepValue string) bool {
+	for _, step := range c.ConceptStep.ConceptSteps {
+		if step.Value == stepValue {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes the concept of the key, which is the step value qualified by the namespace of the concept
+func (dict *ConceptDictionary) Remove(key string) {
+	delete(dict.ConceptsMap, key)
+	delete(dict.constructionMap, key)
 }
 
 type ByLineNo []*Concept
@@ -0,0 +1,69 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func conceptsForQuery() *ConceptDictionary {
+	dict := NewConceptDictionary()
+	login := &Concept{FileName: "concepts/login.cpt", ConceptStep: &Step{
+		Value:        "Login as {}",
+		LineNo:       5,
+		Args:         []*StepArg{{Value: "user", ArgType: Dynamic}},
+		Tags:         &Tags{RawValues: [][]string{{"Auth", "smoke"}}},
+		ConceptSteps: []*Step{{Value: "Open the login page"}, {Value: "Enter {}"}},
+	}}
+	logout := &Concept{FileName: "concepts/login.cpt", ConceptStep: &Step{
+		Value:        "Logout",
+		LineNo:       1,
+		ConceptSteps: []*Step{{Value: "Open the login page"}},
+	}}
+	order := &Concept{FileName: "concepts/order.cpt", ConceptStep: &Step{
+		Value:        "Order {} as {}",
+		LineNo:       1,
+		Args:         []*StepArg{{Value: "item", ArgType: Dynamic}, {Value: "user", ArgType: Dynamic}},
+		Tags:         &Tags{RawValues: [][]string{{"smoke"}}},
+		ConceptSteps: []*Step{{Value: "Login as {}"}, {Value: "Add {} to the cart"}},
+	}}
+	for _, c := range []*Concept{login, logout, order} {
+		dict.ConceptsMap[c.ConceptStep.Value] = c
+	}
+	return dict
+}
+
+func values(concepts []*Concept) []string {
+	var v []string
+	for _, c := range concepts {
+		v = append(v, c.ConceptStep.Value)
+	}
+	return v
+}
+
+func (s *MySuite) TestConceptsInFile(c *C) {
+	c.Assert(values(conceptsForQuery().ConceptsInFile("concepts/login.cpt")), DeepEquals, []string{"Logout", "Login as {}"})
+}
+
+func (s *MySuite) TestConceptsWithTagIgnoresCase(c *C) {
+	c.Assert(values(conceptsForQuery().ConceptsWithTag("auth")), DeepEquals, []string{"Login as {}"})
+}
+
+func (s *MySuite) TestConceptsWithParam(c *C) {
+	c.Assert(values(conceptsForQuery().ConceptsWithParam("user")), DeepEquals, []string{"Login as {}", "Order {} as {}"})
+}
+
+func (s *MySuite) TestConceptsUsing(c *C) {
+	c.Assert(values(conceptsForQuery().ConceptsUsing("Login as {}")), DeepEquals, []string{"Order {} as {}"})
+	c.Assert(values(conceptsForQuery().ConceptsUsing("Open the login page")), DeepEquals, []string{"Logout", "Login as {}"})
+}
+
+func (s *MySuite) TestQueryMatchesAllFields(c *C) {
+	got := conceptsForQuery().Query(ConceptQuery{Tag: "smoke", Param: "user", File: "concepts/order.cpt"})
+
+	c.Assert(values(got), DeepEquals, []string{"Order {} as {}"})
+}
@@ -0,0 +1,182 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	"strings"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+)
+
+// ConvertFromProtoSpec gives the specification of the proto, the reverse of ConvertToProtoSpec. The items of the proto
+// are kept as the items of the specification, and its steps before the first scenario are its contexts, the steps
+// after the teardown marker its teardown steps. The data table is the first table of the proto.
+// Line numbers, stable IDs and annotations are not part of the proto and are not set.
+func ConvertFromProtoSpec(protoSpec *gauge_messages.ProtoSpec) *Specification {
+	if protoSpec == nil {
+		return nil
+	}
+	spec := &Specification{Heading: &Heading{Value: protoSpec.GetSpecHeading(), HeadingType: SpecHeading}, FileName: protoSpec.GetFileName()}
+	if len(protoSpec.GetTags()) > 0 {
+		spec.Tags = &Tags{RawValues: [][]string{protoSpec.GetTags()}}
+	}
+	inTearDown := false
+	for _, protoItem := range protoSpec.GetItems() {
+		switch protoItem.GetItemType() {
+		case gauge_messages.ProtoItem_Comment:
+			if isTearDownMarker(protoItem.GetComment().GetText()) {
+				inTearDown = true
+				spec.Items = append(spec.Items, &TearDown{Value: strings.TrimSpace(protoItem.GetComment().GetText())})
+				continue
+			}
+			comment := &Comment{Value: protoItem.GetComment().GetText()}
+			spec.Comments = append(spec.Comments, comment)
+			spec.Items = append(spec.Items, comment)
+		case gauge_messages.ProtoItem_Tags:
+			if spec.Tags == nil {
+				spec.Tags = &Tags{RawValues: [][]string{protoItem.GetTags().GetTags()}}
+			}
+			spec.Items = append(spec.Items, spec.Tags)
+		case gauge_messages.ProtoItem_Table:
+			if !spec.DataTable.IsInitialized() {
+				spec.DataTable = DataTable{Table: ConvertFromProtoTable(protoItem.GetTable())}
+				spec.Items = append(spec.Items, &spec.DataTable)
+			}
+		case gauge_messages.ProtoItem_Step, gauge_messages.ProtoItem_Concept:
+			step := convertFromProtoStepItem(protoItem)
+			if inTearDown {
+				spec.TearDownSteps = append(spec.TearDownSteps, step)
+			} else {
+				spec.Contexts = append(spec.Contexts, step)
+			}
+			spec.Items = append(spec.Items, step)
+		case gauge_messages.ProtoItem_Scenario:
+			scenario := ConvertFromProtoScenario(protoItem.GetScenario())
+			spec.Scenarios = append(spec.Scenarios, scenario)
+			spec.Items = append(spec.Items, scenario)
+		}
+	}
+	return spec
+}
+
+// ConvertFromProtoScenario gives the scenario of the proto, the reverse of ConvertToProtoScenario. The items of the
+// proto are kept as the items of the scenario, and a concept is a step with its concept steps.
+func ConvertFromProtoScenario(protoScenario *gauge_messages.ProtoScenario) *Scenario {
+	if protoScenario == nil {
+		return nil
+	}
+	scenario := &Scenario{Heading: &Heading{Value: protoScenario.GetScenarioHeading(), HeadingType: ScenarioHeading}}
+	if len(protoScenario.GetTags()) > 0 {
+		scenario.Tags = &Tags{RawValues: [][]string{protoScenario.GetTags()}}
+	}
+	if span := protoScenario.GetSpan(); span != nil {
+		scenario.Span = &Span{Start: int(span.GetStart()), End: int(span.GetEnd())}
+	}
+	inTearDown := false
+	for _, protoItem := range protoScenario.GetScenarioItems() {
+		switch protoItem.GetItemType() {
+		case gauge_messages.ProtoItem_Comment:
+			if strings.EqualFold(strings.TrimSpace(protoItem.GetComment().GetText()), ScenarioTearDownMarker) {
+				inTearDown = true
+				scenario.AddTearDown(&TearDown{Value: strings.TrimSpace(protoItem.GetComment().GetText())})
+				continue
+			}
+			comment := &Comment{Value: protoItem.GetComment().GetText()}
+			scenario.Comments = append(scenario.Comments, comment)
+			scenario.Items = append(scenario.Items, comment)
+		case gauge_messages.ProtoItem_Tags:
+			if scenario.Tags == nil {
+				scenario.Tags = &Tags{RawValues: [][]string{protoItem.GetTags().GetTags()}}
+			}
+			scenario.Items = append(scenario.Items, scenario.Tags)
+		case gauge_messages.ProtoItem_Table:
+			scenario.DataTable = DataTable{Table: ConvertFromProtoTable(protoItem.GetTable())}
+			scenario.Items = append(scenario.Items, &scenario.DataTable)
+		case gauge_messages.ProtoItem_Step, gauge_messages.ProtoItem_Concept:
+			step := convertFromProtoStepItem(protoItem)
+			if inTearDown {
+				scenario.TearDownSteps = append(scenario.TearDownSteps, step)
+			} else {
+				scenario.Steps = append(scenario.Steps, step)
+			}
+			scenario.Items = append(scenario.Items, step)
+		}
+	}
+	return scenario
+}
+
+func convertFromProtoStepItem(protoItem *gauge_messages.ProtoItem) *Step {
+	if protoItem.GetItemType() != gauge_messages.ProtoItem_Concept {
+		return ConvertFromProtoStep(protoItem.GetStep())
+	}
+	concept := ConvertFromProtoStep(protoItem.GetConcept().GetConceptStep())
+	concept.IsConcept = true
+	for _, item := range protoItem.GetConcept().GetSteps() {
+		step := convertFromProtoStepItem(item)
+		step.Parent = concept
+		concept.ConceptSteps = append(concept.ConceptSteps, step)
+		concept.Items = append(concept.Items, step)
+	}
+	return concept
+}
+
+// ConvertFromProtoStep gives the step of the proto, the reverse of ConvertToProtoStep. The args of the step are the
+// parameters of its fragments.
+func ConvertFromProtoStep(protoStep *gauge_messages.ProtoStep) *Step {
+	if protoStep == nil {
+		return nil
+	}
+	step := &Step{Value: protoStep.GetParsedText(), LineText: protoStep.GetActualText(), Fragments: makeFragmentsCopy(protoStep.GetFragments())}
+	for _, fragment := range protoStep.GetFragments() {
+		if fragment.GetFragmentType() != gauge_messages.Fragment_Parameter {
+			continue
+		}
+		arg := convertFromProtoParameter(fragment.GetParameter())
+		if arg.ArgType == TableArg {
+			step.HasInlineTable = true
+		}
+		step.Args = append(step.Args, arg)
+	}
+	return step
+}
+
+func convertFromProtoParameter(parameter *gauge_messages.Parameter) *StepArg {
+	arg := &StepArg{Name: parameter.GetName(), Value: parameter.GetValue()}
+	switch parameter.GetParameterType() {
+	case gauge_messages.Parameter_Static:
+		arg.ArgType = Static
+	case gauge_messages.Parameter_Dynamic:
+		arg.ArgType = Dynamic
+	case gauge_messages.Parameter_Table:
+		arg.ArgType = TableArg
+		arg.Table = *ConvertFromProtoTable(parameter.GetTable())
+	case gauge_messages.Parameter_Special_String:
+		arg.ArgType = SpecialString
+	case gauge_messages.Parameter_Special_Table:
+		arg.ArgType = SpecialTable
+		arg.Table = *ConvertFromProtoTable(parameter.GetTable())
+	}
+	return arg
+}
+
+// ConvertFromProtoTable gives the table of the proto, the reverse of ConvertToProtoTable
+func ConvertFromProtoTable(protoTable *gauge_messages.ProtoTable) *Table {
+	table := &Table{}
+	if protoTable == nil {
+		return table
+	}
+	table.AddHeaders(protoTable.GetHeaders().GetCells())
+	for _, row := range protoTable.GetRows() {
+		table.AddRowValues(table.CreateTableCells(row.GetCells()))
+	}
+	return table
+}
+
+func isTearDownMarker(text string) bool {
+	text = strings.TrimSpace(text)
+	return len(text) >= len(tearDownMarker) && strings.Trim(text, "_") == ""
+}
@@ -0,0 +1,87 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	. "gopkg.in/check.v1"
+)
+
+func specBuiltInCode() *Specification {
+	table := &Table{}
+	table.AddHeaders([]string{"id", "name"})
+	table.AddRowValues(table.CreateTableCells([]string{"1", "apple"}))
+	return &Specification{
+		Heading:  &Heading{Value: "Checkout", HeadingType: SpecHeading},
+		Tags:     &Tags{RawValues: [][]string{{"cart", "smoke"}}},
+		Contexts: []*Step{{Value: "Open the shop"}},
+		Scenarios: []*Scenario{{
+			Heading: &Heading{Value: "Pay by card", HeadingType: ScenarioHeading},
+			Tags:    &Tags{RawValues: [][]string{{"card"}}},
+			Steps: []*Step{
+				{Value: "Pay {} with {}", Args: []*StepArg{{Value: "10", ArgType: Static}, {Value: "card", Name: "card", ArgType: Dynamic}}},
+				{Value: "Check the items {}", Args: []*StepArg{{ArgType: TableArg, Table: *table}}},
+			},
+		}},
+		TearDownSteps: []*Step{{Value: "Close the shop"}},
+	}
+}
+
+func (s *MySuite) TestConvertToProtoStepGivesFragmentsOfStepBuiltInCode(c *C) {
+	step := &Step{Value: "Pay {} with {}", Args: []*StepArg{{Value: "10", ArgType: Static}, {Value: "card", Name: "card", ArgType: Dynamic}}}
+
+	fragments := ConvertToProtoStep(step).Fragments
+
+	c.Assert(len(fragments), Equals, 4)
+	c.Assert(fragments[0].Text, Equals, "Pay ")
+	c.Assert(fragments[1].Parameter.ParameterType, Equals, gauge_messages.Parameter_Static)
+	c.Assert(fragments[1].Parameter.Value, Equals, "10")
+	c.Assert(fragments[2].Text, Equals, " with ")
+	c.Assert(fragments[3].Parameter.ParameterType, Equals, gauge_messages.Parameter_Dynamic)
+	c.Assert(fragments[3].Parameter.Name, Equals, "card")
+}
+
+func (s *MySuite) TestConvertFromProtoStep(c *C) {
+	step := ConvertFromProtoStep(ConvertToProtoStep(specBuiltInCode().Scenarios[0].Steps[1]))
+
+	c.Assert(step.Value, Equals, "Check the items {}")
+	c.Assert(step.HasInlineTable, Equals, true)
+	c.Assert(len(step.Args), Equals, 1)
+	c.Assert(step.Args[0].ArgType, Equals, TableArg)
+	c.Assert(step.Args[0].Table.Headers, DeepEquals, []string{"id", "name"})
+	c.Assert(step.Args[0].Table.Rows(), DeepEquals, [][]string{{"1", "apple"}})
+}
+
+func (s *MySuite) TestConvertFromProtoSpecRoundTripsSpecBuiltInCode(c *C) {
+	spec := specBuiltInCode()
+
+	got := ConvertFromProtoSpec(ConvertToProtoSpec(spec))
+
+	c.Assert(got.Heading.Value, Equals, "Checkout")
+	c.Assert(got.Tags.Values(), DeepEquals, []string{"cart", "smoke"})
+	c.Assert(len(got.Contexts), Equals, 1)
+	c.Assert(len(got.Scenarios), Equals, 1)
+	c.Assert(got.Scenarios[0].Tags.Values(), DeepEquals, []string{"card"})
+	c.Assert(len(got.TearDownSteps), Equals, 1)
+	c.Assert(got.Scenarios[0].Steps[0].Value, Equals, "Pay {} with {}")
+	c.Assert(got.Scenarios[0].Steps[0].Args, DeepEquals, spec.Scenarios[0].Steps[0].Args)
+	c.Assert(got.Scenarios[0].Steps[1].Args[0].Table.Rows(), DeepEquals, [][]string{{"1", "apple"}})
+	c.Assert(got.TearDownSteps[0].Value, Equals, "Close the shop")
+}
+
+func (s *MySuite) TestConvertFromProtoScenarioKeepsConcepts(c *C) {
+	concept := &Step{Value: "Login as {}", IsConcept: true, Args: []*StepArg{{Value: "admin", ArgType: Static}}, ConceptSteps: []*Step{{Value: "Open the login page"}}}
+	scenario := &Scenario{Heading: &Heading{Value: "Login", HeadingType: ScenarioHeading}, Steps: []*Step{concept}}
+
+	got := ConvertFromProtoScenario(ConvertToProtoScenario(scenario))
+
+	c.Assert(got.Heading.Value, Equals, "Login")
+	c.Assert(got.Steps[0].IsConcept, Equals, true)
+	c.Assert(got.Steps[0].Args[0].Value, Equals, "admin")
+	c.Assert(got.Steps[0].ConceptSteps[0].Value, Equals, "Open the login page")
+	c.Assert(got.Steps[0].ConceptSteps[0].Parent, Equals, got.Steps[0])
+}
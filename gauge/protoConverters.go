@@ -7,6 +7,7 @@
 package gauge
 
 import (
+	"strings"
 	"time"
 
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
@@ -51,9 +52,19 @@ func convertToProtoStepItems(steps []*Step) []*gauge_messages.ProtoItem {
 	return protoItems
 }
 
+// ConvertToProtoScenario gives the proto of the scenario with its items. A scenario built in code, which has no items,
+// is given its tags and steps as items.
+func ConvertToProtoScenario(scenario *Scenario) *gauge_messages.ProtoScenario {
+	return convertToProtoScenarioItem(scenario).Scenario
+}
+
 func convertToProtoScenarioItem(scenario *Scenario) *gauge_messages.ProtoItem {
 	scenarioItems := make([]*gauge_messages.ProtoItem, 0)
-	for _, item := range scenario.Items {
+	items := scenario.Items
+	if len(items) == 0 {
+		items = scenario.modelItems()
+	}
+	for _, item := range items {
 		scenarioItems = append(scenarioItems, ConvertToProtoItem(item))
 	}
 	protoScenario := NewProtoScenario(scenario)
@@ -67,8 +78,39 @@ func convertToProtoConcept(concept *Step) *gauge_messages.ProtoItem {
 	return protoConceptItem
 }
 
+// ConvertToProtoStep gives the proto of the step. A step built in code, which has no fragments, is given the
+// fragments of its value and args.
+func ConvertToProtoStep(step *Step) *gauge_messages.ProtoStep {
+	protoStep := convertToProtoStep(step)
+	if len(protoStep.Fragments) == 0 {
+		protoStep.Fragments = fragmentsOf(step)
+	}
+	return protoStep
+}
+
 func convertToProtoStep(step *Step) *gauge_messages.ProtoStep {
-	return &gauge_messages.ProtoStep{ActualText: step.LineText, ParsedText: step.Value, Fragments: makeFragmentsCopy(step.Fragments)}
+	fragments := step.Fragments
+	if len(fragments) == 0 && len(step.Args) > 0 {
+		fragments = fragmentsOf(step)
+	}
+	return &gauge_messages.ProtoStep{ActualText: step.LineText, ParsedText: step.Value, Fragments: makeFragmentsCopy(fragments)}
+}
+
+// fragmentsOf gives the fragments of the value of the step, its text and the parameters of its args
+func fragmentsOf(step *Step) []*gauge_messages.Fragment {
+	var fragments []*gauge_messages.Fragment
+	texts := strings.Split(step.Value, ParameterPlaceholder)
+	for i, text := range texts {
+		if text != "" {
+			fragments = append(fragments, &gauge_messages.Fragment{FragmentType: gauge_messages.Fragment_Text, Text: text})
+		}
+		if i < len(texts)-1 && i < len(step.Args) {
+			if p := convertToProtoParameter(step.Args[i]); p != nil {
+				fragments = append(fragments, &gauge_messages.Fragment{FragmentType: gauge_messages.Fragment_Parameter, Parameter: p})
+			}
+		}
+	}
+	return fragments
 }
 
 func convertToProtoTags(tags *Tags) *gauge_messages.ProtoTags {
@@ -140,7 +182,7 @@ func convertToProtoParameter(arg *StepArg) *gauge_messages.Parameter {
 		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Dynamic, Value: arg.Value, Name: arg.Name}
 	case TableArg:
 		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Table, Table: ConvertToProtoTable(&arg.Table), Name: arg.Name}
-	case SpecialString:
+	case SpecialString, MultilineText:
 		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_String, Value: arg.Value, Name: arg.Name}
 	case SpecialTable:
 		return &gauge_messages.Parameter{ParameterType: gauge_messages.Parameter_Special_Table, Table: ConvertToProtoTable(&arg.Table), Name: arg.Name}
@@ -246,13 +288,61 @@ func ConvertToProtoSpec(spec *Specification) *gauge_messages.ProtoSpec {
 		protoSpec.IsTableDriven = true
 	}
 	var protoItems []*gauge_messages.ProtoItem
-	for _, item := range spec.Items {
+	items := spec.Items
+	if len(items) == 0 {
+		items = spec.protoModelItems()
+	}
+	for _, item := range items {
 		protoItems = append(protoItems, ConvertToProtoItem(item))
 	}
 	protoSpec.Items = protoItems
 	return protoSpec
 }
 
+// protoModelItems gives the items of a specification built in code: its tags, data table, contexts, scenarios and
+// teardown steps after the teardown marker
+func (spec *Specification) protoModelItems() []Item {
+	var items []Item
+	if spec.Tags != nil && len(spec.Tags.RawValues) > 0 {
+		items = append(items, spec.Tags)
+	}
+	if spec.DataTable.IsInitialized() {
+		items = append(items, &spec.DataTable)
+	}
+	for _, step := range spec.Contexts {
+		items = append(items, step)
+	}
+	for _, scn := range spec.Scenarios {
+		items = append(items, scn)
+	}
+	if len(spec.TearDownSteps) > 0 {
+		items = append(items, &TearDown{Value: tearDownMarker})
+		for _, step := range spec.TearDownSteps {
+			items = append(items, step)
+		}
+	}
+	return items
+}
+
+// modelItems gives the items of a scenario built in code, its tags and steps and its teardown steps after the
+// teardown marker
+func (scenario *Scenario) modelItems() []Item {
+	var items []Item
+	if scenario.Tags != nil && len(scenario.Tags.RawValues) > 0 {
+		items = append(items, scenario.Tags)
+	}
+	for _, step := range scenario.Steps {
+		items = append(items, step)
+	}
+	if len(scenario.TearDownSteps) > 0 {
+		items = append(items, &TearDown{Value: ScenarioTearDownMarker})
+		for _, step := range scenario.TearDownSteps {
+			items = append(items, step)
+		}
+	}
+	return items
+}
+
 func ConvertToProtoStepValue(stepValue *StepValue) *gauge_messages.ProtoStepValue {
 	return &gauge_messages.ProtoStepValue{
 		StepValue:              stepValue.StepValue,
@@ -262,9 +352,13 @@ func ConvertToProtoStepValue(stepValue *StepValue) *gauge_messages.ProtoStepValu
 }
 
 func newProtoSpec(specification *Specification) *gauge_messages.ProtoSpec {
+	var heading string
+	if specification.Heading != nil {
+		heading = specification.Heading.Value
+	}
 	return &gauge_messages.ProtoSpec{
 		Items:         make([]*gauge_messages.ProtoItem, 0),
-		SpecHeading:   specification.Heading.Value,
+		SpecHeading:   heading,
 		IsTableDriven: specification.DataTable.IsInitialized(),
 		FileName:      specification.FileName,
 		Tags:          getTags(specification.Tags),
@@ -280,8 +374,16 @@ func NewSpecResult(specification *Specification) *result.SpecResult {
 }
 
 func NewProtoScenario(scenario *Scenario) *gauge_messages.ProtoScenario {
+	var heading string
+	if scenario.Heading != nil {
+		heading = scenario.Heading.Value
+	}
+	span := &gauge_messages.Span{}
+	if scenario.Span != nil {
+		span = &gauge_messages.Span{Start: int64(scenario.Span.Start), End: int64(scenario.Span.End)}
+	}
 	return &gauge_messages.ProtoScenario{
-		ScenarioHeading: scenario.Heading.Value,
+		ScenarioHeading: heading,
 		Failed:          false,
 		Skipped:         false,
 		Tags:            getTags(scenario.Tags),
@@ -289,7 +391,7 @@ func NewProtoScenario(scenario *Scenario) *gauge_messages.ProtoScenario {
 		ExecutionTime:   0,
 		TearDownSteps:   make([]*gauge_messages.ProtoItem, 0),
 		SkipErrors:      make([]string, 0),
-		Span:            &gauge_messages.Span{Start: int64(scenario.Span.Start), End: int64(scenario.Span.End)},
+		Span:            span,
 		ExecutionStatus: gauge_messages.ExecutionStatus_NOTEXECUTED,
 	}
 }
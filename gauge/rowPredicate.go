@@ -0,0 +1,117 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RowPredicate selects data table rows by the values of their columns, like country=IN & tier!=gold.
+// Conditions joined by & must all hold, and a row matches if any of the groups separated by | holds.
+type RowPredicate struct {
+	expression string
+	groups     [][]rowCondition
+}
+
+type rowCondition struct {
+	column string
+	value  string
+	negate bool
+}
+
+// IsRowPredicate returns true if the --table-rows value is a predicate on columns rather than row numbers
+func IsRowPredicate(expression string) bool {
+	return strings.Contains(expression, "=")
+}
+
+// ParseRowPredicate parses conditions of the form column=value or column!=value joined by & and |
+func ParseRowPredicate(expression string) (*RowPredicate, error) {
+	p := &RowPredicate{expression: expression}
+	for _, group := range strings.Split(expression, "|") {
+		var conditions []rowCondition
+		for _, text := range strings.Split(group, "&") {
+			c, err := parseRowCondition(text)
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, c)
+		}
+		p.groups = append(p.groups, conditions)
+	}
+	return p, nil
+}
+
+func parseRowCondition(text string) (rowCondition, error) {
+	c := rowCondition{}
+	i := strings.Index(text, "=")
+	if i == -1 {
+		return c, fmt.Errorf("Condition '%s' should be of format column=value or column!=value", strings.TrimSpace(text))
+	}
+	c.column, c.value = text[:i], strings.TrimSpace(text[i+1:])
+	if strings.HasSuffix(c.column, "!") {
+		c.column, c.negate = strings.TrimSuffix(c.column, "!"), true
+	}
+	if c.column = strings.TrimSpace(c.column); c.column == "" {
+		return c, fmt.Errorf("Condition '%s' should name a column", strings.TrimSpace(text))
+	}
+	return c, nil
+}
+
+func (p *RowPredicate) String() string {
+	return p.expression
+}
+
+// Columns gives the columns the predicate refers to
+func (p *RowPredicate) Columns() []string {
+	var columns []string
+	seen := make(map[string]bool)
+	for _, group := range p.groups {
+		for _, c := range group {
+			if !seen[c.column] {
+				seen[c.column] = true
+				columns = append(columns, c.column)
+			}
+		}
+	}
+	return columns
+}
+
+// UnknownColumn gives the first column of the predicate which is not a column of the table, or an empty string if
+// the table has all of them
+func (p *RowPredicate) UnknownColumn(table *Table) string {
+	for _, column := range p.Columns() {
+		if !table.headerExists(column) {
+			return column
+		}
+	}
+	return ""
+}
+
+// Matches returns true if the row of the table at the given index satisfies the predicate. A condition on a column
+// which is not in the table does not hold.
+func (p *RowPredicate) Matches(table *Table, index int) bool {
+	for _, group := range p.groups {
+		if p.groupMatches(group, table, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RowPredicate) groupMatches(group []rowCondition, table *Table, index int) bool {
+	for _, c := range group {
+		cells, err := table.Get(c.column)
+		if err != nil || index < 0 || index >= len(cells) {
+			return false
+		}
+		if (strings.TrimSpace(cells[index].GetValue()) == c.value) == c.negate {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestRowPredicateMatchesRowsByTheirColumns(c *C) {
+	table := NewTable([]string{"country", "tier"}, [][]TableCell{
+		{{Value: "IN"}, {Value: "IN"}, {Value: "US"}},
+		{{Value: "silver"}, {Value: "gold"}, {Value: "gold"}},
+	}, 1)
+
+	p, err := ParseRowPredicate("country=IN & tier!=gold | country = US")
+
+	c.Assert(err, IsNil)
+	c.Assert(p.Columns(), DeepEquals, []string{"country", "tier"})
+	c.Assert(p.Matches(table, 0), Equals, true)
+	c.Assert(p.Matches(table, 1), Equals, false)
+	c.Assert(p.Matches(table, 2), Equals, true)
+	c.Assert(p.UnknownColumn(table), Equals, "")
+}
+
+func (s *MySuite) TestRowPredicateWithUnknownColumn(c *C) {
+	table := NewTable([]string{"country"}, [][]TableCell{{{Value: "IN"}}}, 1)
+
+	p, err := ParseRowPredicate("region=APAC")
+
+	c.Assert(err, IsNil)
+	c.Assert(p.UnknownColumn(table), Equals, "region")
+	c.Assert(p.Matches(table, 0), Equals, false)
+}
+
+func (s *MySuite) TestParseInvalidRowPredicate(c *C) {
+	_, err := ParseRowPredicate("country=IN & tier")
+
+	c.Assert(err, ErrorMatches, "Condition 'tier' should be of format column=value or column!=value")
+	c.Assert(IsRowPredicate("2-4"), Equals, false)
+	c.Assert(IsRowPredicate("tier!=gold"), Equals, true)
+}
@@ -7,9 +7,13 @@
 package gauge
 
 import (
+	"fmt"
 	"strings"
 )
 
+// ScenarioTearDownMarker is the line of a scenario after which its teardown steps are written
+const ScenarioTearDownMarker = "teardown:"
+
 type Scenario struct {
 	Heading                   *Heading
 	Steps                     []*Step
@@ -22,8 +26,31 @@ type Scenario struct {
 	ScenarioDataTableRow      Table
 	ScenarioDataTableRowIndex int
 	Span                      *Span
+	// TableRowIndices holds the indices of the data table rows to execute this scenario for. All rows are executed if it is empty.
+	TableRowIndices []int
+	// ID is the stable ID declared in a comment of the scenario, see StableID
+	ID string
+	// ExcludedByTags holds the --tags expression the scenario does not match, when it is kept in its spec only to be
+	// reported as skipped, see env.ReportFilteredScenarios
+	ExcludedByTags string
+	// Deprecation is set when the scenario is marked as deprecated, see DeprecationOf
+	Deprecation *Deprecation
+	// WIP is set when the scenario is marked as work in progress, see IsWIP
+	WIP bool
+	// Annotations are the @key: value annotations in the comments of the scenario
+	Annotations Annotations
+	// Priority is the priority level of the scenario, declared by its priority:N tag. It is nil for scenarios without
+	// priority.
+	Priority *Priority
+	// TearDownSteps are the steps written after the teardown: line of the scenario. They are run after the scenario,
+	// before the teardown steps of the spec, even when a step of the scenario fails.
+	TearDownSteps []*Step
 }
 
+// Priority is the priority level of a scenario. Scenarios of a lower level run first, but those of a negative level
+// run after the scenarios without priority, -1 being the last.
+type Priority int
+
 // Span represents scope of Scenario based on line number
 type Span struct {
 	Start int
@@ -44,6 +71,41 @@ func (scenario *Scenario) AddStep(step *Step) {
 	scenario.AddItem(step)
 }
 
+// AddTearDown adds the teardown marker of the scenario, the steps added after it being its teardown steps
+func (scenario *Scenario) AddTearDown(tearDown *TearDown) {
+	scenario.AddItem(tearDown)
+}
+
+func (scenario *Scenario) AddTearDownStep(step *Step) {
+	scenario.TearDownSteps = append(scenario.TearDownSteps, step)
+	scenario.AddItem(step)
+}
+
+// AllSteps gives the steps of the scenario followed by its teardown steps
+func (scenario *Scenario) AllSteps() []*Step {
+	return append(append([]*Step{}, scenario.Steps...), scenario.TearDownSteps...)
+}
+
+// HasTearDown returns true if the scenario has a teardown marker
+func (scenario *Scenario) HasTearDown() bool {
+	for _, item := range scenario.Items {
+		if item.Kind() == TearDownKind {
+			return true
+		}
+	}
+	return false
+}
+
+// ItemsBeforeTearDown gives the items of the scenario up to its teardown marker, all its items if it has none
+func (scenario *Scenario) ItemsBeforeTearDown() []Item {
+	for i, item := range scenario.Items {
+		if item.Kind() == TearDownKind {
+			return scenario.Items[:i]
+		}
+	}
+	return scenario.Items
+}
+
 func (scenario *Scenario) AddTags(tags *Tags) {
 	scenario.Tags = tags
 	scenario.AddItem(tags)
@@ -61,6 +123,14 @@ func (scenario *Scenario) NTags() int {
 }
 
 func (scenario *Scenario) AddComment(comment *Comment) {
+	if scenario.ID == "" {
+		scenario.ID = StableID(comment.Value)
+	}
+	if scenario.Deprecation == nil {
+		scenario.Deprecation = DeprecationOf(comment.Value)
+	}
+	scenario.WIP = scenario.WIP || IsWIP(comment.Value)
+	scenario.Annotations.add(comment.Value)
 	scenario.Comments = append(scenario.Comments, comment)
 	scenario.AddItem(comment)
 }
@@ -74,11 +144,93 @@ func (scenario *Scenario) InSpan(lineNumber int) bool {
 	return scenario.Span.isInRange(lineNumber)
 }
 
+// ExecutesTableRow returns true if the scenario should be executed for the data table row at the given index.
+func (scenario *Scenario) ExecutesTableRow(index int) bool {
+	if len(scenario.TableRowIndices) == 0 {
+		return true
+	}
+	for _, i := range scenario.TableRowIndices {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
+
+// DataTableRow returns the data table row which drives the scenario and its index.
+// The scenario's own data table row takes precedence over the spec's data table row.
+// The index is -1 if the scenario is not data table driven.
+func (scenario *Scenario) DataTableRow() (*Table, int) {
+	if scenario.ScenarioDataTableRow.IsInitialized() {
+		return &scenario.ScenarioDataTableRow, scenario.ScenarioDataTableRowIndex
+	}
+	if scenario.SpecDataTableRow.IsInitialized() {
+		return &scenario.SpecDataTableRow, scenario.SpecDataTableRowIndex
+	}
+	return nil, -1
+}
+
+// RowTags gives the tags of the data table rows which drive the scenario, held in the tags column of the spec and
+// scenario data tables
+func (scenario *Scenario) RowTags() []string {
+	var tags []string
+	for _, row := range []*Table{&scenario.SpecDataTableRow, &scenario.ScenarioDataTableRow} {
+		tags = append(tags, row.RowTags(0)...)
+	}
+	return tags
+}
+
+// AddRowTags adds the tags of the data table rows which drive the scenario to its tags, so that the scenario of each
+// row is tagged with the tags of the row
+func (scenario *Scenario) AddRowTags() {
+	if tags := scenario.RowTags(); len(tags) > 0 {
+		scenario.Tags = scenario.Tags.withInherited(tags)
+	}
+}
+
+// DataTableRowName identifies the data table row which drives the scenario by its 1-based row number and the values of
+// its key columns, e.g. "row 7 (id: 42, region: eu)". The key columns are the ones of the given headers in the table,
+// or else its first column. It is empty if the scenario is not data table driven.
+func (scenario *Scenario) DataTableRowName(keyHeaders []string) string {
+	row, index := scenario.DataTableRow()
+	if row == nil {
+		return ""
+	}
+	cells, err := row.Row(0)
+	if len(row.Headers) == 0 || err != nil || len(cells) == 0 {
+		return fmt.Sprintf("row %d", index+1)
+	}
+	var values []string
+	for _, i := range keyColumns(row.Headers, keyHeaders) {
+		if i < len(cells) {
+			values = append(values, fmt.Sprintf("%s: %s", row.Headers[i], cells[i].Value))
+		}
+	}
+	return fmt.Sprintf("row %d (%s)", index+1, strings.Join(values, ", "))
+}
+
+// keyColumns gives the indices of the key columns among the headers of a data table
+func keyColumns(headers []string, keyHeaders []string) []int {
+	var indices []int
+	for _, key := range keyHeaders {
+		for i, h := range headers {
+			if h == key {
+				indices = append(indices, i)
+				break
+			}
+		}
+	}
+	if len(indices) == 0 {
+		return []int{0}
+	}
+	return indices
+}
+
 func (scenario *Scenario) renameSteps(oldStep *Step, newStep *Step, orderMap map[int]int) ([]*StepDiff, bool) {
 	isRefactored := false
 	diffs := []*StepDiff{}
 	isConcept := false
-	for _, step := range scenario.Steps {
+	for _, step := range scenario.AllSteps() {
 		diff, refactor := step.Rename(oldStep, newStep, isRefactored, orderMap, &isConcept)
 		if diff != nil {
 			diffs = append(diffs, diff)
@@ -97,12 +249,16 @@ func (scenario *Scenario) AddItem(itemToAdd Item) {
 	scenario.Items = append(scenario.Items, itemToAdd)
 }
 
+// LatestStep gives the last step added to the scenario, its last teardown step once it has one
 func (scenario *Scenario) LatestStep() *Step {
+	if len(scenario.TearDownSteps) > 0 {
+		return scenario.TearDownSteps[len(scenario.TearDownSteps)-1]
+	}
 	return scenario.Steps[len(scenario.Steps)-1]
 }
 
 func (scenario *Scenario) UsesArgsInSteps(args ...string) bool {
-	return UsesArgs(scenario.Steps, args...)
+	return UsesArgs(scenario.AllSteps(), args...)
 }
 
 // skipcq CRT-P0003
@@ -73,3 +73,28 @@ func (s *MySuite) TestDoesNotUseArgsInStep(c *C) {
 
 	c.Assert(scenario.UsesArgsInSteps("foo"), Equals, false)
 }
+
+func (s *MySuite) TestDataTableRowNamePrefersScenarioDataTableRow(c *C) {
+	specRow := NewTable([]string{"id"}, [][]TableCell{{{Value: "1", CellType: Static}}}, 0)
+	scnRow := NewTable([]string{"name"}, [][]TableCell{{{Value: "john", CellType: Static}}}, 0)
+	scenario := &Scenario{SpecDataTableRow: *specRow, SpecDataTableRowIndex: 2, ScenarioDataTableRow: *scnRow, ScenarioDataTableRowIndex: 6}
+
+	c.Assert(scenario.DataTableRowName(nil), Equals, "row 7 (name: john)")
+}
+
+func (s *MySuite) TestDataTableRowNameUsesTheKeyColumns(c *C) {
+	row := NewTable([]string{"name", "id", "region"}, [][]TableCell{{{Value: "john", CellType: Static}}, {{Value: "42", CellType: Static}}, {{Value: "eu", CellType: Static}}}, 0)
+	scenario := &Scenario{SpecDataTableRow: *row, SpecDataTableRowIndex: 2}
+
+	c.Assert(scenario.DataTableRowName([]string{"region", "unknown", "id"}), Equals, "row 3 (region: eu, id: 42)")
+}
+
+func (s *MySuite) TestDataTableRowNameIsEmptyForScenarioWithoutDataTable(c *C) {
+	c.Assert((&Scenario{}).DataTableRowName(nil), Equals, "")
+}
+
+func (s *MySuite) TestExecutesTableRow(c *C) {
+	c.Assert((&Scenario{}).ExecutesTableRow(3), Equals, true)
+	c.Assert((&Scenario{TableRowIndices: []int{1, 3}}).ExecutesTableRow(3), Equals, true)
+	c.Assert((&Scenario{TableRowIndices: []int{1, 3}}).ExecutesTableRow(2), Equals, false)
+}
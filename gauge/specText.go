@@ -0,0 +1,227 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	tearDownMarker   = "___"
+	tableLeftSpacing = 3
+)
+
+// ToSpecText gives the markdown of the specification, which parses back to the same specification. A parsed
+// specification is written item by item, keeping its comments and the order of its items. A specification built in
+// code, which has no items, is written from its heading, tags, data table, contexts, scenarios and teardown steps.
+func (spec *Specification) ToSpecText() string {
+	w := &specTextWriter{}
+	queue := &ItemQueue{Items: spec.AllItems()}
+	if len(spec.Items) == 0 {
+		queue.Items = spec.modelItems()
+	}
+	w.itemQueue = queue
+	spec.Traverse(w, queue)
+	if len(spec.Items) == 0 {
+		return strings.TrimRight(w.buffer.String(), "\n") + "\n"
+	}
+	return w.buffer.String()
+}
+
+// modelItems gives the items of the specification from its fields, separated by blank lines
+func (spec *Specification) modelItems() []Item {
+	blank := &Comment{Value: "\n"}
+	items := []Item{blank}
+	if spec.Tags != nil && len(spec.Tags.RawValues) > 0 {
+		items = append(items, spec.Tags)
+	}
+	if spec.DataTable.IsInitialized() || spec.DataTable.IsExternal {
+		items = append(items, &spec.DataTable, blank)
+	}
+	for _, step := range spec.Contexts {
+		items = append(items, step)
+	}
+	if len(spec.Contexts) > 0 {
+		items = append(items, blank)
+	}
+	for _, scn := range spec.Scenarios {
+		items = append(items, &Scenario{Heading: scn.Heading}, blank)
+		if scn.Tags != nil && len(scn.Tags.RawValues) > 0 {
+			items = append(items, scn.Tags)
+		}
+		for _, step := range scn.Steps {
+			items = append(items, step)
+		}
+		if len(scn.TearDownSteps) > 0 {
+			items = append(items, blank, &TearDown{Value: ScenarioTearDownMarker})
+			for _, step := range scn.TearDownSteps {
+				items = append(items, step)
+			}
+		}
+		items = append(items, blank)
+	}
+	if len(spec.TearDownSteps) > 0 {
+		items = append(items, &TearDown{Value: tearDownMarker})
+		for _, step := range spec.TearDownSteps {
+			items = append(items, step)
+		}
+	}
+	return items
+}
+
+// specTextWriter writes the items of a specification as markdown, the way gauge format lays them out
+type specTextWriter struct {
+	buffer    bytes.Buffer
+	itemQueue *ItemQueue
+}
+
+func (w *specTextWriter) Specification(specification *Specification) {
+}
+
+func (w *specTextWriter) Heading(heading *Heading) {
+	if heading == nil {
+		return
+	}
+	switch heading.HeadingType {
+	case SpecHeading:
+		w.buffer.WriteString(fmt.Sprintf("# %s\n", strings.TrimSpace(heading.Value)))
+	case ScenarioHeading:
+		w.buffer.WriteString(fmt.Sprintf("## %s\n", strings.TrimSpace(heading.Value)))
+	}
+}
+
+func (w *specTextWriter) Tags(tags *Tags) {
+	if !strings.HasSuffix(w.buffer.String(), "\n\n") {
+		w.buffer.WriteString("\n")
+	}
+	w.buffer.WriteString(tagsText(tags))
+	if next := w.itemQueue.Peek(); next != nil && (next.Kind() != CommentKind || strings.TrimSpace(next.(*Comment).Value) != "") {
+		w.buffer.WriteString("\n")
+	}
+}
+
+func (w *specTextWriter) Table(table *Table) {
+	w.buffer.WriteString(tableText(table))
+}
+
+func (w *specTextWriter) DataTable(dataTable *DataTable) {
+	if !dataTable.IsExternal {
+		w.Table(dataTable.Table)
+	} else if dataTable.Value != "" {
+		w.buffer.WriteString(dataTable.Value + "\n")
+	}
+}
+
+func (w *specTextWriter) TearDown(t *TearDown) {
+	w.buffer.WriteString(t.Value + "\n")
+}
+
+func (w *specTextWriter) Scenario(scenario *Scenario) {
+}
+
+func (w *specTextWriter) Step(step *Step) {
+	w.buffer.WriteString(stepText(step))
+}
+
+func (w *specTextWriter) Comment(comment *Comment) {
+	if comment.Value == "\n" {
+		w.buffer.WriteString(comment.Value)
+		return
+	}
+	w.buffer.WriteString(comment.Value + "\n")
+}
+
+func stepText(step *Step) string {
+	text := step.Value
+	for i := 0; i < strings.Count(step.Value, ParameterPlaceholder) && i < len(step.Args); i++ {
+		arg := step.Args[i]
+		placeholder, value := ParameterPlaceholder, ""
+		switch arg.ArgType {
+		case TableArg:
+			placeholder, value = " "+ParameterPlaceholder, "\n\n"+tableText(&arg.Table)
+		case MultilineText:
+			placeholder, value = " "+ParameterPlaceholder, "\n"+arg.FencedText()
+		case Dynamic, SpecialString, SpecialTable:
+			value = fmt.Sprintf("<%s>", unquoted(arg.Name))
+		default:
+			value = fmt.Sprintf("\"%s\"", unquoted(arg.Value))
+		}
+		if arg.ParamType != "" {
+			value += fmt.Sprintf("{%s}", arg.ParamType)
+		}
+		if !strings.Contains(text, placeholder) {
+			placeholder = ParameterPlaceholder
+		}
+		text = strings.Replace(text, placeholder, value, 1)
+	}
+	if step.StepTags != nil && len(step.StepTags.Values()) > 0 {
+		tags := " @" + strings.Join(step.StepTags.Values(), " @")
+		if i := strings.Index(text, "\n"); i >= 0 {
+			text = text[:i] + tags + text[i:]
+		} else {
+			text += tags
+		}
+	}
+	if strings.HasSuffix(text, "\n") {
+		return "* " + text
+	}
+	return "* " + text + step.Suffix + "\n"
+}
+
+// unquoted escapes the value as it is written inside the quotes of a parameter
+func unquoted(value string) string {
+	quoted := strconv.Quote(value)
+	return quoted[1 : len(quoted)-1]
+}
+
+func tagsText(tags *Tags) string {
+	var b bytes.Buffer
+	b.WriteString("tags: ")
+	for i, line := range tags.RawValues {
+		b.WriteString(strings.Join(line, ", "))
+		if i != len(tags.RawValues)-1 {
+			b.WriteString(",\n      ")
+		}
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+func tableText(table *Table) string {
+	widths := make([]int, len(table.Headers))
+	for i, header := range table.Headers {
+		widths[i] = utf8.RuneCountInString(header)
+		cells, _ := table.Get(header)
+		for _, cell := range cells {
+			if n := utf8.RuneCountInString(cell.GetValue()); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+	indent := strings.Repeat(" ", tableLeftSpacing)
+	row := func(cells []string) string {
+		var b bytes.Buffer
+		b.WriteString(indent + "|")
+		for i, cell := range cells {
+			b.WriteString(cell + strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)) + "|")
+		}
+		return b.String() + "\n"
+	}
+	var underline []string
+	for _, width := range widths {
+		underline = append(underline, strings.Repeat("-", width))
+	}
+	text := row(table.Headers) + row(underline)
+	for _, cells := range table.Rows() {
+		text += row(cells)
+	}
+	return text
+}
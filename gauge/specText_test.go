@@ -0,0 +1,73 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package gauge
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestToSpecTextOfSpecificationBuiltInCode(c *C) {
+	table := &Table{}
+	table.AddHeaders([]string{"id", "name"})
+	table.AddRowValues(table.CreateTableCells([]string{"1", "apple"}))
+	table.AddRowValues(table.CreateTableCells([]string{"22", "<fruit>"}))
+	spec := &Specification{
+		Heading:  &Heading{Value: "Checkout", HeadingType: SpecHeading},
+		Tags:     &Tags{RawValues: [][]string{{"cart", "smoke"}}},
+		Contexts: []*Step{{Value: "Open the shop"}},
+		Scenarios: []*Scenario{
+			{
+				Heading: &Heading{Value: "Pay by card", HeadingType: ScenarioHeading},
+				Tags:    &Tags{RawValues: [][]string{{"card"}}},
+				Steps: []*Step{
+					{Value: "Pay {} with {}", Args: []*StepArg{{Value: "10 \"EUR\"", ArgType: Static}, {Name: "card", ArgType: Dynamic}}},
+					{Value: "Check the items {}", Args: []*StepArg{{ArgType: TableArg, Table: *table}}},
+				},
+			},
+			{
+				Heading: &Heading{Value: "Empty cart", HeadingType: ScenarioHeading},
+				Steps:   []*Step{{Value: "Checkout"}},
+			},
+		},
+		TearDownSteps: []*Step{{Value: "Close the shop"}},
+	}
+
+	c.Assert(spec.ToSpecText(), Equals, `# Checkout
+
+tags: cart, smoke
+
+* Open the shop
+
+## Pay by card
+
+tags: card
+
+* Pay "10 \"EUR\"" with <card>
+* Check the items
+
+   |id|name   |
+   |--|-------|
+   |1 |apple  |
+   |22|<fruit>|
+
+## Empty cart
+
+* Checkout
+
+___
+* Close the shop
+`)
+}
+
+func (s *MySuite) TestToSpecTextWritesTheItemsOfParsedSpecification(c *C) {
+	spec := &Specification{Heading: &Heading{Value: "Spec", HeadingType: SpecHeading}}
+	scn := &Scenario{Heading: &Heading{Value: "Scenario", HeadingType: ScenarioHeading}}
+	scn.Items = []Item{&Comment{Value: "A comment of the scenario"}, &Step{Value: "Step", Suffix: "\n"}}
+	spec.Items = []Item{&Comment{Value: "\n"}, &Comment{Value: "A comment"}, &Comment{Value: "\n"}, scn}
+
+	c.Assert(spec.ToSpecText(), Equals, "# Spec\n\nA comment\n\n## Scenario\nA comment of the scenario\n* Step\n\n")
+}
@@ -7,7 +7,10 @@
 package gauge
 
 import (
+	"fmt"
 	"reflect"
+	"regexp"
+	"strings"
 )
 
 type HeadingType int
@@ -31,8 +34,33 @@ const (
 	TableKind
 	DataTableKind
 	TearDownKind
+	MultilineTextKind
+	ScenarioTearDownKind
 )
 
+var tokenKindNames = map[TokenKind]string{
+	SpecKind:             "spec",
+	TagKind:              "tag",
+	ScenarioKind:         "scenario",
+	CommentKind:          "comment",
+	StepKind:             "step",
+	TableHeader:          "tableHeader",
+	TableRow:             "tableRow",
+	HeadingKind:          "heading",
+	TableKind:            "table",
+	DataTableKind:        "dataTable",
+	TearDownKind:         "tearDown",
+	MultilineTextKind:    "multilineText",
+	ScenarioTearDownKind: "scenarioTearDown",
+}
+
+func (k TokenKind) String() string {
+	if name, ok := tokenKindNames[k]; ok {
+		return name
+	}
+	return "unknown"
+}
+
 type Specification struct {
 	Heading       *Heading
 	Scenarios     []*Scenario
@@ -43,6 +71,16 @@ type Specification struct {
 	Tags          *Tags
 	Items         []Item
 	TearDownSteps []*Step
+	// ID is the stable ID declared in a comment of the spec, see StableID
+	ID string
+	// Deprecation is set when the spec is marked as deprecated, see DeprecationOf
+	Deprecation *Deprecation
+	// WIP is set when the spec is marked as work in progress, see IsWIP
+	WIP bool
+	// Annotations are the @key: value annotations in the comments of the spec
+	Annotations Annotations
+	// Metadata holds the values of the YAML front matter of the spec, see AddMetadata
+	Metadata map[string]interface{}
 }
 
 type Item interface {
@@ -57,7 +95,7 @@ func (spec *Specification) Kind() TokenKind {
 func (spec *Specification) Steps() []*Step {
 	steps := spec.Contexts
 	for _, scen := range spec.Scenarios {
-		steps = append(steps, scen.Steps...)
+		steps = append(steps, scen.AllSteps()...)
 	}
 	return append(steps, spec.TearDownSteps...)
 }
@@ -69,7 +107,7 @@ func (spec *Specification) ProcessConceptStepsFrom(conceptDictionary *ConceptDic
 		}
 	}
 	for _, scenario := range spec.Scenarios {
-		for _, step := range scenario.Steps {
+		for _, step := range scenario.AllSteps() {
 			if err := spec.processConceptStep(step, conceptDictionary); err != nil {
 				return err
 			}
@@ -80,9 +118,18 @@ func (spec *Specification) ProcessConceptStepsFrom(conceptDictionary *ConceptDic
 			return err
 		}
 	}
+	spec.inheritConceptTags(conceptDictionary)
 	return nil
 }
 
+// inheritConceptTags adds the tags of the concepts used by a scenario, or by the contexts and teardowns, to the scenario
+func (spec *Specification) inheritConceptTags(conceptDictionary *ConceptDictionary) {
+	common := conceptDictionary.ConceptTags(append(append([]*Step{}, spec.Contexts...), spec.TearDownSteps...))
+	for _, scenario := range spec.Scenarios {
+		scenario.Tags = scenario.Tags.withInherited(append(common, conceptDictionary.ConceptTags(scenario.AllSteps())...))
+	}
+}
+
 func (spec *Specification) processConceptStep(step *Step, conceptDictionary *ConceptDictionary) error {
 	if conceptFromDictionary := conceptDictionary.Search(step.Value); conceptFromDictionary != nil {
 		return spec.createConceptStep(conceptFromDictionary.ConceptStep, step)
@@ -98,6 +145,13 @@ func (spec *Specification) createConceptStep(concept *Step, originalStep *Step)
 	originalArgs := originalStep.Args
 	originalStep.CopyFrom(stepCopy)
 	originalStep.Args = originalArgs
+	if concept.StepTags != nil {
+		tags := &Tags{RawValues: [][]string{concept.StepTags.Values()}}
+		if originalStep.StepTags != nil {
+			tags.Add(originalStep.StepTags.Values())
+		}
+		originalStep.StepTags = tags
+	}
 
 	// set parent of all concept steps to be the current concept (referred as originalStep here)
 	// this is used to fetch from parent's lookup when nested
@@ -132,6 +186,14 @@ func (spec *Specification) AddContext(contextStep *Step) {
 }
 
 func (spec *Specification) AddComment(comment *Comment) {
+	if spec.ID == "" {
+		spec.ID = StableID(comment.Value)
+	}
+	if spec.Deprecation == nil {
+		spec.Deprecation = DeprecationOf(comment.Value)
+	}
+	spec.WIP = spec.WIP || IsWIP(comment.Value)
+	spec.Annotations.add(comment.Value)
 	spec.Comments = append(spec.Comments, comment)
 	spec.AddItem(comment)
 }
@@ -249,6 +311,9 @@ func (spec *Specification) GetSpecItems() []Item {
 
 func (spec *Specification) Traverse(processor ItemProcessor, queue *ItemQueue) {
 	processor.Specification(spec)
+	for spec.isBeforeHeading(queue.Peek()) {
+		processor.Comment(queue.Next().(*Comment))
+	}
 	processor.Heading(spec.Heading)
 
 	for queue.Peek() != nil {
@@ -273,6 +338,15 @@ func (spec *Specification) Traverse(processor ItemProcessor, queue *ItemQueue) {
 	}
 }
 
+// isBeforeHeading tells if the item is a comment written above the heading of the specification, like a front matter
+func (spec *Specification) isBeforeHeading(item Item) bool {
+	if item == nil || item.Kind() != CommentKind || spec.Heading == nil {
+		return false
+	}
+	lineNo := item.(*Comment).LineNo
+	return lineNo > 0 && lineNo < spec.Heading.LineNo
+}
+
 func (spec *Specification) AllItems() (items []Item) {
 	for _, item := range spec.Items {
 		items = append(items, item)
@@ -341,6 +415,112 @@ func (comment *Comment) Kind() TokenKind {
 	return CommentKind
 }
 
+var commentAnnotationPattern = regexp.MustCompile(`^\s*<!--\s*([a-zA-Z]+)\s*(?::\s*(.*?))?\s*-->\s*$`)
+
+// commentAnnotation returns the value of a comment of the form <!-- key: value --> or <!-- key -->
+func commentAnnotation(comment, key string) (string, bool) {
+	m := commentAnnotationPattern.FindStringSubmatch(comment)
+	if m == nil || m[1] != key {
+		return "", false
+	}
+	return m[2], true
+}
+
+// StableID returns the ID declared by a comment of the form <!-- id: CHK-102 -->, or an empty string if the comment declares none.
+// Unlike headings and line numbers, the ID stays the same when a spec or scenario is renamed or moved.
+func StableID(comment string) string {
+	if id, ok := commentAnnotation(comment, "id"); ok && !strings.ContainsAny(id, " \t") {
+		return id
+	}
+	return ""
+}
+
+// Deprecation marks a spec or scenario which still runs but is due to be removed
+type Deprecation struct {
+	// Message tells why it is deprecated or what replaces it, and may hold the date of removal
+	Message string
+}
+
+// DeprecationOf returns the deprecation declared by a comment of the form <!-- deprecated --> or <!-- deprecated: message -->,
+// or nil if the comment is not a deprecation marker.
+func DeprecationOf(comment string) *Deprecation {
+	if msg, ok := commentAnnotation(comment, "deprecated"); ok {
+		return &Deprecation{Message: msg}
+	}
+	return nil
+}
+
+var annotationPattern = regexp.MustCompile(`^\s*@([a-zA-Z][\w-]*)\s*:\s*(\S.*?)\s*$`)
+
+// Annotation returns the key and value of a comment of the form @key: value, like @owner: payments or @jira: CHK-102
+func Annotation(comment string) (string, string, bool) {
+	m := annotationPattern.FindStringSubmatch(comment)
+	if m == nil {
+		return "", "", false
+	}
+	return strings.ToLower(m[1]), m[2], true
+}
+
+// Annotations holds the values of the @key: value annotations of a spec or scenario by key
+type Annotations map[string][]string
+
+func (a *Annotations) add(comment string) {
+	key, value, ok := Annotation(comment)
+	if !ok {
+		return
+	}
+	if *a == nil {
+		*a = make(Annotations)
+	}
+	(*a)[key] = append((*a)[key], value)
+}
+
+// AddMetadata sets the metadata of the spec from its YAML front matter. The values which are text, numbers or lists of
+// them are annotations of the spec too, so that an owner: payments front matter is like an @owner: payments comment.
+func (spec *Specification) AddMetadata(metadata map[string]interface{}) {
+	spec.Metadata = metadata
+	for key, value := range metadata {
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+		for _, v := range values {
+			switch v.(type) {
+			case string, int, int64, float64, bool:
+				if spec.Annotations == nil {
+					spec.Annotations = make(Annotations)
+				}
+				k := strings.ToLower(key)
+				spec.Annotations[k] = append(spec.Annotations[k], fmt.Sprint(v))
+			}
+		}
+	}
+}
+
+// HasScenarioAnnotation tells if a scenario of the spec, or the spec itself, has an annotation with the given key
+func (spec *Specification) HasScenarioAnnotation(scn *Scenario, key string) bool {
+	return len(scn.Annotations[key]) > 0 || len(spec.Annotations[key]) > 0
+}
+
+// IsWIP tells if the comment is a <!-- wip --> marker of a spec or scenario which is still being written
+func IsWIP(comment string) bool {
+	_, ok := commentAnnotation(comment, "wip")
+	return ok
+}
+
+// IsScenarioWIP tells if a scenario of the spec is work in progress, which it is along with its spec
+func (spec *Specification) IsScenarioWIP(scn *Scenario) bool {
+	return scn.WIP || spec.WIP
+}
+
+// ScenarioDeprecation returns the deprecation of a scenario of the spec, which is deprecated along with its spec
+func (spec *Specification) ScenarioDeprecation(scn *Scenario) *Deprecation {
+	if scn.Deprecation != nil {
+		return scn.Deprecation
+	}
+	return spec.Deprecation
+}
+
 type TearDown struct {
 	LineNo int
 	Value  string
@@ -364,6 +544,34 @@ func (tags *Tags) Values() (val []string) {
 	}
 	return val
 }
+
+// withInherited returns a copy of the tags which also holds the given inherited tags.
+// The tags are returned as is if they already hold all of them.
+func (tags *Tags) withInherited(inherited []string) *Tags {
+	existing := make(map[string]bool)
+	if tags != nil {
+		for _, t := range tags.Values() {
+			existing[t] = true
+		}
+	}
+	var missing []string
+	for _, t := range inherited {
+		if !existing[t] {
+			existing[t] = true
+			missing = append(missing, t)
+		}
+	}
+	if len(missing) == 0 {
+		return tags
+	}
+	t := &Tags{}
+	if tags != nil {
+		t.RawValues = append(t.RawValues, tags.RawValues...)
+	}
+	t.Add(missing)
+	return t
+}
+
 func (tags *Tags) Kind() TokenKind {
 	return TagKind
 }
@@ -111,3 +111,51 @@ func (s *MySuite) TestStepsGiveAllStepsPresentInSpec(c *C) {
 
 	c.Assert(spec.Steps(), DeepEquals, []*Step{step1, step2, step3})
 }
+
+func (s *MySuite) TestStableID(c *C) {
+	c.Assert(StableID("<!-- id: CHK-102 -->"), Equals, "CHK-102")
+	c.Assert(StableID("<!--id:CHK-102-->"), Equals, "CHK-102")
+	c.Assert(StableID("id: CHK-102"), Equals, "")
+	c.Assert(StableID("<!-- a comment -->"), Equals, "")
+}
+
+func (s *MySuite) TestDeprecationOf(c *C) {
+	c.Assert(DeprecationOf("<!-- deprecated -->"), DeepEquals, &Deprecation{})
+	c.Assert(DeprecationOf("<!-- deprecated: removed after 2024-06-30 -->"), DeepEquals, &Deprecation{Message: "removed after 2024-06-30"})
+	c.Assert(DeprecationOf("deprecated"), IsNil)
+	c.Assert(StableID("<!-- deprecated: CHK -->"), Equals, "")
+}
+
+func (s *MySuite) TestIsWIP(c *C) {
+	c.Assert(IsWIP("<!-- wip -->"), Equals, true)
+	c.Assert(IsWIP("wip"), Equals, false)
+
+	scn := &Scenario{}
+	scn.AddComment(&Comment{Value: "<!-- wip -->"})
+	c.Assert(scn.WIP, Equals, true)
+	c.Assert((&Specification{WIP: true}).IsScenarioWIP(&Scenario{}), Equals, true)
+}
+
+func (s *MySuite) TestAnnotationsAreReadFromComments(c *C) {
+	scn := &Scenario{}
+	scn.AddComment(&Comment{Value: "@Owner: payments"})
+	scn.AddComment(&Comment{Value: "@link: https://example.com/a"})
+	scn.AddComment(&Comment{Value: "@link: https://example.com/b"})
+	scn.AddComment(&Comment{Value: "mail me at someone@example.com: thanks"})
+	spec := &Specification{}
+	spec.AddComment(&Comment{Value: "@jira: CHK-102"})
+
+	c.Assert(scn.Annotations, DeepEquals, Annotations{"owner": {"payments"}, "link": {"https://example.com/a", "https://example.com/b"}})
+	c.Assert(spec.HasScenarioAnnotation(scn, "jira"), Equals, true)
+	c.Assert(spec.HasScenarioAnnotation(&Scenario{}, "owner"), Equals, false)
+}
+
+func (s *MySuite) TestTraverseGivesCommentsAboveTheHeadingFirst(c *C) {
+	spec := &Specification{Heading: &Heading{Value: "Spec", LineNo: 4, HeadingType: SpecHeading}}
+	spec.AddComment(&Comment{Value: "---", LineNo: 1})
+	spec.AddComment(&Comment{Value: "owner: me", LineNo: 2})
+	spec.AddComment(&Comment{Value: "---", LineNo: 3})
+	spec.AddComment(&Comment{Value: "under the heading", LineNo: 5})
+
+	c.Assert(spec.ToSpecText(), Equals, "---\nowner: me\n---\n# Spec\nunder the heading\n")
+}
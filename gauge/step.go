@@ -32,10 +32,20 @@ type Step struct {
 	Fragments      []*gauge_messages.Fragment
 	Parent         *Step
 	HasInlineTable bool
-	Items          []Item
-	PreComments    []*Comment
-	Suffix         string
-	LineSpanEnd    int
+	// HasMultilineText tells if the last arg of the step is the multiline text written after it
+	HasMultilineText bool
+	Items            []Item
+	PreComments      []*Comment
+	Suffix           string
+	LineSpanEnd      int
+	// Tags holds the tags of a concept heading, which are inherited by the scenarios using the concept
+	Tags *Tags
+	// Namespace holds the namespace of the concept file of a concept heading, which steps may qualify the concept with
+	Namespace string
+	// DataTable holds the table written under a concept heading, whose steps the concept repeats for each row
+	DataTable *DataTable
+	// StepTags holds the tags written at the end of the step, like @slow, which apply to the step alone
+	StepTags *Tags
 }
 
 type StepDiff struct {
@@ -67,6 +77,9 @@ func (step *Step) GetLineText() string {
 	if step.HasInlineTable {
 		return fmt.Sprintf("%s <%s>", step.LineText, TableArg)
 	}
+	if step.HasMultilineText {
+		return fmt.Sprintf("%s <%s>", step.LineText, "text")
+	}
 	return step.LineText
 }
 
@@ -255,7 +268,9 @@ func (step *Step) CopyFrom(another *Step) {
 
 	step.LineText = another.LineText
 	step.HasInlineTable = another.HasInlineTable
+	step.HasMultilineText = another.HasMultilineText
 	step.Value = another.Value
+	step.Namespace = another.Namespace
 	step.Lookup = another.Lookup
 	step.Parent = another.Parent
 }
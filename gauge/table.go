@@ -6,13 +6,28 @@
 
 package gauge
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// RowTagsHeader is the header of the optional data table column which holds the tags of each row
+const RowTagsHeader = "tags"
 
 type Table struct {
 	headerIndexMap map[string]int
 	Columns        [][]TableCell
 	Headers        []string
 	LineNo         int
+	rows           RowSource
+}
+
+// RowSource gives the rows of a table which are read as they are needed rather than held in memory, like the rows of
+// a large CSV file
+type RowSource interface {
+	RowCount() int
+	Row(index int) ([]TableCell, error)
 }
 
 type DataTable struct {
@@ -41,6 +56,71 @@ func NewTable(headers []string, cols [][]TableCell, lineNo int) *Table {
 	}
 }
 
+// jsonTable is a table as written in JSON, with whether it has its headers rather than their unexported index
+type jsonTable struct {
+	Columns     [][]TableCell
+	Headers     []string
+	LineNo      int
+	Initialized bool
+}
+
+// MarshalJSON writes the table along with whether it is initialized
+func (table Table) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonTable{Columns: table.Columns, Headers: table.Headers, LineNo: table.LineNo, Initialized: table.IsInitialized()})
+}
+
+// UnmarshalJSON reads a table written by MarshalJSON, indexing its headers again when it was initialized
+func (table *Table) UnmarshalJSON(b []byte) error {
+	var t jsonTable
+	if err := json.Unmarshal(b, &t); err != nil {
+		return err
+	}
+	*table = Table{Columns: t.Columns, Headers: t.Headers, LineNo: t.LineNo}
+	if t.Initialized {
+		table.headerIndexMap = make(map[string]int)
+		for i, header := range t.Headers {
+			table.headerIndexMap[header] = i
+		}
+	}
+	return nil
+}
+
+// NewLazyTable creates a table whose rows are read from the source as they are needed. The table has no Columns, its
+// cells are read with Row, Rows and Get.
+func NewLazyTable(headers []string, rows RowSource, lineNo int) *Table {
+	table := NewTable(headers, nil, lineNo)
+	table.rows = rows
+	return table
+}
+
+// IsLazy tells if the rows of the table are read from a RowSource as they are needed
+func (table *Table) IsLazy() bool {
+	return table != nil && table.rows != nil
+}
+
+// LazyRow gives a table of the row at the index of a lazy table, which reads the row only when it is needed. The specs
+// of a data table row refer to their row this way, so that the rows are read when the specs are executed.
+func (table *Table) LazyRow(index int) *Table {
+	return NewLazyTable(table.Headers, &sourceRow{rows: table.rows, index: index}, table.LineNo)
+}
+
+// sourceRow is a single row of a RowSource
+type sourceRow struct {
+	rows  RowSource
+	index int
+}
+
+func (r *sourceRow) RowCount() int {
+	return 1
+}
+
+func (r *sourceRow) Row(index int) ([]TableCell, error) {
+	if index != 0 {
+		return nil, fmt.Errorf("Table row %d not found", index)
+	}
+	return r.rows.Row(r.index)
+}
+
 func (table *Table) IsInitialized() bool {
 	return table != nil && table.headerIndexMap != nil
 }
@@ -73,11 +153,38 @@ func (table *Table) GetDynamicArgs() []string {
 	return args
 }
 
+// Get gives the cells of the column, which for a lazy table reads every row
 func (table *Table) Get(header string) ([]TableCell, error) {
 	if !table.headerExists(header) {
 		return nil, fmt.Errorf("Table column %s not found", header)
 	}
-	return table.Columns[table.headerIndexMap[header]], nil
+	if !table.IsLazy() {
+		return table.Columns[table.headerIndexMap[header]], nil
+	}
+	cells := make([]TableCell, 0, table.rows.RowCount())
+	for i := 0; i < table.rows.RowCount(); i++ {
+		row, err := table.rows.Row(i)
+		if err != nil {
+			return nil, err
+		}
+		cells = append(cells, row[table.headerIndexMap[header]])
+	}
+	return cells, nil
+}
+
+// Row gives the cells of the row at the index, in the order of the headers
+func (table *Table) Row(index int) ([]TableCell, error) {
+	if index < 0 || index >= table.GetRowCount() {
+		return nil, fmt.Errorf("Table row %d not found", index)
+	}
+	if table.IsLazy() {
+		return table.rows.Row(index)
+	}
+	cells := make([]TableCell, len(table.Columns))
+	for i, column := range table.Columns {
+		cells[i] = column[index]
+	}
+	return cells, nil
 }
 
 func (table *Table) headerExists(header string) bool {
@@ -128,30 +235,56 @@ func (table *Table) addRows(rows []TableCell) {
 	}
 }
 
+// Rows gives the values of the cells of every row. The rows of a lazy table are read up to the first one which cannot
+// be read.
 func (table *Table) Rows() [][]string {
 	if !table.IsInitialized() {
 		return nil
 	}
 
 	tableRows := make([][]string, 0)
-	if len(table.Columns) == 0 {
-		return tableRows
-	}
-	for i := 0; i < len(table.Columns[0]); i++ {
+	for i := 0; i < table.GetRowCount(); i++ {
+		cells, err := table.Row(i)
+		if err != nil {
+			break
+		}
 		row := make([]string, 0)
-		for _, header := range table.Headers {
-			tableCells, _ := table.Get(header)
-			tableCell := tableCells[i]
-			value := tableCell.GetValue()
-			row = append(row, value)
+		for _, tableCell := range cells {
+			row = append(row, tableCell.GetValue())
 		}
 		tableRows = append(tableRows, row)
 	}
 	return tableRows
 }
 
+// HasRowTags returns true if the table has a column which holds tags for its rows
+func (table *Table) HasRowTags() bool {
+	return table.IsInitialized() && table.headerExists(RowTagsHeader)
+}
+
+// RowTags returns the comma separated tags of the row at the given index
+func (table *Table) RowTags(index int) []string {
+	tags := make([]string, 0)
+	if !table.HasRowTags() {
+		return tags
+	}
+	cells, err := table.Row(index)
+	if err != nil {
+		return tags
+	}
+	for _, tag := range strings.Split(cells[table.headerIndexMap[RowTagsHeader]].Value, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
 func (table *Table) GetRowCount() int {
-	if table.IsInitialized() {
+	if table.IsLazy() {
+		return table.rows.RowCount()
+	}
+	if table.IsInitialized() && len(table.Columns) > 0 {
 		return len(table.Columns[0])
 	}
 	return 0
@@ -163,3 +163,87 @@ func (s *MySuite) TestCreateTableCells(c *C) {
 	c.Assert(table.Columns[0][0].Value, Equals, "cell 1")
 	c.Assert(table.Columns[1][0].Value, Equals, "cell 2   ")
 }
+
+func (s *MySuite) TestRowTags(c *C) {
+	table := NewTable([]string{"id", "tags"}, [][]TableCell{
+		{{Value: "1"}, {Value: "2"}},
+		{{Value: " smoke , fast,"}, {Value: ""}},
+	}, 0)
+
+	c.Assert(table.HasRowTags(), Equals, true)
+	c.Assert(table.RowTags(0), DeepEquals, []string{"smoke", "fast"})
+	c.Assert(table.RowTags(1), DeepEquals, []string{})
+}
+
+func (s *MySuite) TestRowTagsWithoutTagsColumn(c *C) {
+	table := NewTable([]string{"id"}, [][]TableCell{{{Value: "1"}}}, 0)
+
+	c.Assert(table.HasRowTags(), Equals, false)
+	c.Assert(table.RowTags(0), DeepEquals, []string{})
+}
+
+// stringRows is a row source of rows of static cells, counting the rows read
+type stringRows struct {
+	rows [][]string
+	read int
+}
+
+func (r *stringRows) RowCount() int {
+	return len(r.rows)
+}
+
+func (r *stringRows) Row(index int) ([]TableCell, error) {
+	r.read++
+	return new(Table).CreateTableCells(r.rows[index]), nil
+}
+
+func (s *MySuite) TestLazyTableReadsItsRowsFromItsSource(c *C) {
+	rows := &stringRows{rows: [][]string{{"1", "smoke"}, {"2", "fast"}}}
+	table := NewLazyTable([]string{"id", "tags"}, rows, 3)
+
+	c.Assert(table.IsInitialized(), Equals, true)
+	c.Assert(table.IsLazy(), Equals, true)
+	c.Assert(table.GetRowCount(), Equals, 2)
+	c.Assert(rows.read, Equals, 0)
+
+	row, err := table.Row(1)
+	c.Assert(err, IsNil)
+	c.Assert(row, DeepEquals, []TableCell{{Value: "2", CellType: Static}, {Value: "fast", CellType: Static}})
+	ids, err := table.Get("id")
+	c.Assert(err, IsNil)
+	c.Assert(ids, DeepEquals, []TableCell{{Value: "1", CellType: Static}, {Value: "2", CellType: Static}})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"1", "smoke"}, {"2", "fast"}})
+	c.Assert(table.RowTags(0), DeepEquals, []string{"smoke"})
+	_, err = table.Row(2)
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestLazyRowReadsTheRowOnlyWhenItIsNeeded(c *C) {
+	rows := &stringRows{rows: [][]string{{"1", "alice"}, {"2", "bob"}}}
+	table := NewLazyTable([]string{"id", "name"}, rows, 3)
+
+	row := table.LazyRow(1)
+
+	c.Assert(rows.read, Equals, 0)
+	c.Assert(row.GetRowCount(), Equals, 1)
+	c.Assert(row.LineNo, Equals, 3)
+	lookup := new(ArgLookup)
+	c.Assert(lookup.ReadDataTableRow(row, 0), IsNil)
+	name, _ := lookup.GetArg("name")
+	c.Assert(name.Value, Equals, "bob")
+	c.Assert(rows.read, Equals, 1)
+}
+
+func (s *MySuite) TestRow(c *C) {
+	table := NewTable([]string{"id", "name"}, [][]TableCell{
+		{{Value: "1"}, {Value: "2"}},
+		{{Value: "alice"}, {Value: "bob"}},
+	}, 0)
+
+	row, err := table.Row(1)
+
+	c.Assert(err, IsNil)
+	c.Assert(row, DeepEquals, []TableCell{{Value: "2"}, {Value: "bob"}})
+	_, err = table.Row(2)
+	c.Assert(err, ErrorMatches, "Table row 2 not found")
+}
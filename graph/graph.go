@@ -0,0 +1,177 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+// Package graph gives the graph of which specs use which concepts and which concepts nest others, to find the concepts
+// used the most, the ones not used at all and the deeply nested ones.
+package graph
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	// SpecNode is the kind of the nodes of specs
+	SpecNode = "spec"
+	// ConceptNode is the kind of the nodes of concepts
+	ConceptNode = "concept"
+)
+
+// Node is a spec or a concept of the graph
+type Node struct {
+	ID     string `json:"id"`
+	Kind   string `json:"kind"`
+	Label  string `json:"label"`
+	File   string `json:"file"`
+	LineNo int    `json:"lineNo,omitempty"`
+	// UsedBy is the number of specs and concepts using the concept
+	UsedBy int `json:"usedBy"`
+	// Depth is the number of levels of concepts the concept expands to, 1 for a concept which uses no other concept
+	Depth int `json:"depth,omitempty"`
+}
+
+// Edge tells that a spec or a concept uses a concept, as many times as its count
+type Edge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Count int    `json:"count"`
+}
+
+// Graph holds the specs and concepts as nodes, ordered by kind and file, and the uses of concepts as edges
+type Graph struct {
+	Nodes []*Node `json:"nodes"`
+	Edges []*Edge `json:"edges"`
+	edges map[[2]string]*Edge
+	nodes map[string]*Node
+}
+
+// New gives the graph of the specs and of the concepts of the dictionary. The concepts which no spec or concept uses
+// are nodes without edges to them.
+func New(specs []*gauge.Specification, dict *gauge.ConceptDictionary) *Graph {
+	g := &Graph{Nodes: make([]*Node, 0), Edges: make([]*Edge, 0), edges: make(map[[2]string]*Edge), nodes: make(map[string]*Node)}
+	concepts := dict.Query(gauge.ConceptQuery{})
+	for _, c := range concepts {
+		g.addNode(&Node{ID: conceptID(c), Kind: ConceptNode, Label: gauge.QualifiedStepValue(c.ConceptStep.Namespace, c.ConceptStep.LineText), File: relPath(c.FileName), LineNo: c.ConceptStep.LineNo})
+	}
+	for _, c := range concepts {
+		for _, step := range c.ConceptStep.ConceptSteps {
+			if used := dict.SearchFrom(c.ConceptStep.Namespace, step.Value); used != nil {
+				g.addEdge(conceptID(c), conceptID(used))
+			}
+		}
+	}
+	sorted := append([]*gauge.Specification{}, specs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].FileName < sorted[j].FileName })
+	for _, spec := range sorted {
+		id := SpecNode + ":" + filepath.ToSlash(relPath(spec.FileName))
+		label := ""
+		if spec.Heading != nil {
+			label = spec.Heading.Value
+		}
+		g.addNode(&Node{ID: id, Kind: SpecNode, Label: label, File: relPath(spec.FileName)})
+		for _, step := range spec.Steps() {
+			if !step.IsConcept {
+				continue
+			}
+			if used := dict.Search(gauge.ConceptKey(step)); used != nil {
+				g.addEdge(id, conceptID(used))
+			}
+		}
+	}
+	depths := make(map[string]int)
+	for _, n := range g.Nodes {
+		if n.Kind == ConceptNode {
+			n.Depth = g.depth(n.ID, depths, make(map[string]bool))
+		}
+	}
+	return g
+}
+
+func conceptID(c *gauge.Concept) string {
+	return ConceptNode + ":" + gauge.ConceptKey(c.ConceptStep)
+}
+
+func relPath(file string) string {
+	if file == "" {
+		return file
+	}
+	return util.RelPathToProjectRoot(file)
+}
+
+func (g *Graph) addNode(n *Node) {
+	g.Nodes = append(g.Nodes, n)
+	g.nodes[n.ID] = n
+}
+
+func (g *Graph) addEdge(from, to string) {
+	key := [2]string{from, to}
+	if e, ok := g.edges[key]; ok {
+		e.Count++
+		return
+	}
+	e := &Edge{From: from, To: to, Count: 1}
+	g.edges[key] = e
+	g.Edges = append(g.Edges, e)
+	g.nodes[to].UsedBy++
+}
+
+// depth gives the depth of the concept, counting a concept met again in a cycle as the end of it
+func (g *Graph) depth(id string, depths map[string]int, visiting map[string]bool) int {
+	if d, ok := depths[id]; ok {
+		return d
+	}
+	if visiting[id] {
+		return 0
+	}
+	visiting[id] = true
+	d := 0
+	for _, e := range g.Edges {
+		if e.From == id {
+			if nested := g.depth(e.To, depths, visiting); nested > d {
+				d = nested
+			}
+		}
+	}
+	delete(visiting, id)
+	depths[id] = d + 1
+	return d + 1
+}
+
+// DOT gives the graph in the DOT language of Graphviz. Specs are boxes, concepts are ellipses and the concepts which
+// are not used are dashed. Edges of concepts used more than once are labelled with the count.
+func (g *Graph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph gauge {\n\trankdir=LR;\n")
+	for _, n := range g.Nodes {
+		attrs := fmt.Sprintf("label=%s", dotQuote(n.Label))
+		if n.Kind == SpecNode {
+			attrs += ", shape=box"
+		} else if n.UsedBy == 0 {
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(&b, "\t%s [%s];\n", dotQuote(n.ID), attrs)
+	}
+	for _, e := range g.Edges {
+		if e.Count > 1 {
+			fmt.Fprintf(&b, "\t%s -> %s [label=\"%d\"];\n", dotQuote(e.From), dotQuote(e.To), e.Count)
+		} else {
+			fmt.Fprintf(&b, "\t%s -> %s;\n", dotQuote(e.From), dotQuote(e.To))
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
@@ -0,0 +1,84 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package graph
+
+import (
+	"testing"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	. "gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type MySuite struct{}
+
+var _ = Suite(&MySuite{})
+
+func graphOf(c *C, conceptText string, specTexts ...string) *Graph {
+	dict := gauge.NewConceptDictionary()
+	concepts, res := new(parser.ConceptParser).Parse(conceptText, "concepts.cpt")
+	c.Assert(res.ParseErrors, HasLen, 0)
+	_, err := parser.AddConcept(concepts, "concepts.cpt", dict)
+	c.Assert(err, IsNil)
+	var specs []*gauge.Specification
+	for i, text := range specTexts {
+		spec, res, err := new(parser.SpecParser).Parse(text, dict, []string{"a.spec", "b.spec"}[i])
+		c.Assert(err, IsNil)
+		c.Assert(res.Ok, Equals, true)
+		specs = append(specs, spec)
+	}
+	return New(specs, dict)
+}
+
+const concepts = `# log in as <user>
+* open the login page
+* enter <user>
+
+# place an order
+* log in as "buyer"
+* pay
+
+# clean up
+* delete everything
+`
+
+func (s *MySuite) TestGraphOfSpecsAndConcepts(c *C) {
+	g := graphOf(c, concepts,
+		"# Orders\n## Order\n* place an order\n* log in as \"admin\"\n* place an order\n",
+		"# Login\n## Log in\n* log in as \"admin\"\n")
+
+	c.Assert(g.Nodes, DeepEquals, []*Node{
+		{ID: "concept:log in as {}", Kind: ConceptNode, Label: "log in as <user>", File: "concepts.cpt", LineNo: 1, UsedBy: 3, Depth: 1},
+		{ID: "concept:place an order", Kind: ConceptNode, Label: "place an order", File: "concepts.cpt", LineNo: 5, UsedBy: 1, Depth: 2},
+		{ID: "concept:clean up", Kind: ConceptNode, Label: "clean up", File: "concepts.cpt", LineNo: 9, UsedBy: 0, Depth: 1},
+		{ID: "spec:a.spec", Kind: SpecNode, Label: "Orders", File: "a.spec"},
+		{ID: "spec:b.spec", Kind: SpecNode, Label: "Login", File: "b.spec"},
+	})
+	c.Assert(g.Edges, DeepEquals, []*Edge{
+		{From: "concept:place an order", To: "concept:log in as {}", Count: 1},
+		{From: "spec:a.spec", To: "concept:place an order", Count: 2},
+		{From: "spec:a.spec", To: "concept:log in as {}", Count: 1},
+		{From: "spec:b.spec", To: "concept:log in as {}", Count: 1},
+	})
+}
+
+func (s *MySuite) TestDOT(c *C) {
+	g := graphOf(c, concepts, "# Orders \"now\"\n## Order\n* place an order\n* place an order\n")
+
+	c.Assert(g.DOT(), Equals, `digraph gauge {
+	rankdir=LR;
+	"concept:log in as {}" [label="log in as <user>"];
+	"concept:place an order" [label="place an order"];
+	"concept:clean up" [label="clean up", style=dashed];
+	"spec:a.spec" [label="Orders \"now\"", shape=box];
+	"concept:place an order" -> "concept:log in as {}";
+	"spec:a.spec" -> "concept:place an order" [label="2"];
+}
+`)
+}
@@ -0,0 +1,34 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package i18n
+
+var de = map[string]string{
+	"Specifications:\t%d executed\t%d passed\t%d failed\t%d skipped": "Spezifikationen:\t%d ausgeführt\t%d bestanden\t%d fehlgeschlagen\t%d übersprungen",
+	"Scenarios:\t%d executed\t%d passed\t%d failed\t%d skipped":      "Szenarien:\t%d ausgeführt\t%d bestanden\t%d fehlgeschlagen\t%d übersprungen",
+	"\nTotal time taken: %s":                 "\nGesamtdauer: %s",
+	"Failures by owner:":                     "Fehler nach Verantwortlichen:",
+	"\t%s\t%d failed":                        "\t%s\t%d fehlgeschlagen",
+	"Scenarios by priority:":                 "Szenarien nach Priorität:",
+	"\t%s\t%d passed\t%d failed\t%d skipped": "\t%s\t%d bestanden\t%d fehlgeschlagen\t%d übersprungen",
+	"unprioritized":                          "ohne Priorität",
+	"No specifications found in %s.":         "Keine Spezifikationen in %s gefunden.",
+	"Error Message: %s":                      "Fehlermeldung: %s",
+	"\nFailed Step: %s":                      "\nFehlgeschlagener Schritt: %s",
+	"Specification: %s:%v":                   "Spezifikation: %s:%v",
+	"Specification: %s":                      "Spezifikation: %s",
+	"%s, data table %s":                      "%s, Datentabelle %s",
+	"Stacktrace: \n%s":                       "Stacktrace: \n%s",
+
+	// parse errors
+	"Spec does not have any elements":                 "Die Spezifikation hat keine Elemente",
+	"Spec heading not found":                          "Überschrift der Spezifikation nicht gefunden",
+	"Spec heading should have at least one character": "Die Überschrift der Spezifikation muss mindestens ein Zeichen haben",
+	"Data table should have at least 1 data row":      "Die Datentabelle muss mindestens eine Datenzeile haben",
+	"Spec should have atleast one scenario":           "Die Spezifikation muss mindestens ein Szenario haben",
+	"Scenario should have atleast one step":           "Das Szenario muss mindestens einen Schritt haben",
+	"Duplicate concept definition found":              "Doppelte Konzeptdefinition gefunden",
+}
@@ -0,0 +1,34 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package i18n
+
+var fr = map[string]string{
+	"Specifications:\t%d executed\t%d passed\t%d failed\t%d skipped": "Spécifications :\t%d exécutées\t%d réussies\t%d échouées\t%d ignorées",
+	"Scenarios:\t%d executed\t%d passed\t%d failed\t%d skipped":      "Scénarios :\t%d exécutés\t%d réussis\t%d échoués\t%d ignorés",
+	"\nTotal time taken: %s":                 "\nDurée totale : %s",
+	"Failures by owner:":                     "Échecs par responsable :",
+	"\t%s\t%d failed":                        "\t%s\t%d échoués",
+	"Scenarios by priority:":                 "Scénarios par priorité :",
+	"\t%s\t%d passed\t%d failed\t%d skipped": "\t%s\t%d réussis\t%d échoués\t%d ignorés",
+	"unprioritized":                          "sans priorité",
+	"No specifications found in %s.":         "Aucune spécification trouvée dans %s.",
+	"Error Message: %s":                      "Message d'erreur : %s",
+	"\nFailed Step: %s":                      "\nÉtape en échec : %s",
+	"Specification: %s:%v":                   "Spécification : %s:%v",
+	"Specification: %s":                      "Spécification : %s",
+	"%s, data table %s":                      "%s, table de données %s",
+	"Stacktrace: \n%s":                       "Pile d'appels : \n%s",
+
+	// parse errors
+	"Spec does not have any elements":                 "La spécification n'a aucun élément",
+	"Spec heading not found":                          "Titre de la spécification introuvable",
+	"Spec heading should have at least one character": "Le titre de la spécification doit avoir au moins un caractère",
+	"Data table should have at least 1 data row":      "La table de données doit avoir au moins une ligne",
+	"Spec should have atleast one scenario":           "La spécification doit avoir au moins un scénario",
+	"Scenario should have atleast one step":           "Le scénario doit avoir au moins une étape",
+	"Duplicate concept definition found":              "Définition de concept en double",
+}
@@ -0,0 +1,67 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package i18n translates the messages gauge itself shows to users, like the console reporter and the run summary,
+into the language set in GAUGE_LANG.
+
+	GAUGE_LANG=fr gauge run specs
+
+Messages are looked up by their English format string, so an untranslated message, or an unknown language,
+shows in English. The catalogs are compiled into the binary.
+*/
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// LangEnv is the environment variable which sets the language, like fr or de_DE.UTF-8
+const LangEnv = "GAUGE_LANG"
+
+const english = "en"
+
+var catalogs = map[string]map[string]string{
+	"fr": fr,
+	"de": de,
+}
+
+// Language gives the language of the messages, without its region and encoding
+func Language() string {
+	return normalize(os.Getenv(LangEnv))
+}
+
+func normalize(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "_-."); i >= 0 {
+		lang = lang[:i]
+	}
+	if _, ok := catalogs[lang]; !ok {
+		return english
+	}
+	return lang
+}
+
+// T gives the translation of the format string, or the format string itself when it has no translation
+func T(format string) string {
+	return TranslateTo(Language(), format)
+}
+
+// TranslateTo gives the translation of the format string into the language, like fr or de_DE.UTF-8, rather than
+// the one set in GAUGE_LANG
+func TranslateTo(lang, format string) string {
+	if t, ok := catalogs[normalize(lang)][format]; ok {
+		return t
+	}
+	return format
+}
+
+// Sprintf formats the translation of the format string with the args
+func Sprintf(format string, args ...interface{}) string {
+	return fmt.Sprintf(T(format), args...)
+}
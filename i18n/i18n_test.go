@@ -0,0 +1,78 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package i18n
+
+import (
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestLanguage(t *testing.T) {
+	defer os.Unsetenv(LangEnv)
+	for value, want := range map[string]string{"": "en", "fr": "fr", "de_DE.UTF-8": "de", "FR-ca": "fr", "xx": "en"} {
+		os.Setenv(LangEnv, value)
+		if got := Language(); got != want {
+			t.Errorf("expected %s for %s=%s, got %s", want, LangEnv, value, got)
+		}
+	}
+}
+
+func TestSprintfTranslates(t *testing.T) {
+	defer os.Unsetenv(LangEnv)
+	os.Setenv(LangEnv, "fr")
+
+	if got := Sprintf("No specifications found in %s.", "specs"); got != "Aucune spécification trouvée dans specs." {
+		t.Errorf("unexpected translation %s", got)
+	}
+	if got := Sprintf("Unknown message %d", 1); got != "Unknown message 1" {
+		t.Errorf("expected the untranslated message, got %s", got)
+	}
+}
+
+func TestSprintfInEnglish(t *testing.T) {
+	os.Unsetenv(LangEnv)
+
+	if got := Sprintf("Error Message: %s", "boom"); got != "Error Message: boom" {
+		t.Errorf("unexpected message %s", got)
+	}
+}
+
+var verb = regexp.MustCompile(`%[a-z]`)
+
+func TestCatalogsKeepFormatVerbs(t *testing.T) {
+	for lang, catalog := range catalogs {
+		for format, translation := range catalog {
+			if !reflect.DeepEqual(verb.FindAllString(format, -1), verb.FindAllString(translation, -1)) {
+				t.Errorf("%s translation of %q does not keep its format verbs: %q", lang, format, translation)
+			}
+		}
+	}
+}
+
+func TestCatalogsTranslateTheSameMessages(t *testing.T) {
+	for lang, catalog := range catalogs {
+		for format := range fr {
+			if _, ok := catalog[format]; !ok {
+				t.Errorf("%s has no translation of %q", lang, format)
+			}
+		}
+	}
+}
+
+func TestTranslateToIgnoresGaugeLang(t *testing.T) {
+	defer os.Unsetenv(LangEnv)
+	os.Setenv(LangEnv, "fr")
+
+	if got := TranslateTo("de_DE.UTF-8", "Spec heading not found"); got != "Überschrift der Spezifikation nicht gefunden" {
+		t.Errorf("unexpected translation %s", got)
+	}
+	if got := TranslateTo("en", "Spec heading not found"); got != "Spec heading not found" {
+		t.Errorf("expected the untranslated message, got %s", got)
+	}
+}
@@ -0,0 +1,63 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lint
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const commandRule = "command"
+
+var findingLine = regexp.MustCompile(`^(.+?):(\d+): *(error|warning|info): *(.*)$`)
+
+// runCommand runs the command set in gauge_lint_command with the spec files as arguments and reads its findings.
+// A command which exits with an error is not a failure, linters usually do when they find errors.
+func runCommand(files []string) ([]Finding, error) {
+	command := env.LintCommand()
+	if command == "" || len(files) == 0 {
+		return nil, nil
+	}
+	args := append(strings.Fields(command), files...)
+	logger.Debugf(true, "Running lint command: %s", command)
+	var out bytes.Buffer
+	cmd, err := common.ExecuteSystemCommand(args, config.ProjectRoot, &out, os.Stderr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run lint command '%s'. %s", command, err.Error())
+	}
+	if err = cmd.Wait(); err != nil {
+		logger.Debugf(true, "Lint command '%s' exited with: %s", command, err.Error())
+	}
+	return parseFindings(&out), nil
+}
+
+// parseFindings reads findings written as "file:line: severity: message", other lines are ignored
+func parseFindings(r io.Reader) []Finding {
+	var findings []Finding
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		m := findingLine.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if m == nil {
+			continue
+		}
+		line, _ := strconv.Atoi(m[2])
+		findings = append(findings, Finding{Rule: commandRule, Severity: Severity(m[3]), File: util.RelPathToProjectRoot(m[1]), Line: line, Message: m[4]})
+	}
+	return findings
+}
@@ -0,0 +1,141 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lint
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ConfigFile is the name of the lint configuration in the project root. It sets the severity of the findings of
+// rules, off disabling a rule, and the options of the rules which have some:
+//
+//	rules:
+//	  max-steps:
+//	    severity: error
+//	    max: 10
+//	  missing-tags:
+//	    severity: warning
+//	  priority:
+//	    required: true
+//	  hard-coded-credentials:
+//	    patterns: ['sk_live_[0-9a-zA-Z]{24}']
+//	  heading-style:
+//	    severity: off
+const ConfigFile = "gauge-lint.yaml"
+
+// Off is the severity which disables a rule
+const Off Severity = "off"
+
+// Config holds the configuration of the rules, by name
+type Config struct {
+	Rules map[string]*RuleConfig `yaml:"rules"`
+}
+
+// RuleConfig configures a rule. Options which do not apply to the rule are ignored.
+type RuleConfig struct {
+	// Severity replaces the severity of the findings of the rule, off disables it
+	Severity Severity `yaml:"severity"`
+	// Max is the limit of max-steps and max-table-rows
+	Max int `yaml:"max"`
+	// Required makes the priority rule flag scenarios without a priority tag
+	Required bool `yaml:"required"`
+	// Patterns are regular expressions of the values hard-coded-credentials flags, along with the built-in ones
+	Patterns []string `yaml:"patterns"`
+}
+
+// configurable is a rule with options, it gives a copy of itself configured with them
+type configurable interface {
+	configure(c *RuleConfig) (Rule, error)
+}
+
+// LoadConfig reads the lint configuration from the given project root. It returns an empty configuration if the
+// project has none.
+func LoadConfig(projectRoot string) (*Config, error) {
+	p := filepath.Join(projectRoot, ConfigFile)
+	content, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s. %s", p, err.Error())
+	}
+	c, err := ParseConfig(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s. %s", p, err.Error())
+	}
+	return c, nil
+}
+
+// ParseConfig creates a configuration from the contents of a gauge-lint.yaml file
+func ParseConfig(content []byte) (*Config, error) {
+	c := &Config{}
+	if err := yaml.UnmarshalStrict(content, c); err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool)
+	for _, name := range append(Rules(), spellingRuleName, commandRule) {
+		known[name] = true
+	}
+	names := make([]string, 0, len(c.Rules))
+	for name := range c.Rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown rule %s", name)
+		}
+		rc := c.Rules[name]
+		if rc == nil {
+			c.Rules[name] = &RuleConfig{}
+			continue
+		}
+		switch rc.Severity {
+		case "", Error, Warning, Info, Off:
+		default:
+			return nil, fmt.Errorf("rule %s has an invalid severity %s, it should be one of error, warning, info or off", name, rc.Severity)
+		}
+		if rc.Max < 0 {
+			return nil, fmt.Errorf("rule %s has a negative max %d", name, rc.Max)
+		}
+	}
+	return c, nil
+}
+
+func (c *Config) rule(name string) *RuleConfig {
+	if c == nil || c.Rules[name] == nil {
+		return &RuleConfig{}
+	}
+	return c.Rules[name]
+}
+
+// enabled tells if the rule is not turned off
+func (c *Config) enabled(name string) bool {
+	return c.rule(name).Severity != Off
+}
+
+// apply gives the findings of the enabled rules, with the severities the configuration sets
+func (c *Config) apply(findings []Finding) []Finding {
+	applied := make([]Finding, 0, len(findings))
+	for _, f := range findings {
+		rc := c.rule(f.Rule)
+		if rc.Severity == Off {
+			continue
+		}
+		if rc.Severity != "" {
+			f.Severity = rc.Severity
+		}
+		applied = append(applied, f)
+	}
+	return applied
+}
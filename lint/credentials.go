@@ -0,0 +1,108 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+var (
+	// credentialWords are the words of steps and table headers whose values are credentials
+	credentialWords = regexp.MustCompile(`(?i)\b(password|passwd|passphrase|secret|token|api[ _-]?key|credentials?)\b`)
+	// credentialPatterns match values which are credentials whatever the step, like AWS access keys
+	credentialPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`),
+		regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+		regexp.MustCompile(`\bgh[pousr]_[0-9A-Za-z]{36}\b`),
+	}
+)
+
+// credentialsRule flags credentials written in specs, as static parameters following words like password or token in
+// the step, as cells of such columns of data tables or as values which look like keys. They belong in
+// <env:NAME> parameters, which the environment of the run sets.
+type credentialsRule struct {
+	patterns []*regexp.Regexp
+}
+
+func (r *credentialsRule) Name() string {
+	return "hard-coded-credentials"
+}
+
+func (r *credentialsRule) configure(c *RuleConfig) (Rule, error) {
+	configured := &credentialsRule{patterns: append([]*regexp.Regexp{}, r.patterns...)}
+	for _, p := range c.Patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s has an invalid pattern %s. %s", r.Name(), p, err.Error())
+		}
+		configured.patterns = append(configured.patterns, re)
+	}
+	return configured, nil
+}
+
+func (r *credentialsRule) Check(spec *gauge.Specification) []Finding {
+	var findings []Finding
+	checkTable := func(t *gauge.Table, line int) {
+		if !t.IsInitialized() {
+			return
+		}
+		for _, header := range t.Headers {
+			cells, _ := t.Get(header)
+			for _, cell := range cells {
+				if cell.CellType != gauge.Static || cell.Value == "" {
+					continue
+				}
+				if credentialWords.MatchString(header) || r.matches(cell.Value) {
+					findings = append(findings, newFinding(r.Name(), Error, spec, line, "column '%s' has a hard-coded credential, use an <env:NAME> parameter instead", header))
+					break
+				}
+			}
+		}
+	}
+	checkStep := func(step *gauge.Step) {
+		texts := strings.Split(step.Value, gauge.ParameterPlaceholder)
+		for i, arg := range step.Args {
+			switch arg.ArgType {
+			case gauge.Static:
+				if arg.Value != "" && ((i < len(texts) && credentialWords.MatchString(texts[i])) || r.matches(arg.Value)) {
+					findings = append(findings, newFinding(r.Name(), Error, spec, step.LineNo, "step '%s' has a hard-coded credential, use an <env:NAME> parameter instead", step.LineText))
+					return
+				}
+			case gauge.TableArg:
+				checkTable(&arg.Table, step.LineNo)
+			}
+		}
+	}
+	checkDataTable := func(dt gauge.DataTable) {
+		if !dt.IsExternal && dt.Table != nil {
+			checkTable(dt.Table, dt.Table.LineNo)
+		}
+	}
+	checkDataTable(spec.DataTable)
+	steps := append(append([]*gauge.Step{}, spec.Contexts...), spec.TearDownSteps...)
+	for _, scn := range spec.Scenarios {
+		checkDataTable(scn.DataTable)
+		steps = append(steps, scn.Steps...)
+	}
+	for _, step := range steps {
+		checkStep(step)
+	}
+	return findings
+}
+
+func (r *credentialsRule) matches(value string) bool {
+	for _, p := range r.patterns {
+		if p.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
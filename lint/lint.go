@@ -0,0 +1,162 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package lint checks specs against rules of style and maintainability, like the number of steps of a scenario
+or the style of headings, and reports findings with a severity and a position.
+
+The gauge-lint.yaml file of a project sets the severity of the findings of each rule, turns rules off and sets
+their options, like the limit of max-steps.
+
+Projects add their own rules either by registering a Rule, when embedding gauge, or with a command set in
+gauge_lint_command. The command gets the spec files as arguments and prints a finding per line:
+
+	specs/checkout.spec:12: warning: scenario has no owner annotation
+*/
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+)
+
+// Severity tells how serious a finding is
+type Severity string
+
+const (
+	// Error findings make gauge lint fail
+	Error Severity = "error"
+	// Warning findings should be fixed
+	Warning Severity = "warning"
+	// Info findings are suggestions
+	Info Severity = "info"
+)
+
+// Finding is a violation of a rule at a position of a spec file
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Message  string   `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s [%s]", f.File, f.Line, f.Severity, f.Message, f.Rule)
+}
+
+// Rule checks a spec and returns its findings
+type Rule interface {
+	Name() string
+	Check(spec *gauge.Specification) []Finding
+}
+
+var rules = []Rule{
+	&maxStepsRule{max: defaultMaxSteps},
+	&maxTableRowsRule{max: defaultMaxTableRows},
+	&missingTagsRule{},
+	&priorityRule{},
+	&headingStyleRule{},
+	&credentialsRule{patterns: credentialPatterns},
+}
+
+// Register adds a rule which runs along with the built-in ones
+func Register(r Rule) {
+	rules = append(rules, r)
+}
+
+// Rules gives the names of the registered rules
+func Rules() []string {
+	names := make([]string, 0, len(rules))
+	for _, r := range rules {
+		names = append(names, r.Name())
+	}
+	return names
+}
+
+// Lint checks the specs with all the registered rules, and with the project command if there is one, as configured
+// by the gauge-lint.yaml file of the project. Findings are sorted by file and line.
+func Lint(specs []*gauge.Specification) ([]Finding, error) {
+	c, err := LoadConfig(config.ProjectRoot)
+	if err != nil {
+		return nil, err
+	}
+	return LintWith(specs, c)
+}
+
+// LintWith checks the specs like Lint, with the given configuration
+func LintWith(specs []*gauge.Specification, c *Config) ([]Finding, error) {
+	enabled, err := enabledRules(c)
+	if err != nil {
+		return nil, err
+	}
+	findings := make([]Finding, 0)
+	files := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		files = append(files, spec.FileName)
+		for _, r := range enabled {
+			findings = append(findings, r.Check(spec)...)
+		}
+	}
+	if c.enabled(commandRule) {
+		external, err := runCommand(files)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, external...)
+	}
+	findings = c.apply(findings)
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].File != findings[j].File {
+			return findings[i].File < findings[j].File
+		}
+		return findings[i].Line < findings[j].Line
+	})
+	return findings, nil
+}
+
+// enabledRules gives the registered rules and the optional ones which the project configures, leaving out the ones
+// turned off
+func enabledRules(c *Config) ([]Rule, error) {
+	var enabled []Rule
+	for _, r := range rules {
+		if !c.enabled(r.Name()) {
+			continue
+		}
+		if cr, ok := r.(configurable); ok {
+			configured, err := cr.configure(c.rule(r.Name()))
+			if err != nil {
+				return nil, err
+			}
+			r = configured
+		}
+		enabled = append(enabled, r)
+	}
+	if !c.enabled(spellingRuleName) {
+		return enabled, nil
+	}
+	spelling, err := newSpellingRule()
+	if err != nil {
+		return nil, err
+	}
+	if spelling != nil {
+		enabled = append(enabled, spelling)
+	}
+	return enabled, nil
+}
+
+// HasErrors tells if any of the findings is an error
+func HasErrors(findings []Finding) bool {
+	for _, f := range findings {
+		if f.Severity == Error {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/parser"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	defaultMaxSteps     = 15
+	defaultMaxTableRows = 50
+)
+
+func newFinding(rule string, severity Severity, spec *gauge.Specification, line int, format string, args ...interface{}) Finding {
+	return Finding{Rule: rule, Severity: severity, File: util.RelPathToProjectRoot(spec.FileName), Line: line, Message: fmt.Sprintf(format, args...)}
+}
+
+// maxStepsRule flags scenarios with so many steps that they are hard to follow, concepts count as one step
+type maxStepsRule struct {
+	max int
+}
+
+func (r *maxStepsRule) Name() string {
+	return "max-steps"
+}
+
+func (r *maxStepsRule) configure(c *RuleConfig) (Rule, error) {
+	if c.Max == 0 {
+		return r, nil
+	}
+	return &maxStepsRule{max: c.Max}, nil
+}
+
+func (r *maxStepsRule) Check(spec *gauge.Specification) []Finding {
+	var findings []Finding
+	for _, scn := range spec.Scenarios {
+		if n := len(scn.Steps); n > r.max {
+			findings = append(findings, newFinding(r.Name(), Warning, spec, scn.Heading.LineNo, "scenario '%s' has %d steps, more than %d", scn.Heading.Value, n, r.max))
+		}
+	}
+	return findings
+}
+
+// maxTableRowsRule flags data tables and table parameters which would read better as external CSV files
+type maxTableRowsRule struct {
+	max int
+}
+
+func (r *maxTableRowsRule) Name() string {
+	return "max-table-rows"
+}
+
+func (r *maxTableRowsRule) configure(c *RuleConfig) (Rule, error) {
+	if c.Max == 0 {
+		return r, nil
+	}
+	return &maxTableRowsRule{max: c.Max}, nil
+}
+
+func (r *maxTableRowsRule) Check(spec *gauge.Specification) []Finding {
+	var findings []Finding
+	check := func(t *gauge.Table, line int, what string) {
+		if t.IsInitialized() && t.GetRowCount() > r.max {
+			findings = append(findings, newFinding(r.Name(), Warning, spec, line, "%s has %d rows, more than %d. Consider moving it to a CSV file", what, t.GetRowCount(), r.max))
+		}
+	}
+	if !spec.DataTable.IsExternal {
+		check(spec.DataTable.Table, spec.DataTable.LineNo, "data table")
+	}
+	steps := append(append([]*gauge.Step{}, spec.Contexts...), spec.TearDownSteps...)
+	for _, scn := range spec.Scenarios {
+		if !scn.DataTable.IsExternal {
+			check(scn.DataTable.Table, scn.DataTable.LineNo, "data table")
+		}
+		steps = append(steps, scn.Steps...)
+	}
+	for _, step := range steps {
+		for _, arg := range step.Args {
+			if arg.ArgType == gauge.TableArg {
+				check(&arg.Table, step.LineNo, "table parameter")
+			}
+		}
+	}
+	return findings
+}
+
+// missingTagsRule flags scenarios which have no tags of their own or through their spec, so cannot be selected with --tags
+type missingTagsRule struct{}
+
+func (r *missingTagsRule) Name() string {
+	return "missing-tags"
+}
+
+func (r *missingTagsRule) Check(spec *gauge.Specification) []Finding {
+	if spec.Tags != nil && len(spec.Tags.Values()) > 0 {
+		return nil
+	}
+	var findings []Finding
+	for _, scn := range spec.Scenarios {
+		if scn.Tags == nil || len(scn.Tags.Values()) == 0 {
+			findings = append(findings, newFinding(r.Name(), Info, spec, scn.Heading.LineNo, "scenario '%s' has no tags", scn.Heading.Value))
+		}
+	}
+	return findings
+}
+
+// priorityRule flags scenarios with conflicting priority tags, like priority:1 and Priority2. When they are required, it
+// also flags the scenarios without a priority of their own or from their spec. Malformed priority:N tags are parse
+// errors.
+type priorityRule struct {
+	required bool
+}
+
+func (r *priorityRule) Name() string {
+	return "priority"
+}
+
+func (r *priorityRule) configure(c *RuleConfig) (Rule, error) {
+	return &priorityRule{required: c.Required}, nil
+}
+
+func (r *priorityRule) Check(spec *gauge.Specification) []Finding {
+	var findings []Finding
+	specPriority := parser.TagsPriority(spec.Tags)
+	for _, scn := range spec.Scenarios {
+		var priorities []string
+		if scn.Tags != nil {
+			for _, t := range scn.Tags.Values() {
+				if _, ok := parser.PriorityOfTag(t); ok {
+					priorities = append(priorities, t)
+				}
+			}
+		}
+		if r.required && specPriority == parser.NoPriority && parser.ScenarioPriority(scn) == parser.NoPriority {
+			findings = append(findings, newFinding(r.Name(), Warning, spec, scn.Heading.LineNo, "scenario '%s' has no priority tag", scn.Heading.Value))
+		}
+		if len(priorities) > 1 {
+			findings = append(findings, newFinding(r.Name(), Warning, spec, scn.Heading.LineNo, "scenario '%s' has several priority tags: %s", scn.Heading.Value, strings.Join(priorities, ", ")))
+		}
+	}
+	return findings
+}
+
+// headingStyleRule flags headings which do not start with a capital letter or end with a full stop
+type headingStyleRule struct{}
+
+func (r *headingStyleRule) Name() string {
+	return "heading-style"
+}
+
+func (r *headingStyleRule) Check(spec *gauge.Specification) []Finding {
+	var findings []Finding
+	check := func(h *gauge.Heading, what string) {
+		if h == nil || h.Value == "" {
+			return
+		}
+		if first, _ := utf8.DecodeRuneInString(h.Value); unicode.IsLower(first) {
+			findings = append(findings, newFinding(r.Name(), Warning, spec, h.LineNo, "%s heading '%s' should start with a capital letter", what, h.Value))
+		}
+		if strings.HasSuffix(h.Value, ".") {
+			findings = append(findings, newFinding(r.Name(), Warning, spec, h.LineNo, "%s heading '%s' should not end with a full stop", what, h.Value))
+		}
+	}
+	check(spec.Heading, "spec")
+	for _, scn := range spec.Scenarios {
+		check(scn.Heading, "scenario")
+	}
+	return findings
+}
@@ -0,0 +1,173 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package lint
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+)
+
+const (
+	spellingRuleName    = "spelling"
+	glossaryReplacement = "->"
+)
+
+var (
+	wordPattern    = regexp.MustCompile(`\p{L}+(?:'\p{L}+)*`)
+	ignoredPattern = regexp.MustCompile("\\S+://\\S+|`[^`]*`|\"[^\"]*\"|<[^>]*>")
+)
+
+// replacement is a term of the glossary which should be written as another one, like "log in -> sign in"
+type replacement struct {
+	term      *regexp.Regexp
+	text      string
+	preferred string
+}
+
+// spellingRule checks the words of headings, comments and steps against dictionaries and the glossary of the project,
+// and flags the terms which the glossary replaces. Typos in steps end up in step implementations, where they stay.
+type spellingRule struct {
+	words        map[string]bool
+	replacements []*replacement
+}
+
+// newSpellingRule loads the rule from gauge_lint_dictionaries and gauge_lint_glossary, it is nil if neither is set
+func newSpellingRule() (*spellingRule, error) {
+	dictionaries, glossary := env.LintDictionaries(), env.LintGlossary()
+	if len(dictionaries) == 0 && glossary == "" {
+		return nil, nil
+	}
+	r := &spellingRule{words: make(map[string]bool)}
+	for _, d := range dictionaries {
+		if err := readLines(d, r.addWord); err != nil {
+			return nil, err
+		}
+	}
+	if glossary == "" {
+		return r, nil
+	}
+	if err := readLines(glossary, r.addGlossaryEntry); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func readLines(file string, add func(string)) error {
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(config.ProjectRoot, file)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("unable to read word list. %s", err.Error())
+	}
+	defer f.Close()
+	return readWords(f, add)
+}
+
+func readWords(r io.Reader, add func(string)) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			add(line)
+		}
+	}
+	return scanner.Err()
+}
+
+// addWord adds a dictionary word, dropping the affix flags of hunspell dictionaries like "walk/DGS"
+func (r *spellingRule) addWord(word string) {
+	r.words[strings.ToLower(strings.SplitN(word, "/", 2)[0])] = true
+}
+
+// addGlossaryEntry adds an approved term, whose words are all approved, or a replacement written as "term -> preferred"
+func (r *spellingRule) addGlossaryEntry(entry string) {
+	parts := strings.SplitN(entry, glossaryReplacement, 2)
+	if len(parts) == 2 {
+		term, preferred := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		r.replacements = append(r.replacements, &replacement{
+			term:      regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(term) + `\b`),
+			text:      term,
+			preferred: preferred,
+		})
+		entry = preferred
+	}
+	for _, w := range wordPattern.FindAllString(entry, -1) {
+		r.addWord(w)
+	}
+}
+
+func (r *spellingRule) Name() string {
+	return spellingRuleName
+}
+
+func (r *spellingRule) Check(spec *gauge.Specification) []Finding {
+	var findings []Finding
+	check := func(text string, line int) {
+		text = ignoredPattern.ReplaceAllString(text, " ")
+		for _, rep := range r.replacements {
+			if rep.term.MatchString(text) {
+				findings = append(findings, newFinding(r.Name(), Warning, spec, line, "use '%s' instead of '%s'", rep.preferred, rep.text))
+				text = rep.term.ReplaceAllString(text, " ")
+			}
+		}
+		if len(r.words) == 0 {
+			return
+		}
+		reported := make(map[string]bool)
+		for _, w := range wordPattern.FindAllString(text, -1) {
+			if reported[w] || r.known(w) {
+				continue
+			}
+			reported[w] = true
+			findings = append(findings, newFinding(r.Name(), Warning, spec, line, "unknown word '%s'", w))
+		}
+	}
+	checkComments := func(comments []*gauge.Comment) {
+		for _, c := range comments {
+			if !strings.HasPrefix(strings.TrimSpace(c.Value), "@") {
+				check(c.Value, c.LineNo)
+			}
+		}
+	}
+	checkSteps := func(steps []*gauge.Step) {
+		for _, s := range steps {
+			check(s.Value, s.LineNo)
+		}
+	}
+	if spec.Heading != nil {
+		check(spec.Heading.Value, spec.Heading.LineNo)
+	}
+	checkComments(spec.Comments)
+	checkSteps(spec.Contexts)
+	for _, scn := range spec.Scenarios {
+		check(scn.Heading.Value, scn.Heading.LineNo)
+		checkComments(scn.Comments)
+		checkSteps(scn.Steps)
+	}
+	checkSteps(spec.TearDownSteps)
+	return findings
+}
+
+// known tells if the word is in a dictionary or the glossary. Acronyms and single letters are not checked.
+func (r *spellingRule) known(word string) bool {
+	if len([]rune(word)) == 1 || strings.ToUpper(word) == word && strings.IndexFunc(word, unicode.IsUpper) >= 0 {
+		return true
+	}
+	w := strings.ToLower(word)
+	return r.words[w] || r.words[strings.TrimSuffix(w, "'s")]
+}
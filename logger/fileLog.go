@@ -0,0 +1,54 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package logger
+
+import (
+	"github.com/natefinch/lumberjack"
+	logging "github.com/op/go-logging"
+)
+
+// FileLog is a log of its own in the logs directory, apart from the log of gauge, like the log of a client of the
+// daemon
+type FileLog struct {
+	file   *lumberjack.Logger
+	logger *logging.Logger
+}
+
+// NewFileLog gives the log written to the file of the logs directory, whose lines name the module
+func NewFileLog(module, fileName string) *FileLog {
+	file := &lumberjack.Logger{Filename: getLogFile(fileName), MaxSize: 10, MaxBackups: 3, MaxAge: 28}
+	backend := logging.AddModuleLevel(logging.NewBackendFormatter(logging.NewLogBackend(file, "", 0), fileLogFormat))
+	backend.SetLevel(logging.DEBUG, "")
+	l := logging.MustGetLogger(module)
+	l.SetBackend(backend)
+	return &FileLog{file: file, logger: l}
+}
+
+// File gives the path of the log file
+func (f *FileLog) File() string {
+	return f.file.Filename
+}
+
+// Infof logs INFO messages to the file.
+func (f *FileLog) Infof(msg string, args ...interface{}) {
+	f.logger.Infof(msg, args...)
+}
+
+// Errorf logs ERROR messages to the file.
+func (f *FileLog) Errorf(msg string, args ...interface{}) {
+	f.logger.Errorf(msg, args...)
+}
+
+// Debugf logs DEBUG messages to the file.
+func (f *FileLog) Debugf(msg string, args ...interface{}) {
+	f.logger.Debugf(msg, args...)
+}
+
+// Close closes the log file
+func (f *FileLog) Close() error {
+	return f.file.Close()
+}
@@ -237,6 +237,8 @@ func loggingLevel(logLevel string) logging.Level {
 			return logging.WARNING
 		case "error":
 			return logging.ERROR
+		case "critical":
+			return logging.CRITICAL
 		}
 	}
 	return logging.INFO
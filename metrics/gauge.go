@@ -0,0 +1,15 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package metrics
+
+// The metrics recorded by gauge
+var (
+	Runs             = NewCounter("gauge_runs_total", "Number of runs, by result.", "result")
+	ScenarioDuration = NewHistogram("gauge_scenario_duration_seconds", "Time taken to execute a scenario, by result.", DefaultBuckets, "result")
+	ScenarioFailures = NewCounter("gauge_scenario_failures_total", "Number of failed scenarios, by tag. Scenarios without tags are counted with an empty tag.", "tag")
+	RunnerStarts     = NewCounter("gauge_runner_starts_total", "Number of times a language runner was started. Starts after the first one are restarts.")
+)
@@ -0,0 +1,223 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package metrics keeps the counters and histograms of a long running gauge process, like the daemon,
+and exposes them in the Prometheus text format.
+
+	gauge daemon --metrics-port 9494 1234
+	curl http://localhost:9494/metrics
+
+Metrics are only recorded once Enable has been called, so that regular runs do not pay for them.
+*/
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Path is the path at which the metrics are served
+const Path = "/metrics"
+
+var (
+	mu      sync.Mutex
+	enabled bool
+	all     []metric
+)
+
+type metric interface {
+	write(w io.Writer)
+}
+
+// Enable starts recording metrics
+func Enable() {
+	mu.Lock()
+	defer mu.Unlock()
+	enabled = true
+}
+
+// Enabled tells if metrics are being recorded
+func Enabled() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return enabled
+}
+
+// Counter is a metric which only goes up, with a value for every combination of its labels
+type Counter struct {
+	name, help string
+	labels     []string
+	values     map[string]float64
+}
+
+// NewCounter creates a counter and registers it to be exposed
+func NewCounter(name, help string, labels ...string) *Counter {
+	c := &Counter{name: name, help: help, labels: labels, values: make(map[string]float64)}
+	register(c)
+	return c
+}
+
+// Inc adds one to the counter for the given label values
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds the value to the counter for the given label values
+func (c *Counter) Add(v float64, labelValues ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if enabled {
+		c.values[labelPairs(c.labels, labelValues)] += v
+	}
+}
+
+func (c *Counter) write(w io.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", c.name, c.help, c.name)
+	for _, l := range sortedKeys(c.values) {
+		fmt.Fprintf(w, "%s%s %s\n", c.name, braces(l), formatValue(c.values[l]))
+	}
+}
+
+// Histogram counts observations, like durations, in cumulative buckets
+type Histogram struct {
+	name, help string
+	labels     []string
+	buckets    []float64
+	series     map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// DefaultBuckets are the upper bounds, in seconds, of the buckets used for durations
+var DefaultBuckets = []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 300}
+
+// NewHistogram creates a histogram with the given bucket upper bounds and registers it to be exposed
+func NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	h := &Histogram{name: name, help: help, labels: labels, buckets: buckets, series: make(map[string]*histogramSeries)}
+	register(h)
+	return h
+}
+
+// Observe records the value for the given label values
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if !enabled {
+		return
+	}
+	l := labelPairs(h.labels, labelValues)
+	s, ok := h.series[l]
+	if !ok {
+		s = &histogramSeries{counts: make([]uint64, len(h.buckets))}
+		h.series[l] = s
+	}
+	for i, b := range h.buckets {
+		if v <= b {
+			s.counts[i]++
+		}
+	}
+	s.count++
+	s.sum += v
+}
+
+func (h *Histogram) write(w io.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", h.name, h.help, h.name)
+	keys := make([]string, 0, len(h.series))
+	for k := range h.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, l := range keys {
+		s := h.series[l]
+		for i, b := range h.buckets {
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, braces(join(l, "le=\""+formatValue(b)+"\"")), s.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, braces(join(l, `le="+Inf"`)), s.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.name, braces(l), formatValue(s.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.name, braces(l), s.count)
+	}
+}
+
+// Write writes all the registered metrics in the Prometheus text format
+func Write(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, m := range all {
+		m.write(w)
+	}
+}
+
+// Handler serves the registered metrics
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		Write(w)
+	})
+}
+
+// Serve serves the metrics on the given port until the server fails
+func Serve(port int) error {
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler())
+	return http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
+}
+
+func register(m metric) {
+	mu.Lock()
+	defer mu.Unlock()
+	all = append(all, m)
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func labelPairs(names, values []string) string {
+	pairs := make([]string, len(names))
+	for i, n := range names {
+		v := ""
+		if i < len(values) {
+			v = values[i]
+		}
+		pairs[i] = fmt.Sprintf("%s=\"%s\"", n, labelValueEscaper.Replace(v))
+	}
+	return strings.Join(pairs, ",")
+}
+
+func join(pairs, pair string) string {
+	if pairs == "" {
+		return pair
+	}
+	return pairs + "," + pair
+}
+
+func braces(pairs string) string {
+	if pairs == "" {
+		return ""
+	}
+	return "{" + pairs + "}"
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
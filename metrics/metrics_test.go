@@ -0,0 +1,81 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package metrics
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCounterIsNotRecordedUntilEnabled(t *testing.T) {
+	c := &Counter{name: "test_total", help: "Test.", values: make(map[string]float64)}
+	enabled = false
+	c.Inc()
+	if len(c.values) != 0 {
+		t.Errorf("Expected the counter to be empty. Got: %v", c.values)
+	}
+}
+
+func TestCounterWritesEveryLabelValue(t *testing.T) {
+	Enable()
+	c := &Counter{name: "failures_total", help: "Failures by tag.", labels: []string{"tag"}, values: make(map[string]float64)}
+	c.Inc("smoke")
+	c.Inc("smoke")
+	c.Add(3, `say "hi"`)
+
+	var b bytes.Buffer
+	c.write(&b)
+
+	want := `# HELP failures_total Failures by tag.
+# TYPE failures_total counter
+failures_total{tag="say \"hi\""} 3
+failures_total{tag="smoke"} 2
+`
+	if b.String() != want {
+		t.Errorf("Expected:\n%s\nGot:\n%s", want, b.String())
+	}
+}
+
+func TestHistogramWritesCumulativeBuckets(t *testing.T) {
+	Enable()
+	h := &Histogram{name: "duration_seconds", help: "Durations.", buckets: []float64{1, 5}, series: make(map[string]*histogramSeries)}
+	h.Observe(0.5)
+	h.Observe(2)
+	h.Observe(10)
+
+	var b bytes.Buffer
+	h.write(&b)
+
+	want := `# HELP duration_seconds Durations.
+# TYPE duration_seconds histogram
+duration_seconds_bucket{le="1"} 1
+duration_seconds_bucket{le="5"} 2
+duration_seconds_bucket{le="+Inf"} 3
+duration_seconds_sum 12.5
+duration_seconds_count 3
+`
+	if b.String() != want {
+		t.Errorf("Expected:\n%s\nGot:\n%s", want, b.String())
+	}
+}
+
+func TestHandlerServesGaugeMetrics(t *testing.T) {
+	Enable()
+	RunnerStarts.Inc()
+	rec := httptest.NewRecorder()
+
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", Path, nil))
+
+	if !strings.Contains(rec.Body.String(), "gauge_runner_starts_total 1\n") {
+		t.Errorf("Expected runner starts to be served. Got:\n%s", rec.Body.String())
+	}
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain") {
+		t.Errorf("Expected the text format. Got: %s", rec.Header().Get("Content-Type"))
+	}
+}
@@ -0,0 +1,116 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package owners reads the SPECOWNERS file of a project, which maps spec paths and tags to the teams owning them.
+
+	# pattern             owners
+	specs/                @qa
+	specs/payments/       @payments @qa
+	tag:team:identity     @identity
+
+Each line has a path glob, or a tag prefixed with tag:, followed by one or more owners.
+Like CODEOWNERS, when several lines match a scenario the last one wins.
+*/
+package owners
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/util"
+)
+
+// File is the name of the ownership file in the project root
+const File = "SPECOWNERS"
+
+const tagPrefix = "tag:"
+
+// Owners maps spec paths and tags to their owners
+type Owners struct {
+	entries []*entry
+}
+
+type entry struct {
+	path   *regexp.Regexp
+	tag    string
+	owners []string
+}
+
+// Load reads the ownership file from the given project root. It returns nil if the project has no such file.
+func Load(projectRoot string) (*Owners, error) {
+	p := filepath.Join(projectRoot, File)
+	content, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s. %s", p, err.Error())
+	}
+	o, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s. %s", p, err.Error())
+	}
+	return o, nil
+}
+
+// Parse creates the ownership mapping from the contents of a SPECOWNERS file
+func Parse(content []byte) (*Owners, error) {
+	o := &Owners{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: '%s' has no owners", lineNo, fields[0])
+		}
+		e := &entry{owners: fields[1:]}
+		if strings.HasPrefix(fields[0], tagPrefix) {
+			e.tag = strings.TrimPrefix(fields[0], tagPrefix)
+		} else {
+			e.path = util.GlobToRegexp(strings.TrimPrefix(filepath.ToSlash(fields[0]), "/"))
+		}
+		o.entries = append(o.entries, e)
+	}
+	return o, scanner.Err()
+}
+
+// For returns the owners of a scenario, given the path of its spec relative to the project root and its tags
+func (o *Owners) For(path string, tags []string) []string {
+	if o == nil {
+		return nil
+	}
+	path = filepath.ToSlash(path)
+	var owners []string
+	for _, e := range o.entries {
+		if e.matches(path, tags) {
+			owners = e.owners
+		}
+	}
+	return owners
+}
+
+func (e *entry) matches(path string, tags []string) bool {
+	if e.path != nil {
+		return e.path.MatchString(path)
+	}
+	for _, t := range tags {
+		if t == e.tag || (!env.AllowCaseSensitiveTags() && strings.EqualFold(t, e.tag)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package owners
+
+import (
+	"reflect"
+	"testing"
+)
+
+var content = []byte(`
+# pattern          owners
+specs/             @qa
+specs/payments/    @payments @qa
+tag:team:identity  @identity
+`)
+
+func TestOwnersForPathAndTags(t *testing.T) {
+	o, err := Parse(content)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err.Error())
+	}
+	tests := []struct {
+		path   string
+		tags   []string
+		owners []string
+	}{
+		{"specs/login.spec", nil, []string{"@qa"}},
+		{"specs/payments/refund.spec", nil, []string{"@payments", "@qa"}},
+		{"specs/payments/refund.spec", []string{"Team:Identity"}, []string{"@identity"}},
+		{"other/login.spec", nil, nil},
+	}
+	for _, test := range tests {
+		if got := o.For(test.path, test.tags); !reflect.DeepEqual(got, test.owners) {
+			t.Errorf("Expected owners of %s %v to be %v, got %v", test.path, test.tags, test.owners, got)
+		}
+	}
+}
+
+func TestParseLineWithoutOwners(t *testing.T) {
+	_, err := Parse([]byte("specs/\n"))
+	if err == nil || err.Error() != "line 1: 'specs/' has no owners" {
+		t.Errorf("Expected error for line without owners, got %v", err)
+	}
+}
+
+func TestNilOwnersHasNoOwners(t *testing.T) {
+	var o *Owners
+	if got := o.For("specs/login.spec", nil); got != nil {
+		t.Errorf("Expected no owners, got %v", got)
+	}
+}
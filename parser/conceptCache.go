@@ -0,0 +1,307 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/version"
+)
+
+const conceptCacheFile = "concepts.json"
+
+// conceptCache keeps the concepts parsed from each concept file of the project, along with the hash of the content
+// they were parsed from. A concept file whose content has the hash of its entry is not parsed again. The whole cache
+// is dropped when it was written by another version of gauge or with other parser options, see conceptParserOptions.
+type conceptCache struct {
+	Version string
+	Options string
+	Files   map[string]*conceptCacheEntry
+	changed bool
+}
+
+// conceptCacheEntry is a concept file which parsed without errors. Its concepts are read only when the file is
+// unchanged, see cachedConcept.
+type conceptCacheEntry struct {
+	Hash     string
+	Concepts json.RawMessage
+	Warnings []*Warning
+}
+
+// cachedConcept is a concept as written in the cache. The items of the concept are the concept itself, its tags, its
+// data table, its steps and comments, and all but the comments are written as references to the other fields so that
+// they are the same values once read.
+type cachedConcept struct {
+	Concept      *gauge.Step
+	ConceptSteps []*gauge.Step
+	Items        []cachedItem
+}
+
+const (
+	conceptRef   = "concept"
+	tagsRef      = "tags"
+	dataTableRef = "dataTable"
+	stepRef      = "step"
+)
+
+// cachedItem is an item of a concept, either a reference with the index of the step it refers to, or a comment or a
+// step which is not one of the steps of the concept, like a step repeated for each row of the concept data table.
+type cachedItem struct {
+	Ref     string         `json:",omitempty"`
+	Index   int            `json:",omitempty"`
+	Comment *gauge.Comment `json:",omitempty"`
+	Step    *gauge.Step    `json:",omitempty"`
+}
+
+// conceptParserOptions gives the env toggles which change how concept files are parsed, for the cached concepts to be
+// the ones parsed with the same toggles
+func conceptParserOptions() string {
+	return fmt.Sprintf("multiline_text=%t,multiline_step=%t,front_matter=%t,concept_datatable=%t,step_tags=%t",
+		env.AllowMultilineText(), env.AllowMultiLineStep(), env.AllowFrontMatter(), env.AllowConceptDatatable(), env.AllowStepTags())
+}
+
+func conceptCachePath() string {
+	return filepath.Join(config.ProjectRoot, common.DotGauge, conceptCacheFile)
+}
+
+// useConceptCache tells if the concepts are cached, which needs a project to keep the cache in
+func useConceptCache() bool {
+	return config.ProjectRoot != "" && env.ConceptCache()
+}
+
+func readConceptCache() *conceptCache {
+	cache := &conceptCache{Version: version.FullVersion(), Options: conceptParserOptions(), Files: make(map[string]*conceptCacheEntry)}
+	if !useConceptCache() {
+		return cache
+	}
+	content, err := ioutil.ReadFile(conceptCachePath())
+	if err != nil {
+		return cache
+	}
+	read := &conceptCache{}
+	if err = json.Unmarshal(content, read); err != nil {
+		logger.Debugf(true, "Ignoring invalid concept cache %s. %s", conceptCachePath(), err.Error())
+		cache.changed = true
+		return cache
+	}
+	if read.Version != cache.Version || read.Options != cache.Options || read.Files == nil {
+		cache.changed = true
+		return cache
+	}
+	cache.Files = read.Files
+	return cache
+}
+
+// update keeps the entries of the files parsed and drops the ones of the concept files which do not exist anymore
+func (cache *conceptCache) update(files []string, entries []*conceptCacheEntry) {
+	for i, file := range files {
+		if entries[i] != cache.Files[file] {
+			cache.changed = true
+		}
+		if entries[i] == nil {
+			delete(cache.Files, file)
+			continue
+		}
+		cache.Files[file] = entries[i]
+	}
+	for file := range cache.Files {
+		if !common.FileExists(file) {
+			delete(cache.Files, file)
+			cache.changed = true
+		}
+	}
+}
+
+func (cache *conceptCache) write() {
+	if !useConceptCache() || !cache.changed {
+		return
+	}
+	b, err := json.Marshal(cache)
+	if err != nil {
+		logger.Debugf(true, "Unable to save concept cache. %s", err.Error())
+		return
+	}
+	if err = os.MkdirAll(filepath.Dir(conceptCachePath()), common.NewDirectoryPermissions); err != nil {
+		logger.Debugf(true, "Unable to save concept cache. %s", err.Error())
+		return
+	}
+	// the cache is written to a temporary file first, so that it is never left half written when gauge dies
+	tmp := conceptCachePath() + ".tmp"
+	if err = ioutil.WriteFile(tmp, b, common.NewFilePermissions); err == nil {
+		err = os.Rename(tmp, conceptCachePath())
+	}
+	if err != nil {
+		logger.Debugf(true, "Unable to save concept cache. %s", err.Error())
+	}
+}
+
+// parseCachedConceptFile gives the concepts of the file from its entry when the content of the file has the hash of
+// the entry, and parses the file otherwise. It gives the entry of the file for the cache, which is nil when the file
+// has parse errors or special params.
+func parseCachedConceptFile(file string, entry *conceptCacheEntry) ([]*gauge.Step, *ParseResult, *conceptCacheEntry) {
+	text, err := common.ReadFileContents(util.LongPath(file))
+	if err != nil {
+		return nil, &ParseResult{ParseErrors: []ParseError{{Message: fmt.Sprintf("failed to read concept file %s", file)}}}, nil
+	}
+	hash := contentHash(text)
+	if entry != nil && entry.Hash == hash {
+		concepts, err := entry.concepts()
+		if err == nil {
+			return concepts, &ParseResult{Warnings: entry.Warnings}, entry
+		}
+		logger.Debugf(true, "Ignoring invalid concept cache entry of %s. %s", file, err.Error())
+	}
+	concepts, res := new(ConceptParser).Parse(text, file)
+	if len(res.ParseErrors) > 0 || !useConceptCache() || hasSpecialParams(concepts) {
+		return concepts, res, nil
+	}
+	entry, err = newConceptCacheEntry(hash, concepts, res.Warnings)
+	if err != nil {
+		logger.Debugf(true, "Unable to cache the concepts of %s. %s", file, err.Error())
+		return concepts, res, nil
+	}
+	return concepts, res, entry
+}
+
+// hasSpecialParams tells if a step of the concepts has a special param, like <file:notes.txt>, whose value is read
+// from outside the concept file when it is parsed and would be out of date in the cache
+func hasSpecialParams(concepts []*gauge.Step) bool {
+	for _, concept := range concepts {
+		for _, item := range concept.Items {
+			if step, ok := item.(*gauge.Step); ok && step != concept && stepHasSpecialParams(step) {
+				return true
+			}
+		}
+		for _, step := range concept.ConceptSteps {
+			if stepHasSpecialParams(step) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func stepHasSpecialParams(step *gauge.Step) bool {
+	for _, arg := range step.Args {
+		if arg.ArgType == gauge.SpecialString || arg.ArgType == gauge.SpecialTable {
+			return true
+		}
+	}
+	return false
+}
+
+func contentHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// newConceptCacheEntry writes the concepts as parsed, before they are added to a concept dictionary which resolves
+// the concepts their steps use
+func newConceptCacheEntry(hash string, concepts []*gauge.Step, warnings []*Warning) (*conceptCacheEntry, error) {
+	cached := make([]*cachedConcept, len(concepts))
+	for i, concept := range concepts {
+		cached[i] = toCachedConcept(concept)
+	}
+	b, err := json.Marshal(cached)
+	if err != nil {
+		return nil, err
+	}
+	return &conceptCacheEntry{Hash: hash, Concepts: b, Warnings: warnings}, nil
+}
+
+func (entry *conceptCacheEntry) concepts() ([]*gauge.Step, error) {
+	var cached []*cachedConcept
+	if err := json.Unmarshal(entry.Concepts, &cached); err != nil {
+		return nil, err
+	}
+	concepts := make([]*gauge.Step, len(cached))
+	for i, c := range cached {
+		concept, err := c.toConcept()
+		if err != nil {
+			return nil, err
+		}
+		concepts[i] = concept
+	}
+	return concepts, nil
+}
+
+func toCachedConcept(concept *gauge.Step) *cachedConcept {
+	c := *concept
+	c.ConceptSteps, c.Items = nil, nil
+	cached := &cachedConcept{Concept: &c, ConceptSteps: concept.ConceptSteps}
+	if concept.Items != nil {
+		cached.Items = make([]cachedItem, len(concept.Items))
+	}
+	for i, item := range concept.Items {
+		cached.Items[i] = toCachedItem(concept, item)
+	}
+	return cached
+}
+
+func toCachedItem(concept *gauge.Step, item gauge.Item) cachedItem {
+	switch i := item.(type) {
+	case *gauge.Comment:
+		return cachedItem{Comment: i}
+	case *gauge.Tags:
+		return cachedItem{Ref: tagsRef}
+	case *gauge.DataTable:
+		return cachedItem{Ref: dataTableRef}
+	case *gauge.Step:
+		if i == concept {
+			return cachedItem{Ref: conceptRef}
+		}
+		for index, step := range concept.ConceptSteps {
+			if step == i {
+				return cachedItem{Ref: stepRef, Index: index}
+			}
+		}
+		return cachedItem{Step: i}
+	}
+	return cachedItem{}
+}
+
+func (cached *cachedConcept) toConcept() (*gauge.Step, error) {
+	concept := cached.Concept
+	if concept == nil {
+		return nil, fmt.Errorf("concept is missing")
+	}
+	concept.ConceptSteps = cached.ConceptSteps
+	if cached.Items != nil {
+		concept.Items = make([]gauge.Item, len(cached.Items))
+	}
+	for i, item := range cached.Items {
+		switch {
+		case item.Comment != nil:
+			concept.Items[i] = item.Comment
+		case item.Step != nil:
+			concept.Items[i] = item.Step
+		case item.Ref == conceptRef:
+			concept.Items[i] = concept
+		case item.Ref == tagsRef && concept.Tags != nil:
+			concept.Items[i] = concept.Tags
+		case item.Ref == dataTableRef && concept.DataTable != nil:
+			concept.Items[i] = concept.DataTable
+		case item.Ref == stepRef && item.Index < len(concept.ConceptSteps):
+			concept.Items[i] = concept.ConceptSteps[item.Index]
+		default:
+			return nil, fmt.Errorf("item %d of concept %s is invalid", i, concept.LineText)
+		}
+	}
+	return concept, nil
+}
@@ -0,0 +1,190 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+const cachedConcepts = `namespace: login
+Comment of the file
+
+# Log in as <user> with <password>
+tags: login, smoke
+* Open the login page
+Comment of the concept
+* Type <user> and <password>
+   |field   |value     |
+   |--------|----------|
+   |user    |<user>    |
+   |password|<password>|
+* Submit "login"
+
+# Log in as every user
+   |user |password|
+   |-----|--------|
+   |alice|secret  |
+   |bob  |hidden  |
+* Log in as <user> with <password>
+`
+
+func writeConceptProject(c *C, text string) string {
+	dir, err := ioutil.TempDir("", "gauge-concept-cache")
+	c.Assert(err, IsNil)
+	config.ProjectRoot = dir
+	file := filepath.Join(dir, "login.cpt")
+	c.Assert(ioutil.WriteFile(file, []byte(text), common.NewFilePermissions), IsNil)
+	return file
+}
+
+// parseAndCacheConceptFiles parses the concept files with the concept cache of the project, as the concept dictionary
+// of the project is created
+func parseAndCacheConceptFiles(files []string) ([][]*gauge.Step, []*ParseResult) {
+	cache := readConceptCache()
+	concepts, results := parseConceptFiles(files, cache)
+	cache.write()
+	return concepts, results
+}
+
+func (s *MySuite) TestCachedConceptsAreTheParsedConcepts(c *C) {
+	old := env.AllowConceptDatatable
+	env.AllowConceptDatatable = func() bool { return true }
+	defer func() { env.AllowConceptDatatable = old }()
+	file := writeConceptProject(c, cachedConcepts)
+	defer os.RemoveAll(config.ProjectRoot)
+
+	parsed, results := parseAndCacheConceptFiles([]string{file})
+	c.Assert(results[0].ParseErrors, HasLen, 0)
+	entry := readConceptCache().Files[file]
+	c.Assert(entry, NotNil)
+	cached, res, read := parseCachedConceptFile(file, entry)
+
+	c.Assert(read, Equals, entry)
+	c.Assert(res.ParseErrors, HasLen, 0)
+	c.Assert(cached, DeepEquals, parsed[0])
+	concept := cached[0]
+	c.Assert(concept.Items[0], Equals, gauge.Item(concept))
+	c.Assert(concept.Items[1], Equals, gauge.Item(concept.Tags))
+	c.Assert(concept.Items[2], Equals, gauge.Item(concept.ConceptSteps[0]))
+	c.Assert(concept.Items[4], Equals, gauge.Item(concept.ConceptSteps[1]))
+	c.Assert(concept.ConceptSteps[1].Args[2].Table.IsInitialized(), Equals, true)
+	c.Assert(cached[1].Items[1], Equals, gauge.Item(cached[1].DataTable))
+	c.Assert(cached[1].ConceptSteps, HasLen, 2)
+}
+
+func (s *MySuite) TestChangedConceptFileIsParsedAgain(c *C) {
+	file := writeConceptProject(c, "# Log in\n* Open the login page\n")
+	defer os.RemoveAll(config.ProjectRoot)
+	_, _ = parseAndCacheConceptFiles([]string{file})
+	c.Assert(ioutil.WriteFile(file, []byte("# Log in\n* Open the home page\n* Click login\n"), common.NewFilePermissions), IsNil)
+
+	concepts, results := parseAndCacheConceptFiles([]string{file})
+
+	c.Assert(results[0].ParseErrors, HasLen, 0)
+	c.Assert(concepts[0][0].ConceptSteps, HasLen, 2)
+	c.Assert(concepts[0][0].ConceptSteps[0].Value, Equals, "Open the home page")
+	c.Assert(readConceptCache().Files[file].Hash, Equals, contentHash("# Log in\n* Open the home page\n* Click login\n"))
+}
+
+func (s *MySuite) TestConceptFileWithParseErrorsIsNotCached(c *C) {
+	file := writeConceptProject(c, "# Log in\n")
+	defer os.RemoveAll(config.ProjectRoot)
+
+	_, results := parseAndCacheConceptFiles([]string{file})
+
+	c.Assert(results[0].ParseErrors, HasLen, 1)
+	c.Assert(readConceptCache().Files, HasLen, 0)
+}
+
+func (s *MySuite) TestConceptFileWithSpecialParamsIsNotCached(c *C) {
+	file := writeConceptProject(c, "# Log in\n* Log in as <file:user.txt>\n")
+	defer os.RemoveAll(config.ProjectRoot)
+	c.Assert(ioutil.WriteFile(filepath.Join(config.ProjectRoot, "user.txt"), []byte("alice"), common.NewFilePermissions), IsNil)
+
+	concepts, results := parseAndCacheConceptFiles([]string{file})
+
+	c.Assert(results[0].ParseErrors, HasLen, 0)
+	c.Assert(concepts[0][0].ConceptSteps[0].Args[0].Value, Equals, "alice")
+	c.Assert(readConceptCache().Files, HasLen, 0)
+}
+
+func (s *MySuite) TestConceptCacheOfAnotherVersionIsDropped(c *C) {
+	file := writeConceptProject(c, "# Log in\n* Open the login page\n")
+	defer os.RemoveAll(config.ProjectRoot)
+	c.Assert(os.MkdirAll(filepath.Dir(conceptCachePath()), common.NewDirectoryPermissions), IsNil)
+	stale := `{"Version":"0.0.1","Files":{"` + filepath.ToSlash(file) + `":{"Hash":"` + contentHash("# Log in\n* Open the login page\n") + `","Concepts":[]}}}`
+	c.Assert(ioutil.WriteFile(conceptCachePath(), []byte(stale), common.NewFilePermissions), IsNil)
+
+	concepts, _ := parseAndCacheConceptFiles([]string{file})
+
+	c.Assert(concepts[0], HasLen, 1)
+}
+
+func (s *MySuite) TestConceptsAreNotCachedWhenTheCacheIsOff(c *C) {
+	old := env.ConceptCache
+	env.ConceptCache = func() bool { return false }
+	defer func() { env.ConceptCache = old }()
+	file := writeConceptProject(c, "# Log in\n* Open the login page\n")
+	defer os.RemoveAll(config.ProjectRoot)
+
+	_, _ = parseAndCacheConceptFiles([]string{file})
+
+	c.Assert(common.FileExists(conceptCachePath()), Equals, false)
+}
+
+func (s *MySuite) TestConceptCacheWithOtherParserOptionsIsDropped(c *C) {
+	file := writeConceptProject(c, "# Log in\n* Open the login page\n")
+	defer os.RemoveAll(config.ProjectRoot)
+	_, _ = parseAndCacheConceptFiles([]string{file})
+	c.Assert(readConceptCache().Files, HasLen, 1)
+	old := env.AllowStepTags
+	env.AllowStepTags = func() bool { return !old() }
+	defer func() { env.AllowStepTags = old }()
+
+	c.Assert(readConceptCache().Files, HasLen, 0)
+}
+
+func (s *MySuite) TestConceptCacheIsWrittenWholeInPlace(c *C) {
+	file := writeConceptProject(c, "# Log in\n* Open the login page\n")
+	defer os.RemoveAll(config.ProjectRoot)
+
+	_, _ = parseAndCacheConceptFiles([]string{file})
+
+	c.Assert(readConceptCache().Files, HasLen, 1)
+	c.Assert(common.FileExists(conceptCachePath()+".tmp"), Equals, false)
+}
+
+func (s *MySuite) TestAddConceptsDoesNotWriteTheConceptCache(c *C) {
+	file := writeConceptProject(c, "# Log in\n* Open the login page\n")
+	defer os.RemoveAll(config.ProjectRoot)
+
+	_, errs, err := AddConcepts([]string{file}, gauge.NewConceptDictionary())
+
+	c.Assert(err, IsNil)
+	c.Assert(errs, HasLen, 0)
+	c.Assert(common.FileExists(conceptCachePath()), Equals, false)
+}
+
+func (s *MySuite) TestCreateConceptsDictionaryWritesTheConceptCache(c *C) {
+	file := writeConceptProject(c, "# Log in\n* Open the login page\n")
+	defer os.RemoveAll(config.ProjectRoot)
+
+	dict, res, err := createConceptsDictionary([]string{file})
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(dict.ConceptsMap, HasLen, 1)
+	c.Assert(readConceptCache().Files[file], NotNil)
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/util"
@@ -20,8 +21,13 @@ import (
 type ConceptParser struct {
 	currentState   int
 	currentConcept *gauge.Step
+	namespace      string
 }
 
+// conceptNamespaceMarker starts the line declaring the namespace of the concepts of a concept file, like
+// "namespace: payments", which is written before the first concept heading of the file
+const conceptNamespaceMarker = "namespace:"
+
 // Parse Generates token for the given concept file and cretes concepts(array of steps) and parse results.
 // concept file can have multiple concept headings.
 func (parser *ConceptParser) Parse(text, fileName string) ([]*gauge.Step, *ParseResult) {
@@ -35,7 +41,7 @@ func (parser *ConceptParser) Parse(text, fileName string) ([]*gauge.Step, *Parse
 
 // ParseFile Reads file contents from a give file and parses the file.
 func (parser *ConceptParser) ParseFile(file string) ([]*gauge.Step, *ParseResult) {
-	fileText, fileReadErr := common.ReadFileContents(file)
+	fileText, fileReadErr := common.ReadFileContents(util.LongPath(file))
 	if fileReadErr != nil {
 		return nil, &ParseResult{ParseErrors: []ParseError{{Message: fmt.Sprintf("failed to read concept file %s", file)}}}
 	}
@@ -45,6 +51,7 @@ func (parser *ConceptParser) ParseFile(file string) ([]*gauge.Step, *ParseResult
 func (parser *ConceptParser) resetState() {
 	parser.currentState = initial
 	parser.currentConcept = nil
+	parser.namespace = ""
 }
 
 func (parser *ConceptParser) createConcepts(tokens []*Token, fileName string) ([]*gauge.Step, *ParseResult) {
@@ -60,6 +67,7 @@ func (parser *ConceptParser) createConcepts(tokens []*Token, fileName string) ([
 					parseRes.ParseErrors = append(parseRes.ParseErrors, ParseError{FileName: fileName, LineNo: parser.currentConcept.LineNo, SpanEnd: parser.currentConcept.LineSpanEnd, Message: "Concept should have atleast one step", LineText: parser.currentConcept.LineText})
 					continue
 				}
+				parseRes.ParseErrors = append(parseRes.ParseErrors, expandConceptRows(parser.currentConcept, fileName)...)
 				concepts = append(concepts, parser.currentConcept)
 			}
 			var res *ParseResult
@@ -83,6 +91,9 @@ func (parser *ConceptParser) createConcepts(tokens []*Token, fileName string) ([
 				parseRes.ParseErrors = append(parseRes.ParseErrors, errs...)
 			}
 			addStates(&parser.currentState, stepScope)
+		} else if parser.isConceptDataTableHeader(token) {
+			parser.processConceptDataTableHeader(token)
+			addStates(&parser.currentState, tableScope)
 		} else if parser.isTableHeader(token) {
 			if !isInState(parser.currentState, stepScope) {
 				parseRes.ParseErrors = append(parseRes.ParseErrors, ParseError{FileName: fileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: "Table doesn't belong to any step", LineText: token.LineText()})
@@ -90,20 +101,33 @@ func (parser *ConceptParser) createConcepts(tokens []*Token, fileName string) ([
 			}
 			parser.processTableHeader(token)
 			addStates(&parser.currentState, tableScope)
+		} else if parser.isMultilineText(token) && isInState(parser.currentState, stepScope) {
+			steps := parser.currentConcept.ConceptSteps
+			addMultilineText(steps[len(steps)-1], token)
+			retainStates(&parser.currentState, conceptScope)
 		} else if parser.isScenarioHeading(token) {
 			parseRes.ParseErrors = append(parseRes.ParseErrors, ParseError{FileName: fileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: "Scenario Heading is not allowed in concept file", LineText: token.LineText()})
 			continue
+		} else if parser.isTag(token) && parser.currentConcept != nil && len(parser.currentConcept.ConceptSteps) == 0 {
+			parser.processConceptTags(token)
 		} else if parser.isTableDataRow(token) {
 			if areUnderlined(token.Args) && !isInState(parser.currentState, tableSeparatorScope) {
 				addStates(&parser.currentState, tableSeparatorScope)
 			} else if isInState(parser.currentState, stepScope) {
-				parser.processTableDataRow(token, &parser.currentConcept.Lookup, fileName)
+				parser.processTableDataRow(token, parser.stepLookup(), fileName)
+			} else if parser.currentConcept != nil && parser.currentConcept.DataTable != nil && len(parser.currentConcept.ConceptSteps) == 0 {
+				warnings, errs := parser.processConceptDataTableRow(token, fileName)
+				parseRes.ParseErrors = append(parseRes.ParseErrors, errs...)
+				parseRes.Warnings = append(parseRes.Warnings, warnings...)
 			}
 		} else {
 			retainStates(&parser.currentState, conceptScope)
 			addStates(&parser.currentState, commentScope)
 			comment := &gauge.Comment{Value: token.Value, LineNo: token.LineNo}
 			if parser.currentConcept == nil {
+				if err := parser.processNamespace(token, fileName); err != nil {
+					parseRes.ParseErrors = append(parseRes.ParseErrors, *err)
+				}
 				preComments = append(preComments, comment)
 				addPreComments = true
 				continue
@@ -117,6 +141,7 @@ func (parser *ConceptParser) createConcepts(tokens []*Token, fileName string) ([
 	}
 
 	if parser.currentConcept != nil {
+		parseRes.ParseErrors = append(parseRes.ParseErrors, expandConceptRows(parser.currentConcept, fileName)...)
 		concepts = append(concepts, parser.currentConcept)
 	}
 	return concepts, parseRes
@@ -126,6 +151,13 @@ func (parser *ConceptParser) isConceptHeading(token *Token) bool {
 	return token.Kind == gauge.SpecKind
 }
 
+// isConceptDataTableHeader tells if the token is the header of a table written under a concept heading, before the
+// steps of the concept
+func (parser *ConceptParser) isConceptDataTableHeader(token *Token) bool {
+	return env.AllowConceptDatatable() && parser.isTableHeader(token) && parser.currentConcept != nil &&
+		parser.currentConcept.DataTable == nil && len(parser.currentConcept.ConceptSteps) == 0
+}
+
 func (parser *ConceptParser) isStep(token *Token) bool {
 	return token.Kind == gauge.StepKind
 }
@@ -142,6 +174,14 @@ func (parser *ConceptParser) isTableDataRow(token *Token) bool {
 	return token.Kind == gauge.TableRow
 }
 
+func (parser *ConceptParser) isMultilineText(token *Token) bool {
+	return token.Kind == gauge.MultilineTextKind
+}
+
+func (parser *ConceptParser) isTag(token *Token) bool {
+	return token.Kind == gauge.TagKind
+}
+
 func (parser *ConceptParser) processConceptHeading(token *Token, fileName string) (*gauge.Step, *ParseResult) {
 	processStep(new(SpecParser), token)
 	token.Lines[0] = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(token.Lines[0]), "#"))
@@ -157,14 +197,68 @@ func (parser *ConceptParser) processConceptHeading(token *Token, fileName string
 	}
 
 	concept.IsConcept = true
+	concept.Namespace = parser.namespace
 	parser.createConceptLookup(concept)
 	concept.Items = append(concept.Items, concept)
 	return concept, parseRes
 }
 
+// processNamespace reads the namespace of the concept file from the line declaring it, which stays a comment of the file
+func (parser *ConceptParser) processNamespace(token *Token, fileName string) *ParseError {
+	text := strings.TrimSpace(token.Value)
+	if len(text) < len(conceptNamespaceMarker) || !strings.EqualFold(text[:len(conceptNamespaceMarker)], conceptNamespaceMarker) {
+		return nil
+	}
+	namespace := strings.Trim(strings.TrimSpace(text[len(conceptNamespaceMarker):]), gauge.ConceptNamespaceSeparator)
+	if namespace == "" {
+		return &ParseError{FileName: fileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: "Concept namespace should not be blank", LineText: token.LineText()}
+	}
+	if parser.namespace != "" {
+		return &ParseError{FileName: fileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: "Concept namespace can be defined only once per concept file", LineText: token.LineText()}
+	}
+	parser.namespace = namespace
+	return nil
+}
+
+func (parser *ConceptParser) processConceptTags(token *Token) {
+	if parser.currentConcept.Tags == nil {
+		parser.currentConcept.Tags = &gauge.Tags{}
+		parser.currentConcept.Items = append(parser.currentConcept.Items, parser.currentConcept.Tags)
+	}
+	parser.currentConcept.Tags.Add(token.Args)
+}
+
+func (parser *ConceptParser) processConceptDataTableHeader(token *Token) {
+	table := &gauge.Table{LineNo: token.LineNo}
+	table.AddHeaders(token.Args)
+	parser.currentConcept.DataTable = &gauge.DataTable{Table: table, Value: token.Value, LineNo: token.LineNo}
+	parser.currentConcept.Items = append(parser.currentConcept.Items, parser.currentConcept.DataTable)
+}
+
+func (parser *ConceptParser) processConceptDataTableRow(token *Token, fileName string) ([]*Warning, []ParseError) {
+	cells, warnings, errs := validateTableRows(token, &parser.currentConcept.Lookup, fileName)
+	if len(errs) == 0 {
+		parser.currentConcept.DataTable.Table.AddRowValues(cells)
+	}
+	return warnings, errs
+}
+
+// stepLookup gives the lookup of the params the steps of the current concept may use, which are the params of the
+// concept heading and the columns of the data table of the concept
+func (parser *ConceptParser) stepLookup() *gauge.ArgLookup {
+	if parser.currentConcept.DataTable == nil {
+		return &parser.currentConcept.Lookup
+	}
+	lookup, _ := parser.currentConcept.Lookup.GetCopy()
+	for _, header := range parser.currentConcept.DataTable.Table.Headers {
+		lookup.AddArgName(header)
+	}
+	return lookup
+}
+
 func (parser *ConceptParser) processConceptStep(token *Token, fileName string) []ParseError {
 	processStep(new(SpecParser), token)
-	conceptStep, parseRes := CreateStepUsingLookup(token, &parser.currentConcept.Lookup, fileName)
+	conceptStep, parseRes := CreateStepUsingLookup(token, parser.stepLookup(), fileName)
 	if conceptStep != nil {
 		conceptStep.Suffix = token.Suffix
 		parser.currentConcept.ConceptSteps = append(parser.currentConcept.ConceptSteps, conceptStep)
@@ -189,6 +283,72 @@ func (parser *ConceptParser) processTableDataRow(token *Token, argLookup *gauge.
 	items[len(items)-1] = currentStep
 }
 
+// expandConceptRows repeats the steps of a concept with a data table for each row of the table. The row is read into
+// a lookup of the columns, which gives the dynamic params of the steps naming a column their value for the row.
+func expandConceptRows(concept *gauge.Step, fileName string) []ParseError {
+	if concept.DataTable == nil {
+		return nil
+	}
+	table := concept.DataTable.Table
+	if table.GetRowCount() == 0 {
+		return []ParseError{{FileName: fileName, LineNo: concept.DataTable.LineNo, SpanEnd: concept.DataTable.LineNo, Message: "Concept data table should have at least one row", LineText: concept.DataTable.Value}}
+	}
+	var steps []*gauge.Step
+	for i := 0; i < table.GetRowCount(); i++ {
+		row := new(gauge.ArgLookup)
+		for _, header := range table.Headers {
+			cells, _ := table.Get(header)
+			row.AddArgName(header)
+			_ = row.AddArgValue(header, &gauge.StepArg{Value: cells[i].Value, ArgType: cells[i].CellType})
+		}
+		for _, step := range concept.ConceptSteps {
+			steps = append(steps, stepForRow(step, row))
+		}
+	}
+	concept.ConceptSteps = steps
+	return nil
+}
+
+func stepForRow(step *gauge.Step, row *gauge.ArgLookup) *gauge.Step {
+	rowStep := new(gauge.Step)
+	*rowStep = *step
+	rowStep.Args = make([]*gauge.StepArg, len(step.Args))
+	for i, arg := range step.Args {
+		rowStep.Args[i] = argForRow(arg, row)
+	}
+	rowStep.PopulateFragments()
+	return rowStep
+}
+
+func argForRow(arg *gauge.StepArg, row *gauge.ArgLookup) *gauge.StepArg {
+	if arg.ArgType == gauge.Dynamic && row.ContainsArg(arg.Value) {
+		return cellArg(arg.Value, arg.ParamType, row)
+	}
+	if arg.ArgType != gauge.TableArg {
+		return arg
+	}
+	columns := make([][]gauge.TableCell, len(arg.Table.Columns))
+	for i, cells := range arg.Table.Columns {
+		for _, cell := range cells {
+			if cell.CellType == gauge.Dynamic && row.ContainsArg(cell.Value) {
+				cellArg := cellArg(cell.Value, "", row)
+				cell = gauge.TableCell{Value: cellArg.Value, CellType: cellArg.ArgType}
+			}
+			columns[i] = append(columns[i], cell)
+		}
+	}
+	return &gauge.StepArg{Name: arg.Name, ArgType: gauge.TableArg, Table: *gauge.NewTable(arg.Table.Headers, columns, arg.Table.LineNo)}
+}
+
+// cellArg gives the arg of the value of the column in the row, which names a param of the concept if it is dynamic
+func cellArg(column, paramType string, row *gauge.ArgLookup) *gauge.StepArg {
+	cell, _ := row.GetArg(column)
+	if cell.ArgType == gauge.Dynamic {
+		return &gauge.StepArg{Name: cell.Value, Value: cell.Value, ArgType: gauge.Dynamic, ParamType: paramType}
+	}
+	return &gauge.StepArg{Value: cell.Value, ArgType: cell.ArgType, ParamType: paramType}
+}
+
 func (parser *ConceptParser) hasOnlyDynamicParams(step *gauge.Step) bool {
 	for _, arg := range step.Args {
 		if arg.ArgType != gauge.Dynamic {
@@ -206,8 +366,12 @@ func (parser *ConceptParser) createConceptLookup(concept *gauge.Step) {
 
 // CreateConceptsDictionary generates a ConceptDictionary which is map of concept text to concept. ConceptDictionary is used to search for a concept.
 func CreateConceptsDictionary() (*gauge.ConceptDictionary, *ParseResult, error) {
+	return createConceptsDictionary(util.GetConceptFiles())
+}
+
+func createConceptsDictionary(files []string) (*gauge.ConceptDictionary, *ParseResult, error) {
 	cptFilesMap := make(map[string]bool)
-	for _, cpt := range util.GetConceptFiles() {
+	for _, cpt := range files {
 		cptFilesMap[cpt] = true
 	}
 	var conceptFiles []string
@@ -216,7 +380,10 @@ func CreateConceptsDictionary() (*gauge.ConceptDictionary, *ParseResult, error)
 	}
 	conceptsDictionary := gauge.NewConceptDictionary()
 	res := &ParseResult{Ok: true}
-	if _, errs, e := AddConcepts(conceptFiles, conceptsDictionary); len(errs) > 0 {
+	cache := readConceptCache()
+	_, errs, e := addConcepts(conceptFiles, conceptsDictionary, cache)
+	cache.write()
+	if len(errs) > 0 {
 		if e != nil {
 			return nil, nil, e
 		}
@@ -238,7 +405,7 @@ func CreateConceptsDictionary() (*gauge.ConceptDictionary, *ParseResult, error)
 func AddConcept(concepts []*gauge.Step, file string, conceptDictionary *gauge.ConceptDictionary) ([]ParseError, error) {
 	parseErrors := make([]ParseError, 0)
 	for _, conceptStep := range concepts {
-		if dupConcept, exists := conceptDictionary.ConceptsMap[conceptStep.Value]; exists {
+		if dupConcept, exists := conceptDictionary.ConceptsMap[gauge.ConceptKey(conceptStep)]; exists {
 			parseErrors = append(parseErrors, ParseError{
 				FileName: file,
 				LineNo:   conceptStep.LineNo,
@@ -254,7 +421,7 @@ func AddConcept(concepts []*gauge.Step, file string, conceptDictionary *gauge.Co
 					LineText: dupConcept.ConceptStep.LineText,
 				})
 		}
-		conceptDictionary.ConceptsMap[conceptStep.Value] = &gauge.Concept{ConceptStep: conceptStep, FileName: file}
+		conceptDictionary.ConceptsMap[gauge.ConceptKey(conceptStep)] = &gauge.Concept{ConceptStep: conceptStep, FileName: file}
 		if err := conceptDictionary.ReplaceNestedConceptSteps(conceptStep); err != nil {
 			return nil, err
 		}
@@ -265,10 +432,21 @@ func AddConcept(concepts []*gauge.Step, file string, conceptDictionary *gauge.Co
 
 // AddConcepts parses the given concept file and adds each concept to the concept dictionary.
 func AddConcepts(conceptFiles []string, conceptDictionary *gauge.ConceptDictionary) ([]*gauge.Step, []ParseError, error) {
+	return addConcepts(conceptFiles, conceptDictionary, nil)
+}
+
+// addConcepts adds the concepts of the files to the concept dictionary, reading the ones of the unchanged files from
+// the cache if one is given. The concept files given to AddConcepts, like the one the language server parses on each
+// change, are parsed without the cache, which is kept for the concepts of the whole project.
+func addConcepts(conceptFiles []string, conceptDictionary *gauge.ConceptDictionary, cache *conceptCache) ([]*gauge.Step, []ParseError, error) {
 	var conceptSteps []*gauge.Step
 	var parseResults []*ParseResult
-	for _, conceptFile := range conceptFiles {
-		concepts, parseRes := new(ConceptParser).ParseFile(conceptFile)
+	parsed, results := parseConceptFiles(conceptFiles, cache)
+	for i, conceptFile := range conceptFiles {
+		concepts, parseRes := parsed[i], results[i]
+		if parseRes != nil && strict() {
+			parseRes.promoteWarnings()
+		}
 		if parseRes != nil && parseRes.Warnings != nil {
 			for _, warning := range parseRes.Warnings {
 				logger.Warningf(true, warning.String())
@@ -286,6 +464,27 @@ func AddConcepts(conceptFiles []string, conceptDictionary *gauge.ConceptDictiona
 	return conceptSteps, errs, nil
 }
 
+// parseConceptFiles parses the concept files in parallel, see parseWorkers. It gives the concepts and parse results
+// in the order of the files, for them to be added to the concept dictionary in that order. When a concept cache is
+// given, the concepts of the files which did not change since they were last parsed are read from it instead and it
+// is updated with the files parsed, see conceptCache.
+func parseConceptFiles(conceptFiles []string, cache *conceptCache) ([][]*gauge.Step, []*ParseResult) {
+	concepts := make([][]*gauge.Step, len(conceptFiles))
+	results := make([]*ParseResult, len(conceptFiles))
+	entries := make([]*conceptCacheEntry, len(conceptFiles))
+	inParallel(conceptFiles, func(i int, file string) {
+		if cache == nil {
+			concepts[i], results[i] = new(ConceptParser).ParseFile(file)
+			return
+		}
+		concepts[i], results[i], entries[i] = parseCachedConceptFile(file, cache.Files[file])
+	})
+	if cache != nil {
+		cache.update(conceptFiles, entries)
+	}
+	return concepts, results
+}
+
 func collectAllParseErrors(results []*ParseResult) (errs []ParseError) {
 	for _, res := range results {
 		errs = append(errs, res.ParseErrors...)
@@ -298,9 +497,9 @@ func ValidateConcepts(conceptDictionary *gauge.ConceptDictionary) *ParseResult {
 	res := &ParseResult{ParseErrors: []ParseError{}}
 	var conceptsWithError []*gauge.Concept
 	for _, concept := range conceptDictionary.ConceptsMap {
-		errs := checkCircularReferencing(conceptDictionary, concept.ConceptStep, nil)
+		errs := checkCircularReferencing(conceptDictionary, concept.ConceptStep, concept.ConceptStep.Namespace, nil)
 		if errs != nil {
-			delete(conceptDictionary.ConceptsMap, concept.ConceptStep.Value)
+			delete(conceptDictionary.ConceptsMap, gauge.ConceptKey(concept.ConceptStep))
 			res.ParseErrors = append(res.ParseErrors, errs...)
 			conceptsWithError = append(conceptsWithError, concept)
 		}
@@ -323,19 +522,24 @@ func removeAllReferences(conceptDictionary *gauge.ConceptDictionary, concept *ga
 	}
 }
 
-func checkCircularReferencing(conceptDictionary *gauge.ConceptDictionary, concept *gauge.Step, traversedSteps map[string]string) []ParseError {
+func checkCircularReferencing(conceptDictionary *gauge.ConceptDictionary, concept *gauge.Step, namespace string, traversedSteps map[string]string) []ParseError {
 	if traversedSteps == nil {
 		traversedSteps = make(map[string]string)
 	}
-	con := conceptDictionary.Search(concept.Value)
+	con := conceptDictionary.SearchFrom(namespace, concept.Value)
 	if con == nil {
 		return nil
 	}
+	key := gauge.ConceptKey(con.ConceptStep)
 	currentConceptFileName := con.FileName
-	traversedSteps[concept.Value] = currentConceptFileName
+	traversedSteps[key] = currentConceptFileName
 	for _, step := range concept.ConceptSteps {
-		if _, exists := traversedSteps[step.Value]; exists {
-			conceptDictionary.Remove(concept.Value)
+		stepKey := step.Value
+		if nested := conceptDictionary.SearchFrom(con.ConceptStep.Namespace, step.Value); nested != nil {
+			stepKey = gauge.ConceptKey(nested.ConceptStep)
+		}
+		if _, exists := traversedSteps[stepKey]; exists {
+			conceptDictionary.Remove(key)
 			return []ParseError{
 				{
 					FileName: step.FileName,
@@ -354,12 +558,12 @@ func checkCircularReferencing(conceptDictionary *gauge.ConceptDictionary, concep
 			}
 		}
 		if step.IsConcept {
-			if errs := checkCircularReferencing(conceptDictionary, step, traversedSteps); errs != nil {
-				conceptDictionary.Remove(concept.Value)
+			if errs := checkCircularReferencing(conceptDictionary, step, con.ConceptStep.Namespace, traversedSteps); errs != nil {
+				conceptDictionary.Remove(key)
 				return errs
 			}
 		}
 	}
-	delete(traversedSteps, concept.Value)
+	delete(traversedSteps, key)
 	return nil
 }
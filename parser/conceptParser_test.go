@@ -7,12 +7,15 @@
 package parser
 
 import (
+	"fmt"
+	"io/ioutil"
 	"path/filepath"
 	"strings"
 
 	"testing"
 
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	. "gopkg.in/check.v1"
 )
@@ -87,6 +90,9 @@ func (s *MySuite) TestDuplicateConceptsinMultipleFile(c *C) {
 }
 
 func (s *MySuite) TestCreateConceptDictionaryGivesAllParseErrors(c *C) {
+	old := env.ConceptCache
+	env.ConceptCache = func() bool { return false }
+	defer func() { env.ConceptCache = old }()
 	config.ProjectRoot, _ = filepath.Abs(filepath.Join("testdata", "err", "cpt"))
 
 	_, res, err := CreateConceptsDictionary()
@@ -97,6 +103,9 @@ func (s *MySuite) TestCreateConceptDictionaryGivesAllParseErrors(c *C) {
 }
 
 func (s *MySuite) TestCreateConceptDictionary(c *C) {
+	old := env.ConceptCache
+	env.ConceptCache = func() bool { return false }
+	defer func() { env.ConceptCache = old }()
 	config.ProjectRoot, _ = filepath.Abs(filepath.Join("testdata", "dir1"))
 
 	dict, res, err := CreateConceptsDictionary()
@@ -786,3 +795,136 @@ func containsAny(errs []ParseError, msg string) bool {
 	}
 	return false
 }
+
+func (s *MySuite) TestParsingConceptWithTags(c *C) {
+	parser := new(ConceptParser)
+	concepts, parseRes := parser.Parse("# my concept \ntags: requires-db, slow\n * first step \n * second step ", "")
+
+	c.Assert(len(parseRes.ParseErrors), Equals, 0)
+	c.Assert(len(concepts), Equals, 1)
+	c.Assert(concepts[0].Tags.Values(), DeepEquals, []string{"requires-db", "slow"})
+	c.Assert(len(concepts[0].ConceptSteps), Equals, 2)
+}
+
+func (s *MySuite) TestScenariosInheritTagsOfNestedConcepts(c *C) {
+	dictionary := gauge.NewConceptDictionary()
+	concepts, _ := new(ConceptParser).Parse("# inner concept\ntags: requires-db\n* inner step\n# outer concept\ntags: slow\n* inner concept\n# context concept\ntags: browser\n* context step", "concept.cpt")
+	_, err := AddConcept(concepts, "concept.cpt", dictionary)
+	c.Assert(err, IsNil)
+	specText := newSpecBuilder().specHeading("Spec").step("context concept").
+		scenarioHeading("First").tags("smoke").step("outer concept").
+		scenarioHeading("Second").step("normal step").String()
+
+	spec, res, err := new(SpecParser).Parse(specText, dictionary, "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(spec.Scenarios[0].Tags.Values(), DeepEquals, []string{"smoke", "browser", "slow", "requires-db"})
+	c.Assert(spec.Scenarios[1].Tags.Values(), DeepEquals, []string{"browser"})
+}
+
+func (s *MySuite) TestParsingConceptWithNamespace(c *C) {
+	concepts, parseRes := new(ConceptParser).Parse("namespace: payments\n# refund order\n* refund step\n# cancel order\n* cancel step", "payments.cpt")
+
+	c.Assert(len(parseRes.ParseErrors), Equals, 0)
+	c.Assert(len(concepts), Equals, 2)
+	c.Assert(concepts[0].Namespace, Equals, "payments")
+	c.Assert(concepts[1].Namespace, Equals, "payments")
+	c.Assert(concepts[0].PreComments[0].Value, Equals, "namespace: payments")
+}
+
+func (s *MySuite) TestParsingConceptWithBlankNamespace(c *C) {
+	_, parseRes := new(ConceptParser).Parse("namespace:\n# refund order\n* refund step", "payments.cpt")
+
+	c.Assert(len(parseRes.ParseErrors), Equals, 1)
+	c.Assert(parseRes.ParseErrors[0].Message, Equals, "Concept namespace should not be blank")
+}
+
+func (s *MySuite) TestSameConceptInDifferentNamespaces(c *C) {
+	dictionary := gauge.NewConceptDictionary()
+	payments, _ := new(ConceptParser).Parse("namespace: payments\n# refund order\n* refund by card\n# close order\n* refund order", "payments.cpt")
+	errs, err := AddConcept(payments, "payments.cpt", dictionary)
+	c.Assert(err, IsNil)
+	c.Assert(errs, HasLen, 0)
+	shipping, _ := new(ConceptParser).Parse("namespace: shipping\n# refund order\n* refund shipping fee", "shipping.cpt")
+	errs, err = AddConcept(shipping, "shipping.cpt", dictionary)
+	c.Assert(err, IsNil)
+	c.Assert(errs, HasLen, 0)
+	specText := newSpecBuilder().specHeading("Spec").scenarioHeading("Scenario").
+		step("payments/refund order").step("shipping/refund order").step("close order").step("refund order").String()
+
+	spec, res, err := new(SpecParser).Parse(specText, dictionary, "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	steps := spec.Scenarios[0].Steps
+	c.Assert(steps[0].IsConcept, Equals, true)
+	c.Assert(gauge.ConceptKey(steps[0]), Equals, "payments/refund order")
+	c.Assert(steps[0].ConceptSteps[0].Value, Equals, "refund by card")
+	c.Assert(steps[1].ConceptSteps[0].Value, Equals, "refund shipping fee")
+	c.Assert(steps[2].ConceptSteps[0].IsConcept, Equals, true)
+	c.Assert(steps[2].ConceptSteps[0].ConceptSteps[0].Value, Equals, "refund by card")
+	c.Assert(steps[3].IsConcept, Equals, false)
+}
+
+func (s *MySuite) TestConceptWithDataTableRepeatsItsStepsForEachRow(c *C) {
+	old := env.AllowConceptDatatable
+	env.AllowConceptDatatable = func() bool { return true }
+	defer func() { env.AllowConceptDatatable = old }()
+	dictionary := gauge.NewConceptDictionary()
+	concepts, parseRes := new(ConceptParser).Parse("# check prices in <country>\n|currency|amount  |\n|--------|--------|\n|USD     |10      |\n|EUR     |<country>|\n* convert \"100\" to <currency>\n* check <amount> in <country>", "prices.cpt")
+	c.Assert(len(parseRes.ParseErrors), Equals, 0)
+	_, err := AddConcept(concepts, "prices.cpt", dictionary)
+	c.Assert(err, IsNil)
+	specText := newSpecBuilder().specHeading("Spec").scenarioHeading("Scenario").step("check prices in \"India\"").String()
+
+	spec, res, err := new(SpecParser).Parse(specText, dictionary, "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	steps := spec.Scenarios[0].Steps[0].ConceptSteps
+	c.Assert(len(steps), Equals, 4)
+	c.Assert(steps[0].Args[1], DeepEquals, &gauge.StepArg{Value: "USD", ArgType: gauge.Static})
+	c.Assert(steps[1].Args[0], DeepEquals, &gauge.StepArg{Value: "10", ArgType: gauge.Static})
+	c.Assert(steps[1].Args[1].ArgType, Equals, gauge.Dynamic)
+	c.Assert(steps[2].Args[1], DeepEquals, &gauge.StepArg{Value: "EUR", ArgType: gauge.Static})
+	c.Assert(steps[3].Args[0], DeepEquals, &gauge.StepArg{Name: "country", Value: "country", ArgType: gauge.Dynamic})
+	params, err := getResolvedParams(steps[3], spec.Scenarios[0].Steps[0], nil)
+	c.Assert(err, IsNil)
+	c.Assert(params[0].GetValue(), Equals, "India")
+	c.Assert(params[1].GetValue(), Equals, "India")
+}
+
+func (s *MySuite) TestConceptWithDataTableWithoutRows(c *C) {
+	old := env.AllowConceptDatatable
+	env.AllowConceptDatatable = func() bool { return true }
+	defer func() { env.AllowConceptDatatable = old }()
+	_, parseRes := new(ConceptParser).Parse("# check prices\n|currency|\n|--------|\n* convert to <currency>", "prices.cpt")
+
+	c.Assert(len(parseRes.ParseErrors), Equals, 1)
+	c.Assert(parseRes.ParseErrors[0].Message, Equals, "Concept data table should have at least one row")
+}
+
+func (s *MySuite) TestAddConceptsAddsTheConceptsInTheOrderOfTheFiles(c *C) {
+	dir := c.MkDir()
+	var files []string
+	for i := 0; i < 10; i++ {
+		file := filepath.Join(dir, fmt.Sprintf("concept%d.cpt", i))
+		text := fmt.Sprintf("# concept %d\n* step %d\n\n# shared concept\n* step of file %d\n", i, i, i)
+		c.Assert(ioutil.WriteFile(file, []byte(text), 0644), IsNil)
+		files = append(files, file)
+	}
+	dictionary := gauge.NewConceptDictionary()
+
+	concepts, errs, err := AddConcepts(files, dictionary)
+
+	c.Assert(err, IsNil)
+	c.Assert(concepts, HasLen, 20)
+	for i := range files {
+		c.Assert(concepts[2*i].FileName, Equals, files[i])
+		c.Assert(concepts[2*i].Value, Equals, fmt.Sprintf("concept %d", i))
+	}
+	c.Assert(errs, HasLen, 18)
+	c.Assert(errs[0].FileName, Equals, files[1])
+	c.Assert(dictionary.Search("shared concept").FileName, Equals, files[9])
+}
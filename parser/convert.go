@@ -37,7 +37,11 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 		}
 		for _, scenario := range spec.Scenarios {
 			if strings.EqualFold(scenario.Heading.Value, token.Value) {
-				return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{spec.FileName, token.LineNo, token.SpanEnd, "Duplicate scenario definition '" + scenario.Heading.Value + "' found in the same specification", token.LineText()}}}
+				message := "Duplicate scenario definition '" + scenario.Heading.Value + "' found in the same specification"
+				return ParseResult{Ok: false, ParseErrors: []ParseError{
+					ParseError{spec.FileName, token.LineNo, token.SpanEnd, message, token.LineText()},
+					ParseError{spec.FileName, scenario.Heading.LineNo, scenario.Heading.SpanEnd, message, scenarioHeadingText(scenario.Heading)},
+				}}
 			}
 		}
 		scenario := &gauge.Scenario{Span: &gauge.Span{Start: token.LineNo, End: token.LineNo}}
@@ -60,8 +64,12 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 		if stepToAdd == nil {
 			return ParseResult{ParseErrors: parseDetails.ParseErrors, Ok: false, Warnings: parseDetails.Warnings}
 		}
-		latestScenario.AddStep(stepToAdd)
-		retainStates(state, specScope, scenarioScope)
+		if isInState(*state, scenarioTearDownScope) {
+			latestScenario.AddTearDownStep(stepToAdd)
+		} else {
+			latestScenario.AddStep(stepToAdd)
+		}
+		retainStates(state, specScope, scenarioScope, scenarioTearDownScope)
 		addStates(state, stepScope)
 		if parseDetails != nil && len(parseDetails.ParseErrors) > 0 {
 			return ParseResult{ParseErrors: parseDetails.ParseErrors, Ok: false, Warnings: parseDetails.Warnings}
@@ -121,6 +129,23 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 		return ParseResult{Ok: true, Warnings: parseDetails.Warnings}
 	})
 
+	scenarioTearDownConverter := converterFn(func(token *Token, state *int) bool {
+		return token.Kind == gauge.ScenarioTearDownKind
+	}, func(token *Token, spec *gauge.Specification, state *int) ParseResult {
+		if !isInState(*state, scenarioScope) {
+			spec.AddComment(&gauge.Comment{Value: token.LineText(), LineNo: token.LineNo})
+			return ParseResult{Ok: false, Warnings: []*Warning{&Warning{spec.FileName, token.LineNo, token.SpanEnd, "Teardown is not associated with a scenario, ignoring it"}}}
+		}
+		scn := spec.LatestScenario()
+		if scn.HasTearDown() {
+			return ParseResult{Ok: false, ParseErrors: []ParseError{ParseError{FileName: spec.FileName, LineNo: token.LineNo, SpanEnd: token.SpanEnd, Message: "Teardown can be defined only once per scenario", LineText: token.LineText()}}}
+		}
+		scn.AddTearDown(&gauge.TearDown{LineNo: token.LineNo, Value: token.Value})
+		retainStates(state, specScope, scenarioScope)
+		addStates(state, scenarioTearDownScope)
+		return ParseResult{Ok: true}
+	})
+
 	commentConverter := converterFn(func(token *Token, state *int) bool {
 		return token.Kind == gauge.CommentKind
 	}, func(token *Token, spec *gauge.Specification, state *int) ParseResult {
@@ -130,7 +155,7 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 		} else {
 			spec.AddComment(comment)
 		}
-		retainStates(state, specScope, scenarioScope, tearDownScope)
+		retainStates(state, specScope, scenarioScope, tearDownScope, scenarioTearDownScope)
 		addStates(state, commentScope)
 		return ParseResult{Ok: true}
 	})
@@ -159,6 +184,9 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 				scn.AddExternalDataTable(externalTable)
 			} else {
 				value := "Multiple data table present, ignoring table"
+				if !env.AllowScenarioDatatable() {
+					value = "Scenario data tables are not allowed, ignoring table. Set allow_scenario_datatable to true to use them"
+				}
 				scn.AddComment(&gauge.Comment{Value: token.LineText(), LineNo: token.LineNo})
 				return ParseResult{Ok: false, Warnings: []*Warning{&Warning{spec.FileName, token.LineNo, token.SpanEnd, value}}}
 			}
@@ -222,7 +250,7 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 					token.LineNo, token.SpanEnd, "Multiple data table present, ignoring table"}}}
 			}
 		}
-		retainStates(state, specScope, scenarioScope, stepScope, contextScope, tearDownScope)
+		retainStates(state, specScope, scenarioScope, stepScope, contextScope, tearDownScope, scenarioTearDownScope)
 		addStates(state, tableScope)
 		return ParseResult{Ok: true}
 	})
@@ -239,7 +267,7 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 				spec.AddComment(&gauge.Comment{Value: token.LineText(), LineNo: token.LineNo})
 			}
 		} else if areUnderlined(token.Args) && !isInState(*state, tableSeparatorScope) {
-			retainStates(state, specScope, scenarioScope, stepScope, contextScope, tearDownScope, tableScope)
+			retainStates(state, specScope, scenarioScope, stepScope, contextScope, tearDownScope, scenarioTearDownScope, tableScope)
 			addStates(state, tableSeparatorScope)
 			// skip table separator
 			result = ParseResult{Ok: true}
@@ -275,14 +303,43 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 				result = ParseResult{Ok: true, Warnings: warnings}
 			}
 		}
-		retainStates(state, specScope, scenarioScope, stepScope, contextScope, tearDownScope, tableScope, tableSeparatorScope)
+		retainStates(state, specScope, scenarioScope, stepScope, contextScope, tearDownScope, scenarioTearDownScope, tableScope, tableSeparatorScope)
 		return result
 	})
 
+	multilineTextConverter := converterFn(func(token *Token, state *int) bool {
+		return token.Kind == gauge.MultilineTextKind
+	}, func(token *Token, spec *gauge.Specification, state *int) ParseResult {
+		if isInState(*state, stepScope) {
+			addMultilineText(spec.LatestScenario().LatestStep(), token)
+		} else if isInState(*state, contextScope) {
+			addMultilineText(spec.LatestContext(), token)
+		} else if isInState(*state, tearDownScope) && len(spec.TearDownSteps) > 0 {
+			addMultilineText(spec.LatestTeardown(), token)
+		} else {
+			for i, line := range token.Lines {
+				comment := &gauge.Comment{Value: line, LineNo: token.LineNo + i}
+				if isInState(*state, scenarioScope) {
+					spec.LatestScenario().AddComment(comment)
+				} else {
+					spec.AddComment(comment)
+				}
+			}
+		}
+		retainStates(state, specScope, scenarioScope, tearDownScope, scenarioTearDownScope)
+		return ParseResult{Ok: true}
+	})
+
 	tagConverter := converterFn(func(token *Token, state *int) bool {
 		return (token.Kind == gauge.TagKind)
 	}, func(token *Token, spec *gauge.Specification, state *int) ParseResult {
 		tags := &gauge.Tags{RawValues: [][]string{token.Args}}
+		var priorityErrs []ParseError
+		if isInState(*state, scenarioScope) {
+			priorityErrs = priorityTagErrors(token, spec.LatestScenario(), spec.FileName)
+		} else {
+			priorityErrs = priorityTagErrors(token, nil, spec.FileName)
+		}
 		if isInState(*state, scenarioScope) {
 			if isInState(*state, tagsScope) {
 				spec.LatestScenario().Tags.Add(tags.RawValues[0])
@@ -303,11 +360,14 @@ func (parser *SpecParser) initializeConverters() []func(*Token, *int, *gauge.Spe
 			}
 		}
 		addStates(state, tagsScope)
+		if len(priorityErrs) > 0 {
+			return ParseResult{Ok: false, ParseErrors: priorityErrs}
+		}
 		return ParseResult{Ok: true}
 	})
 
 	converter := []func(*Token, *int, *gauge.Specification) ParseResult{
-		specConverter, scenarioConverter, stepConverter, contextConverter, commentConverter, tableHeaderConverter, tableRowConverter, tagConverter, keywordConverter, tearDownConverter, tearDownStepConverter,
+		specConverter, scenarioConverter, stepConverter, contextConverter, commentConverter, tableHeaderConverter, tableRowConverter, tagConverter, keywordConverter, tearDownConverter, tearDownStepConverter, multilineTextConverter, scenarioTearDownConverter,
 	}
 
 	return converter
@@ -330,6 +390,17 @@ func addInlineTableHeader(step *gauge.Step, token *Token) {
 	step.AddInlineTableHeaders(token.Args)
 }
 
+// Step value is modified when multiline text is found to account for the new parameter by appending {}
+func addMultilineText(step *gauge.Step, token *Token) {
+	name := "text"
+	if len(token.Args) > 0 && token.Args[0] != "" {
+		name = fmt.Sprintf("%s:%s", name, token.Args[0])
+	}
+	step.Value = fmt.Sprintf("%s %s", step.Value, gauge.ParameterPlaceholder)
+	step.HasMultilineText = true
+	step.AddArgs(&gauge.StepArg{Name: name, Value: token.Value, ArgType: gauge.MultilineText})
+}
+
 func addInlineTableRow(step *gauge.Step, token *Token, argLookup *gauge.ArgLookup, fileName string) ParseResult {
 	tableValues, warnings, err := validateTableRows(token, argLookup, fileName)
 	if len(err) > 0 {
@@ -339,20 +410,37 @@ func addInlineTableRow(step *gauge.Step, token *Token, argLookup *gauge.ArgLooku
 	return ParseResult{Ok: true, Warnings: warnings}
 }
 
+// validateSpecialTableCell gives the error of the special param of a table cell, which should resolve to a string. It
+// is empty if the param is valid.
+func validateSpecialTableCell(param, specialType, value string) string {
+	if specialType == "file" {
+		if _, err := util.GetFileContents(value); err != nil {
+			return fmt.Sprintf("Dynamic param <%s> could not be resolved, Missing file: %s", param, value)
+		}
+		return ""
+	}
+	arg, err := newSpecialTypeResolver().resolve(param)
+	if err != nil {
+		return fmt.Sprintf("Dynamic param <%s> could not be resolved, %s", param, err.Error())
+	}
+	if arg.ArgType != gauge.SpecialString {
+		return fmt.Sprintf("Dynamic param <%s> could not be resolved, A table cell cannot hold a table", param)
+	}
+	return ""
+}
+
 func validateTableRows(token *Token, argLookup *gauge.ArgLookup, fileName string) ([]gauge.TableCell, []*Warning, []ParseError) {
 	dynamicArgMatcher := regexp.MustCompile("^<(.*)>$")
-	specialArgMatcher := regexp.MustCompile("^<(file:.*)>$")
+	specialArgMatcher := regexp.MustCompile("^<(([^:<>]*):(.*))>$")
 	tableValues := make([]gauge.TableCell, 0)
 	warnings := make([]*Warning, 0)
 	error := make([]ParseError, 0)
 	for _, tableValue := range token.Args {
-		if specialArgMatcher.MatchString(tableValue) {
-			match := specialArgMatcher.FindAllStringSubmatch(tableValue, -1)
-			param := match[0][1]
-			file := strings.TrimSpace(strings.TrimPrefix(param, "file:"))
+		if match := specialArgMatcher.FindStringSubmatch(tableValue); match != nil && isSpecialParamType(match[2]) {
+			param := match[1]
 			tableValues = append(tableValues, gauge.TableCell{Value: param, CellType: gauge.SpecialString})
-			if _, err := util.GetFileContents(file); err != nil {
-				error = append(error, ParseError{FileName: fileName, LineNo: token.LineNo, Message: fmt.Sprintf("Dynamic param <%s> could not be resolved, Missing file: %s", param, file), LineText: token.LineText()})
+			if msg := validateSpecialTableCell(param, strings.TrimSpace(match[2]), strings.TrimSpace(match[3])); msg != "" {
+				error = append(error, ParseError{FileName: fileName, LineNo: token.LineNo, Message: msg, LineText: token.LineText()})
 			}
 		} else if dynamicArgMatcher.MatchString(tableValue) {
 			match := dynamicArgMatcher.FindAllStringSubmatch(tableValue, -1)
@@ -7,6 +7,8 @@
 package parser
 
 import (
+	"sort"
+
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 )
@@ -21,8 +23,11 @@ func GetSpecsForDataTableRows(s []*gauge.Specification, errMap *gauge.BuildError
 				nonTableRelatedScenarios, tableRelatedScenarios := FilterTableRelatedScenarios(spec.Scenarios, func(scenario *gauge.Scenario) bool {
 					return scenario.UsesArgsInSteps(spec.DataTable.Table.Headers...)
 				})
+				var s []*gauge.Specification
 				if len(tableRelatedScenarios) > 0 {
-					s := createSpecsForTableRows(spec, tableRelatedScenarios, errMap)
+					s = createSpecsForTableRows(spec, tableRelatedScenarios, errMap)
+				}
+				if len(s) > 0 {
 					s[0].Scenarios = append(s[0].Scenarios, nonTableRelatedScenarios...)
 					for _, scn := range nonTableRelatedScenarios { // nolint
 						s[0].Items = append(s[0].Items, scn)
@@ -39,10 +44,108 @@ func GetSpecsForDataTableRows(s []*gauge.Specification, errMap *gauge.BuildError
 	return
 }
 
+// SplitScenarioDataTableRows creates a separate spec for each scenario data table row, so that
+// the rows of a single data-driven scenario can be picked up by different parallel streams.
+// Scenarios which are not driven by a scenario data table stay together in one spec.
+func SplitScenarioDataTableRows(s []*gauge.Specification, errMap *gauge.BuildErrors) (specs []*gauge.Specification) {
+	for _, spec := range s {
+		otherScenarios, rowScenarios := FilterTableRelatedScenarios(spec.Scenarios, func(scenario *gauge.Scenario) bool {
+			return scenario.ScenarioDataTableRow.IsInitialized()
+		})
+		if len(rowScenarios) == 0 {
+			specs = append(specs, spec)
+			continue
+		}
+		table := spec.DataTable.Table
+		if table == nil {
+			table = &gauge.Table{}
+		}
+		if len(otherScenarios) > 0 {
+			specs = append(specs, createSpec(otherScenarios, table, spec, errMap))
+		}
+		for _, scn := range rowScenarios {
+			specs = append(specs, createSpec([]*gauge.Scenario{scn}, table, spec, errMap))
+		}
+	}
+	return
+}
+
+// SplitScenarios splits the specs tagged with the tag into specs of one scenario each, so that the scenarios, which
+// the tag marks as independent of each other, can run on different streams. Other specs are left as they are.
+func SplitScenarios(s []*gauge.Specification, tag string, errMap *gauge.BuildErrors) (specs []*gauge.Specification) {
+	for _, spec := range s {
+		if len(spec.Scenarios) < 2 || !hasTag(spec.Tags, tag) {
+			specs = append(specs, spec)
+			continue
+		}
+		table := spec.DataTable.Table
+		if table == nil {
+			table = &gauge.Table{}
+		}
+		for _, scn := range spec.Scenarios {
+			specs = append(specs, createSpec([]*gauge.Scenario{scn}, table, spec, errMap))
+		}
+	}
+	return
+}
+
+// SplitScenariosByPriority splits the specs whose scenarios are of several priority levels into a spec per level,
+// in the order of the levels. The parts of a spec can then be scheduled with the
+// scenarios of the same level of other specs. Specs of a single level are left as they are.
+func SplitScenariosByPriority(s []*gauge.Specification, errMap *gauge.BuildErrors) (specs []*gauge.Specification) {
+	for _, spec := range s {
+		var levels []int
+		scenarios := make(map[int][]*gauge.Scenario)
+		for _, scn := range spec.Scenarios {
+			p := ScenarioPriority(scn)
+			if _, ok := scenarios[p]; !ok {
+				levels = append(levels, p)
+			}
+			scenarios[p] = append(scenarios[p], scn)
+		}
+		if len(levels) < 2 {
+			specs = append(specs, spec)
+			continue
+		}
+		sort.SliceStable(levels, func(i, j int) bool { return PriorityLess(levels[i], levels[j]) })
+		table := spec.DataTable.Table
+		if table == nil {
+			table = &gauge.Table{}
+		}
+		for _, p := range levels {
+			specs = append(specs, createSpec(scenarios[p], table, spec, errMap))
+		}
+	}
+	return
+}
+
+// PriorityLess tells if priority level a runs before level b. The positive levels run first, lowest first, then the
+// scenarios without priority, then the negative levels, -1 being the last.
+func PriorityLess(a, b int) bool {
+	if ra, rb := priorityRank(a), priorityRank(b); ra != rb {
+		return ra < rb
+	}
+	return a < b
+}
+
+func priorityRank(p int) int {
+	switch {
+	case p == NoPriority:
+		return 1
+	case p < 0:
+		return 2
+	}
+	return 0
+}
+
 func createSpecsForTableRows(spec *gauge.Specification, scns []*gauge.Scenario, errMap *gauge.BuildErrors) (specs []*gauge.Specification) {
-	for i := range spec.DataTable.Table.Rows() {
+	for i := 0; i < spec.DataTable.Table.GetRowCount(); i++ {
 		t := getTableWithOneRow(spec.DataTable.Table, i)
-		newSpec := createSpec(copyScenarios(scns, *t, i, errMap), t, spec, errMap)
+		rowScns := copyScenarios(scns, *t, i, errMap)
+		if len(scns) > 0 && len(rowScns) == 0 {
+			continue
+		}
+		newSpec := createSpec(rowScns, t, spec, errMap)
 		specs = append(specs, newSpec)
 	}
 	return
@@ -50,7 +153,7 @@ func createSpecsForTableRows(spec *gauge.Specification, scns []*gauge.Scenario,
 
 func createSpec(scns []*gauge.Scenario, table *gauge.Table, spec *gauge.Specification, errMap *gauge.BuildErrors) *gauge.Specification {
 	dt := &gauge.DataTable{Table: table, Value: spec.DataTable.Value, LineNo: spec.DataTable.LineNo, IsExternal: spec.DataTable.IsExternal}
-	s := &gauge.Specification{DataTable: *dt, FileName: spec.FileName, Heading: spec.Heading, Scenarios: scns, Contexts: spec.Contexts, TearDownSteps: spec.TearDownSteps, Tags: spec.Tags}
+	s := &gauge.Specification{DataTable: *dt, FileName: spec.FileName, Heading: spec.Heading, Scenarios: scns, Contexts: spec.Contexts, TearDownSteps: spec.TearDownSteps, Tags: spec.Tags, ID: spec.ID, Deprecation: spec.Deprecation, WIP: spec.WIP, Annotations: spec.Annotations, Metadata: spec.Metadata}
 	index := 0
 	for _, item := range spec.Items {
 		if item.Kind() == gauge.DataTableKind {
@@ -84,11 +187,20 @@ func copyScenarios(scenarios []*gauge.Scenario, table gauge.Table, i int, errMap
 			Tags:                  scn.Tags,
 			Comments:              scn.Comments,
 			Span:                  scn.Span,
+			TableRowIndices:       scn.TableRowIndices,
+			ID:                    scn.ID,
+			ExcludedByTags:        scn.ExcludedByTags,
+			Deprecation:           scn.Deprecation,
+			WIP:                   scn.WIP,
+			Annotations:           scn.Annotations,
+			Priority:              scn.Priority,
+			TearDownSteps:         scn.TearDownSteps,
 		}
 		if scnTableRow.IsInitialized() {
 			newScn.ScenarioDataTableRow = scnTableRow
 			newScn.ScenarioDataTableRowIndex = scnTableRowIndex
 		}
+		newScn.AddRowTags()
 		if len(errMap.ScenarioErrs[scn]) > 0 {
 			errMap.ScenarioErrs[newScn] = errMap.ScenarioErrs[scn]
 		}
@@ -96,18 +208,26 @@ func copyScenarios(scenarios []*gauge.Scenario, table gauge.Table, i int, errMap
 	}
 	for _, scn := range scenarios {
 		if scn.DataTable.IsInitialized() && env.AllowScenarioDatatable() {
-			for i := range scn.DataTable.Table.Rows() {
+			for i := 0; i < scn.DataTable.Table.GetRowCount(); i++ {
+				if !scn.ExecutesTableRow(i) {
+					continue
+				}
 				t := getTableWithOneRow(scn.DataTable.Table, i)
 				scns = append(scns, create(scn, *t, i))
 			}
-		} else {
+		} else if !table.IsInitialized() || scn.ExecutesTableRow(i) {
 			scns = append(scns, create(scn, gauge.Table{}, 0))
 		}
 	}
 	return
 }
 
+// getTableWithOneRow gives a table of the row at the index. The row of a lazy table is read only when the spec of the
+// row is executed.
 func getTableWithOneRow(t *gauge.Table, i int) *gauge.Table {
+	if t.IsLazy() {
+		return t.LazyRow(i)
+	}
 	var row [][]gauge.TableCell
 	for _, c := range t.Columns {
 		row = append(row, []gauge.TableCell{c[i]})
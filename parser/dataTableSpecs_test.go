@@ -179,6 +179,19 @@ func TestGetTableWithOneRow(t *testing.T) {
 	}
 }
 
+func TestGetTableWithOneRowOfALazyTableReadsTheRowWhenItIsNeeded(t *testing.T) {
+	table := gauge.NewLazyTable([]string{"header"}, &csvRows{file: "missing.csv", offsets: []int64{0, 7}, columns: 1}, 3)
+
+	got := getTableWithOneRow(table, 1)
+
+	if !got.IsLazy() || got.GetRowCount() != 1 || got.LineNo != 3 {
+		t.Errorf("Failed: Lazy table with 1 row. Got: lazy %v, %d rows at line %d", got.IsLazy(), got.GetRowCount(), got.LineNo)
+	}
+	if _, err := got.Row(0); err == nil {
+		t.Errorf("Failed: Wanted the row to be read from missing.csv")
+	}
+}
+
 func TestCreateSpecsForTableRows(t *testing.T) {
 	spec := &gauge.Specification{
 		Heading:   &gauge.Heading{},
@@ -245,3 +258,122 @@ func TestCreateSpecsForTableRows(t *testing.T) {
 		t.Errorf("Failed: Create specs for table row.\n\tWanted: %v\n\tGot: %v", string(wantJSON), string(gotJSON))
 	}
 }
+
+func TestGetSpecsForDataTableRowsShouldOnlyCreateSpecsForSelectedRows(t *testing.T) {
+	specs := []*gauge.Specification{
+		{
+			Heading:   &gauge.Heading{},
+			Scenarios: []*gauge.Scenario{{TableRowIndices: []int{1}, Steps: []*gauge.Step{{Args: []*gauge.StepArg{{Value: "header", ArgType: gauge.Dynamic, Name: "header"}}}}}},
+			DataTable: gauge.DataTable{Table: gauge.NewTable([]string{"header"}, [][]gauge.TableCell{
+				{{Value: "row1", CellType: gauge.Static}, {Value: "row2", CellType: gauge.Static}, {Value: "row3", CellType: gauge.Static}},
+			}, 0)},
+		},
+	}
+
+	got := GetSpecsForDataTableRows(specs, gauge.NewBuildErrors())
+
+	if len(got) != 1 {
+		t.Fatalf("Expected 1 spec, got %d", len(got))
+	}
+	if index := got[0].Scenarios[0].SpecDataTableRowIndex; index != 1 {
+		t.Errorf("Expected scenario to be created for row index 1, got %d", index)
+	}
+}
+
+func TestSplitScenarioDataTableRowsCreatesASpecPerRow(t *testing.T) {
+	old := env.AllowScenarioDatatable
+	env.AllowScenarioDatatable = func() bool {
+		return true
+	}
+	defer func() { env.AllowScenarioDatatable = old }()
+	tableScenario := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "table driven"},
+		Steps:   []*gauge.Step{{Args: []*gauge.StepArg{{Value: "header", ArgType: gauge.Dynamic, Name: "header"}}}},
+		DataTable: gauge.DataTable{Table: gauge.NewTable([]string{"header"}, [][]gauge.TableCell{
+			{{Value: "row1", CellType: gauge.Static}, {Value: "row2", CellType: gauge.Static}, {Value: "row3", CellType: gauge.Static}},
+		}, 0)},
+	}
+	otherScenario := &gauge.Scenario{Heading: &gauge.Heading{Value: "other"}}
+	specs := GetSpecsForDataTableRows([]*gauge.Specification{{
+		FileName:  "foo.spec",
+		Heading:   &gauge.Heading{},
+		Scenarios: []*gauge.Scenario{tableScenario, otherScenario},
+		Items:     []gauge.Item{tableScenario, otherScenario},
+	}}, gauge.NewBuildErrors())
+
+	got := SplitScenarioDataTableRows(specs, gauge.NewBuildErrors())
+
+	if len(got) != 4 {
+		t.Fatalf("Failed: Wanted 4 specs, Got: %d", len(got))
+	}
+	if got[0].Scenarios[0].Heading.Value != "other" {
+		t.Errorf("Failed: Wanted first spec to contain scenario 'other', Got: %s", got[0].Scenarios[0].Heading.Value)
+	}
+	for i, spec := range got[1:] {
+		if spec.FileName != "foo.spec" || len(spec.Scenarios) != 1 || len(spec.Items) != 1 {
+			t.Errorf("Failed: Wanted spec %d to contain exactly one scenario of foo.spec", i+1)
+			continue
+		}
+		if spec.Scenarios[0].ScenarioDataTableRowIndex != i {
+			t.Errorf("Failed: Wanted row %d, Got: %d", i, spec.Scenarios[0].ScenarioDataTableRowIndex)
+		}
+	}
+}
+
+func TestSplitScenarioDataTableRowsKeepsSpecsWithoutScenarioDataTable(t *testing.T) {
+	spec := &gauge.Specification{Heading: &gauge.Heading{}, Scenarios: []*gauge.Scenario{{Heading: &gauge.Heading{}}}}
+
+	got := SplitScenarioDataTableRows([]*gauge.Specification{spec}, gauge.NewBuildErrors())
+
+	if len(got) != 1 || got[0] != spec {
+		t.Errorf("Failed: Wanted spec to be unchanged, Got: %v", got)
+	}
+}
+
+func TestSplitScenariosCreatesASpecPerScenarioOfTaggedSpecs(t *testing.T) {
+	first := &gauge.Scenario{Heading: &gauge.Heading{Value: "first"}}
+	second := &gauge.Scenario{Heading: &gauge.Heading{Value: "second"}}
+	tags := &gauge.Tags{RawValues: [][]string{{"parallel-scenarios"}}}
+	tagged := &gauge.Specification{FileName: "foo.spec", Heading: &gauge.Heading{}, Tags: tags, Scenarios: []*gauge.Scenario{first, second}, Items: []gauge.Item{tags, first, second}}
+	other := &gauge.Specification{FileName: "bar.spec", Heading: &gauge.Heading{}, Scenarios: []*gauge.Scenario{first, second}, Items: []gauge.Item{first, second}}
+
+	got := SplitScenarios([]*gauge.Specification{tagged, other}, "parallel-scenarios", gauge.NewBuildErrors())
+
+	if len(got) != 3 {
+		t.Fatalf("Failed: Wanted 3 specs, Got: %d", len(got))
+	}
+	for i, want := range []string{"first", "second"} {
+		spec := got[i]
+		if spec.FileName != "foo.spec" || len(spec.Scenarios) != 1 || spec.Scenarios[0].Heading.Value != want {
+			t.Errorf("Failed: Wanted spec %d to contain only scenario '%s' of foo.spec", i, want)
+		}
+		if len(spec.Items) != 2 || spec.Items[1] != spec.Scenarios[0] {
+			t.Errorf("Failed: Wanted spec %d to keep the tags and its scenario as items, Got: %v", i, spec.Items)
+		}
+	}
+	if got[2] != other {
+		t.Error("Failed: Wanted the spec without the tag to be left as it is")
+	}
+}
+
+func TestGetSpecsForDataTableRowsTagsTheScenariosWithTheirRowTags(t *testing.T) {
+	spec, res, err := new(SpecParser).Parse("# Spec\n|name|tags|\n|----|----|\n|a|smoke, ui|\n|b| |\n## Scenario\ntags: login\n* greet <name>\n", gauge.NewConceptDictionary(), "foo.spec")
+	if err != nil || !res.Ok {
+		t.Fatalf("Failed: could not parse the spec, %v %v", err, res.ParseErrors)
+	}
+
+	got := GetSpecsForDataTableRows([]*gauge.Specification{spec}, gauge.NewBuildErrors())
+
+	if len(got) != 2 {
+		t.Fatalf("Failed: Wanted 2 specs, Got: %d", len(got))
+	}
+	if tags := got[0].Scenarios[0].Tags.Values(); !reflect.DeepEqual(tags, []string{"login", "smoke", "ui"}) {
+		t.Errorf("Failed: Wanted the scenario of the first row to be tagged with login, smoke and ui, Got: %v", tags)
+	}
+	if tags := got[1].Scenarios[0].Tags.Values(); !reflect.DeepEqual(tags, []string{"login"}) {
+		t.Errorf("Failed: Wanted the scenario of the second row to be tagged with login, Got: %v", tags)
+	}
+	if tags := spec.Scenarios[0].Tags.Values(); !reflect.DeepEqual(tags, []string{"login"}) {
+		t.Errorf("Failed: Wanted the parsed scenario to keep its tags, Got: %v", tags)
+	}
+}
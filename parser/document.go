@@ -0,0 +1,340 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+// TextEdit replaces the lines Start to End of a text, both included and counted from 1, by Lines. An End of Start-1
+// inserts the lines before the line Start, and no Lines deletes the lines.
+type TextEdit struct {
+	Start int
+	End   int
+	Lines []string
+}
+
+// Document is a spec file parsed for an editor. An edit falling in the steps, tags, tables or comments of a scenario
+// parses that scenario again along with the spec heading, tags, data table and contexts it depends on, rather than
+// the whole file. Any other edit parses the whole file.
+type Document struct {
+	FileName string
+	Spec     *gauge.Specification
+	Result   *ParseResult
+
+	lines             []string
+	conceptDictionary *gauge.ConceptDictionary
+	priorityPattern   *regexp.Regexp
+}
+
+// ParseDocument parses the spec text of the file as a document which can be edited
+func (parser *SpecParser) ParseDocument(text string, conceptDictionary *gauge.ConceptDictionary, specFile string) (*Document, error) {
+	doc := &Document{FileName: specFile, lines: strings.Split(text, "\n"), conceptDictionary: conceptDictionary, priorityPattern: parser.priorityPattern}
+	if err := doc.parse(); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Text gives the text of the document with its edits
+func (doc *Document) Text() string {
+	return strings.Join(doc.lines, "\n")
+}
+
+// Edit applies the edit to the text of the document and updates its spec and parse result. It gives the items of the
+// spec which were parsed again, the scenario the edit falls in or all the items when the whole file was parsed.
+func (doc *Document) Edit(edit TextEdit) ([]gauge.Item, error) {
+	if edit.Start < 1 || edit.End < edit.Start-1 || edit.End > len(doc.lines) {
+		return nil, fmt.Errorf("cannot edit lines %d to %d of %s, which has %d lines", edit.Start, edit.End, doc.FileName, len(doc.lines))
+	}
+	start, end, scn := doc.editedScenario(edit)
+	delta := len(edit.Lines) - (edit.End - edit.Start + 1)
+	doc.lines = append(append(append([]string{}, doc.lines[:edit.Start-1]...), edit.Lines...), doc.lines[edit.End:]...)
+	if scn != nil {
+		reparsed, res, err := doc.parseScenario(start, end+delta)
+		if err != nil {
+			return nil, err
+		}
+		if reparsed != nil && samePriority(reparsed.Priority, scn.Priority) {
+			doc.replaceScenario(scn, reparsed, delta)
+			doc.mergeResult(res, start, end, delta)
+			return []gauge.Item{reparsed}, nil
+		}
+	}
+	if err := doc.parse(); err != nil {
+		return nil, err
+	}
+	return doc.Spec.Items, nil
+}
+
+// text gives the lines start to end of the document, with the line break ending the last one
+func (doc *Document) text(start, end int) string {
+	text := strings.Join(doc.lines[start-1:end], "\n")
+	if end < len(doc.lines) {
+		text += "\n"
+	}
+	return text
+}
+
+func (doc *Document) parse() error {
+	parser := &SpecParser{priorityPattern: doc.priorityPattern}
+	spec, res, err := parser.Parse(doc.Text(), doc.conceptDictionary, doc.FileName)
+	if err != nil {
+		return err
+	}
+	doc.Spec, doc.Result = spec, res
+	return nil
+}
+
+// editedScenario gives the scenario whose steps, tags, tables or comments the edit falls in, with the lines the
+// scenario spans from its heading to the item following it. It gives no scenario when the edit touches a heading,
+// falls outside the scenarios or when the file has parse errors elsewhere, all of which need the whole file parsed.
+func (doc *Document) editedScenario(edit TextEdit) (int, int, *gauge.Scenario) {
+	if doc.Spec == nil || doc.Spec.Heading == nil {
+		return 0, 0, nil
+	}
+	for i, item := range doc.Spec.Items {
+		if item.Kind() != gauge.ScenarioKind {
+			continue
+		}
+		scn := item.(*gauge.Scenario)
+		start, end := scn.Heading.LineNo, len(doc.lines)
+		if i+1 < len(doc.Spec.Items) {
+			end = itemLineNo(doc.Spec.Items[i+1]) - 1
+		}
+		if edit.Start <= scn.Heading.SpanEnd || edit.Start > end+1 || edit.End > end {
+			continue
+		}
+		for _, err := range doc.Result.ParseErrors {
+			if err.LineNo < start || err.LineNo > end {
+				return 0, 0, nil
+			}
+		}
+		return start, end, scn
+	}
+	return 0, 0, nil
+}
+
+// parseScenario parses the lines of a scenario after the lines of the spec before its first scenario. It gives no
+// scenario when the lines are not exactly one scenario, like when a heading or a spec teardown was written in it or
+// a multiline text is not closed.
+func (doc *Document) parseScenario(start, end int) (*gauge.Scenario, *ParseResult, error) {
+	headerEnd := 0
+	for _, item := range doc.Spec.Items {
+		if item.Kind() == gauge.ScenarioKind {
+			headerEnd = itemLineNo(item) - 1
+			break
+		}
+	}
+	parser := &SpecParser{conceptDictionary: doc.conceptDictionary, priorityPattern: doc.priorityPattern}
+	tokens, errs := parser.GenerateTokens(doc.text(1, headerEnd), doc.FileName)
+	scnTokens, scnErrs := new(SpecParser).GenerateTokens(doc.text(start, end), doc.FileName)
+	for _, token := range scnTokens {
+		token.LineNo += start - 1
+		token.SpanEnd += start - 1
+		if token.Kind == gauge.SpecKind || token.Kind == gauge.TearDownKind || (token.Kind == gauge.ScenarioKind && token.LineNo != start) || !isClosed(token) {
+			return nil, nil, nil
+		}
+	}
+	for i := range scnErrs {
+		scnErrs[i].LineNo += start - 1
+		scnErrs[i].SpanEnd += start - 1
+	}
+	spec, res := parser.createSpecification(append(tokens, scnTokens...), doc.FileName)
+	if err := spec.ProcessConceptStepsFrom(doc.conceptDictionary); err != nil {
+		return nil, nil, err
+	}
+	if len(spec.Scenarios) != 1 || len(errs) > 0 {
+		return nil, nil, nil
+	}
+	scn := spec.Scenarios[0]
+	scnRes := &ParseResult{ParseErrors: scnErrs}
+	for _, err := range res.ParseErrors {
+		if err.LineNo >= start {
+			scnRes.ParseErrors = append(scnRes.ParseErrors, err)
+		}
+	}
+	for _, warning := range res.Warnings {
+		if warning.LineNo >= start {
+			scnRes.Warnings = append(scnRes.Warnings, warning)
+		}
+	}
+	if len(scn.Steps) == 0 {
+		scnRes.ParseErrors = append(scnRes.ParseErrors, ParseError{FileName: doc.FileName, LineNo: scn.Heading.LineNo, SpanEnd: scn.Heading.SpanEnd, Message: "Scenario should have atleast one step"})
+	}
+	return scn, scnRes, nil
+}
+
+// replaceScenario puts the scenario parsed again in place of the edited one, moving the items after it by the lines
+// the edit added or removed
+func (doc *Document) replaceScenario(old, scn *gauge.Scenario, delta int) {
+	for i, s := range doc.Spec.Scenarios {
+		if s == old {
+			doc.Spec.Scenarios[i] = scn
+		}
+	}
+	s := &lineShifter{delta: delta, seen: make(map[interface{}]bool)}
+	edited := -1
+	for i, item := range doc.Spec.Items {
+		if item == gauge.Item(old) {
+			doc.Spec.Items[i] = scn
+			edited = i
+		} else if edited >= 0 {
+			s.item(item)
+		}
+	}
+	for _, step := range doc.Spec.TearDownSteps {
+		s.step(step)
+	}
+	if edited < len(doc.Spec.Items)-1 {
+		scn.Span.End = old.Span.End + delta
+	}
+}
+
+// mergeResult replaces the errors and warnings of the lines of the edited scenario by those of the scenario parsed
+// again, moving the ones after it by the lines the edit added or removed
+func (doc *Document) mergeResult(res *ParseResult, start, end, delta int) {
+	merged := &ParseResult{FileName: doc.FileName, ParseErrors: res.ParseErrors, Warnings: res.Warnings}
+	for _, err := range doc.Result.ParseErrors {
+		if err.LineNo < start || err.LineNo > end {
+			if err.LineNo > end {
+				err.LineNo, err.SpanEnd = err.LineNo+delta, err.SpanEnd+delta
+			}
+			merged.ParseErrors = append(merged.ParseErrors, err)
+		}
+	}
+	for _, warning := range doc.Result.Warnings {
+		if warning.LineNo < start || warning.LineNo > end {
+			if warning.LineNo > end {
+				warning.LineNo, warning.LineSpanEnd = warning.LineNo+delta, warning.LineSpanEnd+delta
+			}
+			merged.Warnings = append(merged.Warnings, warning)
+		}
+	}
+	sort.SliceStable(merged.ParseErrors, func(i, j int) bool { return merged.ParseErrors[i].LineNo < merged.ParseErrors[j].LineNo })
+	sort.SliceStable(merged.Warnings, func(i, j int) bool { return merged.Warnings[i].LineNo < merged.Warnings[j].LineNo })
+	merged.Ok = len(merged.ParseErrors) == 0
+	doc.Result = merged
+}
+
+func samePriority(p1, p2 *gauge.Priority) bool {
+	if p1 == nil || p2 == nil {
+		return p1 == p2
+	}
+	return *p1 == *p2
+}
+
+// isClosed tells if the token is not a multiline text running to the end of the text for want of a closing fence
+func isClosed(token *Token) bool {
+	if token.Kind != gauge.MultilineTextKind {
+		return true
+	}
+	fence := multilineTextFence(strings.TrimSpace(token.Lines[0]))
+	return len(token.Lines) > 1 && isClosingFence(token.Lines[len(token.Lines)-1], fence)
+}
+
+// itemLineNo gives the line an item of a spec starts at
+func itemLineNo(item gauge.Item) int {
+	switch i := item.(type) {
+	case *gauge.Scenario:
+		return i.Heading.LineNo
+	case *gauge.Step:
+		return i.LineNo
+	case *gauge.Comment:
+		return i.LineNo
+	case *gauge.TearDown:
+		return i.LineNo
+	case *gauge.DataTable:
+		return i.LineNo
+	case *gauge.Table:
+		return i.LineNo
+	}
+	return 0
+}
+
+// lineShifter moves the items of a spec by the lines an edit before them added or removed, each item once
+type lineShifter struct {
+	delta int
+	seen  map[interface{}]bool
+}
+
+func (s *lineShifter) once(v interface{}) bool {
+	if s.seen[v] {
+		return false
+	}
+	s.seen[v] = true
+	return true
+}
+
+func (s *lineShifter) item(item gauge.Item) {
+	switch i := item.(type) {
+	case *gauge.Scenario:
+		s.scenario(i)
+	case *gauge.Step:
+		s.step(i)
+	case *gauge.Comment:
+		if s.once(i) {
+			i.LineNo += s.delta
+		}
+	case *gauge.TearDown:
+		if s.once(i) {
+			i.LineNo += s.delta
+		}
+	case *gauge.DataTable:
+		s.dataTable(i)
+	case *gauge.Table:
+		s.table(i)
+	}
+}
+
+func (s *lineShifter) scenario(scn *gauge.Scenario) {
+	if !s.once(scn) {
+		return
+	}
+	scn.Heading.LineNo += s.delta
+	scn.Heading.SpanEnd += s.delta
+	scn.Span.Start += s.delta
+	scn.Span.End += s.delta
+	s.dataTable(&scn.DataTable)
+	for _, item := range scn.Items {
+		s.item(item)
+	}
+}
+
+func (s *lineShifter) step(step *gauge.Step) {
+	if !s.once(step) {
+		return
+	}
+	step.LineNo += s.delta
+	step.LineSpanEnd += s.delta
+	for _, arg := range step.Args {
+		if arg.ArgType == gauge.TableArg {
+			s.table(&arg.Table)
+		}
+	}
+}
+
+func (s *lineShifter) dataTable(dataTable *gauge.DataTable) {
+	if !s.once(dataTable) {
+		return
+	}
+	if dataTable.LineNo > 0 {
+		dataTable.LineNo += s.delta
+	}
+	s.table(dataTable.Table)
+}
+
+func (s *lineShifter) table(table *gauge.Table) {
+	if table != nil && table.LineNo > 0 && s.once(table) {
+		table.LineNo += s.delta
+	}
+}
@@ -0,0 +1,187 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"sort"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+const documentSpec = `# Login
+
+   |user |password|
+   |-----|--------|
+   |alice|secret  |
+
+* Open the browser
+
+## Log in with a password
+tags: smoke
+
+* Log in as <user>
+* Type <password>
+Comment of the first scenario
+
+## Log in with a token
+
+* Log in with token "abc"
+   |token|
+   |-----|
+   |abc  |
+* Check the home page
+
+## Log out
+* Log out
+
+___
+* Close the browser
+`
+
+// assertReparsed checks the document has the spec and the errors and warnings of parsing its text again in full
+func assertReparsed(c *C, doc *Document, dictionary *gauge.ConceptDictionary) {
+	spec, res, err := new(SpecParser).Parse(doc.Text(), dictionary, doc.FileName)
+	c.Assert(err, IsNil)
+	sort.SliceStable(res.ParseErrors, func(i, j int) bool { return res.ParseErrors[i].LineNo < res.ParseErrors[j].LineNo })
+	c.Assert(doc.Spec, DeepEquals, spec)
+	c.Assert(doc.Result.ParseErrors, DeepEquals, res.ParseErrors)
+	if len(doc.Result.Warnings) > 0 || len(res.Warnings) > 0 {
+		c.Assert(doc.Result.Warnings, DeepEquals, res.Warnings)
+	}
+	c.Assert(doc.Result.Ok, Equals, res.Ok)
+}
+
+func (s *MySuite) TestEditInAScenarioParsesTheScenarioAgain(c *C) {
+	dictionary := gauge.NewConceptDictionary()
+	doc, err := new(SpecParser).ParseDocument(documentSpec, dictionary, "login.spec")
+	c.Assert(err, IsNil)
+	c.Assert(doc.Result.Ok, Equals, true)
+
+	items, err := doc.Edit(TextEdit{Start: 18, End: 18, Lines: []string{"* Log in as <user> with a token", "Comment of the second scenario", "", "* Check the session"}})
+
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Assert(items[0].(*gauge.Scenario).Heading.Value, Equals, "Log in with a token")
+	c.Assert(doc.Spec.Scenarios[1].Steps[0].Value, Equals, "Log in as {} with a token")
+	c.Assert(doc.Spec.Scenarios[1].Steps[1].HasInlineTable, Equals, true)
+	c.Assert(doc.Spec.Scenarios[2].Heading.LineNo, Equals, 27)
+	assertReparsed(c, doc, dictionary)
+}
+
+func (s *MySuite) TestDeletingLinesOfAScenarioMovesTheItemsAfterIt(c *C) {
+	dictionary := gauge.NewConceptDictionary()
+	doc, err := new(SpecParser).ParseDocument(documentSpec, dictionary, "login.spec")
+	c.Assert(err, IsNil)
+
+	items, err := doc.Edit(TextEdit{Start: 13, End: 14})
+
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Assert(doc.Spec.Scenarios[0].Steps, HasLen, 1)
+	c.Assert(doc.Spec.TearDownSteps[0].LineNo, Equals, 26)
+	assertReparsed(c, doc, dictionary)
+}
+
+func (s *MySuite) TestEditInAScenarioGivesItsErrors(c *C) {
+	dictionary := gauge.NewConceptDictionary()
+	doc, err := new(SpecParser).ParseDocument(documentSpec, dictionary, "login.spec")
+	c.Assert(err, IsNil)
+
+	items, err := doc.Edit(TextEdit{Start: 25, End: 25, Lines: []string{"* Log out of <session>"}})
+
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Assert(doc.Result.Ok, Equals, false)
+	c.Assert(doc.Result.ParseErrors, HasLen, 1)
+	c.Assert(doc.Result.ParseErrors[0].LineNo, Equals, 25)
+	assertReparsed(c, doc, dictionary)
+
+	items, err = doc.Edit(TextEdit{Start: 25, End: 25, Lines: []string{"* Log out of <user>"}})
+
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Assert(doc.Result.Ok, Equals, true)
+	assertReparsed(c, doc, dictionary)
+}
+
+func (s *MySuite) TestEditAddingAHeadingParsesTheWholeSpec(c *C) {
+	dictionary := gauge.NewConceptDictionary()
+	doc, err := new(SpecParser).ParseDocument(documentSpec, dictionary, "login.spec")
+	c.Assert(err, IsNil)
+
+	items, err := doc.Edit(TextEdit{Start: 15, End: 14, Lines: []string{"## Log in twice", "* Log in as <user>"}})
+
+	c.Assert(err, IsNil)
+	c.Assert(len(items) > 1, Equals, true)
+	c.Assert(doc.Spec.Scenarios, HasLen, 4)
+	assertReparsed(c, doc, dictionary)
+}
+
+func (s *MySuite) TestEditOfAHeadingParsesTheWholeSpec(c *C) {
+	dictionary := gauge.NewConceptDictionary()
+	doc, err := new(SpecParser).ParseDocument(documentSpec, dictionary, "login.spec")
+	c.Assert(err, IsNil)
+
+	items, err := doc.Edit(TextEdit{Start: 24, End: 24, Lines: []string{"## Log out of the app"}})
+
+	c.Assert(err, IsNil)
+	c.Assert(len(items) > 1, Equals, true)
+	c.Assert(doc.Spec.Scenarios[2].Heading.Value, Equals, "Log out of the app")
+	assertReparsed(c, doc, dictionary)
+}
+
+func (s *MySuite) TestEditOpeningAMultilineTextParsesTheWholeSpec(c *C) {
+	old := env.AllowMultilineText
+	env.AllowMultilineText = func() bool { return true }
+	defer func() { env.AllowMultilineText = old }()
+	dictionary := gauge.NewConceptDictionary()
+	doc, err := new(SpecParser).ParseDocument(documentSpec, dictionary, "login.spec")
+	c.Assert(err, IsNil)
+
+	items, err := doc.Edit(TextEdit{Start: 14, End: 13, Lines: []string{"```"}})
+
+	c.Assert(err, IsNil)
+	c.Assert(len(items) > 1, Equals, true)
+	assertReparsed(c, doc, dictionary)
+}
+
+func (s *MySuite) TestEditMovesTheDataTablesOfTheScenariosAfterIt(c *C) {
+	old := env.AllowScenarioDatatable
+	env.AllowScenarioDatatable = func() bool { return true }
+	defer func() { env.AllowScenarioDatatable = old }()
+	dictionary := gauge.NewConceptDictionary()
+	concepts, res := new(ConceptParser).Parse("# Log in as <name>\n* Type <name>\n* Submit\n", "login.cpt")
+	c.Assert(res.ParseErrors, HasLen, 0)
+	_, err := AddConcept(concepts, "login.cpt", dictionary)
+	c.Assert(err, IsNil)
+	text := "# Login\n\n## Log in as bob\n\n   |name|\n   |----|\n   |bob |\n\n* Log in as <name>\n\n## Log in as alice\n\n   |name |\n   |-----|\n   |alice|\n\n* Log in as <name>\n"
+	doc, err := new(SpecParser).ParseDocument(text, dictionary, "login.spec")
+	c.Assert(err, IsNil)
+	c.Assert(doc.Result.Ok, Equals, true)
+
+	items, err := doc.Edit(TextEdit{Start: 10, End: 9, Lines: []string{"Comment", "   |user|", "   |----|", "   |dave|"}})
+
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Assert(doc.Spec.Scenarios[0].Steps[0].IsConcept, Equals, true)
+	c.Assert(doc.Spec.Scenarios[1].DataTable.Table.LineNo, Equals, 17)
+	c.Assert(doc.Result.Warnings, HasLen, 1)
+	c.Assert(doc.Result.Warnings[0].LineNo, Equals, 11)
+	assertReparsed(c, doc, dictionary)
+}
+
+func (s *MySuite) TestEditOutsideTheDocumentIsAnError(c *C) {
+	doc, err := new(SpecParser).ParseDocument(documentSpec, gauge.NewConceptDictionary(), "login.spec")
+	c.Assert(err, IsNil)
+
+	_, err = doc.Edit(TextEdit{Start: 40, End: 41, Lines: []string{"* Log out"}})
+
+	c.Assert(err, ErrorMatches, "cannot edit lines 40 to 41 of login.spec, which has 29 lines")
+	c.Assert(doc.Text(), Equals, documentSpec)
+}
@@ -0,0 +1,63 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"os"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+)
+
+const (
+	envParamPrefix = "env:"
+	// MaskedValue replaces the value of a masked <env:NAME> parameter in reports
+	MaskedValue = "******"
+)
+
+// EnvParamName gives the environment variable of an <env:NAME> parameter from the name of the parameter, or an empty
+// string if the parameter does not read an environment variable
+func EnvParamName(paramName string) string {
+	if !strings.HasPrefix(paramName, envParamPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(paramName, envParamPrefix))
+}
+
+// EnvParamValue gives the value of the environment variable of an <env:NAME> parameter as the step sees it
+func EnvParamValue(name string) string {
+	return os.Getenv(name)
+}
+
+// envParamReportValue gives the value of the environment variable of an <env:NAME> parameter as reports show it,
+// masked if the variable is listed in gauge_masked_env_params
+func envParamReportValue(name string) string {
+	for _, masked := range env.MaskedEnvParams() {
+		if masked == name {
+			return MaskedValue
+		}
+	}
+	return EnvParamValue(name)
+}
+
+// UndefinedEnvParams gives the environment variables read by the <env:NAME> parameters of the step which are not
+// defined
+func UndefinedEnvParams(step *gauge.Step) []string {
+	var names []string
+	for _, arg := range step.Args {
+		if arg.ArgType != gauge.SpecialString {
+			continue
+		}
+		if name := EnvParamName(arg.Name); name != "" {
+			if _, ok := os.LookupEnv(name); !ok {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
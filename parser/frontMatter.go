@@ -0,0 +1,59 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+	"gopkg.in/yaml.v2"
+)
+
+// addFrontMatter reads the YAML front matter the spec text starts with into the metadata of the spec. The lines of
+// the front matter stay comments of the spec, which is how plugins get them.
+func addFrontMatter(spec *gauge.Specification, res *ParseResult, specText string) {
+	end := frontMatterEnd(specText)
+	if end == 0 {
+		return
+	}
+	lines := strings.Split(specText, "\n")[1 : end-1]
+	var metadata map[string]interface{}
+	if err := yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &metadata); err != nil {
+		res.Ok = false
+		res.ParseErrors = append(res.ParseErrors, ParseError{FileName: spec.FileName, LineNo: 1, SpanEnd: end, Message: fmt.Sprintf("Invalid YAML front matter. %s", err.Error()), LineText: frontMatterDelimiter})
+		return
+	}
+	if len(metadata) > 0 {
+		spec.AddMetadata(yamlValue(metadata).(map[string]interface{}))
+	}
+}
+
+// yamlValue gives the value with its maps keyed by strings, as YAML maps may have keys of any type but JSON maps may
+// not
+func yamlValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, e := range value {
+			m[k] = yamlValue(e)
+		}
+		return m
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, e := range value {
+			m[fmt.Sprint(k)] = yamlValue(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range value {
+			value[i] = yamlValue(e)
+		}
+		return value
+	}
+	return v
+}
@@ -0,0 +1,53 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestFrontMatterIsReadIntoTheMetadataOfTheSpec(c *C) {
+	text := "---\nowner: payments\njira: CHK-102\npriority: 1\nreviewers: [ann, bob]\nlinks:\n  docs: https://example.com\n---\n# Spec\n## Scenario\n* step\n"
+
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(spec.Heading.Value, Equals, "Spec")
+	c.Assert(spec.Metadata["owner"], Equals, "payments")
+	c.Assert(spec.Metadata["priority"], Equals, 1)
+	c.Assert(spec.Metadata["reviewers"], DeepEquals, []interface{}{"ann", "bob"})
+	c.Assert(spec.Metadata["links"], DeepEquals, map[string]interface{}{"docs": "https://example.com"})
+	c.Assert(spec.Annotations["jira"], DeepEquals, []string{"CHK-102"})
+	c.Assert(spec.Annotations["reviewers"], DeepEquals, []string{"ann", "bob"})
+	c.Assert(spec.Annotations["links"], IsNil)
+}
+
+func (s *MySuite) TestSpecWithoutFrontMatterHasNoMetadata(c *C) {
+	spec, _, _ := new(SpecParser).Parse("# Spec\n## Scenario\n* step\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(spec.Metadata, IsNil)
+}
+
+func (s *MySuite) TestInvalidFrontMatterGivesParseError(c *C) {
+	_, res, err := new(SpecParser).Parse("---\nowner: [payments\n---\n# Spec\n## Scenario\n* step\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.ParseErrors[0].LineNo, Equals, 1)
+	c.Assert(res.ParseErrors[0].Message, Matches, "Invalid YAML front matter. .*")
+}
+
+func (s *MySuite) TestMetadataIsKeptWhenSplittingSpecs(c *C) {
+	spec, _, _ := new(SpecParser).Parse("---\nowner: payments\n---\n# Spec\n|a|\n|-|\n|1|\n|2|\n## Scenario\n* step <a>\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	specs := GetSpecsForDataTableRows([]*gauge.Specification{spec}, gauge.NewBuildErrors())
+
+	c.Assert(len(specs), Equals, 2)
+	c.Assert(specs[1].Metadata["owner"], Equals, "payments")
+}
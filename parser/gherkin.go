@@ -0,0 +1,284 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+)
+
+const featureFileExtension = ".feature"
+
+var (
+	gherkinHeadingKeywords = []string{"Feature", "Background", "Scenario Outline", "Scenario Template", "Scenario", "Example", "Examples", "Scenarios", "Rule"}
+	gherkinStepKeywords    = []string{"Given", "When", "Then", "And", "But", "*"}
+	gherkinLanguagePattern = regexp.MustCompile(`^#\s*language\s*:\s*(\S+)`)
+)
+
+// IsFeatureFile tells if the file is a Gherkin feature file. Feature files are parsed as specs when their extension
+// is one of the gauge_spec_file_extensions.
+func IsFeatureFile(path string) bool {
+	return strings.ToLower(filepath.Ext(path)) == featureFileExtension
+}
+
+// gherkinScenario holds the tokens of a scenario of a feature file until the scenario ends, as the tags and the
+// examples of a Gherkin scenario go in other places than in a spec.
+type gherkinScenario struct {
+	heading  *Token
+	tags     *Token
+	headers  []string
+	examples []*Token
+	body     []*Token
+}
+
+func (s *gherkinScenario) tokens() []*Token {
+	tokens := []*Token{s.heading}
+	if s.tags != nil {
+		tokens = append(tokens, s.tags)
+	}
+	return append(append(tokens, s.examples...), s.body...)
+}
+
+const (
+	noGherkinTable = iota
+	gherkinStepTable
+	gherkinExamplesTable
+)
+
+// gherkinReader turns the lines of a feature file into the tokens of a spec. The feature is the spec heading, the
+// steps of the background are contexts and the examples of a scenario outline are the data table of the scenario.
+// The tags written above a feature or a scenario are given after its heading, and the tags of a rule and of examples
+// go to the scenarios of the rule and of the examples.
+type gherkinReader struct {
+	fileName   string
+	tokens     []*Token
+	errs       []ParseError
+	tags       *Token
+	ruleTags   string
+	scenario   *gherkinScenario
+	table      int
+	tableRows  int
+	text       *Token
+	background bool
+}
+
+func (parser *SpecParser) generateGherkinTokens(text, fileName string) ([]*Token, []ParseError) {
+	parser.initialize()
+	reader := &gherkinReader{fileName: fileName}
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		reader.read(scanner.Text(), lineNo)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, []ParseError{{FileName: fileName, Message: err.Error()}}
+	}
+	reader.endScenario()
+	errs := reader.errs
+	for _, token := range reader.tokens {
+		errs = append(errs, parser.accept(token, fileName)...)
+	}
+	return parser.tokens, errs
+}
+
+func (r *gherkinReader) read(line string, lineNo int) {
+	trimmedLine := strings.TrimSpace(line)
+	if r.text != nil {
+		r.text.Lines = append(r.text.Lines, line)
+		r.text.SpanEnd = lineNo
+		if isClosingFence(line, multilineTextFence(strings.TrimSpace(r.text.Lines[0]))) {
+			r.text = nil
+		}
+		return
+	}
+	if len(trimmedLine) == 0 {
+		return
+	}
+	if trimmedLine[0] != '|' {
+		r.table = noGherkinTable
+	}
+	switch {
+	case trimmedLine[0] == '#':
+		if m := gherkinLanguagePattern.FindStringSubmatch(trimmedLine); m != nil && !strings.EqualFold(m[1], "en") {
+			r.error(lineNo, line, fmt.Sprintf("Only the English Gherkin keywords are supported, found language '%s'", m[1]))
+		}
+		r.add(&Token{Kind: gauge.CommentKind, LineNo: lineNo, Lines: []string{line}, Value: strings.TrimRight(line, " \t"), SpanEnd: lineNo})
+	case trimmedLine[0] == '@':
+		r.readTags(trimmedLine, line, lineNo)
+	case trimmedLine[0] == '|':
+		r.readTableRow(trimmedLine, line, lineNo)
+	case multilineTextFence(trimmedLine) != "":
+		r.text = &Token{Kind: gauge.MultilineTextKind, LineNo: lineNo, Lines: []string{line}, SpanEnd: lineNo}
+		if env.AllowMultilineText() {
+			r.add(r.text)
+		} else {
+			r.error(lineNo, line, "Doc strings are read as the multiline text of the step, set allow_multiline_text to true to use them")
+		}
+	default:
+		if keyword, value, ok := gherkinHeading(trimmedLine); ok {
+			r.readHeading(keyword, value, line, lineNo)
+		} else if value, ok := gherkinStep(trimmedLine); ok {
+			if r.background && r.scenario != nil {
+				r.error(lineNo, line, "Background should be defined before the scenarios of the feature")
+			}
+			r.add(&Token{Kind: gauge.StepKind, LineNo: lineNo, Lines: []string{trimmedLine}, Value: value, SpanEnd: lineNo})
+			r.table, r.tableRows = gherkinStepTable, 0
+		} else {
+			r.add(&Token{Kind: gauge.CommentKind, LineNo: lineNo, Lines: []string{line}, Value: strings.TrimRight(line, " \t"), SpanEnd: lineNo})
+		}
+	}
+}
+
+func (r *gherkinReader) readHeading(keyword, value, line string, lineNo int) {
+	tags := r.takeTags()
+	r.background = false
+	switch keyword {
+	case "Feature":
+		r.endScenario()
+		r.add(&Token{Kind: gauge.SpecKind, LineNo: lineNo, Lines: []string{line}, Value: value, SpanEnd: lineNo})
+		if tags != nil {
+			r.add(tags)
+		}
+	case "Rule":
+		r.endScenario()
+		r.ruleTags = ""
+		if tags != nil {
+			r.ruleTags = tags.Value
+		}
+		r.add(&Token{Kind: gauge.CommentKind, LineNo: lineNo, Lines: []string{line}, Value: strings.TrimSpace(line), SpanEnd: lineNo})
+	case "Background":
+		r.background = true
+		r.add(&Token{Kind: gauge.CommentKind, LineNo: lineNo, Lines: []string{line}, Value: strings.TrimSpace(line), SpanEnd: lineNo})
+	case "Examples", "Scenarios":
+		if r.scenario == nil {
+			r.error(lineNo, line, "Examples should be defined in a scenario outline")
+			return
+		}
+		if !env.AllowScenarioDatatable() {
+			r.error(lineNo, line, "Examples are read as the data table of the scenario, set allow_scenario_datatable to true to use them")
+		}
+		if tags != nil {
+			r.scenario.tags = mergeTags(r.scenario.tags, tags)
+		}
+		r.table, r.tableRows = gherkinExamplesTable, 0
+	default:
+		r.endScenario()
+		r.scenario = &gherkinScenario{heading: &Token{Kind: gauge.ScenarioKind, LineNo: lineNo, Lines: []string{line}, Value: value, SpanEnd: lineNo}}
+		if r.ruleTags != "" {
+			r.scenario.tags = &Token{Kind: gauge.TagKind, LineNo: lineNo, Value: r.ruleTags, SpanEnd: lineNo}
+		}
+		if tags != nil {
+			r.scenario.tags = mergeTags(r.scenario.tags, tags)
+		}
+	}
+}
+
+func (r *gherkinReader) readTags(trimmedLine, line string, lineNo int) {
+	var tags []string
+	for _, t := range strings.Fields(trimmedLine) {
+		if strings.HasPrefix(t, "#") {
+			break
+		}
+		if t = strings.TrimPrefix(t, "@"); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	r.tags = mergeTags(r.tags, &Token{Kind: gauge.TagKind, LineNo: lineNo, Lines: []string{line}, Value: strings.Join(tags, ", "), SpanEnd: lineNo})
+}
+
+func (r *gherkinReader) readTableRow(trimmedLine, line string, lineNo int) {
+	token := &Token{Kind: gauge.TableRow, LineNo: lineNo, Lines: []string{line}, Value: trimmedLine, SpanEnd: lineNo}
+	switch r.table {
+	case gherkinStepTable:
+		if r.tableRows == 0 {
+			token.Kind = gauge.TableHeader
+		}
+		r.add(token)
+	case gherkinExamplesTable:
+		if r.tableRows == 0 {
+			headers := &Token{Kind: gauge.TableHeader, Value: trimmedLine}
+			processTable(new(SpecParser), headers)
+			if r.scenario.headers == nil {
+				r.scenario.headers = headers.Args
+				token.Kind = gauge.TableHeader
+				r.scenario.examples = append(r.scenario.examples, token)
+			} else if strings.Join(headers.Args, "|") != strings.Join(r.scenario.headers, "|") {
+				r.error(lineNo, line, "Examples of a scenario outline should all have the same headers")
+			}
+		} else {
+			r.scenario.examples = append(r.scenario.examples, token)
+		}
+	default:
+		token.Kind = gauge.CommentKind
+		r.add(token)
+	}
+	r.tableRows++
+}
+
+// add gives the token to the scenario being read, or to the spec before the first scenario
+func (r *gherkinReader) add(token *Token) {
+	if r.scenario != nil {
+		r.scenario.body = append(r.scenario.body, token)
+	} else {
+		r.tokens = append(r.tokens, token)
+	}
+}
+
+func (r *gherkinReader) endScenario() {
+	if r.scenario != nil {
+		r.tokens = append(r.tokens, r.scenario.tokens()...)
+		r.scenario = nil
+	}
+}
+
+func (r *gherkinReader) takeTags() *Token {
+	tags := r.tags
+	r.tags = nil
+	return tags
+}
+
+func (r *gherkinReader) error(lineNo int, line, message string) {
+	r.errs = append(r.errs, ParseError{FileName: r.fileName, LineNo: lineNo, SpanEnd: lineNo, Message: message, LineText: strings.TrimSpace(line)})
+}
+
+// mergeTags adds the tags of b to the tag token a, which is created if there is none yet
+func mergeTags(a, b *Token) *Token {
+	if a == nil {
+		a = &Token{Kind: gauge.TagKind, LineNo: b.LineNo, SpanEnd: b.SpanEnd}
+	}
+	if a.Value != "" && b.Value != "" {
+		a.Value += ", "
+	}
+	a.Value += b.Value
+	a.Lines = append(a.Lines, b.Lines...)
+	return a
+}
+
+// gherkinHeading gives the keyword of a line like "Scenario: name" and the text after it
+func gherkinHeading(line string) (string, string, bool) {
+	for _, keyword := range gherkinHeadingKeywords {
+		if strings.HasPrefix(line, keyword+":") {
+			return keyword, strings.TrimSpace(line[len(keyword)+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+// gherkinStep gives the text of a step line like "Given some text", without its keyword
+func gherkinStep(line string) (string, bool) {
+	for _, keyword := range gherkinStepKeywords {
+		if strings.HasPrefix(line, keyword+" ") {
+			return strings.TrimSpace(line[len(keyword):]), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,156 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestParseFeatureFile(c *C) {
+	text := `@smoke
+Feature: Login
+  Users sign in with their account
+
+  Background:
+    Given the application is open
+
+  @fast @priority:1
+  Scenario: Sign in
+    Given a user "jane"
+    When she signs in with
+      | user | password |
+      | jane | secret   |
+    Then the dashboard is shown
+    But no error is shown
+`
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "login.feature")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(spec.Heading.Value, Equals, "Login")
+	c.Assert(spec.Tags.Values(), DeepEquals, []string{"smoke"})
+	c.Assert(len(spec.Contexts), Equals, 1)
+	c.Assert(spec.Contexts[0].Value, Equals, "the application is open")
+	c.Assert(len(spec.Scenarios), Equals, 1)
+
+	scn := spec.Scenarios[0]
+	c.Assert(scn.Heading.Value, Equals, "Sign in")
+	c.Assert(scn.Heading.LineNo, Equals, 9)
+	c.Assert(scn.Tags.Values(), DeepEquals, []string{"fast", "priority:1"})
+	c.Assert(*scn.Priority, Equals, gauge.Priority(1))
+	c.Assert(len(scn.Steps), Equals, 4)
+	c.Assert(scn.Steps[0].Value, Equals, "a user {}")
+	c.Assert(scn.Steps[0].Args[0].Value, Equals, "jane")
+	c.Assert(scn.Steps[1].Value, Equals, "she signs in with {}")
+	c.Assert(scn.Steps[1].HasInlineTable, Equals, true)
+	c.Assert(scn.Steps[1].Args[0].Table.Headers, DeepEquals, []string{"user", "password"})
+	c.Assert(scn.Steps[1].Args[0].Table.GetRowCount(), Equals, 1)
+	c.Assert(scn.Steps[3].Value, Equals, "no error is shown")
+}
+
+func (s *MySuite) TestParseScenarioOutlineWithExamples(c *C) {
+	old := env.AllowScenarioDatatable
+	env.AllowScenarioDatatable = func() bool { return true }
+	defer func() { env.AllowScenarioDatatable = old }()
+	text := `Feature: Cukes
+  Scenario Outline: Eating
+    Given there are <start> cucumbers
+    When I eat <eat> cucumbers
+
+    @fast
+    Examples:
+      | start | eat |
+      | 12    | 5   |
+
+    Examples: More
+      | start | eat |
+      | 20    | 5   |
+`
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "cukes.feature")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	scn := spec.Scenarios[0]
+	c.Assert(scn.DataTable.Table.Headers, DeepEquals, []string{"start", "eat"})
+	c.Assert(scn.DataTable.Table.GetRowCount(), Equals, 2)
+	c.Assert(scn.Tags.Values(), DeepEquals, []string{"fast"})
+	c.Assert(scn.Steps[0].Value, Equals, "there are {} cucumbers")
+	c.Assert(scn.Steps[0].Args[0].ArgType, Equals, gauge.Dynamic)
+}
+
+func (s *MySuite) TestExamplesWithDifferentHeadersGiveParseError(c *C) {
+	old := env.AllowScenarioDatatable
+	env.AllowScenarioDatatable = func() bool { return true }
+	defer func() { env.AllowScenarioDatatable = old }()
+	text := "Feature: F\nScenario Outline: S\nGiven <a>\nExamples:\n| a |\n| 1 |\nExamples:\n| b |\n| 2 |\n"
+
+	_, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "f.feature")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Examples of a scenario outline should all have the same headers")
+	c.Assert(res.ParseErrors[0].LineNo, Equals, 8)
+}
+
+func (s *MySuite) TestExamplesNeedScenarioDataTables(c *C) {
+	old := env.AllowScenarioDatatable
+	env.AllowScenarioDatatable = func() bool { return false }
+	defer func() { env.AllowScenarioDatatable = old }()
+
+	_, res, _ := new(SpecParser).Parse("Feature: F\nScenario Outline: S\nGiven <a>\nExamples:\n| a |\n| 1 |\n", gauge.NewConceptDictionary(), "f.feature")
+
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Examples are read as the data table of the scenario, set allow_scenario_datatable to true to use them")
+}
+
+func (s *MySuite) TestRuleTagsGoToItsScenarios(c *C) {
+	text := "Feature: F\n@billing\nRule: Pay\n@slow\nScenario: A\nGiven a\nScenario: B\nGiven b\nRule: Other\nScenario: C\nGiven c\n"
+
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "f.feature")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(scenarioHeadings(spec), DeepEquals, []string{"A", "B", "C"})
+	c.Assert(spec.Scenarios[0].Tags.Values(), DeepEquals, []string{"billing", "slow"})
+	c.Assert(spec.Scenarios[1].Tags.Values(), DeepEquals, []string{"billing"})
+	c.Assert(spec.Scenarios[2].Tags, IsNil)
+}
+
+func (s *MySuite) TestUnsupportedGherkinGivesParseErrors(c *C) {
+	text := "# language: fr\nFeature: F\nScenario: S\nGiven a text\n\"\"\"\nsome text\n\"\"\"\n"
+
+	_, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "f.feature")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(len(res.ParseErrors), Equals, 2)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Only the English Gherkin keywords are supported, found language 'fr'")
+	c.Assert(res.ParseErrors[1].Message, Equals, "Doc strings are read as the multiline text of the step, set allow_multiline_text to true to use them")
+	c.Assert(res.ParseErrors[1].LineNo, Equals, 5)
+}
+
+func (s *MySuite) TestIsFeatureFile(c *C) {
+	c.Assert(IsFeatureFile("specs/login.FEATURE"), Equals, true)
+	c.Assert(IsFeatureFile("specs/login.spec"), Equals, false)
+}
+
+func (s *MySuite) TestDocStringIsTheMultilineTextOfTheStep(c *C) {
+	old := env.AllowMultilineText
+	env.AllowMultilineText = func() bool { return true }
+	defer func() { env.AllowMultilineText = old }()
+	text := "Feature: F\nScenario: S\n  Given the payload\n    \"\"\"json\n    {\"id\": 1}\n    \"\"\"\n  Then it is saved\n"
+
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "f.feature")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(spec.Scenarios[0].Steps[0].Value, Equals, "the payload {}")
+	c.Assert(spec.Scenarios[0].Steps[0].Args[0], DeepEquals, &gauge.StepArg{Name: "text:json", Value: `{"id": 1}`, ArgType: gauge.MultilineText})
+	c.Assert(spec.Scenarios[0].Steps[1].Value, Equals, "it is saved")
+}
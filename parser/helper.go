@@ -44,16 +44,7 @@ func addStates(currentState *int, states ...int) {
 }
 
 func isUnderline(text string, underlineChar rune) bool {
-	// This is a workaround to support YAML data in markdown file
-	// YAML header in markdown files are used mostly for pandoc
-	// Note: This trig a bug, tables with three dash long column line
-	// 	representation are note correclty handled, example:
-	// 	| A | B |
-	// 	|---|---|
-	// 	| a | 1 |
-	// 	| b | 2 |
-	// 	| c | 3 |
-	if len(text) == 0 || rune(text[0]) != underlineChar || text == "---" {
+	if len(text) == 0 || rune(text[0]) != underlineChar {
 		return false
 	}
 	for _, value := range text {
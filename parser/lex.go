@@ -32,6 +32,13 @@ const (
 	keywordScope        = 1 << iota
 	tagsScope           = 1 << iota
 	newLineScope        = 1 << iota
+	// scenarioTearDownScope is the state after the teardown: line of a scenario
+	scenarioTearDownScope = 1 << iota
+)
+
+const (
+	frontMatterDelimiter = "---"
+	frontMatterEnding    = "..."
 )
 
 // Token defines the type of entity identified by the lexer
@@ -59,16 +66,28 @@ func (parser *SpecParser) initialize() {
 	parser.processors[gauge.TableRow] = processTable
 	parser.processors[gauge.DataTableKind] = processDataTable
 	parser.processors[gauge.TearDownKind] = processTearDown
+	parser.processors[gauge.MultilineTextKind] = processMultilineText
+	parser.processors[gauge.ScenarioTearDownKind] = processScenarioTearDown
 }
 
-// GenerateTokens gets tokens based on the parsed line.
+// Tokenize gives the tokens of the text of a spec or concept file, as the parsers see them before building the
+// model. Tools building on the syntax of specs, like highlighters, use it rather than the parsed specification.
+func Tokenize(text, fileName string) ([]*Token, []ParseError) {
+	return new(SpecParser).GenerateTokens(text, fileName)
+}
+
+// GenerateTokens gets tokens based on the parsed line. The lines of a feature file are read as Gherkin.
 func (parser *SpecParser) GenerateTokens(specText, fileName string) ([]*Token, []ParseError) {
+	if IsFeatureFile(fileName) {
+		return parser.generateGherkinTokens(specText, fileName)
+	}
 	parser.initialize()
 	parser.scanner = bufio.NewScanner(strings.NewReader(specText))
 	parser.currentState = initial
 	var errors []ParseError
 	var newToken *Token
 	var lastTokenErrorCount int
+	frontMatterEnd := frontMatterEnd(specText)
 	for line, hasLine, err := parser.nextLine(); hasLine; line, hasLine, err = parser.nextLine() {
 		if err != nil {
 			errors = append(errors, ParseError{Message: err.Error()})
@@ -78,10 +97,12 @@ func (parser *SpecParser) GenerateTokens(specText, fileName string) ([]*Token, [
 		if len(trimmedLine) == 0 {
 			addStates(&parser.currentState, newLineScope)
 			if newToken != nil && newToken.Kind == gauge.StepKind {
-				newToken.Suffix = "\n"
+				newToken.Suffix += "\n"
 				continue
 			}
 			newToken = &Token{Kind: gauge.CommentKind, LineNo: parser.lineNo, Lines: []string{line}, Value: "\n", SpanEnd: parser.lineNo}
+		} else if parser.lineNo <= frontMatterEnd {
+			newToken = &Token{Kind: gauge.CommentKind, LineNo: parser.lineNo, Lines: []string{line}, Value: common.TrimTrailingSpace(line), SpanEnd: parser.lineNo}
 		} else if parser.isScenarioHeading(trimmedLine) {
 			newToken = &Token{Kind: gauge.ScenarioKind, LineNo: parser.lineNo, Lines: []string{line}, Value: strings.TrimSpace(trimmedLine[2:]), SpanEnd: parser.lineNo}
 		} else if parser.isSpecHeading(trimmedLine) {
@@ -123,6 +144,10 @@ func (parser *SpecParser) GenerateTokens(specText, fileName string) ([]*Token, [
 			newToken = &Token{Kind: gauge.DataTableKind, LineNo: parser.lineNo, Lines: []string{line}, Value: value, SpanEnd: parser.lineNo}
 		} else if parser.isTearDown(trimmedLine) {
 			newToken = &Token{Kind: gauge.TearDownKind, LineNo: parser.lineNo, Lines: []string{line}, Value: trimmedLine, SpanEnd: parser.lineNo}
+		} else if parser.isScenarioTearDown(trimmedLine) {
+			newToken = &Token{Kind: gauge.ScenarioTearDownKind, LineNo: parser.lineNo, Lines: []string{line}, Value: trimmedLine, SpanEnd: parser.lineNo}
+		} else if fence := multilineTextFence(trimmedLine); fence != "" && env.AllowMultilineText() && newToken != nil && newToken.Kind == gauge.StepKind {
+			newToken = parser.multilineTextToken(line, fence)
 		} else if env.AllowMultiLineStep() && newToken != nil && newToken.Kind == gauge.StepKind && !isInState(parser.currentState, newLineScope) {
 			v := strings.TrimSpace(fmt.Sprintf("%s %s", newToken.LineText(), line))
 			newToken = parser.tokens[len(parser.tokens)-1]
@@ -141,6 +166,60 @@ func (parser *SpecParser) GenerateTokens(specText, fileName string) ([]*Token, [
 	return parser.tokens, errors
 }
 
+// frontMatterEnd gives the line closing the YAML front matter the text starts with, or 0 when it has none. A front
+// matter opens with "---" on the first line and closes with the next "---" or "..." line. Without a closing line the
+// first line is read as any other, so a setext underline or a table separator of three dashes is not taken for one.
+func frontMatterEnd(text string) int {
+	if !env.AllowFrontMatter() {
+		return 0
+	}
+	lines := strings.Split(text, "\n")
+	if strings.TrimSpace(lines[0]) != frontMatterDelimiter {
+		return 0
+	}
+	for i := 1; i < len(lines); i++ {
+		if l := strings.TrimSpace(lines[i]); l == frontMatterDelimiter || l == frontMatterEnding {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// multilineTextToken reads the lines of the multiline text opened by the fence up to the closing fence. The text runs
+// to the end of the file when the fence is not closed.
+func (parser *SpecParser) multilineTextToken(line, fence string) *Token {
+	token := &Token{Kind: gauge.MultilineTextKind, LineNo: parser.lineNo, Lines: []string{line}, SpanEnd: parser.lineNo}
+	for l, hasLine, _ := parser.nextLine(); hasLine; l, hasLine, _ = parser.nextLine() {
+		token.Lines = append(token.Lines, l)
+		token.SpanEnd = parser.lineNo
+		if isClosingFence(l, fence) {
+			break
+		}
+	}
+	return token
+}
+
+// multilineTextFence gives the fence a line opens a multiline text with: three or more backticks or tildes, as in
+// markdown, or the three double quotes of a Gherkin doc string
+func multilineTextFence(text string) string {
+	if strings.HasPrefix(text, `"""`) {
+		return `"""`
+	}
+	for _, c := range []string{"`", "~"} {
+		if strings.HasPrefix(text, c+c+c) {
+			return text[:len(text)-len(strings.TrimLeft(text, c))]
+		}
+	}
+	return ""
+}
+
+// isClosingFence tells if the line closes the multiline text opened by the fence, being a line of at least as many
+// of the characters of the fence
+func isClosingFence(line, fence string) bool {
+	text := strings.TrimSpace(line)
+	return len(text) >= len(fence) && strings.Trim(text, fence[:1]) == ""
+}
+
 func (parser *SpecParser) tokenKindBasedOnCurrentState(state int, matchingToken gauge.TokenKind, alternateToken gauge.TokenKind) gauge.TokenKind {
 	if isInState(parser.currentState, state) {
 		return matchingToken
@@ -203,6 +282,10 @@ func (parser *SpecParser) isTearDown(text string) bool {
 	return isUnderline(text, rune('_'))
 }
 
+func (parser *SpecParser) isScenarioTearDown(text string) bool {
+	return strings.EqualFold(text, gauge.ScenarioTearDownMarker)
+}
+
 func (parser *SpecParser) isSpecUnderline(text string) bool {
 	return isUnderline(text, rune('='))
 }
@@ -690,3 +690,136 @@ func (s *MySuite) TestParsingSpecWithTearDownSteps(c *C) {
 	c.Assert(tokens[6].Kind, Equals, gauge.StepKind)
 	c.Assert(tokens[6].Value, Equals, "step2")
 }
+
+func (s *MySuite) TestTokenizeGivesTheTokensOfTheText(c *C) {
+	tokens, errs := Tokenize("# Spec\n## Scenario\n* Say <hello>", "foo.spec")
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(len(tokens), Equals, 3)
+	c.Assert(tokens[0].Kind.String(), Equals, "spec")
+	c.Assert(tokens[1].Kind.String(), Equals, "scenario")
+	c.Assert(tokens[2].Kind.String(), Equals, "step")
+	c.Assert(tokens[2].Value, Equals, "Say {dynamic}")
+	c.Assert(tokens[2].Args, DeepEquals, []string{"hello"})
+}
+
+func (s *MySuite) TestFrontMatterAtTheStartIsReadAsComments(c *C) {
+	specText := "---\ntitle: Checkout\nauthor: team\n---\n# Spec\n## Scenario\n* step"
+
+	tokens, errs := new(SpecParser).GenerateTokens(specText, "")
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(len(tokens), Equals, 7)
+	for _, t := range tokens[:4] {
+		c.Assert(t.Kind, Equals, gauge.CommentKind)
+	}
+	c.Assert(tokens[3].Value, Equals, "---")
+	c.Assert(tokens[4].Kind, Equals, gauge.SpecKind)
+	c.Assert(tokens[5].Kind, Equals, gauge.ScenarioKind)
+}
+
+func (s *MySuite) TestFrontMatterCanCloseWithDots(c *C) {
+	tokens, errs := new(SpecParser).GenerateTokens("---\ntitle: Checkout\n...\n# Spec", "")
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(tokens[2].Kind, Equals, gauge.CommentKind)
+	c.Assert(tokens[3].Kind, Equals, gauge.SpecKind)
+}
+
+func (s *MySuite) TestThreeDashesAreAScenarioUnderlineOutsideFrontMatter(c *C) {
+	specText := "# Spec\nScenario heading\n---\n* step"
+
+	tokens, errs := new(SpecParser).GenerateTokens(specText, "")
+
+	c.Assert(errs, HasLen, 0)
+	c.Assert(tokens[1].Kind, Equals, gauge.ScenarioKind)
+	c.Assert(tokens[1].Value, Equals, "Scenario heading")
+}
+
+func (s *MySuite) TestFrontMatterIsNotReadWhenDisabled(c *C) {
+	old := env.AllowFrontMatter
+	defer func() { env.AllowFrontMatter = old }()
+	env.AllowFrontMatter = func() bool { return false }
+
+	tokens, _ := new(SpecParser).GenerateTokens("---\nSpec heading\n---\n", "")
+
+	c.Assert(tokens[1].Kind, Equals, gauge.ScenarioKind)
+	c.Assert(tokens[1].Value, Equals, "Spec heading")
+}
+
+func (s *MySuite) TestParsingTableWithThreeDashSeparator(c *C) {
+	specText := "# Spec\n## Scenario\n* step\n\n   |A|B|\n   |---|---|\n   |a|1|\n   |b|2|\n"
+
+	spec, res, err := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), "")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	table := spec.Scenarios[0].Steps[0].Args[0].Table
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"a", "1"}, {"b", "2"}})
+}
+
+func (s *MySuite) TestParsingMultilineTextAfterStep(c *C) {
+	old := env.AllowMultilineText
+	env.AllowMultilineText = func() bool { return true }
+	defer func() { env.AllowMultilineText = old }()
+	specText := "* post the payload\n\n   ```json\n   {\n     \"id\": 1\n   }\n   ```\n* next step\n"
+
+	tokens, err := new(SpecParser).GenerateTokens(specText, "")
+
+	c.Assert(err, IsNil)
+	c.Assert(len(tokens), Equals, 3)
+	c.Assert(tokens[1].Kind, Equals, gauge.MultilineTextKind)
+	c.Assert(tokens[1].LineNo, Equals, 3)
+	c.Assert(tokens[1].SpanEnd, Equals, 7)
+	c.Assert(tokens[1].Value, Equals, "{\n  \"id\": 1\n}")
+	c.Assert(tokens[1].Args, DeepEquals, []string{"json"})
+	c.Assert(tokens[2].Kind, Equals, gauge.StepKind)
+}
+
+func (s *MySuite) TestParsingMultilineTextWithLongerFence(c *C) {
+	old := env.AllowMultilineText
+	env.AllowMultilineText = func() bool { return true }
+	defer func() { env.AllowMultilineText = old }()
+	specText := "* run\n````\n```\nnested\n```\n````\n"
+
+	tokens, err := new(SpecParser).GenerateTokens(specText, "")
+
+	c.Assert(err, IsNil)
+	c.Assert(len(tokens), Equals, 2)
+	c.Assert(tokens[1].Value, Equals, "```\nnested\n```")
+}
+
+func (s *MySuite) TestParsingUnclosedMultilineText(c *C) {
+	old := env.AllowMultilineText
+	env.AllowMultilineText = func() bool { return true }
+	defer func() { env.AllowMultilineText = old }()
+
+	tokens, err := new(SpecParser).GenerateTokens("* run\n```\nselect 1\n", "")
+
+	c.Assert(len(tokens), Equals, 2)
+	c.Assert(tokens[1].Value, Equals, "select 1")
+	c.Assert(len(err), Equals, 1)
+	c.Assert(err[0].Message, Equals, "Multiline text should end with ```")
+	c.Assert(err[0].LineNo, Equals, 2)
+}
+
+func (s *MySuite) TestFenceIsCommentWhenMultilineTextIsNotAllowed(c *C) {
+	old := env.AllowMultilineText
+	env.AllowMultilineText = func() bool { return false }
+	defer func() { env.AllowMultilineText = old }()
+
+	tokens, err := new(SpecParser).GenerateTokens("* run\n```\nselect 1\n```\n", "")
+
+	c.Assert(err, IsNil)
+	c.Assert(len(tokens), Equals, 4)
+	c.Assert(tokens[1].Kind, Equals, gauge.CommentKind)
+}
+
+func (s *MySuite) TestBlankLinesAfterStepAreKeptInItsSuffix(c *C) {
+	tokens, err := new(SpecParser).GenerateTokens("* first step\n\n\n\n* second step\n\n", "")
+
+	c.Assert(err, IsNil)
+	c.Assert(len(tokens), Equals, 2)
+	c.Assert(tokens[0].Suffix, Equals, "\n\n\n")
+	c.Assert(tokens[1].Suffix, Equals, "\n")
+}
@@ -0,0 +1,63 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+const defaultDateLayout = "2006-01-02"
+
+// paramTypeValidators check that a value is of the type, like "5" of int. A date type may give the layout of its
+// values after a colon, like date:02/01/2006, which defaults to 2006-01-02.
+var paramTypeValidators = map[string]func(value string, format string) error{
+	"string": func(value string, format string) error { return nil },
+	"int": func(value string, format string) error {
+		_, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		return err
+	},
+	"float": func(value string, format string) error {
+		_, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		return err
+	},
+	"bool": func(value string, format string) error {
+		_, err := strconv.ParseBool(strings.TrimSpace(value))
+		return err
+	},
+	"date": func(value string, format string) error {
+		if format == "" {
+			format = defaultDateLayout
+		}
+		_, err := time.Parse(format, strings.TrimSpace(value))
+		return err
+	},
+}
+
+// validateParamType checks that the type declared for the arg is supported and that the value of a static arg is of
+// the type. The values of other args are known only at execution, where runners coerce them.
+func validateParamType(arg *gauge.StepArg) error {
+	name, format := arg.ParamType, ""
+	if i := strings.Index(arg.ParamType, ":"); i != -1 {
+		name, format = arg.ParamType[:i], arg.ParamType[i+1:]
+	}
+	validate, ok := paramTypeValidators[name]
+	if !ok || (format != "" && name != "date") {
+		return fmt.Errorf("Parameter type '%s' is not supported, it should be one of string, int, float, bool or date:<layout>", arg.ParamType)
+	}
+	if arg.ArgType != gauge.Static {
+		return nil
+	}
+	if validate(arg.Value, format) != nil {
+		return fmt.Errorf("Static parameter \"%s\" is not a valid %s", arg.Value, arg.ParamType)
+	}
+	return nil
+}
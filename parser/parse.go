@@ -26,6 +26,8 @@
 package parser
 
 import (
+	"fmt"
+	"runtime"
 	"strings"
 	"sync"
 
@@ -33,6 +35,7 @@ import (
 	"strconv"
 
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/filter"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
@@ -40,11 +43,8 @@ import (
 	"github.com/getgauge/gauge/util"
 )
 
-// ParseSpecFiles gets all the spec files and parse each spec file.
-// Generates specifications and parse results.
-// TODO: Use single channel instead of one for spec and another for result, so that mapping is consistent
-
 type parseInfo struct {
+	index       int
 	parseResult *ParseResult
 	spec        *gauge.Specification
 }
@@ -56,8 +56,10 @@ func newParseInfo(spec *gauge.Specification, pr *ParseResult) *parseInfo {
 func parse(wg *sync.WaitGroup, sfc *specFileCollection, cpt *gauge.ConceptDictionary, piChan chan *parseInfo) {
 	defer wg.Done()
 	for {
-		if s, err := sfc.Next(); err == nil {
-			piChan <- newParseInfo(parseSpec(s, cpt))
+		if i, s, err := sfc.next(); err == nil {
+			pi := newParseInfo(parseSpec(s, cpt))
+			pi.index = i
+			piChan <- pi
 		} else {
 			return
 		}
@@ -74,20 +76,19 @@ func parseSpecFiles(sfc *specFileCollection, conceptDictionary *gauge.ConceptDic
 	close(piChan)
 }
 
+// ParseSpecFiles parses the spec files in parallel, see parseWorkers. It gives the specifications and parse results in
+// the order of the files.
 func ParseSpecFiles(specFiles []string, conceptDictionary *gauge.ConceptDictionary, buildErrors *gauge.BuildErrors) ([]*gauge.Specification, []*ParseResult) {
 	sfc := NewSpecFileCollection(specFiles)
 	piChan := make(chan *parseInfo)
-	limit := len(specFiles)
-	rLimit, e := util.RLimit()
-	if e == nil && rLimit < limit {
-		logger.Debugf(true, "No of specifcations %d is higher than Max no of open file descriptors %d.\n"+
-			"Starting %d routines for parallel parsing.", limit, rLimit, rLimit/2)
-		limit = rLimit / 2
+	go parseSpecFiles(sfc, conceptDictionary, piChan, parseWorkers(len(specFiles)))
+	infos := make([]*parseInfo, len(specFiles))
+	for r := range piChan {
+		infos[r.index] = r
 	}
-	go parseSpecFiles(sfc, conceptDictionary, piChan, limit)
 	var parseResults []*ParseResult
 	var specs []*gauge.Specification
-	for r := range piChan {
+	for _, r := range infos {
 		if r.spec != nil {
 			specs = append(specs, r.spec)
 			var parseErrs []error
@@ -103,9 +104,43 @@ func ParseSpecFiles(specFiles []string, conceptDictionary *gauge.ConceptDictiona
 	return specs, parseResults
 }
 
+// parseWorkers gives the number of the files to parse at a time, at most one for each CPU Go uses and half the
+// number of files which can be open
+func parseWorkers(files int) int {
+	limit := runtime.GOMAXPROCS(0)
+	if rLimit, e := util.RLimit(); e == nil && rLimit/2 < limit {
+		logger.Debugf(true, "Max no of open file descriptors is %d. Starting %d routines for parallel parsing.", rLimit, rLimit/2)
+		limit = rLimit / 2
+	}
+	if files < limit {
+		limit = files
+	}
+	if limit < 1 {
+		return 1
+	}
+	return limit
+}
+
+// inParallel calls parse for each of the files with its index, parsing parseWorkers of them at a time
+func inParallel(files []string, parse func(i int, file string)) {
+	fc := NewSpecFileCollection(files)
+	wg := &sync.WaitGroup{}
+	for w := 0; w < parseWorkers(len(files)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i, file, err := fc.next(); err == nil; i, file, err = fc.next() {
+				parse(i, file)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 // ParseSpecs parses specs in the give directory and gives specification and pass/fail status, used in validation.
 func ParseSpecs(specsToParse []string, conceptsDictionary *gauge.ConceptDictionary, buildErrors *gauge.BuildErrors) ([]*gauge.Specification, bool) {
 	specs, failed := parseSpecsInDirs(conceptsDictionary, specsToParse, buildErrors)
+	applyTagRulesFromRegistry(specs)
 	specsToExecute := order.Sort(filter.FilterSpecs(specs))
 	return specsToExecute, failed
 }
@@ -123,7 +158,7 @@ func ParseConcepts() (*gauge.ConceptDictionary, *ParseResult, error) {
 }
 
 func parseSpec(specFile string, conceptDictionary *gauge.ConceptDictionary) (*gauge.Specification, *ParseResult) {
-	specFileContent, err := common.ReadFileContents(specFile)
+	specFileContent, err := common.ReadFileContents(util.LongPath(specFile))
 	if err != nil {
 		return nil, &ParseResult{ParseErrors: []ParseError{ParseError{FileName: specFile, Message: err.Error()}}, Ok: false}
 	}
@@ -131,31 +166,66 @@ func parseSpec(specFile string, conceptDictionary *gauge.ConceptDictionary) (*ga
 	if err != nil {
 		logger.Fatalf(true, err.Error())
 	}
+	if strict() {
+		parseResult.promoteWarnings()
+	}
 	return spec, parseResult
 }
 
 type specFile struct {
 	filePath string
 	indices  []int
+	rows     map[int][]int
+	ids      map[string][]int
+}
+
+func (f *specFile) isIndexed() bool {
+	return len(f.indices) > 0 || len(f.ids) > 0
+}
+
+// resolveIDs adds the line numbers of the scenarios whose stable ID was given for the spec.
+// An ID of the spec itself selects all of its scenarios.
+func (f *specFile) resolveIDs(spec *gauge.Specification) {
+	for id, rows := range f.ids {
+		found := false
+		for _, scn := range spec.Scenarios {
+			if scn.ID == id || spec.ID == id {
+				found = true
+				f.indices = append(f.indices, scn.Span.Start)
+				f.rows[scn.Span.Start] = append(f.rows[scn.Span.Start], rows...)
+			}
+		}
+		if !found {
+			logger.Warningf(true, "No scenario with ID '%s' found in %s", id, f.filePath)
+		}
+	}
 }
 
 // parseSpecsInDirs parses all the specs in list of dirs given.
 // It also de-duplicates all specs passed through `specDirs` before parsing specs.
 func parseSpecsInDirs(conceptDictionary *gauge.ConceptDictionary, specDirs []string, buildErrors *gauge.BuildErrors) ([]*gauge.Specification, bool) {
 	passed := true
-	givenSpecs, specFiles := getAllSpecFiles(specDirs)
+	givenSpecs, specFiles, rowErrs := getAllSpecFiles(specDirs)
 	var specs []*gauge.Specification
 	var specParseResults []*ParseResult
 	allSpecs := make([]*gauge.Specification, len(specFiles))
 	logger.Debug(true, "Started specifications parsing.")
 	specs, specParseResults = ParseSpecFiles(givenSpecs, conceptDictionary, buildErrors)
+	if len(rowErrs) > 0 {
+		specParseResults = append(specParseResults, &ParseResult{ParseErrors: rowErrs, Ok: false})
+	}
+	if env.UniqueScenarioHeadings() {
+		specParseResults = append(specParseResults, duplicateScenarioHeadings(specs, buildErrors)...)
+	}
 	passed = !HandleParseResult(specParseResults...) && passed
 	logger.Debugf(true, "%d specifications parsing completed.", len(specFiles))
 	for _, spec := range specs {
 		i, _ := getIndexFor(specFiles, spec.FileName)
 		specFile := specFiles[i]
-		if len(specFile.indices) > 0 {
+		specFile.resolveIDs(spec)
+		if specFile.isIndexed() {
 			s, _ := spec.Filter(filter.NewScenarioFilterBasedOnSpan(specFile.indices))
+			setTableRowIndices(s, specFile.rows)
 			allSpecs[i] = s
 		} else {
 			allSpecs[i] = spec
@@ -164,9 +234,24 @@ func parseSpecsInDirs(conceptDictionary *gauge.ConceptDictionary, specDirs []str
 	return allSpecs, !passed
 }
 
-func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specFile) {
+func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specFile, errs []ParseError) {
 	for _, specSource := range specDirs {
-		if isIndexedSpec(specSource) {
+		specSource, row, err := getIndexedSpecRow(specSource)
+		if err != nil {
+			errs = append(errs, ParseError{FileName: specSource, Message: err.Error()})
+			continue
+		}
+		if specName, id := getSpecScenarioID(specSource); id != "" {
+			files := util.GetSpecFiles([]string{specName})
+			if len(files) < 1 {
+				continue
+			}
+			specificationFile, created := addSpecFile(&specFiles, files[0])
+			if created || specificationFile.isIndexed() {
+				specificationFile.ids[id] = append(specificationFile.ids[id], row)
+			}
+			givenSpecs = append(givenSpecs, files[0])
+		} else if isIndexedSpec(specSource) {
 			var specName string
 			specName, index := getIndexedSpecName(specSource)
 			files := util.GetSpecFiles([]string{specName})
@@ -174,8 +259,9 @@ func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specF
 				continue
 			}
 			specificationFile, created := addSpecFile(&specFiles, files[0])
-			if created || len(specificationFile.indices) > 0 {
+			if created || specificationFile.isIndexed() {
 				specificationFile.indices = append(specificationFile.indices, index)
+				specificationFile.rows[index] = append(specificationFile.rows[index], row)
 			}
 			givenSpecs = append(givenSpecs, files[0])
 		} else {
@@ -183,6 +269,7 @@ func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specF
 			for _, file := range files {
 				specificationFile, _ := addSpecFile(&specFiles, file)
 				specificationFile.indices = specificationFile.indices[0:0]
+				specificationFile.ids = make(map[string][]int)
 			}
 			givenSpecs = append(givenSpecs, files...)
 		}
@@ -193,7 +280,7 @@ func getAllSpecFiles(specDirs []string) (givenSpecs []string, specFiles []*specF
 func addSpecFile(specFiles *[]*specFile, file string) (*specFile, bool) {
 	i, exists := getIndexFor(*specFiles, file)
 	if !exists {
-		specificationFile := &specFile{filePath: file}
+		specificationFile := &specFile{filePath: file, rows: make(map[int][]int), ids: make(map[string][]int)}
 		*specFiles = append(*specFiles, specificationFile)
 		return specificationFile, true
 	}
@@ -210,7 +297,7 @@ func getIndexFor(files []*specFile, file string) (int, bool) {
 }
 
 func isIndexedSpec(specSource string) bool {
-	re := regexp.MustCompile(`(?i).(spec|md):[0-9]+$`)
+	re := regexp.MustCompile(`(?i)\.(spec|md):[0-9]+$`)
 	index := re.FindStringIndex(specSource)
 	if index != nil {
 		return index[0] != 0
@@ -218,6 +305,54 @@ func isIndexedSpec(specSource string) bool {
 	return false
 }
 
+// getSpecScenarioID splits a spec source of the form <spec>#<id> into the spec and the stable ID of the scenario to execute.
+// The ID is empty if the spec source does not have one.
+func getSpecScenarioID(specSource string) (string, string) {
+	re := regexp.MustCompile(`(?i)\.(spec|md)#([^:#]+)$`)
+	index := re.FindStringSubmatchIndex(specSource)
+	if index == nil || index[0] == 0 {
+		return specSource, ""
+	}
+	return specSource[:index[4]-1], specSource[index[4]:]
+}
+
+// getIndexedSpecRow splits a spec source of the form <spec>:<line>:<row> or <spec>#<id>:<row> into <spec>:<line> or <spec>#<id>
+// and the 0-based index of the data table row to execute. The row index is -1 if no row is given. Rows are numbered
+// from 1, so row 0 is an error.
+func getIndexedSpecRow(specSource string) (string, int, error) {
+	re := regexp.MustCompile(`(?i)\.(spec|md)(:[0-9]+|#[^:#]+):[0-9]+$`)
+	if re.FindStringIndex(specSource) == nil {
+		return specSource, -1, nil
+	}
+	index := getIndex(specSource)
+	row, _ := strconv.Atoi(specSource[index+1:])
+	if row < 1 {
+		return specSource[:index], -1, fmt.Errorf("Invalid data table row %s in %s. Rows are numbered from 1", specSource[index+1:], specSource)
+	}
+	return specSource[:index], row - 1, nil
+}
+
+// setTableRowIndices restricts the scenarios to the data table rows requested for the line numbers in their span.
+// A scenario runs for all rows if any of its line numbers was given without a row.
+func setTableRowIndices(spec *gauge.Specification, rows map[int][]int) {
+	for _, scn := range spec.Scenarios {
+		var indices []int
+		for lineNo, rowIndices := range rows {
+			if scn.InSpan(lineNo) {
+				indices = append(indices, rowIndices...)
+			}
+		}
+		scn.TableRowIndices = nil
+		for _, i := range indices {
+			if i < 0 {
+				scn.TableRowIndices = nil
+				break
+			}
+			scn.TableRowIndices = append(scn.TableRowIndices, i)
+		}
+	}
+}
+
 func getIndexedSpecName(indexedSpec string) (string, int) {
 	index := getIndex(indexedSpec)
 	specName := indexedSpec[:index]
@@ -272,6 +407,16 @@ func getParameterizeStepValue(stepValue string, params []string) string {
 	return stepValue
 }
 
+// GithubAnnotations makes parse errors also print as GitHub Actions error annotations
+var GithubAnnotations bool
+
+// StrictMode makes the warnings of parsing specs and concepts errors, as does the strict_parsing property
+var StrictMode bool
+
+func strict() bool {
+	return StrictMode || env.StrictParsing()
+}
+
 // HandleParseResult collates list of parse result and determines if gauge has to break flow.
 func HandleParseResult(results ...*ParseResult) bool {
 	var failed = false
@@ -280,6 +425,11 @@ func HandleParseResult(results ...*ParseResult) bool {
 			for _, err := range result.Errors() {
 				logger.Errorf(true, err)
 			}
+			if GithubAnnotations {
+				for _, err := range result.ParseErrors {
+					fmt.Println(util.GithubErrorCommand(err.FileName, err.LineNo, err.Message))
+				}
+			}
 			failed = true
 		}
 		if result.Warnings != nil {
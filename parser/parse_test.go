@@ -7,10 +7,14 @@
 package parser
 
 import (
+	"fmt"
+	"io/ioutil"
 	"path/filepath"
+	"runtime"
 
 	"strings"
 
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	. "gopkg.in/check.v1"
 )
@@ -143,7 +147,7 @@ func (s *MySuite) TestSpecsFromArgsMaintainsOrderOfSpecsPassed(c *C) {
 func (s *MySuite) TestGetAllSpecsMaintainsOrderOfSpecs(c *C) {
 	sample2Spec := filepath.Join("testdata", "sample2.spec")
 	sampleSpec := filepath.Join("testdata", "sample.spec")
-	givenSpecs, indexedSpecs := getAllSpecFiles([]string{sample2Spec, sampleSpec})
+	givenSpecs, indexedSpecs, _ := getAllSpecFiles([]string{sample2Spec, sampleSpec})
 
 	c.Assert(len(givenSpecs), Equals, 2)
 	c.Assert(len(indexedSpecs), Equals, 2)
@@ -168,7 +172,7 @@ func (s *MySuite) TestGetAllSpecsMaintainsOrderOfSpecs(c *C) {
 
 func (s *MySuite) TestGetAllSpecsAddIndicesForIndexedSpecs(c *C) {
 	file := filepath.Join("testdata", "sample.spec")
-	_, indexedSpecs := getAllSpecFiles([]string{file + ":1", file + ":5"})
+	_, indexedSpecs, _ := getAllSpecFiles([]string{file + ":1", file + ":5"})
 
 	c.Assert(len(indexedSpecs), Equals, 1)
 
@@ -184,7 +188,7 @@ func (s *MySuite) TestGetAllSpecsShouldDeDuplicateSpecs(c *C) {
 	sampleSpec := filepath.Join("testdata", "sample.spec")
 	sample2Spec := filepath.Join("testdata", "sample2.spec")
 
-	_, indexedSpecs := getAllSpecFiles([]string{sampleSpec, sample2Spec, sampleSpec, sample2Spec + ":2"})
+	_, indexedSpecs, _ := getAllSpecFiles([]string{sampleSpec, sample2Spec, sampleSpec, sample2Spec + ":2"})
 
 	c.Assert(len(indexedSpecs), Equals, 2)
 
@@ -201,7 +205,7 @@ func (s *MySuite) TestGetAllSpecsShouldDeDuplicateIndexedSpecs(c *C) {
 	sampleSpec := filepath.Join("testdata", "sample.spec")
 	sample2Spec := filepath.Join("testdata", "sample2.spec")
 
-	_, indexedSpecs := getAllSpecFiles([]string{sampleSpec + ":2", sample2Spec, sampleSpec})
+	_, indexedSpecs, _ := getAllSpecFiles([]string{sampleSpec + ":2", sample2Spec, sampleSpec})
 
 	c.Assert(len(indexedSpecs), Equals, 2)
 
@@ -272,3 +276,168 @@ func specialTableArg(val string) *gauge.StepArg {
 func specialStringArg(val string) *gauge.StepArg {
 	return &gauge.StepArg{ArgType: gauge.SpecialString, Name: val}
 }
+
+func (s *MySuite) TestToObtainIndexedSpecRow(c *C) {
+	specSource, row, _ := getIndexedSpecRow("specs/hello_world.spec:67:3")
+	c.Assert(specSource, Equals, "specs/hello_world.spec:67")
+	c.Assert(row, Equals, 2)
+
+	specSource, row, _ = getIndexedSpecRow("specs/hello_world.spec:67")
+	c.Assert(specSource, Equals, "specs/hello_world.spec:67")
+	c.Assert(row, Equals, -1)
+}
+
+func (s *MySuite) TestIndexedSpecRowNeedsTheSpecExtension(c *C) {
+	specSource, row, err := getIndexedSpecRow("specs/helloXspec:67:3")
+	c.Assert(err, IsNil)
+	c.Assert(specSource, Equals, "specs/helloXspec:67:3")
+	c.Assert(row, Equals, -1)
+	c.Assert(isIndexedSpec("specs/helloXspec:67"), Equals, false)
+}
+
+func (s *MySuite) TestIndexedSpecRowZeroIsInvalid(c *C) {
+	_, _, err := getIndexedSpecRow("specs/hello_world.spec:67:0")
+	c.Assert(err, NotNil)
+
+	file := filepath.Join("testdata", "sample.spec")
+	_, indexedSpecs, errs := getAllSpecFiles([]string{file + ":3:0"})
+	c.Assert(len(indexedSpecs), Equals, 0)
+	c.Assert(len(errs), Equals, 1)
+	c.Assert(errs[0].FileName, Equals, file+":3")
+}
+
+func (s *MySuite) TestGetAllSpecsAddRowsForIndexedSpecsWithRows(c *C) {
+	file := filepath.Join("testdata", "sample.spec")
+	_, indexedSpecs, _ := getAllSpecFiles([]string{file + ":3:2", file + ":3:5", file + ":6"})
+
+	c.Assert(len(indexedSpecs), Equals, 1)
+	c.Assert(indexedSpecs[0].indices, DeepEquals, []int{3, 3, 6})
+	c.Assert(indexedSpecs[0].rows[3], DeepEquals, []int{1, 4})
+	c.Assert(indexedSpecs[0].rows[6], DeepEquals, []int{-1})
+}
+
+func (s *MySuite) TestSetTableRowIndicesForScenariosInSpan(c *C) {
+	scn1 := &gauge.Scenario{Span: &gauge.Span{Start: 3, End: 5}}
+	scn2 := &gauge.Scenario{Span: &gauge.Span{Start: 6, End: 7}}
+	spec := &gauge.Specification{Scenarios: []*gauge.Scenario{scn1, scn2}}
+
+	setTableRowIndices(spec, map[int][]int{3: {1, 4}, 6: {-1}})
+
+	c.Assert(scn1.TableRowIndices, DeepEquals, []int{1, 4})
+	c.Assert(len(scn2.TableRowIndices), Equals, 0)
+}
+
+func (s *MySuite) TestToObtainSpecScenarioID(c *C) {
+	specName, id := getSpecScenarioID("specs/hello_world.spec#CHK-102")
+	c.Assert(specName, Equals, "specs/hello_world.spec")
+	c.Assert(id, Equals, "CHK-102")
+
+	specSource, row, _ := getIndexedSpecRow("specs/hello_world.spec#CHK-102:3")
+	c.Assert(specSource, Equals, "specs/hello_world.spec#CHK-102")
+	c.Assert(row, Equals, 2)
+
+	_, id = getSpecScenarioID("specs/hello_world.spec:67")
+	c.Assert(id, Equals, "")
+}
+
+func (s *MySuite) TestResolveIDsAddsLineNumbersOfScenariosWithTheID(c *C) {
+	file := filepath.Join("testdata", "sample.spec")
+	_, indexedSpecs, _ := getAllSpecFiles([]string{file + "#CHK-102:2"})
+	scn1 := &gauge.Scenario{Span: &gauge.Span{Start: 3, End: 4}}
+	scn2 := &gauge.Scenario{Span: &gauge.Span{Start: 6, End: 7}, ID: "CHK-102"}
+
+	indexedSpecs[0].resolveIDs(&gauge.Specification{Scenarios: []*gauge.Scenario{scn1, scn2}})
+
+	c.Assert(indexedSpecs[0].isIndexed(), Equals, true)
+	c.Assert(indexedSpecs[0].indices, DeepEquals, []int{6})
+	c.Assert(indexedSpecs[0].rows[6], DeepEquals, []int{1})
+}
+
+func (s *MySuite) TestDuplicateScenarioHeadingsAcrossSpecs(c *C) {
+	parse := func(text, file string) *gauge.Specification {
+		spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), file)
+		c.Assert(err, IsNil)
+		c.Assert(res.Ok, Equals, true)
+		return spec
+	}
+	b := parse("# Refunds\n## Pay by card\n* pay\n## Refund\n* refund\n", "b.spec")
+	a := parse("# Orders\n## Place order\n* order\n## pay by card\n* pay\n", "a.spec")
+	buildErrors := gauge.NewBuildErrors()
+
+	results := duplicateScenarioHeadings([]*gauge.Specification{b, a}, buildErrors)
+
+	c.Assert(results, HasLen, 2)
+	c.Assert(results[0].FileName, Equals, "a.spec")
+	c.Assert(results[0].Ok, Equals, false)
+	c.Assert(results[0].ParseErrors, DeepEquals, []ParseError{{FileName: "a.spec", LineNo: 4, SpanEnd: 4, Message: "Duplicate scenario definition 'pay by card' found in b.spec:2", LineText: "## pay by card"}})
+	c.Assert(results[1].ParseErrors, DeepEquals, []ParseError{{FileName: "b.spec", LineNo: 2, SpanEnd: 2, Message: "Duplicate scenario definition 'Pay by card' found in a.spec:4", LineText: "## Pay by card"}})
+	c.Assert(buildErrors.SpecErrs[a], HasLen, 1)
+	c.Assert(buildErrors.SpecErrs[b], HasLen, 1)
+}
+
+func (s *MySuite) TestNoDuplicateScenarioHeadingsAcrossSpecs(c *C) {
+	spec, _, _ := new(SpecParser).Parse("# Orders\n## Place order\n* order\n", gauge.NewConceptDictionary(), "a.spec")
+	other, _, _ := new(SpecParser).Parse("# Refunds\n## Refund\n* refund\n", gauge.NewConceptDictionary(), "b.spec")
+
+	c.Assert(duplicateScenarioHeadings([]*gauge.Specification{spec, other}, gauge.NewBuildErrors()), HasLen, 0)
+}
+
+func (s *MySuite) TestPromoteWarningsMakesThemParseErrors(c *C) {
+	res := &ParseResult{Ok: true, Warnings: []*Warning{{FileName: "foo.spec", LineNo: 3, LineSpanEnd: 3, Message: "Dynamic param <a> could not be resolved, Treating it as static param"}}}
+
+	res.promoteWarnings()
+
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.Warnings, HasLen, 0)
+	c.Assert(res.ParseErrors, DeepEquals, []ParseError{{FileName: "foo.spec", LineNo: 3, SpanEnd: 3, Message: "Dynamic param <a> could not be resolved, Treating it as static param"}})
+}
+
+func (s *MySuite) TestPromoteWarningsKeepsAResultWithoutWarningsOk(c *C) {
+	res := &ParseResult{Ok: true}
+
+	res.promoteWarnings()
+
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(res.ParseErrors, HasLen, 0)
+}
+
+func (s *MySuite) TestStrictWithStrictParsingProperty(c *C) {
+	old := env.StrictParsing
+	defer func() { env.StrictParsing = old }()
+	env.StrictParsing = func() bool { return true }
+
+	c.Assert(strict(), Equals, true)
+}
+
+func (s *MySuite) TestParseSpecFilesGivesTheSpecsInTheOrderOfTheFiles(c *C) {
+	dir := c.MkDir()
+	var files []string
+	for i := 0; i < 20; i++ {
+		file := filepath.Join(dir, fmt.Sprintf("spec%d.spec", i))
+		text := fmt.Sprintf("# Spec %d\n\n## Scenario\n* step\n", i)
+		if i == 7 {
+			text = "# Spec 7\n"
+		}
+		c.Assert(ioutil.WriteFile(file, []byte(text), 0644), IsNil)
+		files = append(files, file)
+	}
+	buildErrors := gauge.NewBuildErrors()
+
+	specs, results := ParseSpecFiles(files, gauge.NewConceptDictionary(), buildErrors)
+
+	c.Assert(specs, HasLen, 20)
+	c.Assert(results, HasLen, 20)
+	for i := range files {
+		c.Assert(specs[i].FileName, Equals, files[i])
+		c.Assert(results[i].FileName, Equals, files[i])
+		c.Assert(results[i].Ok, Equals, i != 7)
+	}
+	c.Assert(buildErrors.SpecErrs, HasLen, 1)
+	c.Assert(buildErrors.SpecErrs[specs[7]], HasLen, 1)
+}
+
+func (s *MySuite) TestParseWorkersAreAtMostOnePerCPU(c *C) {
+	c.Assert(parseWorkers(0), Equals, 1)
+	c.Assert(parseWorkers(1), Equals, 1)
+	c.Assert(parseWorkers(10000) <= runtime.GOMAXPROCS(0), Equals, true)
+}
@@ -0,0 +1,239 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/i18n"
+	"github.com/getgauge/gauge/util"
+)
+
+// Parser parses specs and concepts for tools which only need their model, like doc generators and linters. Unlike
+// a run, it does not filter, order or validate the specs against the step implementations.
+//
+//	p := parser.New(parser.Strict(), parser.WithConceptDirs("concepts"))
+//	res, err := p.Parse("specs")
+type Parser struct {
+	strict          bool
+	language        string
+	priorityPattern *regexp.Regexp
+	conceptDirs     []string
+}
+
+// Option configures a Parser
+type Option func(*Parser)
+
+// Strict reports the warnings of specs and concepts as errors
+func Strict() Option {
+	return func(p *Parser) {
+		p.strict = true
+	}
+}
+
+// WithLanguage gives the messages of the errors and warnings in the language, like fr or de, when they have a
+// translation
+func WithLanguage(lang string) Option {
+	return func(p *Parser) {
+		p.language = lang
+	}
+}
+
+// WithPriorityTagPattern orders the scenarios of a spec by the tags matching the pattern, whose first group is the
+// priority level, like ^P(\d+)$. Tags like Priority1 are the priority tags otherwise.
+func WithPriorityTagPattern(pattern *regexp.Regexp) Option {
+	return func(p *Parser) {
+		p.priorityPattern = pattern
+	}
+}
+
+// WithConceptDirs reads the concepts from the directories rather than from the project
+func WithConceptDirs(dirs ...string) Option {
+	return func(p *Parser) {
+		p.conceptDirs = append(p.conceptDirs, dirs...)
+	}
+}
+
+// New creates a parser with the options
+func New(opts ...Option) *Parser {
+	p := &Parser{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// SpecResult is the outcome of parsing a spec file
+type SpecResult struct {
+	FileName string
+	Spec     *gauge.Specification
+	Errors   []ParseError
+	Warnings []*Warning
+}
+
+// Ok tells if the spec parsed without errors
+func (r *SpecResult) Ok() bool {
+	return len(r.Errors) == 0
+}
+
+// ConceptResult is the outcome of parsing the concepts
+type ConceptResult struct {
+	Dictionary *gauge.ConceptDictionary
+	Errors     []ParseError
+}
+
+// Ok tells if the concepts parsed without errors
+func (r *ConceptResult) Ok() bool {
+	return len(r.Errors) == 0
+}
+
+// SpecsResult is the outcome of parsing specs with the concepts they use
+type SpecsResult struct {
+	Concepts *ConceptResult
+	Specs    []*SpecResult
+}
+
+// Ok tells if the specs and concepts parsed without errors
+func (r *SpecsResult) Ok() bool {
+	if !r.Concepts.Ok() {
+		return false
+	}
+	for _, s := range r.Specs {
+		if !s.Ok() {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse parses the spec files, and the ones in the directories, of the paths with the concepts. The files are parsed in
+// parallel, the specs being in the order of the files.
+func (p *Parser) Parse(paths ...string) (*SpecsResult, error) {
+	concepts, err := p.ParseConcepts()
+	if err != nil {
+		return nil, err
+	}
+	files, err := specFilesIn(paths)
+	if err != nil {
+		return nil, err
+	}
+	res := &SpecsResult{Concepts: concepts, Specs: make([]*SpecResult, len(files))}
+	errs := make([]error, len(files))
+	inParallel(files, func(i int, file string) {
+		res.Specs[i], errs[i] = p.parseSpecFile(file, concepts.Dictionary)
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (p *Parser) parseSpecFile(file string, concepts *gauge.ConceptDictionary) (*SpecResult, error) {
+	text, err := common.ReadFileContents(util.LongPath(file))
+	if err != nil {
+		return nil, err
+	}
+	return p.ParseSpecText(text, file, concepts)
+}
+
+// ParseConcepts parses the concepts of the concept directories, or of the project if there are none
+func (p *Parser) ParseConcepts() (*ConceptResult, error) {
+	var files []string
+	switch {
+	case len(p.conceptDirs) > 0:
+		for _, dir := range p.conceptDirs {
+			abs, err := filepath.Abs(dir)
+			if err != nil {
+				return nil, err
+			}
+			if !common.DirExists(abs) {
+				return nil, fmt.Errorf("Concepts directory %s does not exist", dir)
+			}
+			files = append(files, util.FindConceptFilesIn(abs)...)
+		}
+	case config.ProjectRoot != "":
+		files = util.GetConceptFiles()
+	}
+	dictionary, res, err := createConceptsDictionary(files)
+	if err != nil {
+		return nil, err
+	}
+	return &ConceptResult{Dictionary: dictionary, Errors: p.errors(res)}, nil
+}
+
+// ParseSpecText parses the text of a spec file, its steps are resolved against the concepts of the dictionary
+func (p *Parser) ParseSpecText(text, fileName string, concepts *gauge.ConceptDictionary) (*SpecResult, error) {
+	if concepts == nil {
+		concepts = gauge.NewConceptDictionary()
+	}
+	sp := &SpecParser{priorityPattern: p.priorityPattern}
+	spec, res, err := sp.Parse(text, concepts, fileName)
+	if err != nil {
+		return nil, err
+	}
+	r := &SpecResult{FileName: fileName, Spec: spec, Errors: p.errors(res)}
+	if !p.strict {
+		r.Warnings = p.warnings(res)
+	}
+	return r, nil
+}
+
+// errors gives the errors of the result in the language of the parser, with its warnings in strict mode
+func (p *Parser) errors(res *ParseResult) []ParseError {
+	var errs []ParseError
+	for _, e := range res.ParseErrors {
+		e.Message = p.translate(e.Message)
+		errs = append(errs, e)
+	}
+	if p.strict {
+		for _, w := range p.warnings(res) {
+			errs = append(errs, w.parseError())
+		}
+	}
+	return errs
+}
+
+func (p *Parser) warnings(res *ParseResult) []*Warning {
+	var warnings []*Warning
+	for _, w := range res.Warnings {
+		warnings = append(warnings, &Warning{FileName: w.FileName, LineNo: w.LineNo, LineSpanEnd: w.LineSpanEnd, Message: p.translate(w.Message)})
+	}
+	return warnings
+}
+
+func (p *Parser) translate(message string) string {
+	if p.language == "" {
+		return message
+	}
+	return i18n.TranslateTo(p.language, message)
+}
+
+func specFilesIn(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case common.DirExists(abs):
+			files = append(files, util.FindSpecFilesIn(abs)...)
+		case common.FileExists(abs):
+			files = append(files, abs)
+		default:
+			return nil, fmt.Errorf("Specs path %s does not exist", path)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,88 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"path/filepath"
+	"regexp"
+
+	. "gopkg.in/check.v1"
+)
+
+const specWithWarning = "# Spec\n|a|\n|-|\n|1|\n\n|b|\n|-|\n|2|\n## Scenario\n* step\n"
+
+func (s *MySuite) TestParserParsesSpecText(c *C) {
+	res, err := New().ParseSpecText(specWithWarning, "foo.spec", nil)
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok(), Equals, true)
+	c.Assert(res.Spec.Heading.Value, Equals, "Spec")
+	c.Assert(len(res.Warnings), Equals, 1)
+	c.Assert(res.Warnings[0].Message, Equals, "Multiple data table present, ignoring table")
+}
+
+func (s *MySuite) TestStrictParserReportsWarningsAsErrors(c *C) {
+	res, err := New(Strict()).ParseSpecText(specWithWarning, "foo.spec", nil)
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok(), Equals, false)
+	c.Assert(res.Warnings, IsNil)
+	c.Assert(len(res.Errors), Equals, 1)
+	c.Assert(res.Errors[0].Message, Equals, "Multiple data table present, ignoring table")
+	c.Assert(res.Errors[0].LineNo, Equals, 6)
+}
+
+func (s *MySuite) TestParserGivesMessagesInLanguage(c *C) {
+	res, err := New(WithLanguage("fr")).ParseSpecText("# Spec\n## Scenario\n", "foo.spec", nil)
+
+	c.Assert(err, IsNil)
+	c.Assert(len(res.Errors), Equals, 1)
+	c.Assert(res.Errors[0].Message, Equals, "Le scénario doit avoir au moins une étape")
+}
+
+func (s *MySuite) TestParserOrdersScenariosByPriorityTagPattern(c *C) {
+	text := "# Spec\n## First\nTags: P2\n* step\n## Second\n* step\n## Third\nTags: smoke, P1\n* step\n## Fourth\nTags: Priority0\n* step\n"
+
+	res, err := New(WithPriorityTagPattern(regexp.MustCompile(`^P(\d+)$`))).ParseSpecText(text, "foo.spec", nil)
+
+	c.Assert(err, IsNil)
+	var headings []string
+	for _, scn := range res.Spec.Scenarios {
+		headings = append(headings, scn.Heading.Value)
+	}
+	c.Assert(headings, DeepEquals, []string{"Third", "First", "Second", "Fourth"})
+}
+
+func (s *MySuite) TestParserReadsConceptsFromConceptDirs(c *C) {
+	p := New(WithConceptDirs(filepath.Join("testdata", "dir1")))
+
+	res, err := p.ParseConcepts()
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok(), Equals, true)
+	c.Assert(len(res.Dictionary.ConceptsMap) > 0, Equals, true)
+}
+
+func (s *MySuite) TestParserFailsForMissingConceptDir(c *C) {
+	_, err := New(WithConceptDirs(filepath.Join("testdata", "missing"))).ParseConcepts()
+
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestParserParsesSpecFilesOfPaths(c *C) {
+	res, err := New(WithConceptDirs("testdata")).Parse(filepath.Join("testdata", "sample.spec"))
+
+	c.Assert(err, IsNil)
+	c.Assert(len(res.Specs), Equals, 1)
+	c.Assert(res.Specs[0].Spec, NotNil)
+}
+
+func (s *MySuite) TestParserFailsForMissingSpecPath(c *C) {
+	_, err := New(WithConceptDirs("testdata")).Parse(filepath.Join("testdata", "missing.spec"))
+
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,113 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+)
+
+// PriorityOrder makes the scenarios run in the order of their priority, whatever gauge_scenario_priority_ordering is
+var PriorityOrder bool
+
+// PriorityOrderEnabled tells if the scenarios of specs are ordered by their priority, rather than kept in the order
+// they are written
+func PriorityOrderEnabled() bool {
+	return PriorityOrder || env.ScenarioPriorityOrdering()
+}
+
+const (
+	// NoPriority is the priority level of scenarios without priority tags, which run after the scenarios of a
+	// positive level and before those of a negative level
+	NoPriority = math.MinInt32
+	// LastPriority is the level of priority:last, the scenarios of which run after all others
+	LastPriority      = -1
+	lastPriorityLevel = "last"
+)
+
+var (
+	// priorityTagPattern matches the priority:N tags, whatever their value, so that malformed ones are reported
+	priorityTagPattern = regexp.MustCompile(`(?i)^priority\s*:\s*(.*)$`)
+	// legacyPriorityTagPattern matches the PriorityN tags, which set the priority before the priority:N tags
+	legacyPriorityTagPattern = regexp.MustCompile(`^Priority(\d+)$`)
+	priorityLevelPattern     = regexp.MustCompile(`^-?\d+$`)
+)
+
+// PriorityName gives the short name of a priority level, as P1, or last for the level of priority:last. Scenarios
+// without priority are unprioritized.
+func PriorityName(level int) string {
+	switch level {
+	case NoPriority:
+		return "unprioritized"
+	case LastPriority:
+		return lastPriorityLevel
+	}
+	return fmt.Sprintf("P%d", level)
+}
+
+// PriorityOfTag gives the priority level of a tag, either a priority:N tag or a PriorityN tag. It returns false for
+// tags which do not declare a valid priority.
+func PriorityOfTag(tag string) (int, bool) {
+	if m := legacyPriorityTagPattern.FindStringSubmatch(tag); m != nil {
+		p, err := strconv.Atoi(m[1])
+		return p, err == nil
+	}
+	p, isPriority, err := parsePriorityTag(tag)
+	return int(p), isPriority && err == nil
+}
+
+// parsePriorityTag reads a priority:N tag, N being a whole number or last. It tells if the tag is a priority tag, and
+// gives an error when its level is not valid.
+func parsePriorityTag(tag string) (gauge.Priority, bool, error) {
+	m := priorityTagPattern.FindStringSubmatch(strings.TrimSpace(tag))
+	if m == nil {
+		return 0, false, nil
+	}
+	if strings.EqualFold(m[1], lastPriorityLevel) {
+		return LastPriority, true, nil
+	}
+	if !priorityLevelPattern.MatchString(m[1]) {
+		return 0, true, fmt.Errorf("Invalid priority tag '%s', the priority should be a whole number like priority:1, or last", tag)
+	}
+	p, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, true, fmt.Errorf("Invalid priority tag '%s', %s", tag, err.Error())
+	}
+	return gauge.Priority(p), true, nil
+}
+
+// priorityTagErrors reads the priority:N tags of the token into the priority of the scenario. There are parse errors
+// for malformed levels and for a scenario declaring its priority more than once. The tags of the spec are only
+// checked for malformed levels.
+func priorityTagErrors(token *Token, scenario *gauge.Scenario, fileName string) []ParseError {
+	var errs []ParseError
+	for _, tag := range token.Args {
+		p, isPriority, err := parsePriorityTag(tag)
+		if !isPriority {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, ParseError{FileName: fileName, LineNo: token.LineNo, SpanEnd: token.LineNo, Message: err.Error(), LineText: token.LineText()})
+			continue
+		}
+		if scenario == nil {
+			continue
+		}
+		if scenario.Priority != nil {
+			errs = append(errs, ParseError{FileName: fileName, LineNo: token.LineNo, SpanEnd: token.LineNo, Message: fmt.Sprintf("Priority can be defined only once per scenario, found '%s'", tag), LineText: token.LineText()})
+			continue
+		}
+		scenario.Priority = &p
+	}
+	return errs
+}
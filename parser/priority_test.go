@@ -0,0 +1,186 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func scenarioHeadings(spec *gauge.Specification) []string {
+	var headings []string
+	for _, scn := range spec.Scenarios {
+		headings = append(headings, scn.Heading.Value)
+	}
+	return headings
+}
+
+func (s *MySuite) TestPriorityTagsSetThePriorityOfScenarios(c *C) {
+	text := "# Spec\n## First\nTags: priority:2\n* step\n## Second\n* step\n## Third\nTags: smoke, priority: 1\n* step\n"
+	PriorityOrder = true
+	defer func() { PriorityOrder = false }()
+
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(scenarioHeadings(spec), DeepEquals, []string{"Third", "First", "Second"})
+	c.Assert(*spec.Scenarios[0].Priority, Equals, gauge.Priority(1))
+	c.Assert(*spec.Scenarios[1].Priority, Equals, gauge.Priority(2))
+	c.Assert(spec.Scenarios[2].Priority, IsNil)
+}
+
+func (s *MySuite) TestLegacyPriorityTagsStillOrderScenarios(c *C) {
+	text := "# Spec\n## First\nTags: Priority3, Priority1\n* step\n## Second\nTags: Priority0\n* step\n"
+	PriorityOrder = true
+	defer func() { PriorityOrder = false }()
+
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(scenarioHeadings(spec), DeepEquals, []string{"Second", "First"})
+	c.Assert(*spec.Scenarios[1].Priority, Equals, gauge.Priority(1))
+}
+
+func (s *MySuite) TestScenariosKeepTheirOrderUnlessOrderingByPriority(c *C) {
+	text := "# Spec\n## First\nTags: priority:2\n* step\n## Second\nTags: priority:1\n* step\n"
+
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(scenarioHeadings(spec), DeepEquals, []string{"First", "Second"})
+	c.Assert(*spec.Scenarios[1].Priority, Equals, gauge.Priority(1))
+	c.Assert(spec.Scenarios[1].Span.End, Equals, 7)
+}
+
+func (s *MySuite) TestScenariosAreOrderedByPriorityWhenTheEnvEnablesIt(c *C) {
+	old := env.ScenarioPriorityOrdering
+	defer func() { env.ScenarioPriorityOrdering = old }()
+	env.ScenarioPriorityOrdering = func() bool { return true }
+	text := "# Spec\n## First\nTags: priority:2\n* step\n## Second\nTags: priority:1\n* step\n"
+
+	spec, _, _ := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(scenarioHeadings(spec), DeepEquals, []string{"Second", "First"})
+	c.Assert(spec.Scenarios[0].Span.End, Equals, 7)
+}
+
+func (s *MySuite) TestTagsOnlyContainingPriorityAreNotPriorityTags(c *C) {
+	text := "# Spec\n## First\nTags: PriorityReview, lowpriority:1\n* step\n"
+
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(spec.Scenarios[0].Priority, IsNil)
+	c.Assert(ScenarioPriority(spec.Scenarios[0]), Equals, NoPriority)
+}
+
+func (s *MySuite) TestMalformedPriorityTagsGiveParseErrors(c *C) {
+	for _, tag := range []string{"priority:abc", "priority:", "priority:first", "priority:1.5"} {
+		_, res, err := new(SpecParser).Parse("# Spec\n## First\nTags: "+tag+"\n* step\n", gauge.NewConceptDictionary(), "foo.spec")
+
+		c.Assert(err, IsNil)
+		c.Assert(res.Ok, Equals, false, Commentf(tag))
+		c.Assert(len(res.ParseErrors), Equals, 1, Commentf(tag))
+		c.Assert(res.ParseErrors[0].Message, Equals, "Invalid priority tag '"+tag+"', the priority should be a whole number like priority:1, or last")
+		c.Assert(res.ParseErrors[0].LineNo, Equals, 3)
+	}
+}
+
+func (s *MySuite) TestMalformedPriorityTagOfSpecGivesParseError(c *C) {
+	_, res, err := new(SpecParser).Parse("# Spec\nTags: priority:high\n## First\n* step\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Invalid priority tag 'priority:high', the priority should be a whole number like priority:1, or last")
+}
+
+func (s *MySuite) TestPriorityDefinedTwiceGivesParseError(c *C) {
+	_, res, err := new(SpecParser).Parse("# Spec\n## First\nTags: priority:1, priority:2\n* step\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Priority can be defined only once per scenario, found 'priority:2'")
+}
+
+func (s *MySuite) TestPriorityOfTag(c *C) {
+	p, ok := PriorityOfTag("Priority2")
+	c.Assert(ok, Equals, true)
+	c.Assert(p, Equals, 2)
+
+	p, ok = PriorityOfTag("PRIORITY : 4")
+	c.Assert(ok, Equals, true)
+	c.Assert(p, Equals, 4)
+
+	_, ok = PriorityOfTag("priority:x")
+	c.Assert(ok, Equals, false)
+	_, ok = PriorityOfTag("smoke")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *MySuite) TestScenariosInheritThePriorityOfTheirSpec(c *C) {
+	text := "# Spec\nTags: Priority1\n## First\n* step\n## Second\nTags: priority:0\n* step\n## Third\nTags: smoke\n* step\n## Fourth\nTags: Priority2\n* step\n"
+	PriorityOrder = true
+	defer func() { PriorityOrder = false }()
+
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(scenarioHeadings(spec), DeepEquals, []string{"Second", "First", "Third", "Fourth"})
+	c.Assert(ScenarioPriority(spec.Scenarios[1]), Equals, 1)
+	c.Assert(ScenarioPriority(spec.Scenarios[2]), Equals, 1)
+	c.Assert(ScenarioPriority(spec.Scenarios[3]), Equals, 2)
+}
+
+func (s *MySuite) TestScenariosWithoutTagsHaveNoPriority(c *C) {
+	spec, res, err := new(SpecParser).Parse("# Spec\nTags: smoke\n## First\n* step\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(spec.Scenarios[0].Priority, IsNil)
+	c.Assert(ScenarioPriority(&gauge.Scenario{}), Equals, NoPriority)
+	c.Assert(TagsPriority(nil), Equals, NoPriority)
+}
+
+func (s *MySuite) TestLastAndNegativePrioritiesRunAfterScenariosWithoutPriority(c *C) {
+	text := "# Spec\n## Cleanup\nTags: priority:last\n* step\n## Slow\nTags: priority:-2\n* step\n## Plain\n* step\n## First\nTags: priority:0\n* step\n"
+	PriorityOrder = true
+	defer func() { PriorityOrder = false }()
+
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(scenarioHeadings(spec), DeepEquals, []string{"First", "Plain", "Slow", "Cleanup"})
+	c.Assert(*spec.Scenarios[3].Priority, Equals, gauge.Priority(LastPriority))
+}
+
+func (s *MySuite) TestPriorityLess(c *C) {
+	c.Assert(PriorityLess(0, 1), Equals, true)
+	c.Assert(PriorityLess(5, NoPriority), Equals, true)
+	c.Assert(PriorityLess(NoPriority, -5), Equals, true)
+	c.Assert(PriorityLess(-2, LastPriority), Equals, true)
+	c.Assert(PriorityLess(LastPriority, 3), Equals, false)
+	c.Assert(PriorityLess(NoPriority, NoPriority), Equals, false)
+}
+
+func (s *MySuite) TestTagsPriorityReadsLastPriority(c *C) {
+	c.Assert(TagsPriority(&gauge.Tags{RawValues: [][]string{{"smoke", "priority:last"}}}), Equals, LastPriority)
+	c.Assert(TagsPriority(&gauge.Tags{RawValues: [][]string{{"priority:last", "Priority2"}}}), Equals, 2)
+}
+
+func (s *MySuite) TestPriorityName(c *C) {
+	c.Assert(PriorityName(0), Equals, "P0")
+	c.Assert(PriorityName(-3), Equals, "P-3")
+	c.Assert(PriorityName(LastPriority), Equals, "last")
+	c.Assert(PriorityName(NoPriority), Equals, "unprioritized")
+}
@@ -25,6 +25,32 @@ func processTearDown(parser *SpecParser, token *Token) ([]error, bool) {
 	return []error{}, false
 }
 
+func processScenarioTearDown(parser *SpecParser, token *Token) ([]error, bool) {
+	parser.clearState()
+	return []error{}, false
+}
+
+// processMultilineText gives the lines between the fences of the token as its value, unindented by the indentation
+// of the opening fence. The info string following the opening fence, like json, is the only arg of the token.
+func processMultilineText(parser *SpecParser, token *Token) ([]error, bool) {
+	opening := strings.TrimSpace(token.Lines[0])
+	fence := multilineTextFence(opening)
+	indent := token.Lines[0][:strings.Index(token.Lines[0], opening)]
+	lines := append([]string{}, token.Lines[1:]...)
+	var errs []error
+	if len(lines) > 0 && isClosingFence(lines[len(lines)-1], fence) {
+		lines = lines[:len(lines)-1]
+	} else {
+		errs = append(errs, fmt.Errorf("Multiline text should end with %s", fence))
+	}
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, indent)
+	}
+	token.Value = strings.Join(lines, "\n")
+	token.Args = []string{strings.TrimSpace(strings.TrimPrefix(opening, fence))}
+	return errs, false
+}
+
 func processDataTable(parser *SpecParser, token *Token) ([]error, bool) {
 	if len(strings.TrimSpace(strings.Replace(token.Value, "table:", "", 1))) == 0 {
 		return []error{fmt.Errorf("Table location not specified")}, true
@@ -71,6 +97,9 @@ func processTable(parser *SpecParser, token *Token) ([]error, bool) {
 			continue
 		}
 		if shouldEscape {
+			if element == 'n' {
+				element = '\n'
+			}
 			_, err := buffer.WriteRune(element)
 			if err != nil {
 				errs = append(errs, err)
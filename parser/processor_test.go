@@ -18,3 +18,11 @@ func (s *MySuite) TestProcessTable(c *C) {
 	c.Assert(len(errors), Equals, 0)
 	c.Assert(t.Args[0], Equals, "first second third")
 }
+
+func (s *MySuite) TestProcessTableWithEscapedCells(c *C) {
+	t := &Token{Kind: gauge.TableRow, Value: `|a\|b|first\nsecond|back\\slash|`}
+	errors, _ := processTable(new(SpecParser), t)
+
+	c.Assert(len(errors), Equals, 0)
+	c.Assert(t.Args, DeepEquals, []string{"a|b", "first\nsecond", `back\slash`})
+}
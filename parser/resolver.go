@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/gauge"
@@ -25,6 +26,42 @@ type specialTypeResolver struct {
 	predefinedResolvers map[string]resolverFn
 }
 
+// SpecialParamResolver resolves the value of a special parameter, the text after the colon of <type:value>, to the
+// arg passed to the step. It should give a special string or a special table.
+type SpecialParamResolver func(value string) (*gauge.StepArg, error)
+
+var (
+	registeredResolvers   = make(map[string]SpecialParamResolver)
+	registeredResolversMu sync.RWMutex
+)
+
+// RegisterSpecialParamResolver registers the resolver of the special parameters of a type, like vault for
+// <vault:secret/key>, so that steps and table cells can use them as they use <file:path> and <table:path>.
+// The built in types cannot be replaced, nor can a type be registered twice.
+func RegisterSpecialParamResolver(specialType string, resolver SpecialParamResolver) error {
+	specialType = strings.TrimSpace(specialType)
+	if specialType == "" || strings.ContainsAny(specialType, ":<>") {
+		return fmt.Errorf("Invalid special param type '%s'", specialType)
+	}
+	if _, ok := initializePredefinedResolvers()[specialType]; ok {
+		return fmt.Errorf("Special param type '%s' is built in and cannot be replaced", specialType)
+	}
+	registeredResolversMu.Lock()
+	defer registeredResolversMu.Unlock()
+	if _, ok := registeredResolvers[specialType]; ok {
+		return fmt.Errorf("Special param type '%s' is already registered", specialType)
+	}
+	registeredResolvers[specialType] = resolver
+	return nil
+}
+
+// UnregisterSpecialParamResolver removes the resolver registered for the special parameters of a type
+func UnregisterSpecialParamResolver(specialType string) {
+	registeredResolversMu.Lock()
+	defer registeredResolversMu.Unlock()
+	delete(registeredResolvers, strings.TrimSpace(specialType))
+}
+
 func (invalidSpecialParamError invalidSpecialParamError) Error() string {
 	return invalidSpecialParamError.message
 }
@@ -67,7 +104,13 @@ func getResolvedParams(step *gauge.Step, parent *gauge.Step, lookup *gauge.ArgLo
 			}
 			//In case a special table used in a concept, you will get a dynamic table value which has to be resolved from the concept lookup
 			parameter.Name = resolvedArg.Name
-			if resolvedArg.Table.IsInitialized() {
+			if name := EnvParamName(resolvedArg.Name); name != "" && resolvedArg.ArgType == gauge.SpecialString {
+				parameter.ParameterType = gauge_messages.Parameter_Special_String
+				parameter.Value = envParamReportValue(name)
+			} else if resolvedArg.ArgType == gauge.MultilineText {
+				parameter.ParameterType = gauge_messages.Parameter_Special_String
+				parameter.Value = resolvedArg.Value
+			} else if resolvedArg.Table.IsInitialized() {
 				parameter.ParameterType = gauge_messages.Parameter_Special_Table
 				table, err := createProtoStepTable(&resolvedArg.Table, lookup)
 				if err != nil {
@@ -78,9 +121,12 @@ func getResolvedParams(step *gauge.Step, parent *gauge.Step, lookup *gauge.ArgLo
 				parameter.ParameterType = gauge_messages.Parameter_Dynamic
 				parameter.Value = resolvedArg.Value
 			}
-		} else if arg.ArgType == gauge.SpecialString {
+		} else if arg.ArgType == gauge.SpecialString || arg.ArgType == gauge.MultilineText {
 			parameter.ParameterType = gauge_messages.Parameter_Special_String
 			parameter.Value = arg.Value
+			if name := EnvParamName(arg.Name); name != "" && arg.ArgType == gauge.SpecialString {
+				parameter.Value = envParamReportValue(name)
+			}
 		} else if arg.ArgType == gauge.SpecialTable {
 			parameter.ParameterType = gauge_messages.Parameter_Special_Table
 			table, err := createProtoStepTable(&arg.Table, lookup)
@@ -106,23 +152,22 @@ func createProtoStepTable(table *gauge.Table, lookup *gauge.ArgLookup) (*gauge_m
 	protoTable := new(gauge_messages.ProtoTable)
 	protoTable.Headers = &gauge_messages.ProtoTableRow{Cells: table.Headers}
 	tableRows := make([]*gauge_messages.ProtoTableRow, 0)
-	if len(table.Columns) == 0 {
-		protoTable.Rows = tableRows
-		return protoTable, nil
-	}
-	for i := 0; i < len(table.Columns[0]); i++ {
+	for i := 0; i < table.GetRowCount(); i++ {
+		cells, err := table.Row(i)
+		if err != nil {
+			return nil, err
+		}
 		row := make([]string, 0)
-		for _, header := range table.Headers {
-			tableCells, _ := table.Get(header)
-			value := tableCells[i].Value
-			if tableCells[i].CellType == gauge.Dynamic {
+		for _, cell := range cells {
+			value := cell.Value
+			if cell.CellType == gauge.Dynamic {
 				//if concept has a table with dynamic cell, fetch from datatable
-				arg, err := lookup.GetArg(tableCells[i].Value)
+				arg, err := lookup.GetArg(cell.Value)
 				if err != nil {
 					return nil, err
 				}
 				value = arg.Value
-			} else if tableCells[i].CellType == gauge.SpecialString {
+			} else if cell.CellType == gauge.SpecialString {
 				resolvedArg, _ := newSpecialTypeResolver().resolve(value)
 				value = resolvedArg.Value
 			}
@@ -134,14 +179,42 @@ func createProtoStepTable(table *gauge.Table, lookup *gauge.ArgLookup) (*gauge_m
 	return protoTable, nil
 }
 
+// isSpecialParamType tells if the special type is built in or registered, see RegisterSpecialParamResolver
+func isSpecialParamType(specialType string) bool {
+	_, ok := newSpecialTypeResolver().predefinedResolvers[strings.TrimSpace(specialType)]
+	return ok
+}
+
 func newSpecialTypeResolver() *specialTypeResolver {
 	resolver := new(specialTypeResolver)
 	resolver.predefinedResolvers = initializePredefinedResolvers()
+	registeredResolversMu.RLock()
+	defer registeredResolversMu.RUnlock()
+	for specialType, resolve := range registeredResolvers {
+		resolver.predefinedResolvers[specialType] = registeredResolverFn(specialType, resolve)
+	}
 	return resolver
 }
 
+// registeredResolverFn checks that the arg given by a registered resolver can be passed to a step
+func registeredResolverFn(specialType string, resolve SpecialParamResolver) resolverFn {
+	return func(value string) (*gauge.StepArg, error) {
+		arg, err := resolve(value)
+		if err != nil {
+			return nil, err
+		}
+		if arg == nil || (arg.ArgType != gauge.SpecialString && arg.ArgType != gauge.SpecialTable) {
+			return nil, fmt.Errorf("Resolver of special param type '%s' should give a special string or a special table", specialType)
+		}
+		return arg, nil
+	}
+}
+
 func initializePredefinedResolvers() map[string]resolverFn {
 	return map[string]resolverFn{
+		"env": func(name string) (*gauge.StepArg, error) {
+			return &gauge.StepArg{Value: envParamReportValue(name), ArgType: gauge.SpecialString}, nil
+		},
 		"file": func(filePath string) (*gauge.StepArg, error) {
 			fileContent, err := util.GetFileContents(filePath)
 			if err != nil {
@@ -150,15 +223,11 @@ func initializePredefinedResolvers() map[string]resolverFn {
 			return &gauge.StepArg{Value: fileContent, ArgType: gauge.SpecialString}, nil
 		},
 		"table": func(filePath string) (*gauge.StepArg, error) {
-			csv, err := util.GetFileContents(filePath)
-			if err != nil {
-				return nil, err
-			}
-			csvTable, err := convertCsvToTable(csv)
+			table, err := readTable(filePath)
 			if err != nil {
 				return nil, err
 			}
-			return &gauge.StepArg{Table: *csvTable, ArgType: gauge.SpecialTable}, nil
+			return &gauge.StepArg{Table: *table, ArgType: gauge.SpecialTable}, nil
 		},
 	}
 }
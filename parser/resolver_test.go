@@ -7,8 +7,12 @@
 package parser
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
 
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/util"
 	. "gopkg.in/check.v1"
@@ -277,3 +281,110 @@ func (s *MySuite) TestGetResolveParameterFromDataTable(c *C) {
 	c.Assert(spec.DataTable.Table.Columns[1][0].Value, Equals, "123")
 	c.Assert(spec.DataTable.Table.Columns[1][1].Value, Equals, "007")
 }
+
+func (s *MySuite) TestRegisteredSpecialParamResolver(c *C) {
+	err := RegisterSpecialParamResolver("vault", func(value string) (*gauge.StepArg, error) {
+		return &gauge.StepArg{Value: "secret of " + value, ArgType: gauge.SpecialString}, nil
+	})
+	c.Assert(err, IsNil)
+	defer UnregisterSpecialParamResolver("vault")
+
+	spec, res, err := new(SpecParser).Parse("# Spec\n## Scenario\n* log in with <vault:secret/key>\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	arg := spec.Scenarios[0].Steps[0].Args[0]
+	c.Assert(arg.ArgType, Equals, gauge.SpecialString)
+	c.Assert(arg.Value, Equals, "secret of secret/key")
+	c.Assert(arg.Name, Equals, "vault:secret/key")
+}
+
+func (s *MySuite) TestRegisteredSpecialParamInTableCell(c *C) {
+	err := RegisterSpecialParamResolver("vault", func(value string) (*gauge.StepArg, error) {
+		if value == "missing" {
+			return nil, fmt.Errorf("No secret %s", value)
+		}
+		return &gauge.StepArg{Value: "secret of " + value, ArgType: gauge.SpecialString}, nil
+	})
+	c.Assert(err, IsNil)
+	defer UnregisterSpecialParamResolver("vault")
+	specText := newSpecBuilder().specHeading("Spec heading").text("|user|password|").text("|---|---|").text("|john|<vault:john>|").text("|jane|<vault:missing>|").text("|joe|<table:testdata/data.csv>|").String()
+
+	spec, res := new(SpecParser).ParseSpecText(specText, "foo.spec")
+
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(len(res.ParseErrors), Equals, 2)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Dynamic param <vault:missing> could not be resolved, No secret missing")
+	c.Assert(res.ParseErrors[1].Message, Equals, "Dynamic param <table:testdata/data.csv> could not be resolved, A table cell cannot hold a table")
+	c.Assert(spec.DataTable.Table.Columns[1][0].CellType, Equals, gauge.SpecialString)
+	c.Assert(spec.DataTable.Table.Columns[1][0].Value, Equals, "vault:john")
+}
+
+func (s *MySuite) TestRegisteringSpecialParamResolverTwice(c *C) {
+	resolve := func(value string) (*gauge.StepArg, error) { return nil, nil }
+	c.Assert(RegisterSpecialParamResolver("http", resolve), IsNil)
+	defer UnregisterSpecialParamResolver("http")
+
+	c.Assert(RegisterSpecialParamResolver("http", resolve), ErrorMatches, "Special param type 'http' is already registered")
+	c.Assert(RegisterSpecialParamResolver("file", resolve), ErrorMatches, "Special param type 'file' is built in and cannot be replaced")
+}
+
+func (s *MySuite) TestRegisteredSpecialParamResolverShouldGiveASpecialArg(c *C) {
+	c.Assert(RegisterSpecialParamResolver("http", func(value string) (*gauge.StepArg, error) {
+		return &gauge.StepArg{Value: value, ArgType: gauge.Static}, nil
+	}), IsNil)
+	defer UnregisterSpecialParamResolver("http")
+
+	_, err := newSpecialTypeResolver().resolve("http:example.com")
+
+	c.Assert(err, ErrorMatches, "Resolver of special param type 'http' should give a special string or a special table")
+}
+
+func (s *MySuite) TestEnvParamIsResolvedToTheValueOfTheVariable(c *C) {
+	os.Setenv("GAUGE_TEST_USER", "jane")
+	os.Setenv("GAUGE_TEST_PASSWORD", "secret")
+	defer os.Unsetenv("GAUGE_TEST_USER")
+	defer os.Unsetenv("GAUGE_TEST_PASSWORD")
+	old := env.MaskedEnvParams
+	env.MaskedEnvParams = func() []string { return []string{"GAUGE_TEST_PASSWORD"} }
+	defer func() { env.MaskedEnvParams = old }()
+
+	spec, res, err := new(SpecParser).Parse("# Spec\n## Scenario\n* log in as <env:GAUGE_TEST_USER> with <env:GAUGE_TEST_PASSWORD>\n", gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	step := spec.Scenarios[0].Steps[0]
+
+	params, err := getResolvedParams(step, nil, nil)
+
+	c.Assert(err, IsNil)
+	c.Assert(params[0].GetParameterType(), Equals, gauge_messages.Parameter_Special_String)
+	c.Assert(params[0].GetName(), Equals, "env:GAUGE_TEST_USER")
+	c.Assert(params[0].GetValue(), Equals, "jane")
+	c.Assert(params[1].GetValue(), Equals, MaskedValue)
+	c.Assert(UndefinedEnvParams(step), HasLen, 0)
+}
+
+func (s *MySuite) TestUndefinedEnvParams(c *C) {
+	os.Unsetenv("GAUGE_TEST_UNDEFINED_VAR")
+
+	spec, _, _ := new(SpecParser).Parse("# Spec\n## Scenario\n* log in as <env:GAUGE_TEST_UNDEFINED_VAR>\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(UndefinedEnvParams(spec.Scenarios[0].Steps[0]), DeepEquals, []string{"GAUGE_TEST_UNDEFINED_VAR"})
+}
+
+func (s *MySuite) TestResolvedParamsKeepTheDeclaredTypesOutOfTheNames(c *C) {
+	spec, res, err := new(SpecParser).Parse("# Spec\n\n|count|\n|-----|\n|3    |\n## Scenario\n* add <count>{int} items \"true\"{bool}\n", gauge.NewConceptDictionary(), "foo.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	lookup := new(gauge.ArgLookup).FromDataTables(spec.DataTable.Table)
+	c.Assert(lookup.ReadDataTableRow(spec.DataTable.Table, 0), IsNil)
+	step := spec.Scenarios[0].Steps[0]
+
+	params, err := getResolvedParams(step, nil, lookup)
+
+	c.Assert(err, IsNil)
+	c.Assert(params[0].GetName(), Equals, "count")
+	c.Assert(params[0].GetValue(), Equals, "3")
+	c.Assert(params[1].GetName(), Equals, "")
+	c.Assert(gauge.ConvertToProtoStep(step).GetActualText(), Equals, "add <count>{int} items \"true\"{bool}")
+}
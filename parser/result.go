@@ -45,6 +45,15 @@ func (result *ParseResult) Errors() (errors []string) {
 	return
 }
 
+// promoteWarnings makes the warnings of the result parse errors, failing it if there are any
+func (result *ParseResult) promoteWarnings() {
+	for _, w := range result.Warnings {
+		result.ParseErrors = append(result.ParseErrors, w.parseError())
+		result.Ok = false
+	}
+	result.Warnings = nil
+}
+
 // Warning is used to indicate discrepancies that do not necessarily need to break flow.
 type Warning struct {
 	FileName    string
@@ -56,3 +65,7 @@ type Warning struct {
 func (warning *Warning) String() string {
 	return fmt.Sprintf("%s:%d %s", warning.FileName, warning.LineNo, warning.Message)
 }
+
+func (warning *Warning) parseError() ParseError {
+	return ParseError{FileName: warning.FileName, LineNo: warning.LineNo, SpanEnd: warning.LineSpanEnd, Message: warning.Message}
+}
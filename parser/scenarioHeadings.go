@@ -0,0 +1,76 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/gauge/gauge"
+)
+
+type scenarioHeading struct {
+	spec     *gauge.Specification
+	scenario *gauge.Scenario
+}
+
+// duplicateScenarioHeadings gives a failed parse result for every spec with a scenario whose heading, ignoring case,
+// is the heading of a scenario of another spec. Both scenarios get an error, naming the location of the other one.
+// The errors are added to the build errors of the specs.
+func duplicateScenarioHeadings(specs []*gauge.Specification, buildErrors *gauge.BuildErrors) []*ParseResult {
+	sorted := append([]*gauge.Specification{}, specs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].FileName < sorted[j].FileName })
+	first := make(map[string]scenarioHeading)
+	results := make(map[*gauge.Specification]*ParseResult)
+	addError := func(h, other scenarioHeading) {
+		r, ok := results[h.spec]
+		if !ok {
+			r = &ParseResult{Ok: false, FileName: h.spec.FileName}
+			results[h.spec] = r
+		}
+		e := ParseError{
+			FileName: h.spec.FileName,
+			LineNo:   h.scenario.Heading.LineNo,
+			SpanEnd:  h.scenario.Heading.SpanEnd,
+			Message:  fmt.Sprintf("Duplicate scenario definition '%s' found in %s:%d", h.scenario.Heading.Value, other.spec.FileName, other.scenario.Heading.LineNo),
+			LineText: scenarioHeadingText(h.scenario.Heading),
+		}
+		r.ParseErrors = append(r.ParseErrors, e)
+		buildErrors.SpecErrs[h.spec] = append(buildErrors.SpecErrs[h.spec], e)
+	}
+	for _, spec := range sorted {
+		for _, scn := range spec.Scenarios {
+			if scn.Heading == nil {
+				continue
+			}
+			h := scenarioHeading{spec: spec, scenario: scn}
+			key := strings.ToLower(scn.Heading.Value)
+			f, ok := first[key]
+			if !ok {
+				first[key] = h
+				continue
+			}
+			if f.spec == spec {
+				continue
+			}
+			addError(h, f)
+			addError(f, h)
+		}
+	}
+	var parseResults []*ParseResult
+	for _, spec := range sorted {
+		if r, ok := results[spec]; ok {
+			parseResults = append(parseResults, r)
+		}
+	}
+	return parseResults
+}
+
+func scenarioHeadingText(heading *gauge.Heading) string {
+	return "## " + heading.Value
+}
@@ -24,12 +24,18 @@ func NewSpecFileCollection(s []string) *specFileCollection {
 }
 
 func (s *specFileCollection) Next() (string, error) {
+	_, specFile, err := s.next()
+	return specFile, err
+}
+
+// next gives the next file with its index in the collection
+func (s *specFileCollection) next() (int, string, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 	if s.index < len(s.specFiles) {
 		specFile := s.specFiles[s.index]
 		s.index++
-		return specFile, nil
+		return s.index - 1, specFile, nil
 	}
-	return "", fmt.Errorf("no files in collection")
+	return -1, "", fmt.Errorf("no files in collection")
 }
@@ -0,0 +1,69 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestToSpecTextRoundTripsParsedSpec(c *C) {
+	text := `# Checkout
+
+tags: cart, smoke
+
+   |id|name |
+   |--|-----|
+   |1 |apple|
+
+A comment of the spec
+
+* Open the shop
+
+## Pay by card
+
+tags: card
+
+* Pay "10" with <name>
+* Check the items
+
+   |id|name|
+   |--|----|
+   |1 |pear|
+
+___
+* Close the shop
+`
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "checkout.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(spec.ToSpecText(), Equals, text)
+}
+
+func (s *MySuite) TestToSpecTextOfSpecBuiltInCodeParsesBack(c *C) {
+	spec := &gauge.Specification{
+		Heading: &gauge.Heading{Value: "Checkout", HeadingType: gauge.SpecHeading},
+		Tags:    &gauge.Tags{RawValues: [][]string{{"cart"}}},
+		Scenarios: []*gauge.Scenario{{
+			Heading: &gauge.Heading{Value: "Pay", HeadingType: gauge.ScenarioHeading},
+			Steps:   []*gauge.Step{{Value: "Pay {}", Args: []*gauge.StepArg{{Value: "10", ArgType: gauge.Static}}}},
+		}},
+		TearDownSteps: []*gauge.Step{{Value: "Close the shop"}},
+	}
+
+	parsed, res, err := new(SpecParser).Parse(spec.ToSpecText(), gauge.NewConceptDictionary(), "checkout.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(parsed.Heading.Value, Equals, "Checkout")
+	c.Assert(parsed.Tags.Values(), DeepEquals, []string{"cart"})
+	c.Assert(len(parsed.Scenarios), Equals, 1)
+	c.Assert(parsed.Scenarios[0].Steps[0].Value, Equals, "Pay {}")
+	c.Assert(parsed.Scenarios[0].Steps[0].Args[0].Value, Equals, "10")
+	c.Assert(parsed.TearDownSteps[0].Value, Equals, "Close the shop")
+}
@@ -8,10 +8,12 @@ package parser
 
 import (
 	"bufio"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 )
@@ -24,6 +26,8 @@ type SpecParser struct {
 	currentState      int
 	processors        map[gauge.TokenKind]func(*SpecParser, *Token) ([]error, bool)
 	conceptDictionary *gauge.ConceptDictionary
+	// priorityPattern matches the priority tags of scenarios, the Priority tags are used if nil
+	priorityPattern *regexp.Regexp
 }
 
 type PrioritizedScenarios struct {
@@ -36,7 +40,7 @@ type ByPriority []*PrioritizedScenarios
 
 func (a ByPriority) Len() int           { return len(a) }
 func (a ByPriority) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a ByPriority) Less(i, j int) bool { return a[i].priority < a[j].priority }
+func (a ByPriority) Less(i, j int) bool { return PriorityLess(a[i].priority, a[j].priority) }
 
 // Parse generates tokens for the given spec text and creates the specification.
 func (parser *SpecParser) Parse(specText string, conceptDictionary *gauge.ConceptDictionary, specFile string) (*gauge.Specification, *ParseResult, error) {
@@ -45,6 +49,7 @@ func (parser *SpecParser) Parse(specText string, conceptDictionary *gauge.Concep
 	if err != nil {
 		return nil, nil, err
 	}
+	addFrontMatter(spec, res, specText)
 	res.FileName = specFile
 	if len(errs) > 0 {
 		res.Ok = false
@@ -57,6 +62,7 @@ func (parser *SpecParser) Parse(specText string, conceptDictionary *gauge.Concep
 func (parser *SpecParser) ParseSpecText(specText string, specFile string) (*gauge.Specification, *ParseResult) {
 	tokens, errs := parser.GenerateTokens(specText, specFile)
 	spec, res := parser.createSpecification(tokens, specFile)
+	addFrontMatter(spec, res, specText)
 	res.FileName = specFile
 	if len(errs) > 0 {
 		res.Ok = false
@@ -102,35 +108,27 @@ func (parser *SpecParser) createSpecification(tokens []*Token, specFile string)
 			}
 		}
 	}
+	if len(specification.Scenarios) > 0 {
+		specification.LatestScenario().Span.End = tokens[len(tokens)-1].LineNo
+	}
+	parser.orderByPriority(specification)
+	return specification, finalResult
+}
+
+// orderByPriority sets the priority of the scenarios of the spec, which inherit the priority of the spec unless they
+// have their own. It reorders them by their priority when ordering by priority is enabled or the parser has a
+// priority tag pattern, the scenarios without priority going before those of a negative level.
+func (parser *SpecParser) orderByPriority(specification *gauge.Specification) {
 	// For each priority flag we find, we should create a scenario list associated to this priority level, these lists are pushed in prioritizedScenariosList
 	// On the other side, we fill nonPrioritizedScenarios with the scenarios without priority flag
 	prioritizedScenariosList := []*PrioritizedScenarios{}
 	nonPrioritizedScenarios := []*gauge.Scenario{}
+	specPriority := parser.tagsPriority(specification.Tags)
 	for _, scenario := range specification.Scenarios {
-		scenarioPriority := -1
-		// We look for scenarios with priority level tags
-		if scenario.Tags != nil {
-			for _, tag := range scenario.Tags.RawValues[0] {
-				if strings.Contains(tag, "Priority") {
-					priority, err := strconv.Atoi(strings.SplitAfter(tag, "Priority")[1])
-					if err != nil {
-						logger.Warningf(true, "Unable to get priority level from tag: %s", tag)
-						break
-					}
-					if priority >= 0 {
-						logger.Debugf(true, "Scenario: %s has Priority level: %d", scenario.Heading.Value, priority)
-						if scenarioPriority == -1 {
-							// If not priority level has been set before to this scenario, we should do it now
-							scenarioPriority = priority
-						} else if priority < scenarioPriority {
-							// By default we stick to the highest priority level
-							scenarioPriority = priority
-						}
-					}
-				}
-			}
-		}
-		if scenarioPriority != -1 {
+		scenarioPriority := parser.scenarioPriority(scenario, specPriority)
+		if scenarioPriority != NoPriority {
+			p := gauge.Priority(scenarioPriority)
+			scenario.Priority = &p
 			// Push this scenario to its associated scenario list, if the list exists
 			prioritizedScenariosFound := false
 			for _, prioritizedScenarios := range prioritizedScenariosList {
@@ -152,21 +150,91 @@ func (parser *SpecParser) createSpecification(tokens []*Token, specFile string)
 			nonPrioritizedScenarios = append(nonPrioritizedScenarios, scenario)
 		}
 	}
+	if parser.priorityPattern == nil && !PriorityOrderEnabled() {
+		return
+	}
 	// Filter list of list of Scenarios by priority level
 	sort.Sort(ByPriority(prioritizedScenariosList))
 	// We create a brand new, empty scenario list for the specification
 	specification.Scenarios = []*gauge.Scenario{}
 	for _, prioritizedScenarios := range prioritizedScenariosList {
+		if prioritizedScenarios.priority < 0 && nonPrioritizedScenarios != nil {
+			// Scenarios of a negative level run after those without priority
+			specification.Scenarios = append(specification.Scenarios, nonPrioritizedScenarios...)
+			nonPrioritizedScenarios = nil
+		}
 		// Fill the specification scenario list, starting with the prioritized ones
 		// Note: Priority levels are respected because the list has been sorted by priority level
 		specification.Scenarios = append(specification.Scenarios, prioritizedScenarios.scenarioList...)
 	}
 	// Append nonPrioritizedScenarios to this list
 	specification.Scenarios = append(specification.Scenarios, nonPrioritizedScenarios...)
-	if len(specification.Scenarios) > 0 {
-		specification.LatestScenario().Span.End = tokens[len(tokens)-1].LineNo
+}
+
+// ScenarioPriority gives the priority level of a scenario, from its priority:N tag or else from its priority tags
+// such as Priority1, of which the one running first wins. It returns NoPriority for scenarios without priority.
+func ScenarioPriority(scenario *gauge.Scenario) int {
+	if scenario.Priority != nil {
+		return int(*scenario.Priority)
 	}
-	return specification, finalResult
+	return TagsPriority(scenario.Tags)
+}
+
+// TagsPriority gives the priority level of the priority tags, priority:N or PriorityN, of which the one running first
+// wins. It returns NoPriority when there are none.
+func TagsPriority(tags *gauge.Tags) int {
+	priority := NoPriority
+	if tags == nil {
+		return priority
+	}
+	for _, tag := range tags.Values() {
+		if p, ok := PriorityOfTag(tag); ok && (priority == NoPriority || PriorityLess(p, priority)) {
+			priority = p
+		}
+	}
+	return priority
+}
+
+// scenarioPriority gives the priority level of a scenario, from its own priority tags or else from those of its spec
+func (parser *SpecParser) scenarioPriority(scenario *gauge.Scenario, specPriority int) int {
+	if scenario.Priority != nil {
+		return int(*scenario.Priority)
+	}
+	if p := parser.tagsPriority(scenario.Tags); p != NoPriority {
+		return p
+	}
+	return specPriority
+}
+
+func (parser *SpecParser) tagsPriority(tags *gauge.Tags) int {
+	if parser.priorityPattern == nil {
+		return TagsPriority(tags)
+	}
+	return tagPriority(tags, parser.priorityPattern)
+}
+
+// tagPriority gives the priority level of the tags matching the pattern, whose first group is the level. As with
+// TagsPriority, the lowest level wins and it returns NoPriority when there are no priority tags.
+func tagPriority(tags *gauge.Tags, pattern *regexp.Regexp) int {
+	scenarioPriority := NoPriority
+	if tags == nil {
+		return scenarioPriority
+	}
+	for _, tag := range tags.Values() {
+		m := pattern.FindStringSubmatch(tag)
+		if len(m) < 2 {
+			continue
+		}
+		priority, err := strconv.Atoi(m[1])
+		if err != nil || priority < 0 {
+			logger.Warningf(true, "Unable to get priority level from tag: %s", tag)
+			continue
+		}
+		if scenarioPriority == NoPriority || priority < scenarioPriority {
+			scenarioPriority = priority
+		}
+	}
+	return scenarioPriority
 }
 
 func (parser *SpecParser) validateSpec(specification *gauge.Specification) error {
@@ -210,22 +278,53 @@ func createStep(spec *gauge.Specification, scn *gauge.Scenario, stepToken *Token
 	return stepToAdd, parseDetails
 }
 
+var stepTagsPattern = regexp.MustCompile(`(\s+@[^\s@{}<>"]+)+\s*$`)
+
+// splitStepTags splits the tags written at the end of the step text, like the @slow of "open the app @slow", from
+// the text. A parameter ends the step text, so the text of a quoted or dynamic parameter is never read as a tag.
+func splitStepTags(text string) (string, []string) {
+	loc := stepTagsPattern.FindStringIndex(text)
+	if loc == nil {
+		return text, nil
+	}
+	var tags []string
+	for _, t := range strings.Fields(text[loc[0]:]) {
+		tags = append(tags, strings.TrimPrefix(t, "@"))
+	}
+	return strings.TrimSpace(text[:loc[0]]), tags
+}
+
 // CreateStepUsingLookup generates gauge steps from step token and args lookup.
 func CreateStepUsingLookup(stepToken *Token, lookup *gauge.ArgLookup, specFileName string) (*gauge.Step, *ParseResult) {
-	stepValue, argsType := extractStepValueAndParameterTypes(stepToken.Value)
+	value, lineText := stepToken.Value, strings.Join(stepToken.Lines, " ")
+	var tags []string
+	if env.AllowStepTags() {
+		value, tags = splitStepTags(value)
+		lineText, _ = splitStepTags(lineText)
+	}
+	stepValue, argsType := extractStepValueAndParameterTypes(value)
 	if argsType != nil && len(argsType) != len(stepToken.Args) {
 		return nil, &ParseResult{ParseErrors: []ParseError{ParseError{specFileName, stepToken.LineNo, stepToken.SpanEnd, "Step text should not have '{static}' or '{dynamic}' or '{special}'", stepToken.LineText()}}, Warnings: nil}
 	}
-	lineText := strings.Join(stepToken.Lines, " ")
 	step := &gauge.Step{FileName: specFileName, LineNo: stepToken.LineNo, Value: stepValue, LineText: strings.TrimSpace(lineText), LineSpanEnd: stepToken.SpanEnd}
+	if len(tags) > 0 {
+		step.StepTags = &gauge.Tags{RawValues: [][]string{tags}}
+	}
 	arguments := make([]*gauge.StepArg, 0)
 	var errors []ParseError
 	var warnings []*Warning
+	paramTypes := extractDeclaredParamTypes(value)
 	for i, argType := range argsType {
 		argument, parseDetails := createStepArg(stepToken.Args[i], argType, stepToken, lookup, specFileName)
 		if parseDetails != nil && len(parseDetails.ParseErrors) > 0 {
 			errors = append(errors, parseDetails.ParseErrors...)
 		}
+		if paramTypes[i] != "" {
+			argument.ParamType = paramTypes[i]
+			if err := validateParamType(argument); err != nil {
+				errors = append(errors, ParseError{FileName: specFileName, LineNo: stepToken.LineNo, SpanEnd: stepToken.SpanEnd, Message: err.Error(), LineText: stepToken.LineText()})
+			}
+		}
 		arguments = append(arguments, argument)
 		if parseDetails != nil && parseDetails.Warnings != nil {
 			warnings = append(warnings, parseDetails.Warnings...)
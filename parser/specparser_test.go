@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 
@@ -166,6 +167,8 @@ func (s *MySuite) TestThrowsErrorForDuplicateScenariosWithinTheSameSpec(c *C) {
 
 	c.Assert(result.ParseErrors[0].Message, Equals, "Duplicate scenario definition 'Scenario Heading' found in the same specification")
 	c.Assert(result.ParseErrors[0].LineNo, Equals, 4)
+	c.Assert(result.ParseErrors[1].Message, Equals, "Duplicate scenario definition 'Scenario Heading' found in the same specification")
+	c.Assert(result.ParseErrors[1].LineNo, Equals, 2)
 }
 
 func (s *MySuite) TestSpecWithHeadingAndSimpleSteps(c *C) {
@@ -1365,3 +1368,170 @@ func (s *MySuite) TestDataTableForSpecialParameterWhenFileIsNotFound(c *C) {
 	c.Assert(res.ParseErrors[0].Message, Equals, "Dynamic param <file:notFound.txt> could not be resolved, Missing file: notFound.txt")
 	c.Assert(res.ParseErrors[0].LineText, Equals, "|james|<file:notFound.txt>|")
 }
+
+func (s *MySuite) TestParsingStableIDsOfSpecAndScenarios(c *C) {
+	specText := newSpecBuilder().specHeading("Spec heading").text("<!-- id: CHK -->").scenarioHeading("First").text("<!-- id: CHK-102 -->").step("my step").scenarioHeading("Second").step("my step").String()
+
+	spec, parseRes, err := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), "")
+
+	c.Assert(err, IsNil)
+	c.Assert(parseRes.Ok, Equals, true)
+	c.Assert(spec.ID, Equals, "CHK")
+	c.Assert(spec.Scenarios[0].ID, Equals, "CHK-102")
+	c.Assert(spec.Scenarios[1].ID, Equals, "")
+}
+
+func (s *MySuite) TestParsingAnnotationsOfScenarios(c *C) {
+	specText := newSpecBuilder().specHeading("Spec heading").scenarioHeading("First").text("@owner: payments").step("my step").String()
+
+	spec, parseRes, err := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), "")
+
+	c.Assert(err, IsNil)
+	c.Assert(parseRes.Ok, Equals, true)
+	c.Assert(spec.Scenarios[0].Annotations, DeepEquals, gauge.Annotations{"owner": {"payments"}})
+}
+
+func TestParseScenarioWithExternalDataTableWhenScenarioDataTablesAreNotAllowed(t *testing.T) {
+	old := env.AllowScenarioDatatable
+	defer func() { env.AllowScenarioDatatable = old }()
+	env.AllowScenarioDatatable = func() bool { return false }
+
+	_, res, err := new(SpecParser).Parse("# Spec\n## Scenario\ntable:testdata/data.csv\n* The word is a word\n", gauge.NewConceptDictionary(), "")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "Scenario data tables are not allowed, ignoring table. Set allow_scenario_datatable to true to use them"
+	if len(res.Warnings) != 1 || res.Warnings[0].Message != want {
+		t.Errorf("expected the warning %q, got %v", want, res.Warnings)
+	}
+}
+
+func (s *MySuite) TestMultilineTextIsTheLastArgOfTheStep(c *C) {
+	old := env.AllowMultilineText
+	env.AllowMultilineText = func() bool { return true }
+	defer func() { env.AllowMultilineText = old }()
+	specText := "# Spec\n* connect to \"db\"\n```sql\nselect *\nfrom users\n```\n## Scenario\n* post\n```\n{}\n```\n___\n* cleanup\n~~~\ndone\n~~~\n"
+
+	spec, res, err := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	context := spec.Contexts[0]
+	c.Assert(context.Value, Equals, "connect to {} {}")
+	c.Assert(context.HasMultilineText, Equals, true)
+	c.Assert(context.GetLineText(), Equals, "connect to \"db\" <text>")
+	c.Assert(context.Args[1], DeepEquals, &gauge.StepArg{Name: "text:sql", Value: "select *\nfrom users", ArgType: gauge.MultilineText})
+	c.Assert(spec.Scenarios[0].Steps[0].Value, Equals, "post {}")
+	c.Assert(spec.Scenarios[0].Steps[0].Args[0].Name, Equals, "text")
+	c.Assert(spec.Scenarios[0].Steps[0].Args[0].Value, Equals, "{}")
+	c.Assert(spec.TearDownSteps[0].Args[0].Value, Equals, "done")
+}
+
+func (s *MySuite) TestMultilineTextIsPassedToConcepts(c *C) {
+	old := env.AllowMultilineText
+	env.AllowMultilineText = func() bool { return true }
+	defer func() { env.AllowMultilineText = old }()
+	dictionary := gauge.NewConceptDictionary()
+	concepts, res := new(ConceptParser).Parse("# post <payload>\n* send <payload>\n", "concepts.cpt")
+	c.Assert(res.ParseErrors, HasLen, 0)
+	_, err := AddConcept(concepts, "concepts.cpt", dictionary)
+	c.Assert(err, IsNil)
+
+	spec, res, err := new(SpecParser).Parse("# Spec\n## Scenario\n* post\n```json\n{}\n```\n", dictionary, "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	concept := spec.Scenarios[0].Steps[0]
+	c.Assert(concept.IsConcept, Equals, true)
+	params, err := getResolvedParams(concept.ConceptSteps[0], concept, nil)
+	c.Assert(err, IsNil)
+	c.Assert(params[0].GetParameterType(), Equals, gauge_messages.Parameter_Special_String)
+	c.Assert(params[0].GetValue(), Equals, "{}")
+}
+
+func (s *MySuite) TestStepTagsAreSplitFromTheStepText(c *C) {
+	old := env.AllowStepTags
+	env.AllowStepTags = func() bool { return true }
+	defer func() { env.AllowStepTags = old }()
+	specText := "# Spec\n## Scenario\n* open the app @slow @ui\n* mail \"a @b\" to \"jane\" @smtp\n* notify @team now\n"
+
+	spec, res, err := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	steps := spec.Scenarios[0].Steps
+	c.Assert(steps[0].Value, Equals, "open the app")
+	c.Assert(steps[0].LineText, Equals, "open the app")
+	c.Assert(steps[0].StepTags.Values(), DeepEquals, []string{"slow", "ui"})
+	c.Assert(steps[1].Value, Equals, "mail {} to {}")
+	c.Assert(steps[1].Args[0].Value, Equals, "a @b")
+	c.Assert(steps[1].StepTags.Values(), DeepEquals, []string{"smtp"})
+	c.Assert(steps[2].Value, Equals, "notify @team now")
+	c.Assert(steps[2].StepTags, IsNil)
+}
+
+func (s *MySuite) TestStepTagsAreStepTextWhenNotAllowed(c *C) {
+	old := env.AllowStepTags
+	env.AllowStepTags = func() bool { return false }
+	defer func() { env.AllowStepTags = old }()
+
+	spec, _, _ := new(SpecParser).Parse("# Spec\n## Scenario\n* open the app @slow\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(spec.Scenarios[0].Steps[0].Value, Equals, "open the app @slow")
+	c.Assert(spec.Scenarios[0].Steps[0].StepTags, IsNil)
+}
+
+func (s *MySuite) TestConceptUsageHasTheStepTagsOfTheConceptHeading(c *C) {
+	old := env.AllowStepTags
+	env.AllowStepTags = func() bool { return true }
+	defer func() { env.AllowStepTags = old }()
+	dictionary := gauge.NewConceptDictionary()
+	concepts, res := new(ConceptParser).Parse("# login as <user> @auth\n* enter <user>\n", "concepts.cpt")
+	c.Assert(res.ParseErrors, HasLen, 0)
+	_, err := AddConcept(concepts, "concepts.cpt", dictionary)
+	c.Assert(err, IsNil)
+
+	spec, res, err := new(SpecParser).Parse("# Spec\n## Scenario\n* login as \"jane\" @slow\n", dictionary, "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(spec.Scenarios[0].Steps[0].IsConcept, Equals, true)
+	c.Assert(spec.Scenarios[0].Steps[0].StepTags.Values(), DeepEquals, []string{"auth", "slow"})
+}
+
+func (s *MySuite) TestScenarioTearDownSteps(c *C) {
+	specText := "# Spec\n## Scenario\n* open the app\n\nteardown:\n* close the app\n|id|\n|--|\n|1 |\n## Another\n* step\n___\n* cleanup\n"
+
+	spec, res, err := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	scn := spec.Scenarios[0]
+	c.Assert(scn.Steps, HasLen, 1)
+	c.Assert(scn.TearDownSteps, HasLen, 1)
+	c.Assert(scn.TearDownSteps[0].Value, Equals, "close the app {}")
+	c.Assert(scn.TearDownSteps[0].HasInlineTable, Equals, true)
+	c.Assert(scn.ItemsBeforeTearDown(), HasLen, 1)
+	c.Assert(spec.Scenarios[1].TearDownSteps, HasLen, 0)
+	c.Assert(spec.Scenarios[1].Steps, HasLen, 1)
+	c.Assert(spec.TearDownSteps, HasLen, 1)
+}
+
+func (s *MySuite) TestScenarioTearDownCanBeDefinedOnlyOnce(c *C) {
+	specText := "# Spec\n## Scenario\n* open the app\nteardown:\n* close the app\nTeardown:\n* close it again\n"
+
+	_, res, _ := new(SpecParser).Parse(specText, gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Teardown can be defined only once per scenario")
+	c.Assert(res.ParseErrors[0].LineNo, Equals, 6)
+}
+
+func (s *MySuite) TestTearDownOutsideScenarioIsAComment(c *C) {
+	spec, res, _ := new(SpecParser).Parse("# Spec\nteardown:\n* context\n## Scenario\n* step\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(res.Warnings, HasLen, 1)
+	c.Assert(res.Warnings[0].Message, Equals, "Teardown is not associated with a scenario, ignoring it")
+	c.Assert(spec.Contexts, HasLen, 1)
+}
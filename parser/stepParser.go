@@ -25,6 +25,7 @@ const (
 	inEscape       = 1 << iota
 	inDynamicParam = 1 << iota
 	inSpecialParam = 1 << iota
+	inParamType    = 1 << iota
 )
 const (
 	quotes                 = '"'
@@ -32,6 +33,8 @@ const (
 	dynamicParamStart      = '<'
 	dynamicParamEnd        = '>'
 	specialParamIdentifier = ':'
+	paramTypeStart         = '{'
+	paramTypeEnd           = '}'
 )
 
 type acceptFn func(rune, int) (int, bool)
@@ -42,12 +45,13 @@ func ExtractStepArgsFromToken(stepToken *Token) ([]gauge.StepArg, error) {
 	if argsType != nil && len(argsType) != len(stepToken.Args) {
 		return nil, fmt.Errorf("Step text should not have '{static}' or '{dynamic}' or '{special}'")
 	}
+	paramTypes := extractDeclaredParamTypes(stepToken.Value)
 	var args []gauge.StepArg
 	for i, argType := range argsType {
 		if gauge.ArgType(argType) == gauge.Static {
-			args = append(args, gauge.StepArg{ArgType: gauge.Static, Value: stepToken.Args[i]})
+			args = append(args, gauge.StepArg{ArgType: gauge.Static, Value: stepToken.Args[i], ParamType: paramTypes[i]})
 		} else {
-			args = append(args, gauge.StepArg{ArgType: gauge.Dynamic, Value: stepToken.Args[i]})
+			args = append(args, gauge.StepArg{ArgType: gauge.Dynamic, Value: stepToken.Args[i], ParamType: paramTypes[i]})
 		}
 	}
 	return args, nil
@@ -97,7 +101,7 @@ func processStep(parser *SpecParser, token *Token) ([]error, bool) {
 
 func processStepText(text string) (string, []string, error) {
 	reservedChars := map[rune]struct{}{'{': {}, '}': {}}
-	var stepValue, argText bytes.Buffer
+	var stepValue, argText, typeText bytes.Buffer
 
 	var args []string
 
@@ -105,6 +109,9 @@ func processStepText(text string) (string, []string, error) {
 		if isInAnyState(state, inQuotes, inDynamicParam) {
 			return &argText
 		}
+		if isInState(state, inParamType) {
+			return &typeText
+		}
 		return &stepValue
 	}
 
@@ -141,8 +148,42 @@ func processStepText(text string) (string, []string, error) {
 		argText.Reset()
 	}, inDynamicParam)
 
-	var inParamBoundary bool
+	// The type declared right after a param, like the int of "5"{int}, goes in the placeholder of the param, as in
+	// {static:int}
+	acceptParamType := func(element rune, currentState int, afterParam bool) (int, bool, error) {
+		if element == paramTypeStart && currentState == inDefault && afterParam {
+			return inParamType, true, nil
+		}
+		if currentState != inParamType {
+			return currentState, false, nil
+		}
+		if element == paramTypeEnd {
+			paramType := strings.TrimSpace(typeText.String())
+			if paramType == "" {
+				return currentState, true, fmt.Errorf("Parameter type should not be blank")
+			}
+			placeholder := strings.TrimSuffix(stepValue.String(), "}")
+			stepValue.Reset()
+			stepValue.WriteString(fmt.Sprintf("%s%c%s}", placeholder, specialParamIdentifier, paramType))
+			typeText.Reset()
+			return inDefault, true, nil
+		}
+		if element == paramTypeStart {
+			return currentState, true, fmt.Errorf("'%c' is a reserved character and should be escaped", element)
+		}
+		return currentState, false, nil
+	}
+
+	var inParamBoundary, afterParam bool
 	for _, element := range text {
+		wasAfterParam := afterParam
+		afterParam = false
+		var err error
+		if currentState, inParamBoundary, err = acceptParamType(element, currentState, wasAfterParam); err != nil {
+			return "", nil, err
+		} else if inParamBoundary {
+			continue
+		}
 		if currentState == inEscape {
 			currentState = lastState
 			if _, isReservedChar := reservedChars[element]; currentState == inDefault && !isReservedChar {
@@ -158,14 +199,16 @@ func processStepText(text string) (string, []string, error) {
 			currentState = inEscape
 			continue
 		} else if currentState, inParamBoundary = acceptSpecialDynamicParam(element, currentState); inParamBoundary {
+			afterParam = currentState == inDefault
 			continue
 		} else if currentState, inParamBoundary = acceptStaticParam(element, currentState); inParamBoundary {
+			afterParam = currentState == inDefault
 			continue
 		} else if _, isReservedChar := reservedChars[element]; currentState == inDefault && isReservedChar {
 			return "", nil, fmt.Errorf("'%c' is a reserved character and should be escaped", element)
 		}
 
-		_, err := curBuffer(currentState).WriteRune(element)
+		_, err = curBuffer(currentState).WriteRune(element)
 		if err != nil {
 			logger.Errorf(false, "Unable to write `%c` to step value while parsing : %s", element, err.Error())
 		}
@@ -176,6 +219,8 @@ func processStepText(text string) (string, []string, error) {
 		return "", nil, fmt.Errorf("String not terminated")
 	} else if isInState(currentState, inDynamicParam) {
 		return "", nil, fmt.Errorf("Dynamic parameter not terminated")
+	} else if currentState == inParamType {
+		return "", nil, fmt.Errorf("Parameter type not terminated")
 	}
 
 	return strings.TrimSpace(stepValue.String()), args, nil
@@ -196,9 +241,11 @@ func getEscapedRuneIfValid(element rune) rune {
 	return element
 }
 
+var paramPlaceholderPattern = regexp.MustCompile("{(dynamic|static|special)(?::([^{}]*))?}")
+
 func extractStepValueAndParameterTypes(stepTokenValue string) (string, []string) {
 	argsType := make([]string, 0)
-	r := regexp.MustCompile("{(dynamic|static|special)}")
+	r := paramPlaceholderPattern
 	/*
 		enter {dynamic} and {static}
 		returns
@@ -219,6 +266,16 @@ func extractStepValueAndParameterTypes(stepTokenValue string) (string, []string)
 	return r.ReplaceAllString(stepTokenValue, gauge.ParameterPlaceholder), argsType
 }
 
+// extractDeclaredParamTypes gives the types declared for the params of the step, like the int of {static:int}, with an
+// empty string for a param without a type
+func extractDeclaredParamTypes(stepTokenValue string) []string {
+	var paramTypes []string
+	for _, arg := range paramPlaceholderPattern.FindAllStringSubmatch(stepTokenValue, -1) {
+		paramTypes = append(paramTypes, arg[2])
+	}
+	return paramTypes
+}
+
 func createStepArg(argValue string, typeOfArg string, token *Token, lookup *gauge.ArgLookup, fileName string) (*gauge.StepArg, *ParseResult) {
 	switch typeOfArg {
 	case "special":
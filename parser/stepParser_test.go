@@ -238,3 +238,46 @@ func (s *MySuite) TestParsingStepWithTab(c *C) {
 	c.Assert(len(args), Equals, 0)
 	c.Assert(tokenValue, Equals, "step foo \t only")
 }
+
+func (s *MySuite) TestParsingStepWithTypedParams(c *C) {
+	parser := new(SpecParser)
+	specText := newSpecBuilder().specHeading("Spec heading").scenarioHeading("Scenario Heading").step("add \"5\"{int} items on <day>{date:02/01/2006}").String()
+
+	tokens, err := parser.GenerateTokens(specText, "")
+
+	c.Assert(err, IsNil)
+	c.Assert(tokens[2].Value, Equals, "add {static:int} items on {dynamic:date:02/01/2006}")
+	c.Assert(tokens[2].Args, DeepEquals, []string{"5", "day"})
+}
+
+func (s *MySuite) TestParsingStepWithUnterminatedParamType(c *C) {
+	parser := new(SpecParser)
+	specText := newSpecBuilder().specHeading("Spec heading").scenarioHeading("Scenario Heading").step("add \"5\"{int items").String()
+
+	_, errs := parser.GenerateTokens(specText, "foo.spec")
+
+	c.Assert(len(errs) > 0, Equals, true)
+	c.Assert(errs[0].Error(), Equals, "foo.spec:3 Parameter type not terminated => 'add \"5\"{int items'")
+}
+
+func (s *MySuite) TestCreateStepWithTypedParams(c *C) {
+	spec, res, err := new(SpecParser).Parse("# Spec\n## Scenario\n* add \"5\"{int} items on \"2021-03-04\"{date} as \"pending\"\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	step := spec.Scenarios[0].Steps[0]
+	c.Assert(step.Value, Equals, "add {} items on {} as {}")
+	c.Assert(step.Args[0].ParamType, Equals, "int")
+	c.Assert(step.Args[1].ParamType, Equals, "date")
+	c.Assert(step.Args[2].ParamType, Equals, "")
+	c.Assert(step.Fragments[1].GetParameter().GetName(), Equals, "")
+}
+
+func (s *MySuite) TestCreateStepWithStaticParamNotOfItsType(c *C) {
+	_, res, _ := new(SpecParser).Parse("# Spec\n## Scenario\n* add \"five\"{int} items of \"a\"{decimal}\n", gauge.NewConceptDictionary(), "foo.spec")
+
+	c.Assert(res.Ok, Equals, false)
+	c.Assert(res.ParseErrors, HasLen, 2)
+	c.Assert(res.ParseErrors[0].Message, Equals, "Static parameter \"five\" is not a valid int")
+	c.Assert(res.ParseErrors[1].Message, Equals, "Parameter type 'decimal' is not supported, it should be one of string, int, float, bool or date:<layout>")
+}
@@ -7,32 +7,299 @@
 package parser
 
 import (
+	"bytes"
 	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/util"
+	"gopkg.in/yaml.v2"
 )
 
+// convertToTable reads the data table of a table: special param, a CSV file unless it is a JSON or YAML file
+func convertToTable(filePath, contents string) (*gauge.Table, error) {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		return convertJSONToTable(filePath, contents)
+	case ".yaml", ".yml":
+		return convertYAMLToTable(filePath, contents)
+	}
+	return convertCsvToTable(contents)
+}
+
+// readTable reads the data table of a table: special param from its file, see convertToTable. A CSV table of more rows
+// than gauge_lazy_table_rows reads its rows from the file as they are needed, see csvRows.
+func readTable(filePath string) (*gauge.Table, error) {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json", ".yaml", ".yml":
+		contents, err := util.GetFileContents(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return convertToTable(filePath, contents)
+	}
+	return readCsvFile(util.GetPathToFile(filePath), env.LazyTableRows())
+}
+
+// convertCsvToTable reads a CSV data table. The first record holds the headers, which should be unique and not blank,
+// and every other record should have a cell per header.
 func convertCsvToTable(csvContents string) (*gauge.Table, error) {
-	r := csv.NewReader(strings.NewReader(csvContents))
-	var de = os.Getenv(env.CsvDelimiter)
-	if de != "" {
-		r.Comma = []rune(os.Getenv(env.CsvDelimiter))[0]
+	table, _, _, err := scanCsvTable(strings.NewReader(csvContents), csvDelimiter(), 0)
+	return table, err
+}
+
+// readCsvFile reads the CSV data table of the file, see convertCsvToTable. A table of more than maxRows rows, when
+// maxRows is more than 0, is not held in memory but read from the file a row at a time.
+func readCsvFile(file string, maxRows int) (*gauge.Table, error) {
+	if !common.FileExists(file) {
+		return nil, fmt.Errorf("File %s doesn't exist.", file)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read the file %s.", file)
+	}
+	defer f.Close()
+	start, err := skipBOM(f)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read the file %s.", file)
+	}
+	comma := csvDelimiter()
+	table, offsets, kept, err := scanCsvTable(f, comma, maxRows)
+	if err != nil || kept {
+		return table, err
+	}
+	for i := range offsets {
+		offsets[i] += start
+	}
+	return gauge.NewLazyTable(table.Headers, &csvRows{file: file, comma: comma, offsets: offsets, columns: len(table.Headers)}, 0), nil
+}
+
+// scanCsvTable reads a CSV data table, see convertCsvToTable, giving the offsets its rows start at. Once the table has
+// more than maxRows rows, when maxRows is more than 0, its rows are no longer kept and the table given has only the
+// headers, the rows being read from the offsets as they are needed.
+func scanCsvTable(in io.Reader, comma rune, maxRows int) (table *gauge.Table, offsets []int64, kept bool, err error) {
+	r := newCsvReader(in, comma)
+	table, kept = new(gauge.Table), true
+	for i := 0; ; i++ {
+		offset := r.InputOffset()
+		line, err := r.Read()
+		if err == io.EOF {
+			return table, offsets, kept, nil
+		}
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if i == 0 {
+			headers, err := csvHeaders(line)
+			if err != nil {
+				return nil, nil, false, err
+			}
+			table.AddHeaders(headers)
+			continue
+		}
+		if len(line) != len(table.Headers) {
+			return nil, nil, false, fmt.Errorf("Row %d of the table has %d cells, its header has %d columns", i, len(line), len(table.Headers))
+		}
+		offsets = append(offsets, offset)
+		if !kept {
+			continue
+		}
+		table.AddRowValues(table.CreateTableCells(line))
+		if maxRows > 0 && table.GetRowCount() > maxRows {
+			table, kept = gauge.NewTable(table.Headers, nil, 0), false
+		}
 	}
+}
+
+func newCsvReader(in io.Reader, comma rune) *csv.Reader {
+	r := csv.NewReader(in)
+	r.Comma = comma
 	r.Comment = '#'
-	lines, err := r.ReadAll()
+	r.FieldsPerRecord = -1
+	return r
+}
+
+func csvDelimiter() rune {
+	if de := os.Getenv(env.CsvDelimiter); de != "" {
+		return []rune(de)[0]
+	}
+	return ','
+}
+
+// skipBOM moves past the byte order mark the file may start with, giving the offset of the text of the file
+func skipBOM(f *os.File) (int64, error) {
+	bom := make([]byte, len(utf8BOM))
+	n, err := io.ReadFull(f, bom)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return 0, err
+	}
+	start := int64(0)
+	if n == len(utf8BOM) && string(bom) == utf8BOM {
+		start = int64(len(utf8BOM))
+	}
+	_, err = f.Seek(start, io.SeekStart)
+	return start, err
+}
+
+const utf8BOM = "\xef\xbb\xbf"
+
+// csvRows reads the rows of a CSV data table from its file as they are needed, each from the offset it was found at
+// when the table was first read
+type csvRows struct {
+	file    string
+	comma   rune
+	offsets []int64
+	columns int
+}
+
+func (rows *csvRows) RowCount() int {
+	return len(rows.offsets)
+}
+
+func (rows *csvRows) Row(index int) ([]gauge.TableCell, error) {
+	if index < 0 || index >= len(rows.offsets) {
+		return nil, fmt.Errorf("Table row %d not found", index)
+	}
+	f, err := os.Open(rows.file)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read row %d of %s. %s", index+1, rows.file, err.Error())
+	}
+	defer f.Close()
+	if _, err = f.Seek(rows.offsets[index], io.SeekStart); err != nil {
+		return nil, fmt.Errorf("Failed to read row %d of %s. %s", index+1, rows.file, err.Error())
+	}
+	line, err := newCsvReader(f, rows.comma).Read()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("Failed to read row %d of %s. %s", index+1, rows.file, err.Error())
+	}
+	if len(line) != rows.columns {
+		return nil, fmt.Errorf("Row %d of %s has %d cells, its header has %d columns. The file changed since it was read", index+1, rows.file, len(line), rows.columns)
+	}
+	return new(gauge.Table).CreateTableCells(line), nil
+}
+
+func csvHeaders(line []string) ([]string, error) {
+	var headers []string
+	for _, h := range line {
+		h = strings.TrimSpace(h)
+		if h == "" {
+			return nil, fmt.Errorf("Table header should not be blank")
+		}
+		if arrayContains(headers, h) {
+			return nil, fmt.Errorf("Table header cannot have repeated column values")
+		}
+		headers = append(headers, h)
+	}
+	return headers, nil
+}
+
+// tableRecord is an object of a JSON or YAML data table, with its keys in the order they are written
+type tableRecord struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// convertJSONToTable reads a JSON data table, an array of objects whose keys are the headers of the table
+func convertJSONToTable(filePath, contents string) (*gauge.Table, error) {
+	var objects []json.RawMessage
+	if err := json.Unmarshal([]byte(contents), &objects); err != nil {
+		return nil, fmt.Errorf("%s should be an array of objects. %s", filePath, err.Error())
+	}
+	var records []*tableRecord
+	for i, o := range objects {
+		dec := json.NewDecoder(bytes.NewReader(o))
+		dec.UseNumber()
+		if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+			return nil, fmt.Errorf("Row %d of %s should be an object", i+1, filePath)
+		}
+		r := &tableRecord{values: make(map[string]interface{})}
+		for dec.More() {
+			k, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			var v interface{}
+			if err := dec.Decode(&v); err != nil {
+				return nil, err
+			}
+			r.keys = append(r.keys, k.(string))
+			r.values[k.(string)] = v
+		}
+		records = append(records, r)
+	}
+	return recordsToTable(filePath, records)
+}
+
+// convertYAMLToTable reads a YAML data table, a sequence of mappings whose keys are the headers of the table
+func convertYAMLToTable(filePath, contents string) (*gauge.Table, error) {
+	var objects []yaml.MapSlice
+	if err := yaml.Unmarshal([]byte(contents), &objects); err != nil {
+		return nil, fmt.Errorf("%s should be a sequence of mappings. %s", filePath, err.Error())
 	}
+	var records []*tableRecord
+	for _, o := range objects {
+		r := &tableRecord{values: make(map[string]interface{})}
+		for _, item := range o {
+			k := fmt.Sprint(item.Key)
+			r.keys = append(r.keys, k)
+			r.values[k] = item.Value
+		}
+		records = append(records, r)
+	}
+	return recordsToTable(filePath, records)
+}
+
+// recordsToTable gives the table of the records, the keys of the first record being the headers. Every record should
+// have the same keys, with text, numbers or booleans as values.
+func recordsToTable(filePath string, records []*tableRecord) (*gauge.Table, error) {
 	table := new(gauge.Table)
-	for i, line := range lines {
-		if i == 0 {
-			table.AddHeaders(line)
-		} else {
-			table.AddRowValues(table.CreateTableCells(line))
+	if len(records) == 0 {
+		return table, nil
+	}
+	headers, err := csvHeaders(records[0].keys)
+	if err != nil {
+		return nil, fmt.Errorf("%s in %s", err.Error(), filePath)
+	}
+	table.AddHeaders(headers)
+	for i, r := range records {
+		if !sameKeys(r.keys, records[0].keys) {
+			return nil, fmt.Errorf("Row %d of %s has the keys %s, the first row has the keys %s", i+1, filePath, strings.Join(r.keys, ", "), strings.Join(records[0].keys, ", "))
 		}
+		var row []string
+		for _, k := range records[0].keys {
+			switch v := r.values[k].(type) {
+			case nil:
+				row = append(row, "")
+			case map[string]interface{}, map[interface{}]interface{}, yaml.MapSlice, []interface{}:
+				return nil, fmt.Errorf("Value of '%s' in row %d of %s should be text, a number or a boolean", k, i+1, filePath)
+			default:
+				row = append(row, fmt.Sprint(v))
+			}
+		}
+		table.AddRowValues(table.CreateTableCells(row))
 	}
 	return table, nil
 }
+
+func sameKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]string{}, a...), append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -7,6 +7,13 @@
 package parser
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
 	. "gopkg.in/check.v1"
 )
 
@@ -23,3 +30,155 @@ func (s *MySuite) TestIgnoreCommentLines(c *C) {
 	c.Assert(table.Rows()[0][1], Equals, "bar")
 	c.Assert(table.Rows()[0][2], Equals, "baz")
 }
+
+func (s *MySuite) TestCsvRowsShouldHaveACellPerHeader(c *C) {
+	_, err := convertCsvToTable("id,name\n1,foo\n2\n")
+
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "Row 2 of the table has 1 cells, its header has 2 columns")
+}
+
+func (s *MySuite) TestCsvHeadersShouldBeUniqueAndNotBlank(c *C) {
+	_, err := convertCsvToTable("id, ,name\n1,2,3\n")
+	c.Assert(err.Error(), Equals, "Table header should not be blank")
+
+	_, err = convertCsvToTable("id,name, id\n1,2,3\n")
+	c.Assert(err.Error(), Equals, "Table header cannot have repeated column values")
+}
+
+func (s *MySuite) TestCsvHeadersAreTrimmed(c *C) {
+	table, err := convertCsvToTable("id, name\n1, foo\n")
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"id", "name"})
+	c.Assert(table.Rows()[0][1], Equals, " foo")
+}
+
+func (s *MySuite) TestConvertJSONToTable(c *C) {
+	table, err := convertJSONToTable("users.json", `[{"id": 1, "name": "foo", "admin": true}, {"name": "bar", "id": 2.50, "admin": null}]`)
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"id", "name", "admin"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"1", "foo", "true"}, {"2.50", "bar", ""}})
+}
+
+func (s *MySuite) TestConvertYAMLToTable(c *C) {
+	table, err := convertYAMLToTable("users.yaml", "- id: 1\n  name: foo\n- name: bar\n  id: 2\n")
+
+	c.Assert(err, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"id", "name"})
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"1", "foo"}, {"2", "bar"}})
+}
+
+func (s *MySuite) TestRowsOfJSONAndYAMLTablesShouldHaveTheSameKeys(c *C) {
+	_, err := convertJSONToTable("users.json", `[{"id": 1, "name": "foo"}, {"id": 2, "title": "bar"}]`)
+	c.Assert(err.Error(), Equals, "Row 2 of users.json has the keys id, title, the first row has the keys id, name")
+
+	_, err = convertYAMLToTable("users.yaml", "- id: 1\n  name: foo\n- id: 2\n")
+	c.Assert(err.Error(), Equals, "Row 2 of users.yaml has the keys id, the first row has the keys id, name")
+}
+
+func (s *MySuite) TestJSONTablesShouldBeArraysOfFlatObjects(c *C) {
+	_, err := convertJSONToTable("users.json", `{"id": 1}`)
+	c.Assert(err, ErrorMatches, "users.json should be an array of objects. .*")
+
+	_, err = convertJSONToTable("users.json", `[{"id": 1}, 2]`)
+	c.Assert(err.Error(), Equals, "Row 2 of users.json should be an object")
+
+	_, err = convertJSONToTable("users.json", `[{"id": 1, "roles": ["admin"]}]`)
+	c.Assert(err.Error(), Equals, "Value of 'roles' in row 1 of users.json should be text, a number or a boolean")
+}
+
+func (s *MySuite) TestSpecialTableParamReadsJSONAndYAMLFiles(c *C) {
+	for _, file := range []string{"testdata/data.json", "testdata/data.yaml"} {
+		arg, err := newSpecialTypeResolver().resolve("table:" + file)
+
+		c.Assert(err, IsNil)
+		c.Assert(arg.Table.Headers, DeepEquals, []string{"Word", "Vowel Count"})
+		c.Assert(arg.Table.GetRowCount(), Equals, 2)
+	}
+}
+
+func writeCsvFile(c *C, text string) string {
+	dir, err := ioutil.TempDir("", "gauge-csv")
+	c.Assert(err, IsNil)
+	file := filepath.Join(dir, "users.csv")
+	c.Assert(ioutil.WriteFile(file, []byte(text), common.NewFilePermissions), IsNil)
+	return file
+}
+
+func (s *MySuite) TestCsvFileOfMoreRowsThanTheLimitIsReadAsItsRowsAreNeeded(c *C) {
+	file := writeCsvFile(c, "\xef\xbb\xbfid,name\n1,alice\n# a comment\n2,\"bob\nsmith\"\n\n3,carol\n")
+	defer os.RemoveAll(filepath.Dir(file))
+
+	table, err := readCsvFile(file, 2)
+
+	c.Assert(err, IsNil)
+	c.Assert(table.IsLazy(), Equals, true)
+	c.Assert(table.Columns, IsNil)
+	c.Assert(table.Headers, DeepEquals, []string{"id", "name"})
+	c.Assert(table.GetRowCount(), Equals, 3)
+	row, err := table.Row(1)
+	c.Assert(err, IsNil)
+	c.Assert(row, DeepEquals, []gauge.TableCell{{Value: "2", CellType: gauge.Static}, {Value: "bob\nsmith", CellType: gauge.Static}})
+	inMemory, err := readCsvFile(file, 0)
+	c.Assert(err, IsNil)
+	c.Assert(inMemory.IsLazy(), Equals, false)
+	c.Assert(table.Rows(), DeepEquals, inMemory.Rows())
+}
+
+func (s *MySuite) TestCsvFileUpToTheLimitIsHeldInMemory(c *C) {
+	file := writeCsvFile(c, "id,name\n1,alice\n2,bob\n")
+	defer os.RemoveAll(filepath.Dir(file))
+
+	table, err := readCsvFile(file, 2)
+
+	c.Assert(err, IsNil)
+	c.Assert(table.IsLazy(), Equals, false)
+	c.Assert(table.Rows(), DeepEquals, [][]string{{"1", "alice"}, {"2", "bob"}})
+}
+
+func (s *MySuite) TestRowOfACsvFileChangedSinceItWasReadIsAnError(c *C) {
+	file := writeCsvFile(c, "id,name\n1,alice\n2,bob\n")
+	defer os.RemoveAll(filepath.Dir(file))
+	table, err := readCsvFile(file, 1)
+	c.Assert(err, IsNil)
+	c.Assert(ioutil.WriteFile(file, []byte("id,name\n1,alice,admin\n"), common.NewFilePermissions), IsNil)
+
+	_, err = table.Row(0)
+
+	c.Assert(err, ErrorMatches, "Row 1 of .*users.csv has 3 cells, its header has 2 columns. The file changed since it was read")
+	_, err = table.Row(1)
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestCsvFileRowsShouldHaveACellPerHeader(c *C) {
+	file := writeCsvFile(c, "id,name\n1,foo\n2\n")
+	defer os.RemoveAll(filepath.Dir(file))
+
+	_, err := readCsvFile(file, 1)
+
+	c.Assert(err, ErrorMatches, "Row 2 of the table has 1 cells, its header has 2 columns")
+}
+
+func (s *MySuite) TestSpecsOfTheRowsOfALargeCsvDataTableReadTheirRowWhenExecuted(c *C) {
+	old := env.LazyTableRows
+	env.LazyTableRows = func() int { return 1 }
+	defer func() { env.LazyTableRows = old }()
+	file := writeCsvFile(c, "id,name\n1,alice\n2,bob\n3,carol\n")
+	defer os.RemoveAll(filepath.Dir(file))
+	text := "# Users\n\ntable: " + file + "\n\n## Greet\n* Greet <name>\n"
+	spec, res, err := new(SpecParser).Parse(text, gauge.NewConceptDictionary(), "users.spec")
+	c.Assert(err, IsNil)
+	c.Assert(res.Ok, Equals, true)
+	c.Assert(spec.DataTable.Table.IsLazy(), Equals, true)
+
+	specs := GetSpecsForDataTableRows([]*gauge.Specification{spec}, gauge.NewBuildErrors())
+
+	c.Assert(specs, HasLen, 3)
+	lookup := new(gauge.ArgLookup)
+	c.Assert(lookup.ReadDataTableRow(specs[2].DataTable.Table, 0), IsNil)
+	name, _ := lookup.GetArg("name")
+	c.Assert(name.Value, Equals, "carol")
+	c.Assert(specs[2].Scenarios[0].DataTableRowName(nil), Equals, "row 3 (id: 3)")
+}
@@ -0,0 +1,75 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/tagRegistry"
+	"github.com/getgauge/gauge/util"
+)
+
+func applyTagRulesFromRegistry(specs []*gauge.Specification) {
+	r, err := tagRegistry.Load(config.ProjectRoot)
+	if err != nil {
+		logger.Warningf(true, err.Error())
+		return
+	}
+	applyTagRules(specs, r)
+}
+
+// applyTagRules adds the tags derived from the rules of the tag registry to the specs and scenarios
+func applyTagRules(specs []*gauge.Specification, r *tagRegistry.Registry) {
+	if r == nil || len(r.Rules) == 0 {
+		return
+	}
+	for _, spec := range specs {
+		if spec == nil {
+			continue
+		}
+		path := util.RelPathToProjectRoot(spec.FileName)
+		if spec.Heading != nil {
+			spec.Tags = addTags(spec.Tags, r.SpecTags(path, spec.Heading.Value))
+		}
+		for _, scn := range spec.Scenarios {
+			if scn.Heading != nil {
+				scn.Tags = addTags(scn.Tags, r.ScenarioTags(path, scn.Heading.Value))
+			}
+		}
+	}
+}
+
+func addTags(tags *gauge.Tags, values []string) *gauge.Tags {
+	var missing []string
+	for _, v := range values {
+		if !hasTag(tags, v) && !contains(missing, v) {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) == 0 {
+		return tags
+	}
+	if tags == nil {
+		tags = &gauge.Tags{}
+	}
+	tags.Add(missing)
+	return tags
+}
+
+func hasTag(tags *gauge.Tags, tag string) bool {
+	return tags != nil && contains(tags.Values(), tag)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
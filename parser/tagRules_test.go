@@ -0,0 +1,40 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package parser
+
+import (
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/tagRegistry"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestApplyTagRules(c *C) {
+	r, err := tagRegistry.Parse([]byte(`
+rules:
+  - path: specs/api/
+    tags: [api]
+  - heading: (?i)checkout
+    tags: [checkout]
+  - path: specs/**/slow_*.spec
+    heading: Report
+    tags: [slow, api]
+`))
+	c.Assert(err, IsNil)
+	checkout := &gauge.Scenario{Heading: &gauge.Heading{Value: "Checkout with card"}, Tags: &gauge.Tags{RawValues: [][]string{{"smoke"}}}}
+	other := &gauge.Scenario{Heading: &gauge.Heading{Value: "Search"}}
+	apiSpec := &gauge.Specification{FileName: "specs/api/orders.spec", Heading: &gauge.Heading{Value: "Orders"}, Scenarios: []*gauge.Scenario{checkout, other}}
+	slowSpec := &gauge.Specification{FileName: "specs/reports/slow_sales.spec", Heading: &gauge.Heading{Value: "Report of sales"}, Tags: &gauge.Tags{RawValues: [][]string{{"api"}}}}
+	untagged := &gauge.Specification{FileName: "specs/ui/home.spec", Heading: &gauge.Heading{Value: "Home"}}
+
+	applyTagRules([]*gauge.Specification{apiSpec, slowSpec, untagged, nil}, r)
+
+	c.Assert(apiSpec.Tags.Values(), DeepEquals, []string{"api"})
+	c.Assert(checkout.Tags.Values(), DeepEquals, []string{"smoke", "checkout"})
+	c.Assert(other.Tags, IsNil)
+	c.Assert(slowSpec.Tags.Values(), DeepEquals, []string{"api", "slow"})
+	c.Assert(untagged.Tags, IsNil)
+}
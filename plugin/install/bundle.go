@@ -0,0 +1,177 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package install
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/manifest"
+	"github.com/getgauge/gauge/track"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/version"
+)
+
+const bundleManifestFile = "bundle.json"
+
+// bundleManifest describes the plugins of a bundle and the platform they were downloaded for
+type bundleManifest struct {
+	OS      string          `json:"os"`
+	Arch    string          `json:"arch"`
+	Plugins []bundledPlugin `json:"plugins"`
+}
+
+type bundledPlugin struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	File    string `json:"file"`
+}
+
+// CreateBundle downloads the latest compatible versions of the language runner and plugins of the manifest for
+// the OS and architecture, like linux and amd64, and packages them into one archive, which InstallFromBundle
+// installs without network access.
+func CreateBundle(m *manifest.Manifest, goos, arch, archive string) error {
+	tempDir := common.GetTempDir()
+	defer func() {
+		if err := common.Remove(tempDir); err != nil {
+			logger.Errorf(false, "unable to remove temp directory: %s", err.Error())
+		}
+	}()
+	b := bundleManifest{OS: goos, Arch: arch}
+	for _, name := range manifestPlugins(m) {
+		desc, result := getInstallDescription(name, true)
+		if !result.Success {
+			return fmt.Errorf("Failed to get details of plugin %s. %s", name, result.getMessage())
+		}
+		versionDesc, err := desc.getLatestCompatibleVersionTo(version.CurrentGaugeVersion)
+		if err != nil {
+			return fmt.Errorf("Could not find compatible version for plugin %s. : %s", name, err.Error())
+		}
+		downloadLink, err := getDownloadLinkFor(versionDesc.DownloadUrls, goos, arch)
+		if err != nil {
+			return fmt.Errorf("Could not get download link of plugin %s: %s", name, err.Error())
+		}
+		logger.Infof(true, "Downloading plugin %s %s for %s/%s", name, versionDesc.Version, goos, arch)
+		pluginZip, err := util.Download(downloadLink, tempDir, "", true)
+		if err != nil {
+			return fmt.Errorf("Failed to download plugin %s. %s", name, err.Error())
+		}
+		b.Plugins = append(b.Plugins, bundledPlugin{Name: name, Version: versionDesc.Version, File: filepath.Base(pluginZip)})
+	}
+	return writeBundle(b, tempDir, archive)
+}
+
+// InstallFromBundle installs the plugins of a bundle made by CreateBundle. Plugins already installed are skipped.
+func InstallFromBundle(bundle string) error {
+	tempDir := common.GetTempDir()
+	defer func() {
+		if err := common.Remove(tempDir); err != nil {
+			logger.Errorf(false, "unable to remove temp directory: %s", err.Error())
+		}
+	}()
+	dir, err := common.UnzipArchive(bundle, tempDir)
+	if err != nil {
+		return fmt.Errorf("Failed to unzip bundle %s. %s", bundle, err.Error())
+	}
+	b, err := readBundleManifest(dir)
+	if err != nil {
+		return fmt.Errorf("%s is not a valid plugin bundle. %s", bundle, err.Error())
+	}
+	if b.OS != runtime.GOOS || b.Arch != runtime.GOARCH {
+		return fmt.Errorf("Bundle %s was created for %s/%s and can not be installed on %s/%s", bundle, b.OS, b.Arch, runtime.GOOS, runtime.GOARCH)
+	}
+	var failed []string
+	for _, p := range b.Plugins {
+		if common.IsPluginInstalled(p.Name, p.Version) {
+			logger.Infof(true, "Plugin %s %s is already installed.", p.Name, p.Version)
+			continue
+		}
+		result := InstallPluginFromZipFile(filepath.Join(dir, p.File), p.Name)
+		if result.Success {
+			track.Install(p.Name, p.Version)
+		}
+		if !HandleInstallResult(result, p.Name, false) {
+			failed = append(failed, p.Name)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("Failed to install plugins '%s' from bundle %s", strings.Join(failed, ", "), bundle)
+	}
+	return nil
+}
+
+func manifestPlugins(m *manifest.Manifest) []string {
+	var names []string
+	if m.Language != "" {
+		names = append(names, m.Language)
+	}
+	plugins := append([]string{}, m.Plugins...)
+	sort.Strings(plugins)
+	return append(names, plugins...)
+}
+
+func readBundleManifest(dir string) (*bundleManifest, error) {
+	contents, err := common.ReadFileContents(filepath.Join(dir, bundleManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	var b bundleManifest
+	if err := json.Unmarshal([]byte(contents), &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// writeBundle writes the bundle manifest and the plugin zips it lists, which are in dir, to the archive
+func writeBundle(b bundleManifest, dir, archive string) error {
+	f, err := os.Create(archive)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	contents, err := json.MarshalIndent(b, "", "\t")
+	if err != nil {
+		return err
+	}
+	mw, err := w.Create(bundleManifestFile)
+	if err != nil {
+		return err
+	}
+	if _, err := mw.Write(contents); err != nil {
+		return err
+	}
+	for _, p := range b.Plugins {
+		if err := addBundleFile(w, filepath.Join(dir, p.File)); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+func addBundleFile(w *zip.Writer, path string) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := w.Create(filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	return err
+}
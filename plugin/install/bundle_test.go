@@ -0,0 +1,72 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package install
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/manifest"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestWriteAndReadBundle(c *C) {
+	dir, err := ioutil.TempDir("", "gauge-bundle")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	err = ioutil.WriteFile(filepath.Join(dir, "java-0.7.0-linux.x86_64.zip"), []byte("java"), 0644)
+	c.Assert(err, IsNil)
+	b := bundleManifest{OS: "linux", Arch: "amd64", Plugins: []bundledPlugin{{Name: "java", Version: "0.7.0", File: "java-0.7.0-linux.x86_64.zip"}}}
+	archive := filepath.Join(dir, "bundle.zip")
+
+	c.Assert(writeBundle(b, dir, archive), IsNil)
+
+	unzipped, err := common.UnzipArchive(archive, filepath.Join(dir, "unzipped"))
+	c.Assert(err, IsNil)
+	read, err := readBundleManifest(unzipped)
+	c.Assert(err, IsNil)
+	c.Assert(*read, DeepEquals, b)
+	c.Assert(common.FileExists(filepath.Join(unzipped, "java-0.7.0-linux.x86_64.zip")), Equals, true)
+}
+
+func (s *MySuite) TestInstallFromBundleOfAnotherPlatform(c *C) {
+	dir, err := ioutil.TempDir("", "gauge-bundle")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	archive := filepath.Join(dir, "bundle.zip")
+	c.Assert(writeBundle(bundleManifest{OS: "plan9", Arch: "mips"}, dir, archive), IsNil)
+
+	err = InstallFromBundle(archive)
+
+	c.Assert(err, ErrorMatches, ".*was created for plan9/mips.*")
+}
+
+func (s *MySuite) TestGetDownloadLinkForPlatform(c *C) {
+	urls := downloadUrls{
+		X64:   platformSpecificURL{Linux: "linux.x86_64.zip", Windows: "windows.x86_64.zip"},
+		ARM64: platformSpecificURL{Linux: "linux.arm64.zip", Darwin: "darwin.arm64.zip"},
+	}
+
+	link, err := getDownloadLinkFor(urls, "windows", "amd64")
+	c.Assert(err, IsNil)
+	c.Assert(link, Equals, "windows.x86_64.zip")
+
+	link, err = getDownloadLinkFor(urls, "darwin", "arm64")
+	c.Assert(err, IsNil)
+	c.Assert(link, Equals, "darwin.arm64.zip")
+
+	_, err = getDownloadLinkFor(urls, "darwin", "amd64")
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestManifestPluginsStartWithTheLanguage(c *C) {
+	m := &manifest.Manifest{Language: "java", Plugins: []string{"xml-report", "html-report"}}
+
+	c.Assert(manifestPlugins(m), DeepEquals, []string{"java", "html-report", "xml-report"})
+}
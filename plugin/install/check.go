@@ -7,6 +7,7 @@
 package install
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -14,12 +15,17 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/plugin/pluginInfo"
+	"github.com/getgauge/gauge/util"
 	"github.com/getgauge/gauge/version"
 )
 
-const gauge_releases_url = "https://github.com/getgauge/gauge/releases"
+const (
+	gauge_releases_url     = "https://github.com/getgauge/gauge/releases"
+	gauge_releases_api_url = "https://api.github.com/repos/getgauge/gauge/releases"
+)
 
 type UpdateFacade struct {
 	wg    *sync.WaitGroup
@@ -42,9 +48,11 @@ func (u *UpdateFacade) PrintUpdateBuffer() {
 func PrintUpdateInfoWithDetails() {
 	updates := checkUpdates()
 	if len(updates) > 0 {
+		t := &util.ConsoleTable{}
 		for _, update := range updates {
-			logger.Infof(true, fmt.Sprintf("%-10s\t\t%-10s\t%s", update.Name, update.CompatibleVersion, update.Message))
+			t.Rows = append(t.Rows, []string{update.Name, update.CompatibleVersion, update.Message})
 		}
+		logger.Info(true, strings.TrimSuffix(t.String(), "\n"))
 	} else {
 		logger.Infof(true, "No Updates available.")
 	}
@@ -86,6 +94,13 @@ func waitToPrint(messageChan chan string, printChan chan bool, message string, w
 
 func checkGaugeUpdate() []UpdateInfo {
 	var updateInfos []UpdateInfo
+	if channel := config.UpdateChannel(); channel != version.Stable {
+		v, err := getLatestGaugeRelease(gauge_releases_api_url, channel)
+		if err != nil || !version.IsNewerRelease(v, version.FullVersion()) {
+			return updateInfos
+		}
+		return append(updateInfos, UpdateInfo{"Gauge", v, fmt.Sprintf("Download the installer from %s/tag/v%s", gauge_releases_url, v)})
+	}
 	v, err := getLatestGaugeVersion(gauge_releases_url + "/latest")
 	if err != nil {
 		return updateInfos
@@ -126,24 +141,58 @@ func checkPluginUpdates() []UpdateInfo {
 
 func createPluginUpdateDetail(currentVersion string, latestVersionDetails installDescription) []UpdateInfo {
 	var updateInfo []UpdateInfo
-	v, err := version.ParseVersion(currentVersion)
-	if err != nil {
+	if _, _, err := version.SplitPreRelease(currentVersion); err != nil {
 		return updateInfo
 	}
-	v1, err := version.ParseVersion(latestVersionDetails.Versions[0].Version)
+	versionDesc, err := latestVersionDetails.getLatestCompatibleVersionTo(version.CurrentGaugeVersion)
 	if err != nil {
 		return updateInfo
 	}
-	if v.IsLesserThan(v1) {
-		versionDesc, err := latestVersionDetails.getLatestCompatibleVersionTo(version.CurrentGaugeVersion)
-		if err != nil {
-			return updateInfo
-		}
+	if version.IsNewerRelease(versionDesc.Version, currentVersion) {
 		updateInfo = append(updateInfo, UpdateInfo{latestVersionDetails.Name, versionDesc.Version, fmt.Sprintf("Run 'gauge update %s'", latestVersionDetails.Name)})
 	}
 	return updateInfo
 }
 
+type gaugeRelease struct {
+	TagName string `json:"tag_name"`
+	Draft   bool   `json:"draft"`
+}
+
+// getLatestGaugeRelease gives the latest gauge release on the channel, pre-releases included
+var getLatestGaugeRelease = func(url, channel string) (string, error) {
+	res, err := http.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get gauge releases from %s: %s", url, res.Status)
+	}
+	var releases []gaugeRelease
+	if err := json.NewDecoder(res.Body).Decode(&releases); err != nil {
+		return "", err
+	}
+	return latestReleaseInChannel(releases, channel)
+}
+
+func latestReleaseInChannel(releases []gaugeRelease, channel string) (string, error) {
+	latest := ""
+	for _, r := range releases {
+		v := strings.TrimPrefix(r.TagName, "v")
+		if r.Draft || !version.InChannel(v, channel) {
+			continue
+		}
+		if latest == "" || version.IsNewerRelease(v, latest) {
+			latest = v
+		}
+	}
+	if latest == "" {
+		return "", fmt.Errorf("no gauge release found on the %s channel", channel)
+	}
+	return latest, nil
+}
+
 var getLatestGaugeVersion = func(url string) (string, error) {
 	res, err := http.Get(url)
 	if err != nil {
@@ -67,3 +67,30 @@ func (s *MySuite) TestCreatePluginUpdateDetailForNightly(c *C) {
 	updateDetails := createPluginUpdateDetail("0.1.0", i)
 	c.Assert(len(updateDetails), Equals, 0)
 }
+
+func (s *MySuite) TestCreatePluginUpdateDetailSkipsPreReleasesOnStableChannel(c *C) {
+	version.CurrentGaugeVersion = &version.Version{Major: 0, Minor: 1, Patch: 1}
+	support := version.VersionSupport{Minimum: "0.1.0", Maximum: "0.1.2"}
+	i := installDescription{Name: "ruby", Versions: []versionInstallDescription{
+		{Version: "0.2.0-beta.1", GaugeVersionSupport: support},
+		{Version: "0.1.1", GaugeVersionSupport: support},
+	}}
+	updateDetails := createPluginUpdateDetail("0.1.0", i)
+	c.Assert(len(updateDetails), Equals, 1)
+	c.Assert(updateDetails[0].CompatibleVersion, Equals, "0.1.1")
+}
+
+func (s *MySuite) TestLatestReleaseInChannel(c *C) {
+	releases := []gaugeRelease{{TagName: "v1.1.0"}, {TagName: "v1.2.0-beta.1"}, {TagName: "v1.2.0-beta.2", Draft: true}, {TagName: "v1.0.9"}}
+
+	v, err := latestReleaseInChannel(releases, version.Beta)
+	c.Assert(err, Equals, nil)
+	c.Assert(v, Equals, "1.2.0-beta.1")
+
+	v, err = latestReleaseInChannel(releases, version.Stable)
+	c.Assert(err, Equals, nil)
+	c.Assert(v, Equals, "1.1.0")
+
+	_, err = latestReleaseInChannel(nil, version.Stable)
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,130 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package install
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/plugin"
+	"github.com/getgauge/gauge/plugin/pluginInfo"
+	"github.com/getgauge/gauge/version"
+)
+
+// PluginCompatibility tells if an installed plugin works with the installed gauge, and the version of the plugin
+// to upgrade to when it does not
+type PluginCompatibility struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Compatible bool   `json:"compatible"`
+	Reason     string `json:"reason,omitempty"`
+	Upgrade    string `json:"upgrade,omitempty"`
+}
+
+// CompatibilityReport tells if the installed gauge and plugins work together. GaugeUpgrade is the lowest gauge
+// version which the plugins that have no compatible version for the installed gauge need.
+type CompatibilityReport struct {
+	GaugeVersion string                `json:"gaugeVersion"`
+	Plugins      []PluginCompatibility `json:"plugins"`
+	GaugeUpgrade string                `json:"gaugeUpgrade,omitempty"`
+}
+
+// Compatible tells if all the plugins work with the installed gauge
+func (r *CompatibilityReport) Compatible() bool {
+	for _, p := range r.Plugins {
+		if !p.Compatible {
+			return false
+		}
+	}
+	return true
+}
+
+// Upgrades gives the upgrades which make the plugins work with gauge, upgrading as few of them as possible
+func (r *CompatibilityReport) Upgrades() []string {
+	var upgrades []string
+	if r.GaugeUpgrade != "" {
+		upgrades = append(upgrades, fmt.Sprintf("Upgrade gauge to %s or later. Download the installer from https://gauge.org/get-started/", r.GaugeUpgrade))
+	}
+	for _, p := range r.Plugins {
+		if p.Upgrade != "" {
+			upgrades = append(upgrades, fmt.Sprintf("Upgrade %s to %s with 'gauge update %s'", p.Name, p.Upgrade, p.Name))
+		}
+	}
+	return upgrades
+}
+
+// getPluginInstallDescription gets the versions of the plugin from the plugin repository
+var getPluginInstallDescription = func(name string) (*installDescription, error) {
+	desc, result := getInstallDescription(name, true)
+	if !result.Success {
+		return nil, result.Error
+	}
+	return desc, nil
+}
+
+// CheckCompatibility checks the installed plugins against the installed gauge. The plugin repository is queried
+// for upgrades of the plugins which do not support it.
+func CheckCompatibility() (*CompatibilityReport, error) {
+	plugins, err := pluginInfo.GetAllInstalledPluginsWithVersion()
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching plugins info: %s", err.Error())
+	}
+	report := &CompatibilityReport{GaugeVersion: version.CurrentGaugeVersion.String(), Plugins: make([]PluginCompatibility, 0)}
+	var gaugeUpgrade *version.Version
+	for _, p := range plugins {
+		pd, err := plugin.GetPluginDescriptor(p.Name, filepath.Base(p.Path))
+		if err != nil {
+			report.Plugins = append(report.Plugins, PluginCompatibility{Name: p.Name, Version: filepath.Base(p.Path), Reason: err.Error()})
+			continue
+		}
+		c, required := checkPluginCompatibility(pd.ID, pd.Version, pd.GaugeVersionSupport)
+		if required != nil && (gaugeUpgrade == nil || required.IsGreaterThan(gaugeUpgrade)) {
+			gaugeUpgrade = required
+		}
+		report.Plugins = append(report.Plugins, c)
+	}
+	if gaugeUpgrade != nil {
+		report.GaugeUpgrade = gaugeUpgrade.String()
+	}
+	return report, nil
+}
+
+// checkPluginCompatibility checks a plugin against the installed gauge. When it is not compatible, it gives the
+// version of the plugin to upgrade to or, if there is none, the gauge version the plugin needs.
+func checkPluginCompatibility(name, pluginVersion string, support version.VersionSupport) (PluginCompatibility, *version.Version) {
+	c := PluginCompatibility{Name: name, Version: pluginVersion, Compatible: true}
+	err := version.CheckCompatibility(version.CurrentGaugeVersion, &support)
+	if err == nil {
+		return c, nil
+	}
+	c.Compatible = false
+	c.Reason = fmt.Sprintf("supports gauge %s", supportedRange(support))
+	desc, err := getPluginInstallDescription(name)
+	if err != nil {
+		logger.Debugf(true, "Unable to get versions of plugin %s. %s", name, err.Error())
+		c.Reason += ", unable to look up its upgrades"
+	} else if v, err := desc.getLatestCompatibleVersionTo(version.CurrentGaugeVersion); err == nil && version.IsNewerRelease(v.Version, pluginVersion) {
+		c.Upgrade = v.Version
+		return c, nil
+	}
+	minimum, err := version.ParseVersion(support.Minimum)
+	if err == nil && version.CurrentGaugeVersion.IsLesserThan(minimum) {
+		return c, minimum
+	}
+	if desc != nil {
+		c.Reason += fmt.Sprintf(", no version of it supports gauge %s", version.CurrentGaugeVersion)
+	}
+	return c, nil
+}
+
+func supportedRange(support version.VersionSupport) string {
+	if support.Maximum == "" {
+		return fmt.Sprintf("%s or later", support.Minimum)
+	}
+	return fmt.Sprintf("%s to %s", support.Minimum, support.Maximum)
+}
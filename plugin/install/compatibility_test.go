@@ -0,0 +1,87 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package install
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/version"
+	. "gopkg.in/check.v1"
+)
+
+func stubPluginInstallDescription(versions ...versionInstallDescription) func() {
+	old := getPluginInstallDescription
+	getPluginInstallDescription = func(name string) (*installDescription, error) {
+		if versions == nil {
+			return nil, fmt.Errorf("network issue")
+		}
+		return &installDescription{Name: name, Versions: versions}, nil
+	}
+	return func() { getPluginInstallDescription = old }
+}
+
+func (s *MySuite) TestCompatiblePlugin(c *C) {
+	version.CurrentGaugeVersion = &version.Version{Major: 1, Minor: 2, Patch: 0}
+
+	p, required := checkPluginCompatibility("java", "0.7.0", version.VersionSupport{Minimum: "1.0.0"})
+
+	c.Assert(p.Compatible, Equals, true)
+	c.Assert(required, IsNil)
+}
+
+func (s *MySuite) TestIncompatiblePluginWithAnUpgrade(c *C) {
+	version.CurrentGaugeVersion = &version.Version{Major: 1, Minor: 2, Patch: 0}
+	defer stubPluginInstallDescription(
+		versionInstallDescription{Version: "0.8.0", GaugeVersionSupport: version.VersionSupport{Minimum: "1.1.0"}},
+		versionInstallDescription{Version: "0.7.0", GaugeVersionSupport: version.VersionSupport{Minimum: "1.0.0", Maximum: "1.1.0"}},
+	)()
+
+	p, required := checkPluginCompatibility("java", "0.7.0", version.VersionSupport{Minimum: "1.0.0", Maximum: "1.1.0"})
+
+	c.Assert(p.Compatible, Equals, false)
+	c.Assert(p.Upgrade, Equals, "0.8.0")
+	c.Assert(p.Reason, Equals, "supports gauge 1.0.0 to 1.1.0")
+	c.Assert(required, IsNil)
+}
+
+func (s *MySuite) TestIncompatiblePluginNeedingANewerGauge(c *C) {
+	version.CurrentGaugeVersion = &version.Version{Major: 1, Minor: 2, Patch: 0}
+	defer stubPluginInstallDescription(
+		versionInstallDescription{Version: "4.0.0", GaugeVersionSupport: version.VersionSupport{Minimum: "1.3.0"}},
+	)()
+
+	p, required := checkPluginCompatibility("html-report", "4.0.0", version.VersionSupport{Minimum: "1.3.0"})
+
+	c.Assert(p.Compatible, Equals, false)
+	c.Assert(p.Upgrade, Equals, "")
+	c.Assert(required.String(), Equals, "1.3.0")
+}
+
+func (s *MySuite) TestIncompatiblePluginWhenRepositoryIsUnreachable(c *C) {
+	version.CurrentGaugeVersion = &version.Version{Major: 1, Minor: 2, Patch: 0}
+	defer stubPluginInstallDescription()()
+
+	p, required := checkPluginCompatibility("java", "0.7.0", version.VersionSupport{Minimum: "1.0.0", Maximum: "1.1.0"})
+
+	c.Assert(p.Compatible, Equals, false)
+	c.Assert(p.Reason, Equals, "supports gauge 1.0.0 to 1.1.0, unable to look up its upgrades")
+	c.Assert(required, IsNil)
+}
+
+func (s *MySuite) TestCompatibilityReportUpgrades(c *C) {
+	r := &CompatibilityReport{GaugeVersion: "1.2.0", GaugeUpgrade: "1.3.0", Plugins: []PluginCompatibility{
+		{Name: "java", Version: "0.7.0", Upgrade: "0.8.0"},
+		{Name: "html-report", Version: "4.0.0"},
+		{Name: "xml-report", Version: "0.2.0", Compatible: true},
+	}}
+
+	c.Assert(r.Compatible(), Equals, false)
+	c.Assert(r.Upgrades(), DeepEquals, []string{
+		"Upgrade gauge to 1.3.0 or later. Download the installer from https://gauge.org/get-started/",
+		"Upgrade java to 0.8.0 with 'gauge update java'",
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/getgauge/gauge/plugin"
 	"github.com/getgauge/gauge/plugin/pluginInfo"
 	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/track"
 	"github.com/getgauge/gauge/util"
 	"github.com/getgauge/gauge/version"
 )
@@ -283,6 +284,9 @@ func installPluginVersion(installDesc *installDescription, versionInstallDescrip
 	}
 	res := InstallPluginFromZipFile(pluginZip, installDesc.Name)
 	res.Version = versionInstallDescription.Version
+	if res.Success {
+		track.Install(installDesc.Name, res.Version)
+	}
 	return res
 }
 
@@ -376,8 +380,12 @@ func uninstallVersionOfPlugin(pluginDir, pluginName, uninstallVersion string) er
 }
 
 func getDownloadLink(downloadUrls downloadUrls) (string, error) {
+	return getDownloadLinkFor(downloadUrls, runtime.GOOS, getGoArch())
+}
+
+func getDownloadLinkFor(downloadUrls downloadUrls, goos, arch string) (string, error) {
 	var platformLinks *platformSpecificURL
-	switch getGoArch() {
+	switch arch {
 	case arm64:
 		if downloadUrls.ARM64.Linux == "" {
 			logger.Info(true, "Download URL for 'arm64' architecture is not set for this plugin. Falling back to 'x86_64', but this may cause issues.")
@@ -392,7 +400,7 @@ func getDownloadLink(downloadUrls downloadUrls) (string, error) {
 	}
 
 	var downloadLink string
-	switch runtime.GOOS {
+	switch goos {
 	case "windows":
 		downloadLink = platformLinks.Windows
 	case "darwin":
@@ -401,7 +409,7 @@ func getDownloadLink(downloadUrls downloadUrls) (string, error) {
 		downloadLink = platformLinks.Linux
 	}
 	if downloadLink == "" {
-		return "", fmt.Errorf("Platform not supported for %s. Download URL not specified.", runtime.GOOS)
+		return "", fmt.Errorf("Platform not supported for %s. Download URL not specified.", goos)
 	}
 	return downloadLink, nil
 }
@@ -462,9 +470,15 @@ func (installDesc *installDescription) getVersion(version string) (*versionInsta
 	return nil, errors.New("Could not find install description for Version " + version)
 }
 
+// getLatestCompatibleVersionTo gives the latest version of the plugin on the configured update channel which is
+// compatible with the gauge version
 func (installDesc *installDescription) getLatestCompatibleVersionTo(currentVersion *version.Version) (*versionInstallDescription, error) {
 	installDesc.sortVersionInstallDescriptions()
+	channel := config.UpdateChannel()
 	for _, versionInstallDesc := range installDesc.Versions {
+		if !version.InChannel(versionInstallDesc.Version, channel) {
+			continue
+		}
 		if err := version.CheckCompatibility(currentVersion, &versionInstallDesc.GaugeVersionSupport); err == nil {
 			return &versionInstallDesc, nil
 		}
@@ -644,9 +658,7 @@ type byDecreasingVersion []versionInstallDescription
 func (a byDecreasingVersion) Len() int      { return len(a) }
 func (a byDecreasingVersion) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 func (a byDecreasingVersion) Less(i, j int) bool {
-	version1, _ := version.ParseVersion(a[i].Version)
-	version2, _ := version.ParseVersion(a[j].Version)
-	return version1.IsGreaterThan(version2)
+	return version.IsNewerRelease(a[i].Version, a[j].Version)
 }
 
 // AddPluginToProject adds the given plugin to current Gauge project.
@@ -25,6 +25,7 @@ import (
 	"github.com/getgauge/gauge/api/infoGatherer"
 	"github.com/getgauge/gauge/config"
 	"github.com/getgauge/gauge/conn"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/manifest"
@@ -54,6 +55,15 @@ type plugin struct {
 	pluginCmd        *exec.Cmd
 	descriptor       *PluginDescriptor
 	killTimer        *time.Timer
+	ctx              context.Context
+}
+
+// context gives the context of the run, which cancels the notifications in flight to the plugin
+func (p *plugin) context() context.Context {
+	if p.ctx == nil {
+		return context.Background()
+	}
+	return p.ctx
 }
 
 func isProcessRunning(p *plugin) bool {
@@ -296,9 +306,28 @@ func startLegacyPlugin(pd *PluginDescriptor, command []string) (*plugin, error)
 func SetEnvForPlugin(action pluginScope, pd *PluginDescriptor, m *manifest.Manifest, pluginEnvVars map[string]string) error {
 	pluginEnvVars[fmt.Sprintf("%s_action", pd.ID)] = string(action)
 	pluginEnvVars["test_language"] = m.Language
+	if err := setBrandingProperties(pluginEnvVars); err != nil {
+		return err
+	}
 	return setEnvironmentProperties(pluginEnvVars)
 }
 
+// setBrandingProperties passes the logo and extra summary fields of the reports to the plugins. The title replaces the
+// project name in the suite result.
+func setBrandingProperties(pluginEnvVars map[string]string) error {
+	if logo := env.ReportLogo(); logo != "" {
+		pluginEnvVars[env.GaugeReportLogo] = logo
+	}
+	if fields := env.ReportFields(); len(fields) > 0 {
+		b, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+		pluginEnvVars[env.GaugeReportFieldsJSON] = string(b)
+	}
+	return nil
+}
+
 func setEnvironmentProperties(properties map[string]string) error {
 	for k, v := range properties {
 		if err := common.SetEnvVariable(k, v); err != nil {
@@ -317,7 +346,7 @@ func IsPluginAdded(m *manifest.Manifest, descriptor *PluginDescriptor) bool {
 	return false
 }
 
-func startPluginsForExecution(m *manifest.Manifest) (Handler, []string) {
+func startPluginsForExecution(ctx context.Context, m *manifest.Manifest) (Handler, []string) {
 	var warnings []string
 	handler := &GaugePlugins{}
 	envProperties := make(map[string]string)
@@ -357,6 +386,7 @@ func startPluginsForExecution(m *manifest.Manifest) (Handler, []string) {
 				warnings = append(warnings, fmt.Sprintf("Error starting plugin %s %s. %s", pd.Name, pd.Version, err.Error()))
 				continue
 			}
+			plugin.ctx = ctx
 			if plugin.gRPCConn != nil {
 				handler.addPlugin(pluginID, plugin)
 				continue
@@ -426,7 +456,7 @@ func GenerateDoc(pluginName string, specDirs []string, startAPIFunc func([]strin
 }
 
 func (p *plugin) invokeService(m *gauge_messages.Message) error {
-	ctx := context.Background()
+	ctx := p.context()
 	var err error
 	switch m.GetMessageType() {
 	case gauge_messages.Message_SuiteExecutionResult:
@@ -468,8 +498,10 @@ func (p *plugin) sendMessage(message *gauge_messages.Message) error {
 	return nil
 }
 
-func StartPlugins(m *manifest.Manifest) Handler {
-	pluginHandler, warnings := startPluginsForExecution(m)
+// StartPlugins starts the plugins of the project for an execution. Notifications to the plugins are cancelled when
+// the context is done.
+func StartPlugins(ctx context.Context, m *manifest.Manifest) Handler {
+	pluginHandler, warnings := startPluginsForExecution(ctx, m)
 	logger.HandleWarningMessages(true, warnings)
 	return pluginHandler
 }
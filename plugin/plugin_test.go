@@ -18,6 +18,7 @@ import (
 
 	"github.com/getgauge/common"
 	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/plugin/pluginInfo"
 	"github.com/getgauge/gauge/version"
 	"google.golang.org/grpc"
@@ -203,3 +204,35 @@ func TestSendMessageShouldUseGRPCConnectionIfAvailable(t *testing.T) {
 		t.Errorf("Expected grpc client to be invoked")
 	}
 }
+
+func TestSetBrandingProperties(t *testing.T) {
+	config.ProjectRoot, _ = filepath.Abs(filepath.Join("_testdata", "sample"))
+	os.Setenv(env.GaugeReportLogo, filepath.Join("assets", "logo.png"))
+	os.Setenv("gauge_report_fields", "Build: 42, Team: Payments")
+	defer os.Unsetenv(env.GaugeReportLogo)
+	defer os.Unsetenv("gauge_report_fields")
+	props := make(map[string]string)
+
+	if err := setBrandingProperties(props); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := filepath.Join(config.ProjectRoot, "assets", "logo.png"); props[env.GaugeReportLogo] != want {
+		t.Errorf("expected logo %s, got %s", want, props[env.GaugeReportLogo])
+	}
+	if want := `[{"name":"Build","value":"42"},{"name":"Team","value":"Payments"}]`; props[env.GaugeReportFieldsJSON] != want {
+		t.Errorf("expected fields %s, got %s", want, props[env.GaugeReportFieldsJSON])
+	}
+}
+
+func TestSetBrandingPropertiesWithoutBranding(t *testing.T) {
+	props := make(map[string]string)
+
+	if err := setBrandingProperties(props); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(props) != 0 {
+		t.Errorf("expected no properties, got %v", props)
+	}
+}
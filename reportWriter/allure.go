@@ -0,0 +1,110 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reportWriter
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/getgauge/common"
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+)
+
+type allureResult struct {
+	UUID          string         `json:"uuid"`
+	HistoryID     string         `json:"historyId"`
+	Name          string         `json:"name"`
+	FullName      string         `json:"fullName"`
+	Status        string         `json:"status"`
+	StatusDetails *allureDetails `json:"statusDetails,omitempty"`
+	Stage         string         `json:"stage"`
+	Start         int64          `json:"start"`
+	Stop          int64          `json:"stop"`
+	Labels        []allureLabel  `json:"labels"`
+}
+
+type allureDetails struct {
+	Message string `json:"message,omitempty"`
+	Trace   string `json:"trace,omitempty"`
+}
+
+type allureLabel struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// allureWriter writes an Allure result file per scenario, which the Allure command line turns into a report
+type allureWriter struct{}
+
+func (w *allureWriter) Write(res *gm.ProtoSuiteResult, dir string) error {
+	if err := os.MkdirAll(dir, common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	// the suite result has no start time for scenarios, so they are laid out one after the other up to the end of the run
+	start := time.Now().Add(-time.Duration(res.GetExecutionTime())*time.Millisecond).UnixNano() / int64(time.Millisecond)
+	for _, specRes := range res.GetSpecResults() {
+		for _, s := range scenarioResults(specRes.GetProtoSpec()) {
+			r, err := newAllureResult(s, start)
+			if err != nil {
+				return err
+			}
+			start = r.Stop
+			b, err := json.MarshalIndent(r, "", "\t")
+			if err != nil {
+				return err
+			}
+			if err = ioutil.WriteFile(filepath.Join(dir, r.UUID+"-result.json"), b, common.NewFilePermissions); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func newAllureResult(s *scenarioResult, start int64) (*allureResult, error) {
+	id, err := randomUUID()
+	if err != nil {
+		return nil, err
+	}
+	fullName := fmt.Sprintf("%s: %s", s.spec.GetFileName(), s.heading)
+	history := md5.Sum([]byte(fullName))
+	r := &allureResult{
+		UUID:      id,
+		HistoryID: hex.EncodeToString(history[:]),
+		Name:      s.heading,
+		FullName:  fullName,
+		Status:    s.status,
+		Stage:     "finished",
+		Start:     start,
+		Stop:      start + s.time,
+		Labels:    []allureLabel{{"framework", "gauge"}, {"suite", s.spec.GetSpecHeading()}},
+	}
+	if s.message != "" || s.stackTrace != "" {
+		r.StatusDetails = &allureDetails{Message: s.message, Trace: s.stackTrace}
+	}
+	for _, t := range s.tags {
+		r.Labels = append(r.Labels, allureLabel{"tag", t})
+	}
+	return r, nil
+}
+
+func randomUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
@@ -0,0 +1,88 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reportWriter
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/common"
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/result"
+)
+
+const jsonFile = "result.json"
+
+type jsonSuite struct {
+	Project       string      `json:"project"`
+	Environment   string      `json:"environment"`
+	Tags          string      `json:"tags,omitempty"`
+	Timestamp     string      `json:"timestamp"`
+	ExecutionTime int64       `json:"executionTime"`
+	Failed        bool        `json:"failed"`
+	Specs         []*jsonSpec `json:"specs"`
+}
+
+type jsonSpec struct {
+	File          string          `json:"file"`
+	Heading       string          `json:"heading"`
+	Tags          []string        `json:"tags,omitempty"`
+	Failed        bool            `json:"failed"`
+	Skipped       bool            `json:"skipped"`
+	ExecutionTime int64           `json:"executionTime"`
+	Scenarios     []*jsonScenario `json:"scenarios"`
+}
+
+type jsonScenario struct {
+	Heading       string               `json:"heading"`
+	Tags          []string             `json:"tags,omitempty"`
+	Status        string               `json:"status"`
+	ExecutionTime int64                `json:"executionTime"`
+	Message       string               `json:"message,omitempty"`
+	StackTrace    string               `json:"stackTrace,omitempty"`
+	Attachments   []*result.Attachment `json:"attachments,omitempty"`
+}
+
+type jsonWriter struct{}
+
+func (w *jsonWriter) Write(res *gm.ProtoSuiteResult, dir string) error {
+	suite := &jsonSuite{
+		Project:       res.GetProjectName(),
+		Environment:   res.GetEnvironment(),
+		Tags:          res.GetTags(),
+		Timestamp:     res.GetTimestamp(),
+		ExecutionTime: res.GetExecutionTime(),
+		Failed:        res.GetFailed(),
+		Specs:         make([]*jsonSpec, 0),
+	}
+	for _, specRes := range res.GetSpecResults() {
+		spec := specRes.GetProtoSpec()
+		s := &jsonSpec{
+			File:          spec.GetFileName(),
+			Heading:       spec.GetSpecHeading(),
+			Tags:          spec.GetTags(),
+			Failed:        specRes.GetFailed(),
+			Skipped:       specRes.GetSkipped(),
+			ExecutionTime: specRes.GetExecutionTime(),
+			Scenarios:     make([]*jsonScenario, 0),
+		}
+		for _, scn := range scenarioResults(spec) {
+			s.Scenarios = append(s.Scenarios, &jsonScenario{Heading: scn.heading, Tags: scn.tags, Status: scn.status, ExecutionTime: scn.time, Message: scn.message, StackTrace: scn.stackTrace, Attachments: scn.attachments})
+		}
+		suite.Specs = append(suite.Specs, s)
+	}
+	b, err := json.MarshalIndent(suite, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dir, common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, jsonFile), b, common.NewFilePermissions)
+}
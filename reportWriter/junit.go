@@ -0,0 +1,97 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reportWriter
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/getgauge/common"
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+)
+
+const junitFile = "result.xml"
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Skipped  int               `xml:"skipped,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string           `xml:"name,attr"`
+	File     string           `xml:"file,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Cases    []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+type junitWriter struct{}
+
+func (w *junitWriter) Write(res *gm.ProtoSuiteResult, dir string) error {
+	suites := &junitTestSuites{Name: res.GetProjectName(), Time: seconds(res.GetExecutionTime())}
+	for _, specRes := range res.GetSpecResults() {
+		spec := specRes.GetProtoSpec()
+		scenarios := scenarioResults(spec)
+		suite := &junitTestSuite{
+			Name:     spec.GetSpecHeading(),
+			File:     spec.GetFileName(),
+			Tests:    len(scenarios),
+			Failures: countStatus(scenarios, failed),
+			Skipped:  countStatus(scenarios, skipped),
+			Time:     seconds(specRes.GetExecutionTime()),
+		}
+		for _, s := range scenarios {
+			c := &junitTestCase{Name: s.heading, ClassName: spec.GetSpecHeading(), Time: seconds(s.time)}
+			switch s.status {
+			case failed:
+				c.Failure = &junitMessage{Message: s.message, Text: s.stackTrace}
+			case skipped:
+				c.Skipped = &junitMessage{Message: s.message}
+			}
+			suite.Cases = append(suite.Cases, c)
+		}
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+	b, err := xml.MarshalIndent(suites, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dir, common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, junitFile), append([]byte(xml.Header), b...), common.NewFilePermissions)
+}
+
+func seconds(ms int64) string {
+	return strconv.FormatFloat(float64(ms)/1000, 'f', 3, 64)
+}
@@ -0,0 +1,185 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package reportWriter writes the result of a run in common report formats, without a plugin for each of them.
+All the formats are written from the same suite result at the end of the run, each in its own directory of
+the reports directory.
+
+	gauge_report_formats = junit, json, tap, allure
+	gauge run --report-formats junit,tap specs
+*/
+package reportWriter
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const (
+	passed  = "passed"
+	failed  = "failed"
+	skipped = "skipped"
+)
+
+// Writer writes a suite result in one format into a directory
+type Writer interface {
+	Write(res *gm.ProtoSuiteResult, dir string) error
+}
+
+type format struct {
+	dir    string
+	writer Writer
+}
+
+var formats = map[string]format{
+	"junit":  {"junit", &junitWriter{}},
+	"json":   {"json", &jsonWriter{}},
+	"tap":    {"tap", &tapWriter{}},
+	"allure": {"allure-results", &allureWriter{}},
+}
+
+// Formats gives the names of the supported formats
+func Formats() []string {
+	names := make([]string, 0, len(formats))
+	for n := range formats {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate returns an error for the first format which is not supported
+func Validate(names []string) error {
+	for _, n := range names {
+		if _, ok := formats[strings.ToLower(n)]; !ok {
+			return fmt.Errorf("unknown report format '%s'. Use one of %s", n, strings.Join(Formats(), ", "))
+		}
+	}
+	return nil
+}
+
+// WriteAll writes the suite result in all the formats, each into its directory of reportsDir
+func WriteAll(names []string, res *gm.ProtoSuiteResult, reportsDir string) {
+	for _, n := range names {
+		f, ok := formats[strings.ToLower(n)]
+		if !ok {
+			logger.Errorf(true, "Unable to write %s report. %s", n, Validate([]string{n}).Error())
+			continue
+		}
+		dir := filepath.Join(reportsDir, f.dir)
+		if err := f.writer.Write(res, util.LongPath(dir)); err != nil {
+			logger.Errorf(true, "Unable to write %s report. %s", n, err.Error())
+			continue
+		}
+		logger.Debugf(true, "Wrote %s report to %s", n, dir)
+	}
+}
+
+// scenarioResult is the outcome of a scenario, or of one row of a table driven scenario, in a form common to all formats
+type scenarioResult struct {
+	spec       *gm.ProtoSpec
+	heading    string
+	tags       []string
+	status     string
+	message    string
+	stackTrace string
+	time       int64
+	// attachments are the files attached by the steps and hooks of the scenario, passed or not
+	attachments []*result.Attachment
+}
+
+// scenarioResults gives the results of the scenarios of a spec, in the order they appear
+func scenarioResults(spec *gm.ProtoSpec) []*scenarioResult {
+	var results []*scenarioResult
+	for _, item := range spec.GetItems() {
+		switch item.GetItemType() {
+		case gm.ProtoItem_Scenario:
+			results = append(results, newScenarioResult(spec, item.GetScenario(), 0))
+		case gm.ProtoItem_TableDrivenScenario:
+			t := item.GetTableDrivenScenario()
+			row := t.GetTableRowIndex() + 1
+			if t.GetIsScenarioTableDriven() {
+				row = t.GetScenarioTableRowIndex() + 1
+			}
+			results = append(results, newScenarioResult(spec, t.GetScenario(), row))
+		}
+	}
+	return results
+}
+
+func newScenarioResult(spec *gm.ProtoSpec, scn *gm.ProtoScenario, row int32) *scenarioResult {
+	s := &scenarioResult{spec: spec, heading: scn.GetScenarioHeading(), tags: append(append([]string{}, spec.GetTags()...), scn.GetTags()...), time: scn.GetExecutionTime(), status: passed, attachments: result.ProtoScenarioAttachments(scn)}
+	if row > 0 {
+		s.heading = fmt.Sprintf("%s [row %d]", s.heading, row)
+	}
+	switch {
+	case scn.GetSkipped() || scn.GetExecutionStatus() == gm.ExecutionStatus_SKIPPED:
+		s.status = skipped
+		s.message = strings.Join(scn.GetSkipErrors(), "\n")
+	case scn.GetFailed() || scn.GetExecutionStatus() == gm.ExecutionStatus_FAILED:
+		s.status = failed
+		s.message, s.stackTrace = scenarioError(scn)
+	}
+	return s
+}
+
+// scenarioError gives the error of the first hook or step which failed in the scenario
+func scenarioError(scn *gm.ProtoScenario) (string, string) {
+	if h := scn.GetPreHookFailure(); h != nil {
+		return "Before scenario hook failed: " + h.GetErrorMessage(), h.GetStackTrace()
+	}
+	var items []*gm.ProtoItem
+	items = append(items, scn.GetContexts()...)
+	items = append(items, scn.GetScenarioItems()...)
+	items = append(items, scn.GetTearDownSteps()...)
+	if step, res := failedStep(items); res != nil {
+		return fmt.Sprintf("Step '%s' failed: %s", step.GetActualText(), res.GetErrorMessage()), res.GetStackTrace()
+	}
+	if h := scn.GetPostHookFailure(); h != nil {
+		return "After scenario hook failed: " + h.GetErrorMessage(), h.GetStackTrace()
+	}
+	return "Scenario failed", ""
+}
+
+func failedStep(items []*gm.ProtoItem) (*gm.ProtoStep, *gm.ProtoExecutionResult) {
+	for _, item := range items {
+		switch item.GetItemType() {
+		case gm.ProtoItem_Step:
+			stepResult := item.GetStep().GetStepExecutionResult()
+			if res := stepResult.GetExecutionResult(); res.GetFailed() {
+				return item.GetStep(), res
+			}
+			for _, h := range []*gm.ProtoHookFailure{stepResult.GetPreHookFailure(), stepResult.GetPostHookFailure()} {
+				if h != nil {
+					return item.GetStep(), &gm.ProtoExecutionResult{Failed: true, ErrorMessage: h.GetErrorMessage(), StackTrace: h.GetStackTrace()}
+				}
+			}
+		case gm.ProtoItem_Concept:
+			if step, res := failedStep(item.GetConcept().GetSteps()); res != nil {
+				return step, res
+			}
+		}
+	}
+	return nil, nil
+}
+
+func countStatus(scenarios []*scenarioResult, status string) int {
+	n := 0
+	for _, s := range scenarios {
+		if s.status == status {
+			n++
+		}
+	}
+	return n
+}
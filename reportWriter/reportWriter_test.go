@@ -0,0 +1,166 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reportWriter
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+)
+
+func failedStepItem(text, message string) *gm.ProtoItem {
+	return &gm.ProtoItem{ItemType: gm.ProtoItem_Step, Step: &gm.ProtoStep{ActualText: text, StepExecutionResult: &gm.ProtoStepExecutionResult{
+		ExecutionResult: &gm.ProtoExecutionResult{Failed: true, ErrorMessage: message, StackTrace: "at checkout.pay\nat checkout.run"},
+	}}}
+}
+
+func suiteResult() *gm.ProtoSuiteResult {
+	spec := &gm.ProtoSpec{
+		SpecHeading: "Checkout",
+		FileName:    "specs/checkout.spec",
+		Tags:        []string{"shop"},
+		Items: []*gm.ProtoItem{
+			{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ScenarioHeading: "Pay with card", ExecutionTime: 1500, ExecutionStatus: gm.ExecutionStatus_PASSED,
+				PostHookMessages: []string{"gauge:attachment video/mp4 pay.mp4"}}},
+			{ItemType: gm.ProtoItem_Scenario, Scenario: &gm.ProtoScenario{ScenarioHeading: "Pay with # voucher", Failed: true, ExecutionStatus: gm.ExecutionStatus_FAILED, Tags: []string{"voucher"},
+				ScenarioItems: []*gm.ProtoItem{{ItemType: gm.ProtoItem_Concept, Concept: &gm.ProtoConcept{Steps: []*gm.ProtoItem{failedStepItem("Redeem voucher", "expired")}}}}}},
+			{ItemType: gm.ProtoItem_TableDrivenScenario, TableDrivenScenario: &gm.ProtoTableDrivenScenario{TableRowIndex: 1,
+				Scenario: &gm.ProtoScenario{ScenarioHeading: "Refund", Skipped: true, ExecutionStatus: gm.ExecutionStatus_SKIPPED, SkipErrors: []string{"no refunds yet"}}}},
+		},
+	}
+	return &gm.ProtoSuiteResult{ProjectName: "shop", Environment: "default", ExecutionTime: 2000, Failed: true,
+		SpecResults: []*gm.ProtoSpecResult{{ProtoSpec: spec, Failed: true, ExecutionTime: 2000}}}
+}
+
+func TestScenarioResults(t *testing.T) {
+	scenarios := scenarioResults(suiteResult().SpecResults[0].ProtoSpec)
+
+	if len(scenarios) != 3 {
+		t.Fatalf("expected 3 scenarios, got %d", len(scenarios))
+	}
+	if scenarios[0].status != passed || scenarios[1].status != failed || scenarios[2].status != skipped {
+		t.Errorf("unexpected statuses %s, %s, %s", scenarios[0].status, scenarios[1].status, scenarios[2].status)
+	}
+	if scenarios[1].message != "Step 'Redeem voucher' failed: expired" {
+		t.Errorf("unexpected message %s", scenarios[1].message)
+	}
+	if scenarios[2].heading != "Refund [row 2]" || scenarios[2].message != "no refunds yet" {
+		t.Errorf("unexpected table driven scenario %s: %s", scenarios[2].heading, scenarios[2].message)
+	}
+	if strings.Join(scenarios[1].tags, ",") != "shop,voucher" {
+		t.Errorf("unexpected tags %v", scenarios[1].tags)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate([]string{"JUnit", "tap"}); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+	err := Validate([]string{"junit", "html"})
+	if err == nil || err.Error() != "unknown report format 'html'. Use one of allure, json, junit, tap" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestWriteAll(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reports")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	WriteAll(Formats(), suiteResult(), dir)
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "junit", junitFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	suites := &junitTestSuites{}
+	if err := xml.Unmarshal(b, suites); err != nil {
+		t.Fatal(err)
+	}
+	if suites.Tests != 3 || suites.Failures != 1 || suites.Skipped != 1 || len(suites.Suites[0].Cases) != 3 {
+		t.Errorf("unexpected junit report\n%s", string(b))
+	}
+	if c := suites.Suites[0].Cases[0]; c.Time != "1.500" || c.ClassName != "Checkout" || c.Failure != nil {
+		t.Errorf("unexpected test case %+v", c)
+	}
+
+	b, err = ioutil.ReadFile(filepath.Join(dir, "json", jsonFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &jsonSuite{}
+	if err := json.Unmarshal(b, s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Project != "shop" || len(s.Specs[0].Scenarios) != 3 || s.Specs[0].Scenarios[1].Status != failed {
+		t.Errorf("unexpected json report\n%s", string(b))
+	}
+	if a := s.Specs[0].Scenarios[0].Attachments; len(a) != 1 || a[0].File != "pay.mp4" || a[0].MimeType != "video/mp4" {
+		t.Errorf("expected the attachment of the passed scenario in the json report\n%s", string(b))
+	}
+
+	b, err = ioutil.ReadFile(filepath.Join(dir, "tap", tapFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `TAP version 13
+1..3
+ok 1 - Checkout: Pay with card
+not ok 2 - Checkout: Pay with \# voucher
+  ---
+  message: "Step 'Redeem voucher' failed: expired"
+  file: "specs/checkout.spec"
+  stack: |
+    at checkout.pay
+    at checkout.run
+  ...
+ok 3 - Checkout: Refund [row 2] # SKIP no refunds yet
+`
+	if string(b) != want {
+		t.Errorf("unexpected tap report\n%s", string(b))
+	}
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, "allure-results"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Fatalf("expected 3 allure results, got %d", len(files))
+	}
+	for _, f := range files {
+		if !strings.HasSuffix(f.Name(), "-result.json") {
+			t.Errorf("unexpected allure file %s", f.Name())
+		}
+	}
+}
+
+func TestAllureResult(t *testing.T) {
+	s := scenarioResults(suiteResult().SpecResults[0].ProtoSpec)[1]
+
+	r, err := newAllureResult(s, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Status != failed || r.StatusDetails.Message != s.message || r.Start != 1000 || r.Stop != 1000 {
+		t.Errorf("unexpected allure result %+v", r)
+	}
+	if len(r.Labels) != 4 || r.Labels[1] != (allureLabel{"suite", "Checkout"}) || r.Labels[3] != (allureLabel{"tag", "voucher"}) {
+		t.Errorf("unexpected labels %v", r.Labels)
+	}
+	if len(r.UUID) != 36 {
+		t.Errorf("unexpected uuid %s", r.UUID)
+	}
+}
@@ -0,0 +1,70 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reportWriter
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+)
+
+const tapFile = "result.tap"
+
+// tapWriter writes the results in the Test Anything Protocol, version 13, with a test point per scenario
+type tapWriter struct{}
+
+func (w *tapWriter) Write(res *gm.ProtoSuiteResult, dir string) error {
+	var scenarios []*scenarioResult
+	for _, specRes := range res.GetSpecResults() {
+		scenarios = append(scenarios, scenarioResults(specRes.GetProtoSpec())...)
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "TAP version 13\n1..%d\n", len(scenarios))
+	for i, s := range scenarios {
+		description := tapEscape(fmt.Sprintf("%s: %s", s.spec.GetSpecHeading(), s.heading))
+		switch s.status {
+		case passed:
+			fmt.Fprintf(&b, "ok %d - %s\n", i+1, description)
+		case skipped:
+			fmt.Fprintf(&b, "ok %d - %s # SKIP %s\n", i+1, description, firstLine(s.message))
+		case failed:
+			fmt.Fprintf(&b, "not ok %d - %s\n", i+1, description)
+			fmt.Fprintf(&b, "  ---\n  message: %q\n  file: %q\n", s.message, s.spec.GetFileName())
+			if s.stackTrace != "" {
+				fmt.Fprintf(&b, "  stack: |\n%s\n", indentLines(strings.TrimRight(s.stackTrace, "\n"), "    "))
+			}
+			b.WriteString("  ...\n")
+		}
+	}
+	if err := os.MkdirAll(dir, common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, tapFile), b.Bytes(), common.NewFilePermissions)
+}
+
+// tapEscape keeps a description from being read as a directive
+func tapEscape(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "#", "\\#", "\n", " ").Replace(s)
+}
+
+func firstLine(s string) string {
+	return strings.SplitN(s, "\n", 2)[0]
+}
+
+func indentLines(s, indentation string) string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = indentation + l
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,85 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+)
+
+// ciGroups writes the markers which make a CI system show a section of the log as a collapsible group
+type ciGroups interface {
+	start(w io.Writer, id int, title string)
+	end(w io.Writer, id int)
+}
+
+// githubGroups uses the group workflow commands of GitHub Actions
+type githubGroups struct{}
+
+func (githubGroups) start(w io.Writer, id int, title string) {
+	fmt.Fprintf(w, "::group::%s%s", title, newline)
+}
+
+func (githubGroups) end(w io.Writer, id int) {
+	fmt.Fprintf(w, "::endgroup::%s", newline)
+}
+
+// gitlabGroups uses the collapsible sections of GitLab CI
+type gitlabGroups struct {
+	now func() time.Time
+}
+
+func (g gitlabGroups) start(w io.Writer, id int, title string) {
+	fmt.Fprintf(w, "\x1b[0Ksection_start:%d:spec_%d[collapsed=true]\r\x1b[0K%s%s", g.now().Unix(), id, title, newline)
+}
+
+func (g gitlabGroups) end(w io.Writer, id int) {
+	fmt.Fprintf(w, "\x1b[0Ksection_end:%d:spec_%d\r\x1b[0K%s", g.now().Unix(), id, newline)
+}
+
+// detectCIGroups returns the grouping supported by the CI system gauge runs in, or nil outside of CI
+func detectCIGroups() ciGroups {
+	if os.Getenv("GITHUB_ACTIONS") == "true" {
+		return githubGroups{}
+	}
+	if os.Getenv("GITLAB_CI") == "true" {
+		return gitlabGroups{now: time.Now}
+	}
+	return nil
+}
+
+// groupedConsole wraps the console output of every spec in a collapsible group
+type groupedConsole struct {
+	Reporter
+	writer io.Writer
+	groups ciGroups
+	nSpecs int
+}
+
+func newGroupedConsole(r Reporter, out io.Writer, g ciGroups) *groupedConsole {
+	return &groupedConsole{Reporter: r, writer: out, groups: g}
+}
+
+func (gc *groupedConsole) SpecStart(spec *gauge.Specification, res result.Result) {
+	if !res.(*result.SpecResult).Skipped {
+		gc.nSpecs++
+		gc.groups.start(gc.writer, gc.nSpecs, spec.Heading.Value)
+	}
+	gc.Reporter.SpecStart(spec, res)
+}
+
+func (gc *groupedConsole) SpecEnd(spec *gauge.Specification, res result.Result) {
+	gc.Reporter.SpecEnd(spec, res)
+	if !res.(*result.SpecResult).Skipped {
+		gc.groups.end(gc.writer, gc.nSpecs)
+	}
+}
@@ -0,0 +1,64 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package reporter
+
+import (
+	"os"
+	"time"
+
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestGroupedConsoleWrapsSpecInGithubGroup(c *C) {
+	dw, sc := setupSimpleConsole()
+	gc := newGroupedConsole(sc, dw, githubGroups{})
+	spec := &gauge.Specification{Heading: &gauge.Heading{Value: "Specification heading"}}
+
+	gc.SpecStart(spec, &result.SpecResult{})
+	gc.SpecEnd(spec, &result.SpecResult{ProtoSpec: &gauge_messages.ProtoSpec{}})
+
+	c.Assert(dw.output, Equals, "::group::Specification heading\n# Specification heading\n\n::endgroup::\n")
+}
+
+func (s *MySuite) TestGroupedConsoleDoesNotGroupSkippedSpecs(c *C) {
+	dw, sc := setupSimpleConsole()
+	gc := newGroupedConsole(sc, dw, githubGroups{})
+	spec := &gauge.Specification{Heading: &gauge.Heading{Value: "Specification heading"}}
+
+	gc.SpecStart(spec, &result.SpecResult{Skipped: true})
+	gc.SpecEnd(spec, &result.SpecResult{Skipped: true})
+
+	c.Assert(dw.output, Equals, "")
+}
+
+func (s *MySuite) TestGitlabGroups(c *C) {
+	dw := newDummyWriter()
+	g := gitlabGroups{now: func() time.Time { return time.Unix(1600000000, 0) }}
+
+	g.start(dw, 2, "Specification heading")
+	g.end(dw, 2)
+
+	c.Assert(dw.output, Equals, "\x1b[0Ksection_start:1600000000:spec_2[collapsed=true]\r\x1b[0KSpecification heading\n\x1b[0Ksection_end:1600000000:spec_2\r\x1b[0K\n")
+}
+
+func (s *MySuite) TestDetectCIGroups(c *C) {
+	defer os.Setenv("GITHUB_ACTIONS", os.Getenv("GITHUB_ACTIONS"))
+	defer os.Setenv("GITLAB_CI", os.Getenv("GITLAB_CI"))
+	os.Setenv("GITHUB_ACTIONS", "")
+	os.Setenv("GITLAB_CI", "")
+	c.Assert(detectCIGroups(), IsNil)
+
+	os.Setenv("GITLAB_CI", "true")
+	_, ok := detectCIGroups().(gitlabGroups)
+	c.Assert(ok, Equals, true)
+
+	os.Setenv("GITHUB_ACTIONS", "true")
+	c.Assert(detectCIGroups(), Equals, githubGroups{})
+}
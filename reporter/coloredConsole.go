@@ -15,6 +15,7 @@ import (
 	"github.com/apoorvam/goterminal"
 	ct "github.com/daviddengcn/go-colortext"
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
@@ -24,6 +25,7 @@ type coloredConsole struct {
 	writer         *goterminal.Writer
 	indentation    int
 	sceFailuresBuf bytes.Buffer
+	tableRow       string
 }
 
 func newColoredConsole(out io.Writer) *coloredConsole {
@@ -54,10 +56,11 @@ func (c *coloredConsole) SpecEnd(spec *gauge.Specification, res result.Result) {
 }
 
 func (c *coloredConsole) ScenarioStart(scenario *gauge.Scenario, i *gauge_messages.ExecutionInfo, res result.Result) {
-	if res.(*result.ScenarioResult).ProtoScenario.ExecutionStatus == gauge_messages.ExecutionStatus_SKIPPED {
+	if skippedBeforeExecution(res.(*result.ScenarioResult)) {
 		return
 	}
 	c.indentation += scenarioIndentation
+	c.tableRow = scenario.DataTableRowName(env.DataTableKeyColumns())
 	msg := formatScenario(scenario.Heading.Value)
 	logger.Info(false, msg)
 
@@ -66,7 +69,7 @@ func (c *coloredConsole) ScenarioStart(scenario *gauge.Scenario, i *gauge_messag
 }
 
 func (c *coloredConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i *gauge_messages.ExecutionInfo) {
-	if res.(*result.ScenarioResult).ProtoScenario.ExecutionStatus == gauge_messages.ExecutionStatus_SKIPPED {
+	if skippedBeforeExecution(res.(*result.ScenarioResult)) {
 		return
 	}
 	if printHookFailureCC(c, res, res.GetPreHook) {
@@ -93,6 +96,8 @@ func (c *coloredConsole) StepEnd(step gauge.Step, res result.Result, execInfo *g
 	if !(hookFailed(res.GetPreHook) || hookFailed(res.GetPostHook)) {
 		if stepRes.GetStepFailed() {
 			c.displayMessage(getFailureSymbol(), ct.Red)
+		} else if stepRes.GetSkipped() {
+			c.displayMessage(getSkippedSymbol(), ct.Yellow)
 		} else {
 			c.displayMessage(getSuccessSymbol(), ct.Green)
 		}
@@ -102,7 +107,7 @@ func (c *coloredConsole) StepEnd(step gauge.Step, res result.Result, execInfo *g
 		logger.Error(false, stepText)
 		errMsg := prepErrorMessage(stepRes.ProtoStepExecResult().GetExecutionResult().GetErrorMessage())
 		logger.Error(false, errMsg)
-		specInfo := prepSpecInfo(execInfo.GetCurrentSpec().GetFileName(), step.LineNo, step.InConcept())
+		specInfo := prepSpecInfo(execInfo.GetCurrentSpec().GetFileName(), step.LineNo, step.InConcept(), c.tableRow)
 		logger.Error(false, specInfo)
 		stacktrace := prepStacktrace(stepRes.ProtoStepExecResult().GetExecutionResult().GetStackTrace())
 		logger.Error(false, stacktrace)
@@ -10,6 +10,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/i18n"
+	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/util"
 )
 
@@ -21,12 +27,44 @@ const (
 	failureSymbol       = "✘"
 	successChar         = "P"
 	failureChar         = "F"
+	skippedSymbol       = "⊘"
+	skippedChar         = "S"
+	tableIndentation    = 3
 )
 
 func formatScenario(scenarioHeading string) string {
 	return fmt.Sprintf("## %s", scenarioHeading)
 }
 
+// formatScenarioPriority gives the priority the scenario runs by, to follow its heading, or nothing for scenarios
+// without priority
+func formatScenarioPriority(scenario *gauge.Scenario) string {
+	if scenario.Priority == nil {
+		return ""
+	}
+	return fmt.Sprintf(" [%s]", parser.PriorityName(int(*scenario.Priority)))
+}
+
+// skippedReasonPrefix starts the skip messages of the scenarios the engine skips, which the console prints after the
+// reason instead
+const skippedReasonPrefix = "skipped Reason: "
+
+func formatSkippedScenario(scenarioHeading string, reason fmt.Stringer, message string) string {
+	return fmt.Sprintf("## %s\t ...[SKIPPED] %s: %s", scenarioHeading, reason, strings.TrimPrefix(message, skippedReasonPrefix))
+}
+
+// skippedBeforeExecution returns true if the scenario was skipped without any of its steps being executed
+func skippedBeforeExecution(res *result.ScenarioResult) bool {
+	return res.ProtoScenario.GetExecutionStatus() == gauge_messages.ExecutionStatus_SKIPPED && res.SkipReason != result.SkipRequested
+}
+
+// formatDataTableRow gives the data table row a scenario runs for, as a table aligned to the width of its cells on
+// the console
+func formatDataTableRow(row *gauge.Table) string {
+	t := &util.ConsoleTable{Headers: row.Headers, Rows: row.Rows(), Indent: tableIndentation, Bordered: true, MaxCellWidth: env.ConsoleTableCellWidth()}
+	return newline + t.String()
+}
+
 func formatSpec(specHeading string) string {
 	return fmt.Sprintf("# %s", specHeading)
 }
@@ -56,23 +94,38 @@ func getSuccessSymbol() string {
 	return spaces(1) + successSymbol
 }
 
+func getSkippedSymbol() string {
+	if util.IsWindows() {
+		return spaces(1) + skippedChar
+	}
+	return spaces(1) + skippedSymbol
+}
+
+func formatSkippedStep(stepText string, reason string) string {
+	return fmt.Sprintf("%s\t ...[SKIPPED] %s", stepText, reason)
+}
+
 func prepErrorMessage(msg string) string {
-	return fmt.Sprintf("Error Message: %s", msg)
+	return i18n.Sprintf("Error Message: %s", msg)
 }
 
 func prepStepMsg(msg string) string {
-	return fmt.Sprintf("\nFailed Step: %s", msg)
+	return i18n.Sprintf("\nFailed Step: %s", msg)
 }
 
-func prepSpecInfo(fileName string, lineNo int, excludeLineNo bool) string {
+func prepSpecInfo(fileName string, lineNo int, excludeLineNo bool, tableRow string) string {
+	info := i18n.Sprintf("Specification: %s:%v", util.RelPathToProjectRoot(fileName), lineNo)
 	if excludeLineNo {
-		return fmt.Sprintf("Specification: %s", util.RelPathToProjectRoot(fileName))
+		info = i18n.Sprintf("Specification: %s", util.RelPathToProjectRoot(fileName))
+	}
+	if tableRow != "" {
+		return i18n.Sprintf("%s, data table %s", info, tableRow)
 	}
-	return fmt.Sprintf("Specification: %s:%v", util.RelPathToProjectRoot(fileName), lineNo)
+	return info
 }
 
 func prepStacktrace(stacktrace string) string {
-	return fmt.Sprintf("Stacktrace: \n%s", stacktrace)
+	return i18n.Sprintf("Stacktrace: \n%s", stacktrace)
 }
 
 func formatErrorFragment(fragment string, indentation int) string {
@@ -9,6 +9,8 @@ package reporter
 import (
 	"testing"
 
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
 	. "gopkg.in/check.v1"
 )
 
@@ -22,3 +24,22 @@ func (s *MySuite) TestIndent(c *C) {
 	c.Assert(indent("foo bar", 2), Equals, "  foo bar")
 	c.Assert(indent("\nfoo bar", 2), Equals, "  \n  foo bar")
 }
+
+func (s *MySuite) TestFormatDataTableRow(c *C) {
+	row := &gauge.Table{}
+	row.AddHeaders([]string{"id", "name"})
+	row.AddRowValues(row.CreateTableCells([]string{"1", "苹果"}))
+
+	c.Assert(formatDataTableRow(row), Equals, "\n   |id|name|\n   |--|----|\n   |1 |苹果|\n")
+}
+
+func (s *MySuite) TestFormatDataTableRowTruncatesCellsWiderThanTheConfiguredWidth(c *C) {
+	old := env.ConsoleTableCellWidth
+	defer func() { env.ConsoleTableCellWidth = old }()
+	env.ConsoleTableCellWidth = func() int { return 5 }
+	row := &gauge.Table{}
+	row.AddHeaders([]string{"name"})
+	row.AddRowValues(row.CreateTableCells([]string{"a long name"}))
+
+	c.Assert(formatDataTableRow(row), Equals, "\n   |name |\n   |-----|\n   |a lo…|\n")
+}
@@ -16,7 +16,6 @@ import (
 
 	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/execution/result"
-	"github.com/getgauge/gauge/formatter"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/util"
@@ -69,6 +68,8 @@ type executionResult struct {
 	BeforeHookFailure *executionError  `json:"beforeHookFailure,omitempty"`
 	AfterHookFailure  *executionError  `json:"afterHookFailure,omitempty"`
 	Table             *tableInfo       `json:"table,omitempty"`
+	SkipReason        string           `json:"skipReason,omitempty"`
+	SkipMessage       string           `json:"skipMessage,omitempty"`
 }
 
 type tableInfo struct {
@@ -168,6 +169,7 @@ func (c *jsonConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i
 	defer c.Unlock()
 	addRow := c.isParallel && scenario.SpecDataTableRow.IsInitialized()
 	parentID := getIDWithRow(i.CurrentSpec.FileName, []*gauge.Scenario{scenario}, addRow)
+	sRes := res.(*result.ScenarioResult)
 	e := executionEvent{
 		EventType: scenarioEnd,
 		ID:        parentID + ":" + strconv.Itoa(scenario.Span.Start),
@@ -177,12 +179,14 @@ func (c *jsonConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i
 		Name:      scenario.Heading.Value,
 		Stream:    c.stream,
 		Res: &executionResult{
-			Status:            getScenarioStatus(res.(*result.ScenarioResult)),
+			Status:            getScenarioStatus(sRes),
 			Time:              res.ExecTime(),
-			Errors:            getErrors(c.stepCache, getAllStepsFromScenario(res.(*result.ScenarioResult).ProtoScenario), i.CurrentSpec.FileName, i),
+			Errors:            getErrors(c.stepCache, getAllStepsFromScenario(sRes.ProtoScenario), i.CurrentSpec.FileName, i),
 			BeforeHookFailure: getHookFailure(res.GetPreHook(), "Before Scenario"),
 			AfterHookFailure:  getHookFailure(res.GetPostHook(), "After Scenario"),
 			Table:             getTable(scenario),
+			SkipReason:        sRes.SkipReason.String(),
+			SkipMessage:       sRes.SkipMessage,
 		},
 	}
 	c.write(e)
@@ -311,10 +315,10 @@ func getLineNo(stepCache map[*gm.ScenarioInfo][]*stepInfo, step *gm.ProtoStep, i
 }
 
 func getTable(scenario *gauge.Scenario) *tableInfo {
-	if scenario.SpecDataTableRow.IsInitialized() {
+	if row, index := scenario.DataTableRow(); row != nil {
 		return &tableInfo{
-			Text: formatter.FormatTable(&scenario.SpecDataTableRow),
-			Row:  scenario.SpecDataTableRowIndex,
+			Text: formatDataTableRow(row),
+			Row:  index,
 		}
 	}
 	return nil
@@ -782,3 +782,26 @@ func (s *MySuite) TestSuiteEndWithBeforeAndAfterHookFailure_JSONConsole(c *C) {
 	jc.SuiteEnd(res)
 	c.Assert(dw.output, Equals, expected)
 }
+
+func (s *MySuite) TestSkippedScenarioEndWithReason_JSONConsole(c *C) {
+	dw, jc := setupJSONConsole()
+	protoScenario := &gauge_messages.ProtoScenario{
+		ScenarioHeading: "Scenario",
+		ExecutionStatus: gauge_messages.ExecutionStatus_SKIPPED,
+	}
+	scenario := &gauge.Scenario{
+		Heading: &gauge.Heading{Value: "Scenario", LineNo: 2, HeadingType: 1},
+		Span:    &gauge.Span{Start: 2, End: 3},
+	}
+	info := &gauge_messages.ExecutionInfo{
+		CurrentSpec:     &gauge_messages.SpecInfo{Name: "Specification", FileName: "file"},
+		CurrentScenario: &gauge_messages.ScenarioInfo{Name: "Scenario"},
+	}
+	res := &result.ScenarioResult{ProtoScenario: protoScenario, SkipReason: result.ValidationFailure, SkipMessage: "Step implementation not found"}
+
+	expected := `{"type":"scenarioEnd","id":"file:2","parentId":"file","name":"Scenario","filename":"file","line":2,"result":{"status":"skip","time":0,"skipReason":"validation_failure","skipMessage":"Step implementation not found"}}
+`
+
+	jc.ScenarioEnd(scenario, res, info)
+	c.Assert(dw.output, Equals, expected)
+}
@@ -81,6 +81,9 @@ func Current() Reporter {
 		} else {
 			currentReporter = newColoredConsole(os.Stdout)
 		}
+		if g := detectCIGroups(); g != nil && !MachineReadable {
+			currentReporter = newGroupedConsole(currentReporter, os.Stdout, g)
+		}
 	}
 	return currentReporter
 }
@@ -139,10 +142,10 @@ func ListenExecutionEvents(wg *sync.WaitGroup) {
 				// if it is datatable driven execution
 				if !skipped {
 					if sce.SpecDataTableRow.GetRowCount() != 0 {
-						r.DataTable(formatter.FormatTable(&sce.SpecDataTableRow))
+						r.DataTable(formatDataTableRow(&sce.SpecDataTableRow))
 					}
 					if sce.ScenarioDataTableRow.GetRowCount() != 0 {
-						r.DataTable(formatter.FormatTable(&sce.ScenarioDataTableRow))
+						r.DataTable(formatDataTableRow(&sce.ScenarioDataTableRow))
 					}
 				}
 				r.ScenarioStart(sce, e.ExecutionInfo, e.Result)
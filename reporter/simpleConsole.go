@@ -13,6 +13,7 @@ import (
 	"sync"
 
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
@@ -22,6 +23,7 @@ type simpleConsole struct {
 	mu          *sync.Mutex
 	indentation int
 	writer      io.Writer
+	tableRow    string
 }
 
 func newSimpleConsole(out io.Writer) *simpleConsole {
@@ -54,23 +56,39 @@ func (sc *simpleConsole) SpecEnd(spec *gauge.Specification, res result.Result) {
 }
 
 func (sc *simpleConsole) ScenarioStart(scenario *gauge.Scenario, i *gauge_messages.ExecutionInfo, res result.Result) {
-	if res.(*result.ScenarioResult).ProtoScenario.ExecutionStatus == gauge_messages.ExecutionStatus_SKIPPED {
+	if skippedBeforeExecution(res.(*result.ScenarioResult)) {
 		return
 	}
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
 	sc.indentation += scenarioIndentation
+	sc.tableRow = scenario.DataTableRowName(env.DataTableKeyColumns())
 	formattedHeading := formatScenario(scenario.Heading.Value)
+	if Verbose {
+		formattedHeading += formatScenarioPriority(scenario)
+	}
 	logger.Info(false, formattedHeading)
 	fmt.Fprintf(sc.writer, "%s%s", indent(formattedHeading, sc.indentation), newline)
 }
 
 func (sc *simpleConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i *gauge_messages.ExecutionInfo) {
-	if res.(*result.ScenarioResult).ProtoScenario.ExecutionStatus == gauge_messages.ExecutionStatus_SKIPPED {
-		return
-	}
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
+	sRes := res.(*result.ScenarioResult)
+	if sRes.ProtoScenario.ExecutionStatus == gauge_messages.ExecutionStatus_SKIPPED {
+		indentation := sc.indentation
+		if skippedBeforeExecution(sRes) {
+			indentation += scenarioIndentation
+		}
+		if Verbose {
+			msg := formatSkippedScenario(scenario.Heading.Value, sRes.SkipReason, sRes.SkipMessage)
+			logger.Info(false, msg)
+			fmt.Fprintf(sc.writer, "%s%s", indent(msg, indentation), newline)
+		}
+		if skippedBeforeExecution(sRes) {
+			return
+		}
+	}
 	printHookFailureSC(sc, res, res.GetPreHook)
 	printHookFailureSC(sc, res, res.GetPostHook)
 	sc.indentation -= scenarioIndentation
@@ -91,11 +109,14 @@ func (sc *simpleConsole) StepEnd(step gauge.Step, res result.Result, execInfo *g
 	defer sc.mu.Unlock()
 	printHookFailureSC(sc, res, res.GetPreHook)
 	stepRes := res.(*result.StepResult)
+	if stepRes.GetSkipped() {
+		fmt.Fprint(sc.writer, formatSkippedStep(indent(strings.TrimSpace(step.LineText), sc.indentation), stepRes.ProtoStepExecResult().GetSkippedReason())+newline)
+	}
 	if stepRes.GetStepFailed() {
 		stepText := prepStepMsg(step.LineText)
 		logger.Error(false, stepText)
 
-		specInfo := prepSpecInfo(execInfo.GetCurrentSpec().GetFileName(), step.LineNo, step.InConcept())
+		specInfo := prepSpecInfo(execInfo.GetCurrentSpec().GetFileName(), step.LineNo, step.InConcept(), sc.tableRow)
 		logger.Error(false, specInfo)
 
 		errMsg := prepErrorMessage(stepRes.ProtoStepExecResult().GetExecutionResult().GetErrorMessage())
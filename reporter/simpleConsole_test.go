@@ -99,6 +99,18 @@ func (s *MySuite) TestStepEnd_SimpleConsole(c *C) {
 	c.Assert(sc.indentation, Equals, 2)
 }
 
+func (s *MySuite) TestSkippedStepEnd_SimpleConsole(c *C) {
+	dw, sc := setupSimpleConsole()
+	sc.indentation = 6
+	specInfo := &gauge_messages.ExecutionInfo{CurrentSpec: &gauge_messages.SpecInfo{Name: "hello.spec"}}
+	stepRes := result.NewStepResult(&gauge_messages.ProtoStep{})
+	stepRes.SetSkipped("Step is tagged with slow")
+
+	sc.StepEnd(gauge.Step{LineText: "Say hello to all"}, stepRes, specInfo)
+
+	c.Assert(dw.output, Equals, spaces(6)+"Say hello to all\t ...[SKIPPED] Step is tagged with slow\n")
+}
+
 func (s *MySuite) TestSingleConceptStartInVerboseMode_SimpleConsole(c *C) {
 	dw, sc := setupSimpleConsole()
 	sc.indentation = 2
@@ -15,6 +15,7 @@ import (
 	"github.com/apoorvam/goterminal"
 	ct "github.com/daviddengcn/go-colortext"
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/execution/result"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
@@ -26,6 +27,7 @@ type verboseColoredConsole struct {
 	pluginMessagesBuffer bytes.Buffer
 	errorMessagesBuffer  bytes.Buffer
 	indentation          int
+	tableRow             string
 }
 
 func newVerboseColoredConsole(out io.Writer) *verboseColoredConsole {
@@ -56,11 +58,12 @@ func (c *verboseColoredConsole) SpecEnd(spec *gauge.Specification, res result.Re
 }
 
 func (c *verboseColoredConsole) ScenarioStart(scenario *gauge.Scenario, i *gauge_messages.ExecutionInfo, res result.Result) {
-	if res.(*result.ScenarioResult).ProtoScenario.ExecutionStatus == gauge_messages.ExecutionStatus_SKIPPED {
+	if skippedBeforeExecution(res.(*result.ScenarioResult)) {
 		return
 	}
 	c.indentation += scenarioIndentation
-	msg := formatScenario(scenario.Heading.Value)
+	c.tableRow = scenario.DataTableRowName(env.DataTableKeyColumns())
+	msg := formatScenario(scenario.Heading.Value) + formatScenarioPriority(scenario)
 	logger.Info(false, msg)
 
 	indentedText := indent(msg+"\t", c.indentation)
@@ -69,8 +72,16 @@ func (c *verboseColoredConsole) ScenarioStart(scenario *gauge.Scenario, i *gauge
 }
 
 func (c *verboseColoredConsole) ScenarioEnd(scenario *gauge.Scenario, res result.Result, i *gauge_messages.ExecutionInfo) {
-	if res.(*result.ScenarioResult).ProtoScenario.ExecutionStatus == gauge_messages.ExecutionStatus_SKIPPED {
-		return
+	sRes := res.(*result.ScenarioResult)
+	if sRes.ProtoScenario.ExecutionStatus == gauge_messages.ExecutionStatus_SKIPPED {
+		msg := formatSkippedScenario(scenario.Heading.Value, sRes.SkipReason, sRes.SkipMessage)
+		logger.Info(false, msg)
+		if skippedBeforeExecution(sRes) {
+			c.displayMessage(indent(msg, c.indentation+scenarioIndentation)+newline, ct.Yellow)
+			c.writer.Reset()
+			return
+		}
+		c.displayMessage(indent(msg, c.indentation)+newline, ct.Yellow)
 	}
 	printHookFailureVCC(c, res, res.GetPreHook)
 	printHookFailureVCC(c, res, res.GetPostHook)
@@ -97,6 +108,8 @@ func (c *verboseColoredConsole) StepEnd(step gauge.Step, res result.Result, exec
 	if !(hookFailed(res.GetPreHook) || hookFailed(res.GetPostHook)) {
 		if stepRes.GetStepFailed() {
 			c.displayMessage(c.headingBuffer.String()+"\t ...[FAIL]\n", ct.Red)
+		} else if stepRes.GetSkipped() {
+			c.displayMessage(formatSkippedStep(c.headingBuffer.String(), stepRes.ProtoStepExecResult().GetSkippedReason())+newline, ct.Yellow)
 		} else {
 			c.displayMessage(c.headingBuffer.String()+"\t ...[PASS]\n", ct.Green)
 		}
@@ -111,7 +124,7 @@ func (c *verboseColoredConsole) StepEnd(step gauge.Step, res result.Result, exec
 		logger.Error(false, stepText)
 		errMsg := prepErrorMessage(stepRes.ProtoStepExecResult().GetExecutionResult().GetErrorMessage())
 		logger.Error(false, errMsg)
-		specInfo := prepSpecInfo(execInfo.GetCurrentSpec().GetFileName(), step.LineNo, step.InConcept())
+		specInfo := prepSpecInfo(execInfo.GetCurrentSpec().GetFileName(), step.LineNo, step.InConcept(), c.tableRow)
 		logger.Error(false, specInfo)
 		stacktrace := prepStacktrace(stepRes.ProtoStepExecResult().GetExecutionResult().GetStackTrace())
 		logger.Error(false, stacktrace)
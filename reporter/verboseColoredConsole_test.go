@@ -108,6 +108,17 @@ func (s *MySuite) TestScenarioStartAndScenarioEnd_ColoredConsole(c *C) {
 	c.Assert(cc.pluginMessagesBuffer.String(), Equals, "")
 }
 
+func (s *MySuite) TestSkippedScenarioEndInVerbose_ColoredConsole(c *C) {
+	dw, cc := setupVerboseColoredConsole()
+	scnRes := result.NewScenarioResult(&gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_SKIPPED})
+	scnRes.SkipReason = result.RunnerNotAlive
+	scnRes.SkipMessage = "skipped Reason: Runner is not alive"
+
+	cc.ScenarioEnd(&gauge.Scenario{Heading: &gauge.Heading{Value: "my first scenario"}}, scnRes, &gauge_messages.ExecutionInfo{})
+
+	c.Assert(dw.output, Equals, "  ## my first scenario\t ...[SKIPPED] runner_not_alive: Runner is not alive\n")
+}
+
 func (s *MySuite) TestStepStart_Verbose(c *C) {
 	_, cc := setupVerboseColoredConsole()
 	cc.indentation = 2
@@ -141,6 +152,20 @@ func (s *MySuite) TestFailingStepEndInVerbose_ColoredConsole(c *C) {
 	c.Assert(dw.output, Equals, "      "+stepText+"\t ...[FAIL]\n"+expectedErrMsg)
 }
 
+func (s *MySuite) TestSkippedStepEndInVerbose_ColoredConsole(c *C) {
+	dw, cc := setupVerboseColoredConsole()
+	cc.indentation = 2
+	stepText := "* Say hello to all"
+	stepRes := result.NewStepResult(&gauge_messages.ProtoStep{})
+	stepRes.SetSkipped("Step is tagged with slow")
+	cc.StepStart(stepText)
+	dw.output = ""
+
+	cc.StepEnd(gauge.Step{LineText: stepText}, stepRes, &gauge_messages.ExecutionInfo{})
+
+	c.Assert(dw.output, Equals, spaces(6)+stepText+"\t ...[SKIPPED] Step is tagged with slow\n")
+}
+
 func (s *MySuite) TestStepStartAndStepEnd_ColoredConsole(c *C) {
 	dw, cc := setupVerboseColoredConsole()
 	cc.indentation = 2
@@ -388,3 +413,13 @@ func (s *MySuite) TestSuiteEndWithPostHookFailure_ColoredConsole(c *C) {
 	want := ind + "Error Message: " + errMsg + newline + ind + "Stacktrace: \n" + ind + stackTrace + newline
 	c.Assert(dw.output, Equals, want)
 }
+
+func (s *MySuite) TestScenarioStartInVerboseShowsPriority(c *C) {
+	dw, cc := setupVerboseColoredConsole()
+	scnRes := result.NewScenarioResult(&gauge_messages.ProtoScenario{ExecutionStatus: gauge_messages.ExecutionStatus_PASSED})
+	p := gauge.Priority(0)
+
+	cc.ScenarioStart(&gauge.Scenario{Heading: &gauge.Heading{Value: "checkout"}, Priority: &p}, &gauge_messages.ExecutionInfo{}, scnRes)
+
+	c.Assert(dw.output, Equals, spaces(scenarioIndentation)+"## checkout [P0]\t\n")
+}
@@ -0,0 +1,234 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package run executes the specs of a Gauge project from a Go program, as gauge run does, without starting the gauge
+binary.
+
+	res, err := run.Run(ctx, run.Options{ProjectRoot: "/path/to/project", Tags: "smoke"})
+	if err != nil {
+		return err
+	}
+	if !res.Passed() {
+		...
+	}
+
+Gauge keeps the state of a run in the process, so runs of a program are made one after another.
+*/
+package run
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/execution"
+	"github.com/getgauge/gauge/execution/result"
+	"github.com/getgauge/gauge/filter"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/order"
+	"github.com/getgauge/gauge/plugin/install"
+	"github.com/getgauge/gauge/reporter"
+	"github.com/getgauge/gauge/util"
+	"github.com/getgauge/gauge/validation"
+)
+
+const (
+	defaultEnvironment = "default"
+	defaultLogLevel    = "info"
+)
+
+// Options are the options of a run, which are the flags of gauge run. Unset fields take the defaults of gauge run.
+type Options struct {
+	// ProjectRoot is the directory of the project, the current directory if empty
+	ProjectRoot string
+	// Specs are the spec files and directories to run, relative to the project root. The specs directory of the
+	// project is run if empty.
+	Specs []string
+	// Environment is the comma separated envs of the project to load, default if empty
+	Environment string
+	// Tags is the tag expression filtering the scenarios to run
+	Tags string
+	// TableRows is the rows of the data tables to run, like 1-3,5
+	TableRows string
+	// Scenarios are the names of the scenarios to run
+	Scenarios []string
+	// Parallel runs the specs in Streams parallel streams, which are as many as the cores if not set
+	Parallel bool
+	Streams  int
+	// Strategy is how specs are distributed to parallel streams, lazy, eager or scenario. Lazy if empty.
+	Strategy string
+	// Sort runs the specs in alphabetical order
+	Sort bool
+	// MaxRetriesCount is the number of times a failed scenario is run, once if not set
+	MaxRetriesCount int
+	// RetryOnlyTags is the tag expression of the scenarios which are retried
+	RetryOnlyTags string
+	// RetryInfraFailures retries once, on a fresh runner, the scenarios which failed as the runner died or could
+	// not be reached
+	RetryInfraFailures bool
+	// SkipStepTags is the tag expression of the steps which are skipped
+	SkipStepTags   string
+	SkipDeprecated bool
+	IncludeWIP     bool
+	// ReportFormats are the formats of the failure summary and traceability reports, like json and junit
+	ReportFormats []string
+	// InstallPlugins installs the language runner and plugins of the project which are missing
+	InstallPlugins bool
+	// LogLevel is the level of the gauge logs, info if empty
+	LogLevel        string
+	Verbose         bool
+	SimpleConsole   bool
+	MachineReadable bool
+	HideSuggestion  bool
+}
+
+// Result is the outcome of a run
+type Result struct {
+	// ExitCode is the code gauge run would have exited with
+	ExitCode int
+	// Suite is the result of the specs, which is nil when they did not get to run, like when they fail to parse
+	Suite *result.SuiteResult
+}
+
+// Passed tells if the specs ran and none of them failed
+func (r *Result) Passed() bool {
+	return r.ExitCode == execution.Success
+}
+
+var mu sync.Mutex
+
+// envError carries the error of loading the env of the project out of the properties reader, which only reports
+// errors to a handler
+type envError struct {
+	err error
+}
+
+// Run runs the specs of the project. The error is returned when the run could not be set up, failing specs are
+// reported by the result. Cancelling the context interrupts the run: the scenarios left are skipped, and the running
+// ones are given the shutdown grace period to finish before the runner and plugins are stopped.
+func Run(ctx context.Context, opts Options) (res *Result, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	opts = withDefaults(opts)
+	root, err := filepath.Abs(opts.ProjectRoot)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid project root %s. %s", opts.ProjectRoot, err.Error())
+	}
+	if !common.DirExists(root) {
+		return nil, fmt.Errorf("Project root %s does not exist", root)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chdir(root); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := os.Chdir(wd); e != nil && err == nil {
+			err = e
+		}
+	}()
+	config.ProjectRoot = root
+	if err := common.SetEnvVariable(common.GaugeProjectRootEnv, root); err != nil {
+		return nil, err
+	}
+	if err := loadEnv(opts.Environment); err != nil {
+		return nil, err
+	}
+	logger.Initialize(opts.MachineReadable, opts.LogLevel, logger.CLI)
+	applyOptions(opts)
+	if err := execution.ValidateFlags(); err != nil {
+		return nil, err
+	}
+	if opts.InstallPlugins && os.Getenv("GAUGE_PLUGIN_INSTALL") != "false" {
+		install.AllPlugins(opts.MachineReadable, false)
+	}
+	specs := opts.Specs
+	if len(specs) == 0 {
+		specs = util.GetSpecDirs()
+	}
+	exitCode, suite := execution.Execute(ctx, specs)
+	return &Result{ExitCode: exitCode, Suite: suite}, nil
+}
+
+func withDefaults(opts Options) Options {
+	if opts.ProjectRoot == "" {
+		opts.ProjectRoot = "."
+	}
+	if opts.Environment == "" {
+		opts.Environment = defaultEnvironment
+	}
+	if opts.Streams <= 0 {
+		opts.Streams = util.NumberOfCores()
+	}
+	if opts.Strategy == "" {
+		opts.Strategy = execution.Lazy
+	}
+	if opts.MaxRetriesCount <= 0 {
+		opts.MaxRetriesCount = 1
+	}
+	if opts.LogLevel == "" {
+		opts.LogLevel = defaultLogLevel
+	}
+	return opts
+}
+
+func loadEnv(environment string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(envError)
+			if !ok {
+				panic(r)
+			}
+			err = fmt.Errorf("Failed to load env. %s", e.err.Error())
+		}
+	}()
+	return env.LoadEnv(environment, func(err error) {
+		panic(envError{err})
+	})
+}
+
+// applyOptions sets the options on the packages which run the specs, as gauge run does with its flags
+func applyOptions(opts Options) {
+	simpleConsole := opts.SimpleConsole || opts.Parallel
+	reporter.IsParallel = opts.Parallel
+	reporter.SimpleConsoleOutput = simpleConsole
+	reporter.Verbose = opts.Verbose
+	reporter.MachineReadable = opts.MachineReadable
+	reporter.NumberOfExecutionStreams = opts.Streams
+	execution.MachineReadable = opts.MachineReadable
+	execution.ExecuteTags = opts.Tags
+	execution.SetTableRows(opts.TableRows)
+	execution.NumberOfExecutionStreams = opts.Streams
+	execution.InParallel = opts.Parallel
+	execution.TagsToFilterForParallelRun = ""
+	execution.Verbose = opts.Verbose
+	execution.Strategy = opts.Strategy
+	execution.ReportFormats = opts.ReportFormats
+	execution.MaxRetriesCount = opts.MaxRetriesCount
+	execution.RetryOnlyTags = opts.RetryOnlyTags
+	execution.RetryInfraFailures = opts.RetryInfraFailures
+	execution.SkipStepTags = opts.SkipStepTags
+	validation.TableRows = opts.TableRows
+	validation.HideSuggestion = opts.HideSuggestion
+	filter.ExecuteTags = opts.Tags
+	filter.Distribute = -1
+	filter.NumberOfExecutionStreams = opts.Streams
+	filter.ScenariosName = opts.Scenarios
+	filter.SkipDeprecated = opts.SkipDeprecated
+	filter.IncludeWIP = opts.IncludeWIP
+	order.Sorted = opts.Sort
+}
@@ -0,0 +1,85 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package run
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getgauge/gauge/execution"
+)
+
+func TestRunReturnsErrorOfCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err := Run(ctx, Options{})
+
+	if err != context.Canceled {
+		t.Errorf("Expected %v, got %v", context.Canceled, err)
+	}
+	if res != nil {
+		t.Errorf("Expected no result, got %v", res)
+	}
+}
+
+func TestRunReturnsErrorForMissingProjectRoot(t *testing.T) {
+	_, err := Run(context.Background(), Options{ProjectRoot: filepath.Join(os.TempDir(), "gauge-missing-project")})
+
+	if err == nil {
+		t.Error("Expected an error for a project root which does not exist")
+	}
+}
+
+func TestRunReturnsErrorForUnknownEnvAndRestoresWorkingDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gauge-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	wd, _ := os.Getwd()
+
+	_, err = Run(context.Background(), Options{ProjectRoot: dir, Environment: "unknown"})
+
+	if err == nil {
+		t.Error("Expected an error for an env which does not exist")
+	}
+	if got, _ := os.Getwd(); got != wd {
+		t.Errorf("Expected working directory %s, got %s", wd, got)
+	}
+}
+
+func TestWithDefaults(t *testing.T) {
+	opts := withDefaults(Options{})
+
+	if opts.ProjectRoot != "." || opts.Environment != "default" || opts.Strategy != execution.Lazy || opts.MaxRetriesCount != 1 || opts.LogLevel != "info" {
+		t.Errorf("Unexpected defaults %+v", opts)
+	}
+	if opts.Streams <= 0 {
+		t.Errorf("Expected streams to default to the number of cores, got %d", opts.Streams)
+	}
+}
+
+func TestWithDefaultsKeepsOptionsSet(t *testing.T) {
+	opts := withDefaults(Options{Environment: "ci", Streams: 3, Strategy: execution.Eager, MaxRetriesCount: 2})
+
+	if opts.Environment != "ci" || opts.Streams != 3 || opts.Strategy != execution.Eager || opts.MaxRetriesCount != 2 {
+		t.Errorf("Expected options to be kept, got %+v", opts)
+	}
+}
+
+func TestResultPassed(t *testing.T) {
+	if !(&Result{ExitCode: execution.Success}).Passed() {
+		t.Error("Expected a run with exit code 0 to have passed")
+	}
+	if (&Result{ExitCode: execution.ExecutionFailed}).Passed() {
+		t.Error("Expected a run with failed specs not to have passed")
+	}
+}
@@ -41,6 +41,15 @@ type GrpcRunner struct {
 	Timeout      time.Duration
 	info         *RunnerInfo
 	IsExecuting  bool
+	ctx          context.Context
+}
+
+// context gives the context of the run, which cancels the requests in flight to the runner
+func (r *GrpcRunner) context() context.Context {
+	if r.ctx == nil {
+		return context.Background()
+	}
+	return r.ctx
 }
 
 //nolint:staticcheck
@@ -84,68 +93,68 @@ func (r *GrpcRunner) invokeLegacyLSPService(message *gm.Message) (*gm.Message, e
 func (r *GrpcRunner) invokeServiceFor(message *gm.Message) (*gm.Message, error) {
 	switch message.MessageType {
 	case gm.Message_SuiteDataStoreInit:
-		response, err := r.RunnerClient.InitializeSuiteDataStore(context.Background(), message.SuiteDataStoreInitRequest)
+		response, err := r.RunnerClient.InitializeSuiteDataStore(r.context(), message.SuiteDataStoreInitRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_SpecDataStoreInit:
-		response, err := r.RunnerClient.InitializeSpecDataStore(context.Background(), message.SpecDataStoreInitRequest)
+		response, err := r.RunnerClient.InitializeSpecDataStore(r.context(), message.SpecDataStoreInitRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_ScenarioDataStoreInit:
-		response, err := r.RunnerClient.InitializeScenarioDataStore(context.Background(), message.ScenarioDataStoreInitRequest)
+		response, err := r.RunnerClient.InitializeScenarioDataStore(r.context(), message.ScenarioDataStoreInitRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_ExecutionStarting:
-		response, err := r.RunnerClient.StartExecution(context.Background(), message.ExecutionStartingRequest)
+		response, err := r.RunnerClient.StartExecution(r.context(), message.ExecutionStartingRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_SpecExecutionStarting:
-		response, err := r.RunnerClient.StartSpecExecution(context.Background(), message.SpecExecutionStartingRequest)
+		response, err := r.RunnerClient.StartSpecExecution(r.context(), message.SpecExecutionStartingRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_ScenarioExecutionStarting:
-		response, err := r.RunnerClient.StartScenarioExecution(context.Background(), message.ScenarioExecutionStartingRequest)
+		response, err := r.RunnerClient.StartScenarioExecution(r.context(), message.ScenarioExecutionStartingRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_StepExecutionStarting:
-		response, err := r.RunnerClient.StartStepExecution(context.Background(), message.StepExecutionStartingRequest)
+		response, err := r.RunnerClient.StartStepExecution(r.context(), message.StepExecutionStartingRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_ExecuteStep:
-		response, err := r.RunnerClient.ExecuteStep(context.Background(), message.ExecuteStepRequest)
+		response, err := r.RunnerClient.ExecuteStep(r.context(), message.ExecuteStepRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_StepExecutionEnding:
-		response, err := r.RunnerClient.FinishStepExecution(context.Background(), message.StepExecutionEndingRequest)
+		response, err := r.RunnerClient.FinishStepExecution(r.context(), message.StepExecutionEndingRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_ScenarioExecutionEnding:
-		response, err := r.RunnerClient.FinishScenarioExecution(context.Background(), message.ScenarioExecutionEndingRequest)
+		response, err := r.RunnerClient.FinishScenarioExecution(r.context(), message.ScenarioExecutionEndingRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_SpecExecutionEnding:
-		response, err := r.RunnerClient.FinishSpecExecution(context.Background(), message.SpecExecutionEndingRequest)
+		response, err := r.RunnerClient.FinishSpecExecution(r.context(), message.SpecExecutionEndingRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 	case gm.Message_ExecutionEnding:
-		response, err := r.RunnerClient.FinishExecution(context.Background(), message.ExecutionEndingRequest)
+		response, err := r.RunnerClient.FinishExecution(r.context(), message.ExecutionEndingRequest)
 		return &gm.Message{MessageType: gm.Message_ExecutionStatusResponse, ExecutionStatusResponse: response}, err
 
 	case gm.Message_CacheFileRequest:
-		_, err := r.RunnerClient.CacheFile(context.Background(), message.CacheFileRequest)
+		_, err := r.RunnerClient.CacheFile(r.context(), message.CacheFileRequest)
 		return &gm.Message{}, err
 	case gm.Message_StepNamesRequest:
-		response, err := r.RunnerClient.GetStepNames(context.Background(), message.StepNamesRequest)
+		response, err := r.RunnerClient.GetStepNames(r.context(), message.StepNamesRequest)
 		return &gm.Message{StepNamesResponse: response}, err
 	case gm.Message_StepPositionsRequest:
-		response, err := r.RunnerClient.GetStepPositions(context.Background(), message.StepPositionsRequest)
+		response, err := r.RunnerClient.GetStepPositions(r.context(), message.StepPositionsRequest)
 		return &gm.Message{StepPositionsResponse: response}, err
 	case gm.Message_ImplementationFileListRequest:
-		response, err := r.RunnerClient.GetImplementationFiles(context.Background(), &gm.Empty{})
+		response, err := r.RunnerClient.GetImplementationFiles(r.context(), &gm.Empty{})
 		return &gm.Message{ImplementationFileListResponse: response}, err
 	case gm.Message_StubImplementationCodeRequest:
-		response, err := r.RunnerClient.ImplementStub(context.Background(), message.StubImplementationCodeRequest)
+		response, err := r.RunnerClient.ImplementStub(r.context(), message.StubImplementationCodeRequest)
 		return &gm.Message{FileDiff: response}, err
 	case gm.Message_RefactorRequest:
-		response, err := r.RunnerClient.Refactor(context.Background(), message.RefactorRequest)
+		response, err := r.RunnerClient.Refactor(r.context(), message.RefactorRequest)
 		return &gm.Message{MessageType: gm.Message_RefactorResponse, RefactorResponse: response}, err
 	case gm.Message_StepNameRequest:
-		response, err := r.RunnerClient.GetStepName(context.Background(), message.StepNameRequest)
+		response, err := r.RunnerClient.GetStepName(r.context(), message.StepNameRequest)
 		return &gm.Message{MessageType: gm.Message_StepNameResponse, StepNameResponse: response}, err
 	case gm.Message_ImplementationFileGlobPatternRequest:
-		response, err := r.RunnerClient.GetGlobPatterns(context.Background(), &gm.Empty{})
+		response, err := r.RunnerClient.GetGlobPatterns(r.context(), &gm.Empty{})
 		return &gm.Message{MessageType: gm.Message_ImplementationFileGlobPatternRequest, ImplementationFileGlobPatternResponse: response}, err
 	case gm.Message_StepValidateRequest:
-		response, err := r.RunnerClient.ValidateStep(context.Background(), message.StepValidateRequest)
+		response, err := r.RunnerClient.ValidateStep(r.context(), message.StepValidateRequest)
 		return &gm.Message{MessageType: gm.Message_StepValidateResponse, StepValidateResponse: response}, err
 	case gm.Message_KillProcessRequest:
 		_, _ = r.RunnerClient.Kill(context.Background(), message.KillProcessRequest)
@@ -196,6 +205,9 @@ func (r *GrpcRunner) ExecuteAndGetStatus(m *gm.Message) *gm.ProtoExecutionResult
 	if r.Info().Killed {
 		return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: "Runner is not Alive"}
 	}
+	if err := r.context().Err(); err != nil {
+		return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: fmt.Sprintf("Execution was cancelled. %s", err.Error())}
+	}
 	res, err := r.executeMessage(m, 0)
 	if err != nil {
 		e, ok := status.FromError(err)
@@ -294,21 +306,24 @@ func (r *GrpcRunner) Pid() int {
 	return r.cmd.Process.Pid
 }
 
-// StartGrpcRunner makes a connection with grpc server
-func StartGrpcRunner(m *manifest.Manifest, stdout, stderr io.Writer, timeout time.Duration, shouldWriteToStdout bool) (*GrpcRunner, error) {
+// StartGrpcRunner makes a connection with grpc server. Requests to the runner are cancelled, and the runner is
+// killed, when the context is done.
+func StartGrpcRunner(ctx context.Context, m *manifest.Manifest, stdout, stderr io.Writer, timeout time.Duration, shouldWriteToStdout bool) (*GrpcRunner, error) {
 	portChan := make(chan string)
 	errChan := make(chan error)
 	logWriter := &logger.LogWriter{
 		Stderr: logger.NewCustomWriter(portChan, stderr, m.Language, true),
 		Stdout: logger.NewCustomWriter(portChan, stdout, m.Language, false),
 	}
-	cmd, info, err := runRunnerCommand(m, "0", false, logWriter)
+	cmd, info, err := runRunnerCommand(ctx, m, "0", false, logWriter)
 	if err != nil {
 		return nil, fmt.Errorf("Error occurred while starting runner process.\nError : %w", err)
 	}
 
+	exited := make(chan struct{})
 	go func() {
 		err = cmd.Wait()
+		close(exited)
 		if err != nil {
 			e := fmt.Errorf("Error occurred while waiting for runner process to finish.\nError : %w", err)
 			logger.Errorf(true, e.Error())
@@ -335,7 +350,8 @@ func StartGrpcRunner(m *manifest.Manifest, stdout, stderr io.Writer, timeout tim
 	if err != nil {
 		return nil, err
 	}
-	r := &GrpcRunner{cmd: cmd, conn: conn, Timeout: timeout, info: info}
+	r := &GrpcRunner{cmd: cmd, conn: conn, Timeout: timeout, info: info, ctx: ctx}
+	killOnCancel(ctx, cmd, exited)
 
 	if info.GRPCSupport {
 		r.RunnerClient = gm.NewRunnerClient(conn)
@@ -7,6 +7,7 @@
 package runner
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
@@ -29,6 +30,7 @@ type LegacyRunner struct {
 	multiThreaded bool
 	lostContact   bool
 	info          *RunnerInfo
+	exited        chan struct{}
 }
 
 func (r *LegacyRunner) Alive() bool {
@@ -153,8 +155,8 @@ func (r *LegacyRunner) ExecuteMessageWithTimeout(message *gauge_messages.Message
 
 // StartLegacyRunner looks for a runner configuration inside the runner directory
 // finds the runner configuration matching to the manifest and executes the commands for the current OS
-func StartLegacyRunner(manifest *manifest.Manifest, port string, outputStreamWriter *logger.LogWriter, killChannel chan bool, debug bool) (*LegacyRunner, error) {
-	cmd, r, err := runRunnerCommand(manifest, port, debug, outputStreamWriter)
+func StartLegacyRunner(ctx context.Context, manifest *manifest.Manifest, port string, outputStreamWriter *logger.LogWriter, killChannel chan bool, debug bool) (*LegacyRunner, error) {
+	cmd, r, err := runRunnerCommand(ctx, manifest, port, debug, outputStreamWriter)
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +169,8 @@ func StartLegacyRunner(manifest *manifest.Manifest, port string, outputStreamWri
 	}()
 	// Wait for the process to exit so we will get a detailed error message
 	errChannel := make(chan error)
-	testRunner := &LegacyRunner{info: r, Cmd: cmd, errorChannel: errChannel, mutex: &sync.Mutex{}, multiThreaded: r.Multithreaded}
+	testRunner := &LegacyRunner{info: r, Cmd: cmd, errorChannel: errChannel, mutex: &sync.Mutex{}, multiThreaded: r.Multithreaded, exited: make(chan struct{})}
 	testRunner.waitAndGetErrorMessage()
+	killOnCancel(ctx, cmd, testRunner.exited)
 	return testRunner, nil
 }
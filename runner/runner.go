@@ -7,6 +7,7 @@
 package runner
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -14,6 +15,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -119,7 +121,7 @@ func errorResult(message string) *gauge_messages.ProtoExecutionResult {
 	return &gauge_messages.ProtoExecutionResult{Failed: true, ErrorMessage: message, RecoverableError: false}
 }
 
-func runRunnerCommand(manifest *manifest.Manifest, port string, debug bool, writer *logger.LogWriter) (*exec.Cmd, *RunnerInfo, error) {
+func runRunnerCommand(ctx context.Context, manifest *manifest.Manifest, port string, debug bool, writer *logger.LogWriter) (*exec.Cmd, *RunnerInfo, error) {
 	var r RunnerInfo
 	runnerDir, err := getLanguageJSONFilePath(manifest, &r)
 	if err != nil {
@@ -130,7 +132,7 @@ func runRunnerCommand(manifest *manifest.Manifest, port string, debug bool, writ
 		return nil, nil, fmt.Errorf("Compatibility error. %s", compatibilityErr.Error())
 	}
 	command := getOsSpecificCommand(&r)
-	env := getCleanEnv(port, os.Environ(), debug, getPluginPaths())
+	env := withEnvOf(ctx, getCleanEnv(port, os.Environ(), debug, getPluginPaths()))
 	cmd, err := common.ExecuteCommandWithEnv(command, runnerDir, writer.Stdout, writer.Stderr, env)
 	return cmd, &r, err
 }
@@ -161,6 +163,7 @@ func getLanguageJSONFilePath(manifest *manifest.Manifest, r *RunnerInfo) (string
 func (r *LegacyRunner) waitAndGetErrorMessage() {
 	go func() {
 		pState, err := r.Cmd.Process.Wait()
+		close(r.exited)
 		r.mutex.Lock()
 		r.Cmd.ProcessState = pState
 		r.mutex.Unlock()
@@ -199,6 +202,37 @@ func getCleanEnv(port string, env []string, debug bool, pathToAdd []string) []st
 	return env
 }
 
+type envKey struct{}
+
+// WithEnv gives a context whose runners start with the variables on top of the environment of gauge, like the
+// environment of a client of the daemon
+func WithEnv(ctx context.Context, vars map[string]string) context.Context {
+	return context.WithValue(ctx, envKey{}, vars)
+}
+
+// withEnvOf sets the variables of the context in the environment, in place of those of the same name
+func withEnvOf(ctx context.Context, env []string) []string {
+	vars, _ := ctx.Value(envKey{}).(map[string]string)
+	if len(vars) == 0 {
+		return env
+	}
+	result := make([]string, 0, len(env)+len(vars))
+	for _, e := range env {
+		if _, ok := vars[strings.Split(e, "=")[0]]; !ok {
+			result = append(result, e)
+		}
+	}
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		result = append(result, name+"="+vars[name])
+	}
+	return result
+}
+
 func getOsSpecificCommand(r *RunnerInfo) []string {
 	var command []string
 	switch runtime.GOOS {
@@ -212,10 +246,12 @@ func getOsSpecificCommand(r *RunnerInfo) []string {
 	return command
 }
 
-func Start(manifest *manifest.Manifest, stream int, killChannel chan bool, debug bool) (Runner, error) {
+// Start starts the runner of the project language. The runner is killed when the context is done, so that it does
+// not outlive a cancelled run.
+func Start(ctx context.Context, manifest *manifest.Manifest, stream int, killChannel chan bool, debug bool) (Runner, error) {
 	ri, err := GetRunnerInfo(manifest.Language)
 	if err == nil && ri.GRPCSupport {
-		return StartGrpcRunner(manifest, os.Stdout, os.Stderr, config.RunnerRequestTimeout(), true)
+		return StartGrpcRunner(ctx, manifest, os.Stdout, os.Stderr, config.RunnerRequestTimeout(), true)
 	}
 
 	writer := logger.NewLogWriter(manifest.Language, true, stream)
@@ -228,7 +264,7 @@ func Start(manifest *manifest.Manifest, stream int, killChannel chan bool, debug
 		return nil, err
 	}
 	logger.Debugf(true, "Starting %s runner", manifest.Language)
-	runner, err := StartLegacyRunner(manifest, strconv.Itoa(handler.ConnectionPortNumber()), writer, killChannel, debug)
+	runner, err := StartLegacyRunner(ctx, manifest, strconv.Itoa(handler.ConnectionPortNumber()), writer, killChannel, debug)
 	if err != nil {
 		return nil, err
 	}
@@ -236,6 +272,23 @@ func Start(manifest *manifest.Manifest, stream int, killChannel chan bool, debug
 	return runner, err
 }
 
+// killOnCancel kills the process when the context is done before the process exits
+func killOnCancel(ctx context.Context, cmd *exec.Cmd, exited <-chan struct{}) {
+	if ctx.Done() == nil {
+		return
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			logger.Debugf(true, "Killing runner with PID:%d as the run is cancelled", cmd.Process.Pid)
+			if err := cmd.Process.Kill(); err != nil {
+				logger.Debugf(true, "Unable to kill runner with PID:%d. %s", cmd.Process.Pid, err.Error())
+			}
+		case <-exited:
+		}
+	}()
+}
+
 func connect(h *conn.GaugeConnectionHandler, runner *LegacyRunner) error {
 	connection, connErr := h.AcceptConnection(config.RunnerConnectionTimeout(), runner.errorChannel)
 	if connErr != nil {
@@ -7,12 +7,17 @@
 package runner
 
 import (
+	"context"
 	"os"
+	"os/exec"
 	"reflect"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/getgauge/common"
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
 )
 
 func TestGetCleanEnvRemovesGAUGE_INTERNAL_PORTAndSetsPortNumber(t *testing.T) {
@@ -46,3 +51,57 @@ func TestGetCleanEnvAddsToPath(t *testing.T) {
 		t.Errorf("getCleanEnv failed. Did not append to path.\n\tWanted PATH to contain: `%s`", want)
 	}
 }
+
+func TestKillOnCancelKillsProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs sleep")
+	}
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Skip(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	killOnCancel(ctx, cmd, make(chan struct{}))
+
+	cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		_ = cmd.Process.Kill()
+		t.Error("Expected the process to be killed when the context is cancelled")
+	}
+}
+
+func TestExecuteAndGetStatusFailsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := &GrpcRunner{ctx: ctx, info: &RunnerInfo{}}
+
+	res := r.ExecuteAndGetStatus(&gauge_messages.Message{MessageType: gauge_messages.Message_ExecuteStep})
+
+	if !res.Failed || !strings.Contains(res.ErrorMessage, "cancelled") {
+		t.Errorf("Expected a cancelled failure, got %v", res)
+	}
+}
+
+func TestWithEnvOfSetsTheVariablesOfTheContext(t *testing.T) {
+	ctx := WithEnv(context.Background(), map[string]string{"gauge_environment": "ci", "api_token": "secret"})
+
+	got := withEnvOf(ctx, []string{"HOME=/home/gauge", "gauge_environment=default"})
+
+	want := []string{"HOME=/home/gauge", "api_token=secret", "gauge_environment=ci"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Did not set the env of the context.\n\tWant: %v\n\tGot: %v", want, got)
+	}
+}
+
+func TestWithEnvOfKeepsTheEnvWithoutVariablesInTheContext(t *testing.T) {
+	env := []string{"HOME=/home/gauge"}
+
+	if got := withEnvOf(context.Background(), env); !reflect.DeepEqual(got, env) {
+		t.Errorf("Expected the env unchanged, got %v", got)
+	}
+}
@@ -20,6 +20,16 @@ overwrite_reports = true
 # Set to false to disable screenshots on failure in reports.
 screenshot_on_failure = true
 
+# When screenshots are taken: on-failure, always, never or on-step-tags. Takes precedence over screenshot_on_failure.
+# With on-step-tags, only the steps of scenarios tagged with one of gauge_screenshot_tags are screenshot.
+# gauge_screenshot_strategy = on-failure
+
+# Branding of the reports: a title replacing the project name, a logo relative to the project directory
+# and extra summary fields written as name: value pairs.
+# gauge_report_title = Checkout acceptance tests
+# gauge_report_logo = assets/logo.png
+# gauge_report_fields = Build: ${BUILD_NUMBER}, Team: Payments
+
 # The path to the gauge logs directory. Should be either relative to the project directory or an absolute path
 logs_directory = logs
 
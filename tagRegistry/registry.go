@@ -0,0 +1,151 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package tagRegistry reads the tags.yaml file of a project, which declares the known tags along with their descriptions.
+
+	tags:
+	  - name: smoke
+	    description: Quick checks run on every commit
+	  - name: team:*
+	    description: Team owning the scenario
+	  - name: slow
+	    deprecated: true
+	    replacedBy: nightly
+	rules:
+	  - path: specs/api/
+	    tags: [api]
+	  - heading: (?i)checkout
+	    tags: [team:payments]
+
+A name ending with * declares every tag with that prefix. Rules add tags to the specs and scenarios
+they match right after parsing, so filtering and reports see them like tags written in the spec.
+*/
+package tagRegistry
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/gauge/env"
+	"gopkg.in/yaml.v2"
+)
+
+// File is the name of the tag registry in the project root
+const File = "tags.yaml"
+
+// Tag is a tag declared in the registry
+type Tag struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Deprecated  bool   `yaml:"deprecated"`
+	ReplacedBy  string `yaml:"replacedBy"`
+}
+
+// Registry holds the tags declared by a project and the rules to apply them automatically
+type Registry struct {
+	Tags  []*Tag  `yaml:"tags"`
+	Rules []*Rule `yaml:"rules"`
+}
+
+// Load reads the tag registry from the given project root. It returns nil if the project has no registry.
+func Load(projectRoot string) (*Registry, error) {
+	p := filepath.Join(projectRoot, File)
+	content, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s. %s", p, err.Error())
+	}
+	r, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s. %s", p, err.Error())
+	}
+	return r, nil
+}
+
+// Parse creates a registry from the contents of a tags.yaml file
+func Parse(content []byte) (*Registry, error) {
+	r := &Registry{}
+	if err := yaml.UnmarshalStrict(content, r); err != nil {
+		return nil, err
+	}
+	for i, t := range r.Tags {
+		t.Name = strings.TrimSpace(t.Name)
+		if t.Name == "" {
+			return nil, fmt.Errorf("tag at position %d has no name", i+1)
+		}
+	}
+	for i, rule := range r.Rules {
+		if err := rule.compile(); err != nil {
+			return nil, fmt.Errorf("rule at position %d: %s", i+1, err.Error())
+		}
+	}
+	return r, nil
+}
+
+// Get returns the registry entry which declares the given tag
+func (r *Registry) Get(tag string) (*Tag, bool) {
+	if r == nil {
+		return nil, false
+	}
+	var match *Tag
+	for _, t := range r.Tags {
+		if strings.HasSuffix(t.Name, "*") {
+			if match == nil && hasPrefix(tag, strings.TrimSuffix(t.Name, "*")) {
+				match = t
+			}
+			continue
+		}
+		if equal(t.Name, tag) {
+			return t, true
+		}
+	}
+	return match, match != nil
+}
+
+// Canonical returns the tag with the casing it is declared with in the registry. Tags which are not declared are returned as they are.
+func (r *Registry) Canonical(tag string) string {
+	t, ok := r.Get(tag)
+	if !ok {
+		return tag
+	}
+	if !strings.HasSuffix(t.Name, "*") {
+		return t.Name
+	}
+	prefix := strings.TrimSuffix(t.Name, "*")
+	return prefix + tag[len(prefix):]
+}
+
+// Check returns an error if the tag is not declared in the registry or is deprecated
+func (r *Registry) Check(tag string) error {
+	t, ok := r.Get(tag)
+	if !ok {
+		return fmt.Errorf("tag '%s' is not declared in %s", tag, File)
+	}
+	if !t.Deprecated {
+		return nil
+	}
+	if t.ReplacedBy != "" {
+		return fmt.Errorf("tag '%s' is deprecated, use '%s' instead", tag, t.ReplacedBy)
+	}
+	return fmt.Errorf("tag '%s' is deprecated", tag)
+}
+
+func equal(a, b string) bool {
+	if env.AllowCaseSensitiveTags() {
+		return a == b
+	}
+	return strings.EqualFold(a, b)
+}
+
+func hasPrefix(s, prefix string) bool {
+	return len(s) >= len(prefix) && equal(s[:len(prefix)], prefix)
+}
@@ -0,0 +1,114 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package tagRegistry
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const registry = `
+tags:
+  - name: smoke
+    description: Quick checks run on every commit
+  - name: team:*
+    description: Team owning the scenario
+  - name: slow
+    deprecated: true
+    replacedBy: nightly
+`
+
+func TestGetTag(t *testing.T) {
+	r, err := Parse([]byte(registry))
+	if err != nil {
+		t.Fatalf("Expected no error. Got: %s", err.Error())
+	}
+
+	if tag, ok := r.Get("Smoke"); !ok || tag.Description != "Quick checks run on every commit" {
+		t.Errorf("Expected smoke to be declared. Got: %v", tag)
+	}
+	if tag, ok := r.Get("team:payments"); !ok || tag.Name != "team:*" {
+		t.Errorf("Expected team:payments to match team:*. Got: %v", tag)
+	}
+	if _, ok := r.Get("regression"); ok {
+		t.Errorf("Expected regression to be unknown")
+	}
+}
+
+func TestCanonicalTag(t *testing.T) {
+	r, err := Parse([]byte(registry))
+	if err != nil {
+		t.Fatalf("Expected no error. Got: %s", err.Error())
+	}
+
+	for tag, want := range map[string]string{"SMOKE": "smoke", "Team:Payments": "team:Payments", "Regression": "Regression"} {
+		if got := r.Canonical(tag); got != want {
+			t.Errorf("Expected %s to be written as %s. Got: %s", tag, want, got)
+		}
+	}
+	var nilRegistry *Registry
+	if got := nilRegistry.Canonical("Smoke"); got != "Smoke" {
+		t.Errorf("Expected tags to be unchanged without a registry. Got: %s", got)
+	}
+}
+
+func TestCheckTag(t *testing.T) {
+	r, _ := Parse([]byte(registry))
+
+	if err := r.Check("smoke"); err != nil {
+		t.Errorf("Expected no error. Got: %s", err.Error())
+	}
+	want := "tag 'slow' is deprecated, use 'nightly' instead"
+	if err := r.Check("slow"); err == nil || err.Error() != want {
+		t.Errorf("Expected error %q. Got: %v", want, err)
+	}
+	want = "tag 'regression' is not declared in tags.yaml"
+	if err := r.Check("regression"); err == nil || err.Error() != want {
+		t.Errorf("Expected error %q. Got: %v", want, err)
+	}
+}
+
+func TestParseFailsForTagWithoutName(t *testing.T) {
+	if _, err := Parse([]byte("tags:\n  - description: foo\n")); err == nil {
+		t.Errorf("Expected an error for a tag without name")
+	}
+}
+
+func TestLoadReturnsNilWithoutRegistry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tagRegistry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	r, err := Load(dir)
+	if r != nil || err != nil {
+		t.Errorf("Expected no registry and no error. Got: %v, %v", r, err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, File), []byte(registry), 0644); err != nil {
+		t.Fatal(err)
+	}
+	r, err = Load(dir)
+	if err != nil || len(r.Tags) != 3 {
+		t.Errorf("Expected 3 tags. Got: %v, %v", r, err)
+	}
+}
+
+func TestParseFailsForInvalidRules(t *testing.T) {
+	for _, content := range []string{
+		"rules:\n  - tags: [api]\n",
+		"rules:\n  - path: specs/api/\n",
+		"rules:\n  - heading: \"(\"\n    tags: [api]\n",
+	} {
+		if _, err := Parse([]byte(content)); err == nil {
+			t.Errorf("Expected an error for %q", content)
+		}
+	}
+}
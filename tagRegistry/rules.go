@@ -0,0 +1,78 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package tagRegistry
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"github.com/getgauge/gauge/util"
+)
+
+// Rule applies tags to the specs whose path matches a glob pattern and to the specs and scenarios
+// whose heading matches a regular expression. A rule with both a path and a heading needs both to match.
+type Rule struct {
+	Path    string   `yaml:"path"`
+	Heading string   `yaml:"heading"`
+	Tags    []string `yaml:"tags"`
+	path    *regexp.Regexp
+	heading *regexp.Regexp
+}
+
+func (rule *Rule) compile() (err error) {
+	if rule.Path == "" && rule.Heading == "" {
+		return fmt.Errorf("rule needs a path or a heading")
+	}
+	if len(rule.Tags) == 0 {
+		return fmt.Errorf("rule has no tags")
+	}
+	if rule.Path != "" {
+		rule.path = util.GlobToRegexp(filepath.ToSlash(rule.Path))
+	}
+	if rule.Heading != "" {
+		if rule.heading, err = regexp.Compile(rule.Heading); err != nil {
+			return fmt.Errorf("invalid heading pattern '%s'. %s", rule.Heading, err.Error())
+		}
+	}
+	return nil
+}
+
+func (rule *Rule) matches(path, heading string) bool {
+	if rule.path != nil && !rule.path.MatchString(filepath.ToSlash(path)) {
+		return false
+	}
+	return rule.heading == nil || rule.heading.MatchString(heading)
+}
+
+// SpecTags returns the tags of the rules matching a spec, given its path relative to the project root and its heading
+func (r *Registry) SpecTags(path, heading string) []string {
+	var tags []string
+	if r == nil {
+		return tags
+	}
+	for _, rule := range r.Rules {
+		if rule.matches(path, heading) {
+			tags = append(tags, rule.Tags...)
+		}
+	}
+	return tags
+}
+
+// ScenarioTags returns the tags of the rules with a heading pattern matching a scenario of the spec at the given path
+func (r *Registry) ScenarioTags(path, heading string) []string {
+	var tags []string
+	if r == nil {
+		return tags
+	}
+	for _, rule := range r.Rules {
+		if rule.heading != nil && rule.matches(path, heading) {
+			tags = append(tags, rule.Tags...)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,139 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package testPack runs the specs of a test pack, a zip of a Gauge project with its specs, concepts, env and step
+implementations, which is local or downloaded from an http(s) URL. The pack is extracted to a temporary workspace
+which becomes the project of the run.
+
+	gauge run https://example.com/checkout-specs.zip
+	gauge run checkout-specs.zip specs/cart.spec
+*/
+package testPack
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/util"
+)
+
+const packFile = "pack.zip"
+
+var workspaces []string
+
+// IsTestPack tells if a run argument is a test pack rather than specs of the project
+func IsTestPack(arg string) bool {
+	return isURL(arg) || strings.EqualFold(filepath.Ext(arg), ".zip")
+}
+
+func isURL(arg string) bool {
+	return strings.HasPrefix(arg, "http://") || strings.HasPrefix(arg, "https://")
+}
+
+// Open extracts the test pack, after downloading it if it is a URL, to a new workspace and gives the root of the
+// project in it
+func Open(source string, silent bool) (string, error) {
+	workspace, err := ioutil.TempDir("", "gauge-test-pack")
+	if err != nil {
+		return "", err
+	}
+	workspaces = append(workspaces, workspace)
+	archive := source
+	if isURL(source) {
+		logger.Infof(true, "Downloading test pack %s", source)
+		if archive, err = util.Download(source, workspace, packFile, silent); err != nil {
+			return "", fmt.Errorf("Failed to download test pack %s. %s", source, err.Error())
+		}
+	} else if !common.FileExists(source) {
+		return "", fmt.Errorf("Test pack %s does not exist", source)
+	}
+	project := filepath.Join(workspace, "project")
+	if err = extract(archive, project); err != nil {
+		return "", fmt.Errorf("Failed to extract test pack %s. %s", source, err.Error())
+	}
+	root, err := projectRoot(project)
+	if err != nil {
+		return "", fmt.Errorf("Test pack %s is not a Gauge project. %s", source, err.Error())
+	}
+	logger.Debugf(true, "Extracted test pack %s to %s", source, root)
+	return root, nil
+}
+
+// Close removes the workspaces of the test packs opened
+func Close() {
+	for _, w := range workspaces {
+		if err := os.RemoveAll(w); err != nil {
+			logger.Debugf(true, "Unable to remove test pack workspace %s. %s", w, err.Error())
+		}
+	}
+	workspaces = nil
+}
+
+// projectRoot gives the directory with the manifest, which is the root of the archive or its only directory
+func projectRoot(dir string) (string, error) {
+	if common.FileExists(filepath.Join(dir, common.ManifestFile)) {
+		return dir, nil
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	if len(entries) == 1 && entries[0].IsDir() && common.FileExists(filepath.Join(dir, entries[0].Name(), common.ManifestFile)) {
+		return filepath.Join(dir, entries[0].Name()), nil
+	}
+	return "", fmt.Errorf("%s not found", common.ManifestFile)
+}
+
+// extract unzips the archive into the directory, refusing entries which would be written outside of it
+func extract(archive, dir string) error {
+	r, err := zip.OpenReader(archive)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	for _, f := range r.File {
+		path := filepath.Join(dir, f.Name)
+		if path != dir && !strings.HasPrefix(path, dir+string(filepath.Separator)) {
+			return fmt.Errorf("%s is outside of the archive", f.Name)
+		}
+		if f.FileInfo().IsDir() {
+			if err = os.MkdirAll(path, common.NewDirectoryPermissions); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = extractFile(f, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractFile(f *zip.File, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	out, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, f.Mode()|0600)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, rc)
+	return err
+}
@@ -0,0 +1,118 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package testPack
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writePack(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "gauge-pack-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive := filepath.Join(dir, "specs.zip")
+	f, err := os.Create(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for name, content := range files {
+		fw, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return archive
+}
+
+func TestIsTestPack(t *testing.T) {
+	for arg, want := range map[string]bool{
+		"https://example.com/specs.zip": true,
+		"http://example.com/pack":       true,
+		"packs/checkout.ZIP":            true,
+		"specs/checkout.spec":           false,
+		"specs":                         false,
+	} {
+		if got := IsTestPack(arg); got != want {
+			t.Errorf("IsTestPack(%s) = %v, want %v", arg, got, want)
+		}
+	}
+}
+
+func TestOpenPackWithProjectInADirectory(t *testing.T) {
+	archive := writePack(t, map[string]string{
+		"checkout/manifest.json":              `{"Language": "js"}`,
+		"checkout/specs/cart.spec":            "# Cart",
+		"checkout/env/default/app.properties": "url = http://localhost",
+	})
+	defer os.RemoveAll(filepath.Dir(archive))
+	defer Close()
+
+	root, err := Open(archive, true)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Base(root) != "checkout" {
+		t.Errorf("expected the project root to be the checkout directory, got %s", root)
+	}
+	if _, err := os.Stat(filepath.Join(root, "env", "default", "app.properties")); err != nil {
+		t.Errorf("expected the env to be extracted, %s", err.Error())
+	}
+}
+
+func TestOpenPackWithoutManifest(t *testing.T) {
+	archive := writePack(t, map[string]string{"specs/cart.spec": "# Cart"})
+	defer os.RemoveAll(filepath.Dir(archive))
+	defer Close()
+
+	_, err := Open(archive, true)
+
+	if err == nil || !strings.Contains(err.Error(), "manifest.json not found") {
+		t.Errorf("expected an error for the missing manifest, got %v", err)
+	}
+}
+
+func TestOpenPackWithEntryOutsideOfIt(t *testing.T) {
+	archive := writePack(t, map[string]string{"manifest.json": "{}", "../escaped.spec": "# Escaped"})
+	defer os.RemoveAll(filepath.Dir(archive))
+	defer Close()
+
+	_, err := Open(archive, true)
+
+	if err == nil || !strings.Contains(err.Error(), "outside of the archive") {
+		t.Errorf("expected an error for the entry outside of the pack, got %v", err)
+	}
+}
+
+func TestCloseRemovesWorkspaces(t *testing.T) {
+	archive := writePack(t, map[string]string{"manifest.json": "{}"})
+	defer os.RemoveAll(filepath.Dir(archive))
+
+	root, err := Open(archive, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	Close()
+
+	if _, err := os.Stat(root); !os.IsNotExist(err) {
+		t.Errorf("expected the workspace to be removed, got %v", err)
+	}
+}
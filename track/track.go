@@ -0,0 +1,176 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+/*
+Package track records telemetry events, like the commands run or the size of executions, when telemetry is set
+to local in gauge.properties. Events are appended as JSON lines to a file of the Gauge home directory, which
+gauge telemetry show prints, and are never sent anywhere. Each category of events can be opted out of.
+*/
+package track
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/logger"
+)
+
+const (
+	// Off turns telemetry off
+	Off = "off"
+	// Local records telemetry events to a file
+	Local = "local"
+
+	// CommandCategory has an event for every gauge command, with the names of the flags set but not their values
+	CommandCategory = "command"
+	// ExecutionCategory has an event for every run, with the numbers of specs and scenarios and the time taken
+	ExecutionCategory = "execution"
+	// InstallCategory has an event for every plugin installed
+	InstallCategory = "install"
+
+	eventsFile = "telemetry.jsonl"
+)
+
+// Categories gives all the categories of events
+var Categories = []string{CommandCategory, ExecutionCategory, InstallCategory}
+
+// Event is a telemetry event, its properties never hold paths, spec contents or flag values
+type Event struct {
+	Time       time.Time         `json:"time"`
+	Category   string            `json:"category"`
+	Name       string            `json:"name"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+func (e Event) String() string {
+	s := fmt.Sprintf("%s %s %s", e.Time.Format(time.RFC3339), e.Category, e.Name)
+	for _, k := range sortedKeys(e.Properties) {
+		s += fmt.Sprintf(" %s=%s", k, e.Properties[k])
+	}
+	return s
+}
+
+var mutex sync.Mutex
+
+// Enabled tells if events of the category are recorded
+var Enabled = func(category string) bool {
+	if config.Telemetry() != Local {
+		return false
+	}
+	for _, c := range config.TelemetryOptOut() {
+		if c == category {
+			return false
+		}
+	}
+	return true
+}
+
+// File gives the path of the file the events are recorded to
+func File() (string, error) {
+	home, err := common.GetGaugeHomeDirectory()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, eventsFile), nil
+}
+
+// Record appends an event to the events file if its category is enabled. Failures are only logged, telemetry
+// never gets in the way of a command.
+func Record(category, name string, properties map[string]string) {
+	if !Enabled(category) {
+		return
+	}
+	file, err := File()
+	if err != nil {
+		logger.Debugf(true, "Unable to record telemetry event. %s", err.Error())
+		return
+	}
+	if err := record(file, Event{Time: time.Now().UTC(), Category: category, Name: name, Properties: properties}); err != nil {
+		logger.Debugf(true, "Unable to record telemetry event. %s", err.Error())
+	}
+}
+
+func record(file string, e Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if err := os.MkdirAll(filepath.Dir(file), common.NewDirectoryPermissions); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, common.NewFilePermissions)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(b, '\n'))
+	return err
+}
+
+// Events reads the events recorded to the file, oldest first
+func Events(file string) ([]Event, error) {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return []Event{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	events := make([]Event, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			logger.Debugf(true, "Skipping invalid telemetry event %s. %s", scanner.Text(), err.Error())
+			continue
+		}
+		events = append(events, e)
+	}
+	return events, scanner.Err()
+}
+
+// Command records that a gauge command ran with the given flags set
+func Command(command string, flags []string) {
+	Record(CommandCategory, command, map[string]string{"flags": strings.Join(flags, ",")})
+}
+
+// Execution records the size, result and time taken of a run
+func Execution(specs, scenarios, failedScenarios int, parallel bool, executionTime time.Duration) {
+	Record(ExecutionCategory, "run", map[string]string{
+		"specs":     strconv.Itoa(specs),
+		"scenarios": strconv.Itoa(scenarios),
+		"failed":    strconv.Itoa(failedScenarios),
+		"parallel":  strconv.FormatBool(parallel),
+		"time":      executionTime.String(),
+	})
+}
+
+// Install records that a plugin was installed
+func Install(plugin, version string) {
+	Record(InstallCategory, "plugin", map[string]string{"plugin": plugin, "version": version})
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,93 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package track
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func gaugeHome(t *testing.T, properties string) string {
+	home, err := ioutil.TempDir("", "gauge-home")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(home, "config"), 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(home, "config", "gauge.properties"), []byte(properties), 0644); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("GAUGE_HOME", home)
+	return home
+}
+
+func TestEnabled(t *testing.T) {
+	defer os.Setenv("GAUGE_HOME", "")
+	home := gaugeHome(t, "telemetry = local\ntelemetry_opt_out = Install, command\n")
+	defer os.RemoveAll(home)
+
+	if !Enabled(ExecutionCategory) {
+		t.Error("expected execution events to be recorded")
+	}
+	if Enabled(InstallCategory) || Enabled(CommandCategory) {
+		t.Error("expected opted out categories not to be recorded")
+	}
+}
+
+func TestNothingIsRecordedWhenTelemetryIsOff(t *testing.T) {
+	defer os.Setenv("GAUGE_HOME", "")
+	home := gaugeHome(t, "telemetry = off\n")
+	defer os.RemoveAll(home)
+
+	Command("gauge run", []string{"parallel"})
+
+	if _, err := os.Stat(filepath.Join(home, eventsFile)); !os.IsNotExist(err) {
+		t.Errorf("expected no events file, got %v", err)
+	}
+}
+
+func TestRecordAndReadEvents(t *testing.T) {
+	defer os.Setenv("GAUGE_HOME", "")
+	home := gaugeHome(t, "telemetry = local\ntelemetry_opt_out = install\n")
+	defer os.RemoveAll(home)
+
+	Command("gauge run", []string{"parallel", "tags"})
+	Install("html-report", "4.0.0")
+	Execution(2, 5, 1, true, 1500*time.Millisecond)
+
+	file, err := File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := Events(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %v", events)
+	}
+	if events[0].Category != CommandCategory || events[0].Name != "gauge run" || events[0].Properties["flags"] != "parallel,tags" {
+		t.Errorf("unexpected command event %+v", events[0])
+	}
+	e := events[1]
+	e.Time = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	if e.String() != "2020-01-02T03:04:05Z execution run failed=1 parallel=true scenarios=5 specs=2 time=1.5s" {
+		t.Errorf("unexpected execution event %s", e)
+	}
+}
+
+func TestEventsOfMissingFile(t *testing.T) {
+	events, err := Events(filepath.Join(os.TempDir(), "missing-telemetry.jsonl"))
+
+	if err != nil || len(events) != 0 {
+		t.Errorf("expected no events, got %v %v", events, err)
+	}
+}
@@ -0,0 +1,148 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"strings"
+	"unicode"
+)
+
+const ellipsis = "…"
+
+// ConsoleTable renders rows of text as a table, aligned to the width the cells take on a terminal. Wide characters,
+// like those of CJK scripts, take two columns and combining marks none.
+type ConsoleTable struct {
+	Headers []string
+	Rows    [][]string
+	// MaxCellWidth is the width beyond which cells are truncated with an ellipsis, 0 does not truncate
+	MaxCellWidth int
+	// Indent is the number of spaces before each line
+	Indent int
+	// Bordered writes the cells between pipes with a line under the headers, the way tables are written in specs.
+	// Otherwise the columns are separated by two spaces and the line under the headers is dashes.
+	Bordered bool
+}
+
+// String gives the lines of the table, each ending with a newline
+func (t *ConsoleTable) String() string {
+	cols := len(t.Headers)
+	for _, row := range t.Rows {
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+	header := t.cells(t.Headers, cols)
+	rows := make([][]string, len(t.Rows))
+	for i, row := range t.Rows {
+		rows[i] = t.cells(row, cols)
+	}
+	widths := make([]int, cols)
+	for _, row := range append([][]string{header}, rows...) {
+		for i, cell := range row {
+			if w := DisplayWidth(cell); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+	var b strings.Builder
+	if len(t.Headers) > 0 {
+		t.writeRow(&b, header, widths)
+		underline := make([]string, cols)
+		for i, w := range widths {
+			underline[i] = strings.Repeat("-", w)
+		}
+		t.writeRow(&b, underline, widths)
+	}
+	for _, row := range rows {
+		t.writeRow(&b, row, widths)
+	}
+	return b.String()
+}
+
+func (t *ConsoleTable) cells(row []string, cols int) []string {
+	cells := make([]string, cols)
+	for i := range cells {
+		if i < len(row) {
+			cells[i] = Truncate(row[i], t.MaxCellWidth)
+		}
+	}
+	return cells
+}
+
+func (t *ConsoleTable) writeRow(b *strings.Builder, cells []string, widths []int) {
+	var line strings.Builder
+	line.WriteString(strings.Repeat(" ", t.Indent))
+	if t.Bordered {
+		line.WriteString("|")
+	}
+	for i, cell := range cells {
+		padding := strings.Repeat(" ", widths[i]-DisplayWidth(cell))
+		if t.Bordered {
+			line.WriteString(cell + padding + "|")
+		} else {
+			line.WriteString(cell + padding + "  ")
+		}
+	}
+	if t.Bordered {
+		b.WriteString(line.String() + "\n")
+	} else {
+		b.WriteString(strings.TrimRight(line.String(), " ") + "\n")
+	}
+}
+
+// Truncate shortens the text to the display width, ending it with an ellipsis when it is cut. The text is kept
+// whole when width is 0.
+func Truncate(text string, width int) string {
+	if width <= 0 || DisplayWidth(text) <= width {
+		return text
+	}
+	var b strings.Builder
+	w := 0
+	for _, r := range text {
+		if w+runeWidth(r) > width-1 {
+			break
+		}
+		b.WriteRune(r)
+		w += runeWidth(r)
+	}
+	return b.String() + ellipsis
+}
+
+// DisplayWidth gives the number of columns the text takes on a terminal
+func DisplayWidth(text string) int {
+	w := 0
+	for _, r := range text {
+		w += runeWidth(r)
+	}
+	return w
+}
+
+func runeWidth(r rune) int {
+	switch {
+	case unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) || unicode.Is(unicode.Cf, r):
+		return 0
+	case unicode.IsControl(r):
+		return 0
+	case isWide(r):
+		return 2
+	}
+	return 1
+}
+
+// isWide tells if the rune is of the East Asian wide or fullwidth ranges, or an emoji
+func isWide(r rune) bool {
+	return r >= 0x1100 && (r <= 0x115F || r == 0x2329 || r == 0x232A ||
+		(r >= 0x2E80 && r <= 0xA4CF && r != 0x303F) ||
+		(r >= 0xAC00 && r <= 0xD7A3) ||
+		(r >= 0xF900 && r <= 0xFAFF) ||
+		(r >= 0xFE30 && r <= 0xFE4F) ||
+		(r >= 0xFF00 && r <= 0xFF60) ||
+		(r >= 0xFFE0 && r <= 0xFFE6) ||
+		(r >= 0x1F300 && r <= 0x1F64F) ||
+		(r >= 0x1F900 && r <= 0x1F9FF) ||
+		(r >= 0x20000 && r <= 0x3FFFD))
+}
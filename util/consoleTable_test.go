@@ -0,0 +1,41 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestBorderedConsoleTableAlignsWideCharacters(c *C) {
+	t := &ConsoleTable{Headers: []string{"id", "name"}, Rows: [][]string{{"1", "苹果"}, {"22", "apple"}}, Indent: 3, Bordered: true}
+
+	c.Assert(t.String(), Equals, "   |id|name |\n   |--|-----|\n   |1 |苹果 |\n   |22|apple|\n")
+}
+
+func (s *MySuite) TestConsoleTableSeparatesColumnsBySpaces(c *C) {
+	t := &ConsoleTable{Headers: []string{"Name", "Version"}, Rows: [][]string{{"html-report", "4.0.1"}, {"java"}}}
+
+	c.Assert(t.String(), Equals, "Name         Version\n-----------  -------\nhtml-report  4.0.1\njava\n")
+}
+
+func (s *MySuite) TestConsoleTableTruncatesLongCells(c *C) {
+	t := &ConsoleTable{Rows: [][]string{{"a very long value", "ok"}}, MaxCellWidth: 8}
+
+	c.Assert(t.String(), Equals, "a very …  ok\n")
+}
+
+func (s *MySuite) TestTruncateCountsTheWidthOfCharacters(c *C) {
+	c.Assert(Truncate("日本語のテキスト", 7), Equals, "日本語…")
+	c.Assert(Truncate("short", 8), Equals, "short")
+	c.Assert(Truncate("unlimited", 0), Equals, "unlimited")
+}
+
+func (s *MySuite) TestDisplayWidth(c *C) {
+	c.Assert(DisplayWidth("abc"), Equals, 3)
+	c.Assert(DisplayWidth("日本"), Equals, 4)
+	c.Assert(DisplayWidth("é"), Equals, 1)
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/getgauge/common"
@@ -62,10 +63,18 @@ func addDirFromEnv(name string, add func(value string)) {
 	}
 }
 
-// findFilesIn Finds all the files in the directory of a given extension
+// findFilesIn Finds all the files in the directory of a given extension, leaving out those of .gaugeignore
 func findFilesIn(dirRoot string, isValidFile func(path string) bool, shouldSkip func(path string, f os.FileInfo) bool) []string {
 	absRoot, _ := filepath.Abs(dirRoot)
-	files := common.FindFilesInDir(absRoot, isValidFile, shouldSkip)
+	ignore := loadGaugeIgnore(config.ProjectRoot)
+	files := walkFiles(LongPath(absRoot), func(path string) bool {
+		return isValidFile(path) && !ignore.ignores(path, false)
+	}, func(path string, f os.FileInfo) bool {
+		return (f.IsDir() && ignore.ignores(path, true)) || shouldSkip(path, f)
+	}, env.FollowSymlinks())
+	for i, f := range files {
+		files[i] = NormalizePath(f)
+	}
 	return files
 }
 
@@ -93,7 +102,7 @@ func FindConceptFilesIn(dir string) []string {
 		if !f.IsDir() {
 			return false
 		}
-		_, ok := ignoredDirectories[path]
+		_, ok := ignoredDirectories[NormalizePath(path)]
 		return strings.HasPrefix(f.Name(), ".") || ok
 	})
 }
@@ -220,7 +229,7 @@ func SaveFile(fileName string, content string, backup bool) {
 }
 
 func RelPathToProjectRoot(path string) string {
-	return strings.TrimPrefix(path, config.ProjectRoot+string(filepath.Separator))
+	return trimPathPrefix(NormalizePath(path), NormalizePath(config.ProjectRoot), IsWindows())
 }
 
 // GetPathToFile returns the path to a given file from the Project root
@@ -266,3 +275,30 @@ func GetLineCount(text string) int {
 func OpenFile(fileName string) (io.Writer, error) {
 	return os.OpenFile(fileName, os.O_APPEND|os.O_WRONLY, 0600)
 }
+
+// GlobToRegexp converts a glob pattern, where ** matches across directories, to a regular expression.
+// A pattern ending with / matches everything below that directory.
+func GlobToRegexp(pattern string) *regexp.Regexp {
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				b.WriteString(".*")
+				i++
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
@@ -301,3 +301,21 @@ func createDirIn(dir string, dirName string) (string, error) {
 	err = os.Rename(tempDir, fullDirName)
 	return fullDirName, err
 }
+
+func (s *MySuite) TestGlobToRegexp(c *C) {
+	tests := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{"specs/api/", "specs/api/orders.spec", true},
+		{"specs/api/", "specs/api/v2/orders.spec", true},
+		{"specs/*.spec", "specs/api/orders.spec", false},
+		{"specs/**/*.spec", "specs/api/orders.spec", true},
+		{"specs/?.spec", "specs/a.spec", true},
+		{"specs/a.spec", "specs/ab.spec", false},
+	}
+	for _, test := range tests {
+		c.Assert(GlobToRegexp(test.pattern).MatchString(test.path), Equals, test.match, Commentf("%s matching %s", test.pattern, test.path))
+	}
+}
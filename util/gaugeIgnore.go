@@ -0,0 +1,156 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/getgauge/gauge/logger"
+)
+
+// gaugeIgnoreFile is the file of the project root which lists, in gitignore syntax, the files and directories left
+// out of the discovery of specs and concepts
+const gaugeIgnoreFile = ".gaugeignore"
+
+type ignoreRule struct {
+	pattern *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// gaugeIgnore holds the rules of a .gaugeignore file, paths are matched relative to its directory
+type gaugeIgnore struct {
+	root  string
+	rules []ignoreRule
+}
+
+// loadGaugeIgnore reads the .gaugeignore file of the directory, which has no rules if the file does not exist
+func loadGaugeIgnore(root string) *gaugeIgnore {
+	g := &gaugeIgnore{root: NormalizePath(root)}
+	f, err := os.Open(LongPath(filepath.Join(root, gaugeIgnoreFile)))
+	if err != nil {
+		return g
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		rule, ok, err := parseIgnoreRule(scanner.Text())
+		if err != nil {
+			logger.Warningf(true, "Skipping invalid pattern '%s' of %s. %s", scanner.Text(), gaugeIgnoreFile, err.Error())
+			continue
+		}
+		if ok {
+			g.rules = append(g.rules, rule)
+		}
+	}
+	return g
+}
+
+func parseIgnoreRule(line string) (ignoreRule, bool, error) {
+	var rule ignoreRule
+	line = strings.TrimRight(line, " \t")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return rule, false, nil
+	}
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimSuffix(line, "/")
+	}
+	if line == "" {
+		return rule, false, nil
+	}
+	// a pattern with a slash is relative to the root, any other matches at any depth
+	if strings.Contains(line, "/") {
+		line = strings.TrimPrefix(line, "/")
+	} else {
+		line = "**/" + line
+	}
+	var err error
+	rule.pattern, err = ignorePatternRegexp(line)
+	return rule, true, err
+}
+
+func ignorePatternRegexp(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case pattern[i:] == "/**":
+			b.WriteString("/.*")
+			i += 2
+		case c == '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				b.WriteString(".*")
+				i++
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case c == '?':
+			b.WriteString("[^/]")
+		case c == '[' && strings.Contains(pattern[i+1:], "]"):
+			end := i + 1 + strings.Index(pattern[i+1:], "]")
+			class := pattern[i+1 : end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i = end
+		case c == '\\' && i+1 < len(pattern):
+			b.WriteString(regexp.QuoteMeta(string(pattern[i+1])))
+			i++
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
+
+// ignores tells if the file or directory is left out by the rules. As with gitignore, the files of an ignored
+// directory can not be brought back by a negated rule.
+func (g *gaugeIgnore) ignores(path string, isDir bool) bool {
+	if len(g.rules) == 0 {
+		return false
+	}
+	rel, err := filepath.Rel(g.root, NormalizePath(path))
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return false
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	for i := 1; i < len(parts); i++ {
+		if g.matches(strings.Join(parts[:i], "/"), true) {
+			return true
+		}
+	}
+	return g.matches(strings.Join(parts, "/"), isDir)
+}
+
+func (g *gaugeIgnore) matches(rel string, isDir bool) bool {
+	ignored := false
+	for _, r := range g.rules {
+		if r.dirOnly && !isDir {
+			continue
+		}
+		if r.pattern.MatchString(rel) {
+			ignored = !r.negate
+		}
+	}
+	return ignored
+}
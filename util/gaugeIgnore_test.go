@@ -0,0 +1,71 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+func gaugeIgnoreOf(c *C, root string, rules ...string) *gaugeIgnore {
+	g := &gaugeIgnore{root: root}
+	for _, line := range rules {
+		rule, ok, err := parseIgnoreRule(line)
+		c.Assert(err, IsNil)
+		if ok {
+			g.rules = append(g.rules, rule)
+		}
+	}
+	return g
+}
+
+func (s *MySuite) TestGaugeIgnoreRules(c *C) {
+	root := filepath.FromSlash("/project")
+	path := func(p string) string { return filepath.Join(root, filepath.FromSlash(p)) }
+	g := gaugeIgnoreOf(c, root, "# drafts are not run", "", "*.draft.spec", "/vendor/", "specs/**/generated", "!keep.draft.spec", "tmp?/")
+
+	c.Assert(g.ignores(path("specs/login.draft.spec"), false), Equals, true)
+	c.Assert(g.ignores(path("specs/keep.draft.spec"), false), Equals, false)
+	c.Assert(g.ignores(path("specs/login.spec"), false), Equals, false)
+	c.Assert(g.ignores(path("vendor"), true), Equals, true)
+	c.Assert(g.ignores(path("vendor/lib/a.spec"), false), Equals, true)
+	c.Assert(g.ignores(path("specs/vendor"), true), Equals, false)
+	c.Assert(g.ignores(path("specs/generated"), true), Equals, true)
+	c.Assert(g.ignores(path("specs/a/b/generated/x.spec"), false), Equals, true)
+	c.Assert(g.ignores(path("specs/tmp1"), true), Equals, true)
+	c.Assert(g.ignores(path("specs/tmp1"), false), Equals, false)
+	c.Assert(g.ignores(filepath.FromSlash("/elsewhere/a.draft.spec"), false), Equals, false)
+}
+
+func (s *MySuite) TestNegatedRuleCanNotBringBackFilesOfIgnoredDirectory(c *C) {
+	root := filepath.FromSlash("/project")
+	g := gaugeIgnoreOf(c, root, "drafts/", "!drafts/keep.spec")
+
+	c.Assert(g.ignores(filepath.Join(root, "drafts", "keep.spec"), false), Equals, true)
+}
+
+func (s *MySuite) TestFindSpecFilesLeavesOutGaugeIgnored(c *C) {
+	old := config.ProjectRoot
+	defer func() { config.ProjectRoot = old }()
+	config.ProjectRoot = dir
+	data := []byte("# Spec\n")
+	_, err := createFileIn(dir, gaugeIgnoreFile, []byte("drafts/\n*.wip.spec\n"))
+	c.Assert(err, IsNil)
+	_, err = createFileIn(filepath.Join(dir, "specs"), "login.spec", data)
+	c.Assert(err, IsNil)
+	_, err = createFileIn(filepath.Join(dir, "specs"), "search.wip.spec", data)
+	c.Assert(err, IsNil)
+	_, err = createFileIn(filepath.Join(dir, "specs", "drafts"), "cart.spec", data)
+	c.Assert(err, IsNil)
+
+	files := FindSpecFilesIn(dir)
+
+	c.Assert(len(files), Equals, 1)
+	c.Assert(filepath.Base(files[0]), Equals, "login.spec")
+}
@@ -0,0 +1,35 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	githubDataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	githubPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+// GithubErrorCommand gives the workflow command which makes GitHub Actions show the message as an error
+// annotation on the given line of the file. The file and line are left out when they are not known.
+func GithubErrorCommand(file string, line int, message string) string {
+	var props []string
+	if file != "" {
+		props = append(props, "file="+githubPropertyEscaper.Replace(filepath.ToSlash(RelPathToProjectRoot(file))))
+		if line > 0 {
+			props = append(props, fmt.Sprintf("line=%d", line))
+		}
+	}
+	command := "::error"
+	if len(props) > 0 {
+		command += " " + strings.Join(props, ",")
+	}
+	return fmt.Sprintf("%s::%s", command, githubDataEscaper.Replace(message))
+}
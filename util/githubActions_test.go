@@ -0,0 +1,26 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"path/filepath"
+
+	"github.com/getgauge/gauge/config"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestGithubErrorCommand(c *C) {
+	config.ProjectRoot = filepath.Join("home", "project")
+
+	command := GithubErrorCommand(filepath.Join("home", "project", "specs", "a,b.spec"), 12, "Step failed\nexpected 100% got 50%")
+
+	c.Assert(command, Equals, "::error file=specs/a%2Cb.spec,line=12::Step failed%0Aexpected 100%25 got 50%25")
+}
+
+func (s *MySuite) TestGithubErrorCommandWithoutFile(c *C) {
+	c.Assert(GithubErrorCommand("", 3, "Failed to load concepts"), Equals, "::error::Failed to load concepts")
+}
@@ -0,0 +1,68 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+const (
+	extendedLengthPrefix    = `\\?\`
+	uncExtendedLengthPrefix = `\\?\UNC\`
+)
+
+// LongPath gives the extended-length form of an absolute path on Windows, like \\?\C:\specs, or \\?\UNC\server\share
+// for a path on a network share, which can be read and written even when longer than 260 characters. Paths are
+// returned as they are on other platforms.
+func LongPath(path string) string {
+	if !IsWindows() {
+		return path
+	}
+	return toLongPath(filepath.Clean(path))
+}
+
+func toLongPath(path string) string {
+	switch {
+	case strings.HasPrefix(path, extendedLengthPrefix):
+		return path
+	case strings.HasPrefix(path, `\\`):
+		return uncExtendedLengthPrefix + strings.TrimPrefix(path, `\\`)
+	case len(path) > 2 && path[1] == ':' && path[2] == '\\':
+		return extendedLengthPrefix + path
+	}
+	// relative paths have no extended-length form
+	return path
+}
+
+// NormalizePath gives the usual form of a path, without the extended-length prefix added by LongPath, which is the
+// form shown to users and used to compare paths
+func NormalizePath(path string) string {
+	switch {
+	case strings.HasPrefix(path, uncExtendedLengthPrefix):
+		return `\\` + strings.TrimPrefix(path, uncExtendedLengthPrefix)
+	case strings.HasPrefix(path, extendedLengthPrefix):
+		return strings.TrimPrefix(path, extendedLengthPrefix)
+	}
+	return path
+}
+
+// trimPathPrefix removes the directory from the start of the path. Paths on Windows are compared ignoring case, as
+// drive letters and directory names may be spelt differently.
+func trimPathPrefix(path, dir string, windows bool) string {
+	prefix := dir + "/"
+	if windows {
+		prefix = dir + `\`
+	}
+	if len(path) < len(prefix) {
+		return path
+	}
+	if path[:len(prefix)] == prefix || (windows && strings.EqualFold(path[:len(prefix)], prefix)) {
+		return path[len(prefix):]
+	}
+	return path
+}
@@ -0,0 +1,38 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestToLongPath(c *C) {
+	c.Assert(toLongPath(`C:\projects\specs\login.spec`), Equals, `\\?\C:\projects\specs\login.spec`)
+	c.Assert(toLongPath(`\\server\share\specs`), Equals, `\\?\UNC\server\share\specs`)
+	c.Assert(toLongPath(`\\?\C:\projects`), Equals, `\\?\C:\projects`)
+	c.Assert(toLongPath(`specs\login.spec`), Equals, `specs\login.spec`)
+}
+
+func (s *MySuite) TestNormalizePath(c *C) {
+	c.Assert(NormalizePath(`\\?\C:\projects\specs`), Equals, `C:\projects\specs`)
+	c.Assert(NormalizePath(`\\?\UNC\server\share\specs`), Equals, `\\server\share\specs`)
+	c.Assert(NormalizePath(`/projects/specs`), Equals, `/projects/specs`)
+}
+
+func (s *MySuite) TestLongPathRoundTrip(c *C) {
+	for _, p := range []string{`C:\projects\specs`, `\\server\share\specs`} {
+		c.Assert(NormalizePath(toLongPath(p)), Equals, p)
+	}
+}
+
+func (s *MySuite) TestTrimPathPrefix(c *C) {
+	c.Assert(trimPathPrefix(`c:\Projects\specs\a.spec`, `C:\projects`, true), Equals, `specs\a.spec`)
+	c.Assert(trimPathPrefix(`\\server\share\specs\a.spec`, `\\server\share`, true), Equals, `specs\a.spec`)
+	c.Assert(trimPathPrefix("/projects/specs/a.spec", "/projects", false), Equals, "specs/a.spec")
+	c.Assert(trimPathPrefix("/Projects/specs/a.spec", "/projects", false), Equals, "/Projects/specs/a.spec")
+	c.Assert(trimPathPrefix("/other/a.spec", "/projects", false), Equals, "/other/a.spec")
+}
@@ -0,0 +1,68 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/getgauge/gauge/logger"
+)
+
+// walkFiles finds the files under root for which isValidFile is true, without going into the directories for which
+// shouldSkip is true. Symlinks under root are ignored unless followSymlinks is set. A directory is walked once even
+// when it is linked to more than once, so links to a parent directory do not loop and a shared directory linked
+// to from two places gives its files once.
+func walkFiles(root string, isValidFile func(path string) bool, shouldSkip func(path string, f os.FileInfo) bool, followSymlinks bool) []string {
+	files := []string{}
+	visited := make(map[string]bool)
+	var walk func(path string, info os.FileInfo)
+	walk = func(path string, info os.FileInfo) {
+		if shouldSkip(path, info) {
+			return
+		}
+		if !info.IsDir() {
+			if isValidFile(path) {
+				files = append(files, path)
+			}
+			return
+		}
+		if realPath, err := filepath.EvalSymlinks(path); err == nil {
+			if visited[realPath] {
+				logger.Debugf(true, "Skipping %s, its files are already found through %s.", path, realPath)
+				return
+			}
+			visited[realPath] = true
+		}
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			logger.Debugf(true, "Unable to read directory %s. %s", path, err.Error())
+			return
+		}
+		for _, entry := range entries {
+			p := filepath.Join(path, entry.Name())
+			if entry.Mode()&os.ModeSymlink != 0 {
+				if !followSymlinks {
+					logger.Debugf(true, "Ignoring symlink %s, set gauge_follow_symlinks to follow it.", p)
+					continue
+				}
+				if entry, err = os.Stat(p); err != nil {
+					logger.Debugf(true, "Ignoring broken symlink %s. %s", p, err.Error())
+					continue
+				}
+			}
+			walk(p, entry)
+		}
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return files
+	}
+	walk(root, info)
+	return files
+}
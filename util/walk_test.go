@@ -0,0 +1,56 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package util
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "gopkg.in/check.v1"
+)
+
+func symlinkedSpecsDir(c *C) string {
+	root, err := ioutil.TempDir("", "gauge-symlinks")
+	c.Assert(err, IsNil)
+	shared := filepath.Join(root, "shared")
+	specs := filepath.Join(root, "specs")
+	c.Assert(os.MkdirAll(shared, 0750), IsNil)
+	c.Assert(os.MkdirAll(specs, 0750), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(shared, "login.spec"), []byte("# Login"), 0644), IsNil)
+	c.Assert(ioutil.WriteFile(filepath.Join(specs, "search.spec"), []byte("# Search"), 0644), IsNil)
+	if err := os.Symlink(shared, filepath.Join(specs, "shared")); err != nil {
+		os.RemoveAll(root)
+		c.Skip("symlinks are not supported: " + err.Error())
+	}
+	c.Assert(os.Symlink(specs, filepath.Join(specs, "loop")), IsNil)
+	return root
+}
+
+func noSkip(path string, f os.FileInfo) bool {
+	return false
+}
+
+func (s *MySuite) TestWalkFilesIgnoresSymlinks(c *C) {
+	root := symlinkedSpecsDir(c)
+	defer os.RemoveAll(root)
+	specs := filepath.Join(root, "specs")
+
+	files := walkFiles(specs, IsValidSpecExtension, noSkip, false)
+
+	c.Assert(files, DeepEquals, []string{filepath.Join(specs, "search.spec")})
+}
+
+func (s *MySuite) TestWalkFilesFollowsSymlinksOnce(c *C) {
+	root := symlinkedSpecsDir(c)
+	defer os.RemoveAll(root)
+	specs := filepath.Join(root, "specs")
+
+	files := walkFiles(specs, IsValidSpecExtension, noSkip, true)
+
+	c.Assert(files, DeepEquals, []string{filepath.Join(specs, "search.spec"), filepath.Join(specs, "shared", "login.spec")})
+}
@@ -0,0 +1,64 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+)
+
+// withClosestSteps adds the implemented steps and concepts closest to an unimplemented step to its error,
+// since many unimplemented steps are near-miss typos of existing ones
+func (v *SpecValidator) withClosestSteps(err error) error {
+	e, ok := err.(StepValidationError)
+	if !ok || HideSuggestion || e.errorType == nil || *e.errorType != gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND {
+		return err
+	}
+	for _, sv := range gauge.ClosestStepValues(e.step.Value, v.knownStepValues()) {
+		e.closestSteps = append(e.closestSteps, sv.ParameterizedStepValue)
+	}
+	return e
+}
+
+// knownStepValues gives the values of the steps implemented by the runner and of the concepts, the runner is asked once
+func (v *SpecValidator) knownStepValues() []gauge.StepValue {
+	if v.stepValues != nil {
+		return v.stepValues
+	}
+	v.stepValues = make([]gauge.StepValue, 0)
+	m := &gm.Message{MessageType: gm.Message_StepNamesRequest, StepNamesRequest: &gm.StepNamesRequest{}}
+	r, err := v.runner.ExecuteMessageWithTimeout(m)
+	if err != nil {
+		logger.Debugf(true, "Unable to get the implemented steps from the runner. %s", err.Error())
+	}
+	for _, text := range r.GetStepNamesResponse().GetSteps() {
+		if sv, err := parser.ExtractStepValueAndParams(text, false); err == nil {
+			v.stepValues = append(v.stepValues, *sv)
+		}
+	}
+	if v.conceptsDictionary != nil {
+		names := make([]string, 0, len(v.conceptsDictionary.ConceptsMap))
+		for name := range v.conceptsDictionary.ConceptsMap {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			v.stepValues = append(v.stepValues, parser.CreateStepValue(v.conceptsDictionary.ConceptsMap[name].ConceptStep))
+		}
+	}
+	return v.stepValues
+}
+
+func closestStepsMessage(closestSteps []string) string {
+	return fmt.Sprintf("Did you mean '%s'?", strings.Join(closestSteps, "' or '"))
+}
@@ -0,0 +1,44 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/gauge"
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestStepErrorHasClosestSteps(c *C) {
+	HideSuggestion = false
+	stepNamesRequests := 0
+	runner := &mockRunner{
+		ExecuteMessageFunc: func(m *gauge_messages.Message) (*gauge_messages.Message, error) {
+			if m.MessageType == gauge_messages.Message_StepNamesRequest {
+				stepNamesRequests++
+				res := &gauge_messages.StepNamesResponse{Steps: []string{"Pay with card <number>", "Open the shop"}}
+				return &gauge_messages.Message{MessageType: gauge_messages.Message_StepNamesResponse, StepNamesResponse: res}, nil
+			}
+			res := &gauge_messages.StepValidateResponse{IsValid: false, ErrorType: gauge_messages.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND}
+			return &gauge_messages.Message{MessageType: gauge_messages.Message_StepValidateResponse, StepValidateResponse: res}, nil
+		},
+	}
+	cptDict := gauge.NewConceptDictionary()
+	cptDict.ConceptsMap["Pay with cash"] = &gauge.Concept{ConceptStep: &gauge.Step{Value: "Pay with cash"}, FileName: "pay.cpt"}
+	specVal := &SpecValidator{specification: &gauge.Specification{FileName: "foo.spec"}, runner: runner, conceptsDictionary: cptDict,
+		stepValidationCache: make(map[string]error)}
+	first := &gauge.Step{Value: "Pay with crad {}", LineText: "Pay with crad \"1234\"", LineNo: 3, Args: []*gauge.StepArg{{Value: "1234", ArgType: gauge.Static}}}
+	second := &gauge.Step{Value: "Pay with crad {}", LineText: "Pay with crad \"5678\"", LineNo: 7, Args: []*gauge.StepArg{{Value: "5678", ArgType: gauge.Static}}}
+
+	specVal.Step(first)
+	specVal.Step(second)
+
+	c.Assert(len(specVal.validationErrors), Equals, 2)
+	c.Assert(specVal.validationErrors[0].(StepValidationError).ClosestSteps(), DeepEquals, []string{"Pay with card <number>", "Pay with cash"})
+	c.Assert(specVal.validationErrors[1].(StepValidationError).ClosestSteps(), DeepEquals, []string{"Pay with card <number>", "Pay with cash"})
+	c.Assert(stepNamesRequests, Equals, 1)
+	c.Assert(closestStepsMessage([]string{"Pay with card <number>", "Pay with cash"}), Equals, "Did you mean 'Pay with card <number>' or 'Pay with cash'?")
+}
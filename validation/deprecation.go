@@ -0,0 +1,38 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+func warnOnDeprecatedScenarios(specs []*gauge.Specification) {
+	for _, w := range deprecationWarnings(specs) {
+		logger.Warningf(true, w)
+	}
+}
+
+// deprecationWarnings returns a warning for every deprecated scenario about to be executed
+func deprecationWarnings(specs []*gauge.Specification) (warnings []string) {
+	for _, spec := range specs {
+		for _, scn := range spec.Scenarios {
+			d := spec.ScenarioDeprecation(scn)
+			if d == nil || scn.Heading == nil {
+				continue
+			}
+			w := fmt.Sprintf("%s:%d Scenario '%s' is deprecated", spec.FileName, scn.Heading.LineNo, scn.Heading.Value)
+			if d.Message != "" {
+				w = fmt.Sprintf("%s. %s", w, d.Message)
+			}
+			warnings = append(warnings, w)
+		}
+	}
+	return
+}
@@ -0,0 +1,160 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/getgauge/common"
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/env"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+)
+
+// CheckLinks makes validation verify that the URLs in the comments of the specs resolve
+var CheckLinks bool
+
+const (
+	linkCacheFile = "links.json"
+	linkCacheTTL  = 24 * time.Hour
+	linkTimeout   = 10 * time.Second
+)
+
+var urlPattern = regexp.MustCompile(`https?://[^\s<>()"'\[\]]+`)
+
+// linkChecker verifies links, remembering the ones which resolved so that they are not checked again for a day
+type linkChecker struct {
+	resolve   func(url string) error
+	allowlist []string
+	resolved  map[string]time.Time
+	results   map[string]error
+}
+
+func newLinkChecker() *linkChecker {
+	client := &http.Client{Timeout: linkTimeout}
+	return &linkChecker{
+		resolve:   func(url string) error { return resolveLink(client, url) },
+		allowlist: env.LinkCheckAllowlist(),
+		resolved:  readLinkCache(),
+		results:   make(map[string]error),
+	}
+}
+
+func checkLinks(specs []*gauge.Specification) validationErrors {
+	c := newLinkChecker()
+	errs := c.linkErrors(specs)
+	writeLinkCache(c.resolved)
+	return errs
+}
+
+// linkErrors returns a validation error for every broken link in the comments of the specs
+func (c *linkChecker) linkErrors(specs []*gauge.Specification) validationErrors {
+	errs := make(validationErrors)
+	check := func(spec *gauge.Specification, comments []*gauge.Comment) {
+		for _, comment := range comments {
+			for _, url := range extractURLs(comment.Value) {
+				if err := c.check(url); err != nil {
+					msg := fmt.Sprintf("Link %s is broken. %s", url, err.Error())
+					errs[spec] = append(errs[spec], NewSpecValidationError(msg, fmt.Sprintf("%s:%d", spec.FileName, comment.LineNo)))
+				}
+			}
+		}
+	}
+	for _, spec := range specs {
+		check(spec, spec.Comments)
+		for _, scn := range spec.Scenarios {
+			check(spec, scn.Comments)
+		}
+	}
+	return errs
+}
+
+func (c *linkChecker) check(url string) error {
+	for _, prefix := range c.allowlist {
+		if strings.HasPrefix(url, prefix) {
+			return nil
+		}
+	}
+	if t, ok := c.resolved[url]; ok && time.Since(t) < linkCacheTTL {
+		return nil
+	}
+	if err, ok := c.results[url]; ok {
+		return err
+	}
+	logger.Debugf(true, "Checking link %s", url)
+	err := c.resolve(url)
+	c.results[url] = err
+	if err == nil {
+		c.resolved[url] = time.Now()
+	}
+	return err
+}
+
+func extractURLs(text string) []string {
+	var urls []string
+	for _, url := range urlPattern.FindAllString(text, -1) {
+		urls = append(urls, strings.TrimRight(url, ".,;:!?"))
+	}
+	return urls
+}
+
+// resolveLink sends a HEAD request for the URL, falling back to GET for servers which do not allow HEAD
+func resolveLink(client *http.Client, url string) error {
+	res, err := client.Head(url)
+	if err == nil && (res.StatusCode == http.StatusMethodNotAllowed || res.StatusCode == http.StatusNotImplemented) {
+		res.Body.Close()
+		res, err = client.Get(url)
+	}
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("Got status %s", res.Status)
+	}
+	return nil
+}
+
+func linkCachePath() string {
+	return filepath.Join(config.ProjectRoot, common.DotGauge, linkCacheFile)
+}
+
+func readLinkCache() map[string]time.Time {
+	resolved := make(map[string]time.Time)
+	content, err := ioutil.ReadFile(linkCachePath())
+	if err != nil {
+		return resolved
+	}
+	if err = json.Unmarshal(content, &resolved); err != nil {
+		logger.Debugf(true, "Ignoring invalid link cache %s. %s", linkCachePath(), err.Error())
+		return make(map[string]time.Time)
+	}
+	return resolved
+}
+
+func writeLinkCache(resolved map[string]time.Time) {
+	b, err := json.MarshalIndent(resolved, "", "\t")
+	if err != nil {
+		logger.Debugf(true, "Unable to save link cache. %s", err.Error())
+		return
+	}
+	if err = os.MkdirAll(filepath.Dir(linkCachePath()), common.NewDirectoryPermissions); err == nil {
+		err = ioutil.WriteFile(linkCachePath(), b, common.NewFilePermissions)
+	}
+	if err != nil {
+		logger.Debugf(true, "Unable to save link cache. %s", err.Error())
+	}
+}
@@ -0,0 +1,107 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/parser"
+)
+
+const (
+	// TextFormat prints the errors of validation on the console
+	TextFormat = "text"
+	// JSONFormat prints the errors of validation as a JSON list of findings
+	JSONFormat = "json"
+)
+
+// Format is the format gauge validate prints the errors in, one of text or json
+var Format = TextFormat
+
+const (
+	parseErrorCategory        = "parse"
+	validationErrorCategory   = "validation"
+	unimplementedStepCategory = "unimplemented-step"
+	errorSeverity             = "error"
+)
+
+// Finding is an error of parsing or validation, located in a spec or concept file
+type Finding struct {
+	File     string `json:"file"`
+	LineNo   int    `json:"line,omitempty"`
+	SpanEnd  int    `json:"spanEnd,omitempty"`
+	Message  string `json:"message"`
+	LineText string `json:"lineText,omitempty"`
+	Severity string `json:"severity"`
+	// Category is parse, validation or unimplemented-step
+	Category string `json:"category"`
+}
+
+// parseFindings gives the errors of parsing the concepts and the specs
+func parseFindings(conceptErrs []parser.ParseError, errMap *gauge.BuildErrors) []Finding {
+	findings := make([]Finding, 0)
+	add := func(e parser.ParseError) {
+		findings = append(findings, Finding{File: e.FileName, LineNo: e.LineNo, SpanEnd: e.SpanEnd, Message: e.Message, LineText: e.LineText, Severity: errorSeverity, Category: parseErrorCategory})
+	}
+	for _, e := range conceptErrs {
+		add(e)
+	}
+	for _, errs := range errMap.SpecErrs {
+		for _, err := range errs {
+			if e, ok := err.(parser.ParseError); ok {
+				add(e)
+			}
+		}
+	}
+	return findings
+}
+
+// validationFindings gives the errors of validating the steps and specs, telling apart the unimplemented steps
+func validationFindings(validationErrors validationErrors) []Finding {
+	findings := make([]Finding, 0)
+	for _, err := range FilterDuplicates(validationErrors) {
+		switch e := err.(type) {
+		case StepValidationError:
+			category := validationErrorCategory
+			if e.errorType != nil && *e.errorType == gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND {
+				category = unimplementedStepCategory
+			}
+			findings = append(findings, Finding{File: e.fileName, LineNo: e.step.LineNo, SpanEnd: e.step.LineSpanEnd, Message: e.message, LineText: e.step.GetLineText(), Severity: errorSeverity, Category: category})
+		case SpecValidationError:
+			findings = append(findings, Finding{File: e.fileName, Message: e.message, Severity: errorSeverity, Category: validationErrorCategory})
+		}
+	}
+	return findings
+}
+
+func sortFindings(findings []Finding) []Finding {
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].File != findings[j].File {
+			return findings[i].File < findings[j].File
+		}
+		return findings[i].LineNo < findings[j].LineNo
+	})
+	return findings
+}
+
+func printFindings(findings []Finding) {
+	if findings == nil {
+		findings = make([]Finding, 0)
+	}
+	b, err := json.MarshalIndent(findings, "", "    ")
+	if err != nil {
+		logger.Errorf(true, "Unable to marshal the validation errors as JSON. %s", err.Error())
+		return
+	}
+	// the logger can not be used, since its output is silenced for the JSON to be the only output
+	fmt.Println(string(b))
+}
@@ -0,0 +1,51 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/config"
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/logger"
+	"github.com/getgauge/gauge/tagRegistry"
+)
+
+func warnOnUnregisteredTags(specs []*gauge.Specification) {
+	r, err := tagRegistry.Load(config.ProjectRoot)
+	if err != nil {
+		logger.Warningf(true, err.Error())
+		return
+	}
+	for _, w := range tagWarnings(specs, r) {
+		logger.Warningf(true, w)
+	}
+}
+
+// tagWarnings returns a warning for every unknown or deprecated tag used by the specs
+func tagWarnings(specs []*gauge.Specification, r *tagRegistry.Registry) (warnings []string) {
+	if r == nil {
+		return
+	}
+	check := func(fileName string, heading *gauge.Heading, tags *gauge.Tags) {
+		if tags == nil || heading == nil {
+			return
+		}
+		for _, t := range tags.Values() {
+			if err := r.Check(t); err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s:%d %s", fileName, heading.LineNo, err.Error()))
+			}
+		}
+	}
+	for _, spec := range specs {
+		check(spec.FileName, spec.Heading, spec.Tags)
+		for _, scn := range spec.Scenarios {
+			check(spec.FileName, scn.Heading, scn.Tags)
+		}
+	}
+	return
+}
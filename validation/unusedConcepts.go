@@ -0,0 +1,44 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/getgauge/gauge/gauge"
+	"github.com/getgauge/gauge/graph"
+	"github.com/getgauge/gauge/logger"
+)
+
+// ReportUnusedConcepts makes validation report the concepts which no spec or concept being validated uses
+var ReportUnusedConcepts bool
+
+// FailOnUnusedConcepts makes validation fail when there are concepts which no spec or concept uses
+var FailOnUnusedConcepts bool
+
+func reportUnusedConcepts(specs []*gauge.Specification, dict *gauge.ConceptDictionary) []string {
+	unused := unusedConcepts(specs, dict)
+	for _, u := range unused {
+		if FailOnUnusedConcepts {
+			logger.Errorf(true, u)
+		} else {
+			logger.Warningf(true, u)
+		}
+	}
+	return unused
+}
+
+// unusedConcepts returns a message for every concept of the dictionary which is not used by any of the specs or by
+// another concept
+func unusedConcepts(specs []*gauge.Specification, dict *gauge.ConceptDictionary) (unused []string) {
+	for _, n := range graph.New(specs, dict).Nodes {
+		if n.Kind == graph.ConceptNode && n.UsedBy == 0 {
+			unused = append(unused, fmt.Sprintf("%s:%d Concept '%s' is not used by any spec or concept", n.File, n.LineNo, n.Label))
+		}
+	}
+	return
+}
@@ -9,13 +9,14 @@ Validation invokes language runner for every step in serial fashion with the Ste
 
 Step Level validation
 	1. Duplicate step implementation
-	2. Step implementation not found : Prints a step implementation stub for every unimplemented step
+	2. Step implementation not found : Prints a step implementation stub and the closest implemented steps for every unimplemented step
 
 If there is a validation error it skips that scenario and executes other scenarios in the spec.
 */
 package validation
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -24,6 +25,7 @@ import (
 
 	gm "github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/api"
+	"github.com/getgauge/gauge/env"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/logger"
 	"github.com/getgauge/gauge/parser"
@@ -49,14 +51,17 @@ type SpecValidator struct {
 	conceptsDictionary  *gauge.ConceptDictionary
 	validationErrors    []error
 	stepValidationCache map[string]error
+	scenario            *gauge.Scenario
+	stepValues          []gauge.StepValue
 }
 
 type StepValidationError struct {
-	step       *gauge.Step
-	message    string
-	fileName   string
-	errorType  *gm.StepValidateResponse_ErrorType
-	suggestion string
+	step         *gauge.Step
+	message      string
+	fileName     string
+	errorType    *gm.StepValidateResponse_ErrorType
+	suggestion   string
+	closestSteps []string
 }
 
 type SpecValidationError struct {
@@ -89,6 +94,11 @@ func (s StepValidationError) Suggestion() string {
 	return s.suggestion
 }
 
+// ClosestSteps gives the implemented steps and concepts closest to an unimplemented step
+func (s StepValidationError) ClosestSteps() []string {
+	return s.closestSteps
+}
+
 // Error prints a spec validation error with filename and error message.
 func (s SpecValidationError) Error() string {
 	return fmt.Sprintf("%s %s", s.fileName, s.message)
@@ -105,11 +115,14 @@ func NewStepValidationError(s *gauge.Step, m string, f string, e *gm.StepValidat
 }
 
 // Validate validates specs and if it has any errors, it exits.
-func Validate(args []string) {
+func Validate(ctx context.Context, args []string) {
 	if len(args) == 0 {
 		args = append(args, util.GetSpecDirs()...)
 	}
-	res := ValidateSpecs(args, false)
+	res := ValidateSpecs(ctx, args, false)
+	if Format == JSONFormat {
+		printFindings(res.Findings)
+	}
 	if len(res.Errs) > 0 {
 		os.Exit(1)
 	}
@@ -129,15 +142,15 @@ func Validate(args []string) {
 		logger.Errorf(false, "unable to kill runner: %s", err.Error())
 	}
 
-	if res.ErrMap.HasErrors() {
+	if res.ErrMap.HasErrors() || (FailOnUnusedConcepts && len(res.UnusedConcepts) > 0) {
 		os.Exit(1)
 	}
 	logger.Infof(true, "No errors found.")
 }
 
 //TODO : duplicate in execute.go. Need to fix runner init.
-func startAPI(debug bool) runner.Runner {
-	sc := api.StartAPI(debug)
+func startAPI(ctx context.Context, debug bool) runner.Runner {
+	sc := api.StartAPI(ctx, debug)
 	select {
 	case runner := <-sc.RunnerChan:
 		return runner
@@ -153,6 +166,10 @@ type ValidationResult struct {
 	Runner         runner.Runner
 	Errs           []error
 	ParseOk        bool
+	// UnusedConcepts has a message for every concept not used by the validated specs, when they are reported
+	UnusedConcepts []string
+	// Findings has the errors of parsing and validation, for printing them as JSON
+	Findings []Finding
 }
 
 // NewValidationResult creates a new Validation result
@@ -161,7 +178,8 @@ func NewValidationResult(s *gauge.SpecCollection, errMap *gauge.BuildErrors, r r
 }
 
 // ValidateSpecs parses the specs, creates a new validator and call the runner to get the validation result.
-func ValidateSpecs(specsToValidate []string, debug bool) *ValidationResult {
+// The validation stops, with the error of the context, when the context is done.
+func ValidateSpecs(ctx context.Context, specsToValidate []string, debug bool) *ValidationResult {
 	logger.Debug(true, "Parsing started.")
 	conceptDict, res, err := parser.ParseConcepts()
 	if err != nil {
@@ -170,23 +188,45 @@ func ValidateSpecs(specsToValidate []string, debug bool) *ValidationResult {
 	errMap := gauge.NewBuildErrors()
 	specs, specsFailed := parser.ParseSpecs(specsToValidate, conceptDict, errMap)
 	logger.Debug(true, "Parsing completed.")
-	r := startAPI(debug)
+	findings := parseFindings(res.ParseErrors, errMap)
+	if ctx.Err() != nil {
+		return NewValidationResult(nil, nil, nil, true, ctx.Err())
+	}
+	r := startAPI(ctx, debug)
 	validationErrors := NewValidator(specs, r, conceptDict).Validate()
+	if ctx.Err() != nil {
+		if err := r.Kill(); err != nil {
+			logger.Debugf(true, "unable to kill runner: %s", err.Error())
+		}
+		return NewValidationResult(nil, nil, nil, true, ctx.Err())
+	}
+	if CheckLinks {
+		validationErrors = validationErrors.merge(checkLinks(specs))
+	}
 	errMap = getErrMap(errMap, validationErrors)
+	warnOnUnregisteredTags(specs)
+	var unused []string
+	if ReportUnusedConcepts {
+		unused = reportUnusedConcepts(specs, conceptDict)
+	}
+	warnOnDeprecatedScenarios(specs)
 	specs = parser.GetSpecsForDataTableRows(specs, errMap)
 	printValidationFailures(validationErrors)
 	showSuggestion(validationErrors)
+	findings = sortFindings(append(findings, validationFindings(validationErrors)...))
 	if !res.Ok {
 		err := r.Kill()
 		if err != nil {
 			logger.Errorf(true, "unable to kill runner: %s", err.Error())
 		}
-		return NewValidationResult(nil, nil, nil, false, errors.New("Parsing failed"))
-	}
-	if specsFailed {
-		return NewValidationResult(gauge.NewSpecCollection(specs, false), errMap, r, false)
+		result := NewValidationResult(nil, nil, nil, false, errors.New("Parsing failed"))
+		result.Findings = findings
+		return result
 	}
-	return NewValidationResult(gauge.NewSpecCollection(specs, false), errMap, r, true)
+	result := NewValidationResult(gauge.NewSpecCollection(specs, false), errMap, r, !specsFailed)
+	result.UnusedConcepts = unused
+	result.Findings = findings
+	return result
 }
 
 func getErrMap(errMap *gauge.BuildErrors, validationErrors validationErrors) *gauge.BuildErrors {
@@ -201,7 +241,7 @@ func getErrMap(errMap *gauge.BuildErrors, validationErrors validationErrors) *ga
 		}
 		skippedScnInSpec := 0
 		for _, scenario := range spec.Scenarios {
-			fillScenarioErrors(scenario, errMap, scenario.Steps)
+			fillScenarioErrors(scenario, errMap, scenario.AllSteps())
 			if _, ok := errMap.ScenarioErrs[scenario]; ok {
 				skippedScnInSpec++
 			}
@@ -244,6 +284,9 @@ func fillSpecErrors(spec *gauge.Specification, errMap *gauge.BuildErrors, steps
 func printValidationFailures(validationErrors validationErrors) {
 	for _, e := range FilterDuplicates(validationErrors) {
 		logger.Errorf(true, "[ValidationError] %s", e.Error())
+		if vErr, ok := e.(StepValidationError); ok && len(vErr.closestSteps) > 0 {
+			logger.Infof(true, "\t%s", closestStepsMessage(vErr.closestSteps))
+		}
 	}
 }
 
@@ -271,6 +314,19 @@ func FilterDuplicates(validationErrors validationErrors) []error {
 
 type validationErrors map[*gauge.Specification][]error
 
+func (v validationErrors) merge(other validationErrors) validationErrors {
+	if len(other) == 0 {
+		return v
+	}
+	if v == nil {
+		v = make(validationErrors)
+	}
+	for spec, errs := range other {
+		v[spec] = append(v[spec], errs...)
+	}
+	return v
+}
+
 func NewValidator(s []*gauge.Specification, r runner.Runner, c *gauge.ConceptDictionary) *validator {
 	return &validator{specsToExecute: s, runner: r, conceptsDictionary: c}
 }
@@ -302,6 +358,8 @@ func (v *SpecValidator) validate() []error {
 // Validates a step. If validation result from runner is not valid then it creates a new validation error.
 // If the error type is StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND then gives suggestion with step implementation stub.
 func (v *SpecValidator) Step(s *gauge.Step) {
+	v.validateEnvParams(s)
+	v.validateDynamicParams(s)
 	if s.IsConcept {
 		for _, c := range s.ConceptSteps {
 			v.Step(c)
@@ -312,28 +370,99 @@ func (v *SpecValidator) Step(s *gauge.Step) {
 	if !ok {
 		err := v.validateStep(s)
 		if err != nil {
-			v.validationErrors = append(v.validationErrors, err)
+			err = v.withClosestSteps(err)
+			v.addStepError(err)
 		}
 		v.stepValidationCache[s.Value] = err
 		return
 	}
 	if val != nil {
 		valErr := val.(StepValidationError)
-		if s.Parent == nil {
-			v.validationErrors = append(v.validationErrors,
-				NewStepValidationError(s, valErr.message, v.specification.FileName, valErr.errorType, valErr.suggestion))
-		} else {
-			cpt := v.conceptsDictionary.Search(s.Parent.Value)
-			v.validationErrors = append(v.validationErrors,
-				NewStepValidationError(s, valErr.message, cpt.FileName, valErr.errorType, valErr.suggestion))
+		fileName := v.specification.FileName
+		if s.Parent != nil {
+			fileName = v.conceptFileName(s)
 		}
+		err := NewStepValidationError(s, valErr.message, fileName, valErr.errorType, valErr.suggestion)
+		err.closestSteps = valErr.closestSteps
+		v.addStepError(err)
+	}
+}
+
+// addStepError adds a step validation error, except for unimplemented steps of work in progress scenarios
+func (v *SpecValidator) addStepError(err error) {
+	if e, ok := err.(StepValidationError); ok && v.inWIPScenario() && e.errorType != nil &&
+		*e.errorType == gm.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND {
+		return
+	}
+	v.validationErrors = append(v.validationErrors, err)
+}
+
+func (v *SpecValidator) inWIPScenario() bool {
+	if v.scenario == nil {
+		return v.specification.WIP
 	}
+	return v.specification.IsScenarioWIP(v.scenario)
 }
 
 var invalidResponse gm.StepValidateResponse_ErrorType = -1
+var undefinedEnvParam gm.StepValidateResponse_ErrorType = -2
+var undefinedDynamicParam gm.StepValidateResponse_ErrorType = -3
+
+// validateEnvParams adds an error for every <env:NAME> parameter of the step whose environment variable is not defined
+func (v *SpecValidator) validateEnvParams(s *gauge.Step) {
+	for _, name := range parser.UndefinedEnvParams(s) {
+		msg := fmt.Sprintf("Environment variable '%s' of <env:%s> is not defined", name, name)
+		v.addStepError(NewStepValidationError(s, msg, v.specification.FileName, &undefinedEnvParam, ""))
+	}
+}
+
+// validateDynamicParams adds an error for every dynamic parameter of the step which is neither a column of the data
+// tables of the spec and scenario nor, for a step of a concept, a parameter of the concept
+func (v *SpecValidator) validateDynamicParams(s *gauge.Step) {
+	for _, name := range dynamicParams(s) {
+		if s.Parent != nil {
+			if !s.Parent.Lookup.ContainsArg(name) {
+				msg := fmt.Sprintf("Dynamic parameter <%s> is not a parameter of concept '%s'", name, s.Parent.LineText)
+				v.addStepError(NewStepValidationError(s, msg, v.conceptFileName(s), &undefinedDynamicParam, ""))
+			}
+			continue
+		}
+		if !v.dataTableLookup().ContainsArg(name) {
+			msg := fmt.Sprintf("Dynamic parameter <%s> is not a column of a data table", name)
+			v.addStepError(NewStepValidationError(s, msg, v.specification.FileName, &undefinedDynamicParam, ""))
+		}
+	}
+}
+
+func (v *SpecValidator) dataTableLookup() *gauge.ArgLookup {
+	tables := []*gauge.Table{v.specification.DataTable.Table}
+	if v.scenario != nil {
+		tables = append(tables, v.scenario.DataTable.Table)
+	}
+	return new(gauge.ArgLookup).FromDataTables(tables...)
+}
+
+// dynamicParams gives the names of the dynamic parameters of the step, including the dynamic cells of its table
+func dynamicParams(s *gauge.Step) (names []string) {
+	for _, arg := range s.Args {
+		switch arg.ArgType {
+		case gauge.Dynamic:
+			names = append(names, arg.Value)
+		case gauge.TableArg:
+			for _, column := range arg.Table.Columns {
+				for _, cell := range column {
+					if cell.CellType == gauge.Dynamic {
+						names = append(names, cell.Value)
+					}
+				}
+			}
+		}
+	}
+	return
+}
 
 func (v *SpecValidator) validateStep(s *gauge.Step) error {
-	stepValue, err := parser.ExtractStepValueAndParams(s.LineText, s.HasInlineTable)
+	stepValue, err := parser.ExtractStepValueAndParams(s.GetLineText(), false)
 	if err != nil {
 		return nil
 	}
@@ -355,8 +484,7 @@ func (v *SpecValidator) validateStep(s *gauge.Step) error {
 				vErr := NewStepValidationError(s, msg, v.specification.FileName, &res.ErrorType, suggestion)
 				return vErr
 			}
-			cpt := v.conceptsDictionary.Search(s.Parent.Value)
-			vErr := NewStepValidationError(s, msg, cpt.FileName, &res.ErrorType, suggestion)
+			vErr := NewStepValidationError(s, msg, v.conceptFileName(s), &res.ErrorType, suggestion)
 			return vErr
 
 		}
@@ -365,12 +493,22 @@ func (v *SpecValidator) validateStep(s *gauge.Step) error {
 	return NewStepValidationError(s, "Invalid response from runner for Validation request", v.specification.FileName, &invalidResponse, "")
 }
 
+// conceptFileName gives the file of the concept the step is used in. The concept of the parent is looked up by its
+// namespace, as concepts of different namespaces may share a step value.
+func (v *SpecValidator) conceptFileName(s *gauge.Step) string {
+	if cpt := v.conceptsDictionary.Search(gauge.ConceptKey(s.Parent)); cpt != nil {
+		return cpt.FileName
+	}
+	return s.FileName
+}
+
 func getMessage(message string) string {
 	lower := strings.ToLower(strings.Replace(message, "_", " ", -1))
 	return strings.ToUpper(lower[:1]) + lower[1:]
 }
 
 func (v *SpecValidator) TearDown(step *gauge.TearDown) {
+	v.scenario = nil
 }
 
 func (v *SpecValidator) Heading(heading *gauge.Heading) {
@@ -384,7 +522,7 @@ func (v *SpecValidator) Table(dataTable *gauge.Table) {
 }
 
 func (v *SpecValidator) Scenario(scenario *gauge.Scenario) {
-
+	v.scenario = scenario
 }
 
 func (v *SpecValidator) Comment(comment *gauge.Comment) {
@@ -397,10 +535,46 @@ func (v *SpecValidator) DataTable(dataTable *gauge.DataTable) {
 // Validates data table for the range, if any error found append to the validation errors
 func (v *SpecValidator) Specification(specification *gauge.Specification) {
 	v.validationErrors = make([]error, 0)
-	err := validateDataTableRange(specification.DataTable.Table.GetRowCount())
+	v.scenario = nil
+	err := validateDataTableRows(specification.DataTable.Table)
 	if err != nil {
 		v.validationErrors = append(v.validationErrors, NewSpecValidationError(err.Error(), specification.FileName))
 	}
+	for _, err := range validateAnnotations(specification, env.RequiredAnnotations()) {
+		v.validationErrors = append(v.validationErrors, err)
+	}
+}
+
+// validateAnnotations checks that every scenario of the spec has the required annotations
+func validateAnnotations(spec *gauge.Specification, required []string) (errs []error) {
+	for _, scn := range spec.Scenarios {
+		for _, key := range required {
+			if !spec.HasScenarioAnnotation(scn, key) {
+				msg := fmt.Sprintf("Scenario '%s' is missing the required annotation @%s", scn.Heading.Value, key)
+				errs = append(errs, NewSpecValidationError(msg, fmt.Sprintf("%s:%d", spec.FileName, scn.Heading.LineNo)))
+			}
+		}
+	}
+	return
+}
+
+// validateDataTableRows validates the --table-rows value against the data table of the spec. A predicate on columns
+// should only refer to columns of the table, while row numbers should be in its range.
+func validateDataTableRows(table *gauge.Table) error {
+	if !gauge.IsRowPredicate(TableRows) {
+		return validateDataTableRange(table.GetRowCount())
+	}
+	predicate, err := gauge.ParseRowPredicate(TableRows)
+	if err != nil {
+		return fmt.Errorf("Table rows expression '%s' is invalid => %s", TableRows, err.Error())
+	}
+	if !table.IsInitialized() {
+		return nil
+	}
+	if column := predicate.UnknownColumn(table); column != "" {
+		return fmt.Errorf("Table rows expression validation failed => Column '%s' is not in the data table, which has the columns %s", column, strings.Join(table.Headers, ", "))
+	}
+	return nil
 }
 
 func validateDataTableRange(rowCount int) error {
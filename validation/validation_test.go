@@ -8,12 +8,18 @@ package validation
 
 import (
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/getgauge/gauge-proto/go/gauge_messages"
 	"github.com/getgauge/gauge/gauge"
 	"github.com/getgauge/gauge/parser"
 	"github.com/getgauge/gauge/runner"
+	"github.com/getgauge/gauge/tagRegistry"
 
 	"errors"
 
@@ -272,6 +278,21 @@ func (s *MySuite) TestToValidateDataTableRowsRangeFromInputFlag(c *C) {
 	}
 }
 
+func (s *MySuite) TestToValidateDataTableRowsPredicateFromInputFlag(c *C) {
+	defer func() { TableRows = "" }()
+	table := gauge.NewTable([]string{"country", "tier"}, [][]gauge.TableCell{{{Value: "IN"}}, {{Value: "gold"}}}, 1)
+
+	TableRows = "country=IN & tier!=gold"
+	c.Assert(validateDataTableRows(table), IsNil)
+	c.Assert(validateDataTableRows(&gauge.Table{}), IsNil)
+
+	TableRows = "country=IN & region=APAC"
+	c.Assert(validateDataTableRows(table), DeepEquals, errors.New("Table rows expression validation failed => Column 'region' is not in the data table, which has the columns country, tier"))
+
+	TableRows = "=IN"
+	c.Assert(validateDataTableRows(table), DeepEquals, errors.New("Table rows expression '=IN' is invalid => Condition '=IN' should name a column"))
+}
+
 type mockRunner struct {
 	ExecuteMessageFunc func(m *gauge_messages.Message) (*gauge_messages.Message, error)
 }
@@ -306,3 +327,202 @@ func (r *mockRunner) Info() *runner.RunnerInfo {
 func (r *mockRunner) Pid() int {
 	return -1
 }
+
+func (s *MySuite) TestTagWarningsForUnknownAndDeprecatedTags(c *C) {
+	r, err := tagRegistry.Parse([]byte("tags:\n  - name: smoke\n  - name: slow\n    deprecated: true\n"))
+	c.Assert(err, IsNil)
+	specs := []*gauge.Specification{{
+		FileName: "foo.spec",
+		Heading:  &gauge.Heading{LineNo: 1},
+		Tags:     &gauge.Tags{RawValues: [][]string{{"smoke", "unknown"}}},
+		Scenarios: []*gauge.Scenario{
+			{Heading: &gauge.Heading{LineNo: 4}, Tags: &gauge.Tags{RawValues: [][]string{{"slow"}}}},
+		},
+	}}
+
+	warnings := tagWarnings(specs, r)
+
+	c.Assert(warnings, DeepEquals, []string{
+		"foo.spec:1 tag 'unknown' is not declared in tags.yaml",
+		"foo.spec:4 tag 'slow' is deprecated",
+	})
+	c.Assert(tagWarnings(specs, nil), IsNil)
+}
+
+func (s *MySuite) TestDeprecationWarnings(c *C) {
+	deprecated := &gauge.Scenario{Heading: &gauge.Heading{Value: "old checkout", LineNo: 4}, Deprecation: &gauge.Deprecation{Message: "use CHK-200"}}
+	current := &gauge.Scenario{Heading: &gauge.Heading{Value: "checkout", LineNo: 8}}
+	legacy := &gauge.Scenario{Heading: &gauge.Heading{Value: "legacy", LineNo: 3}}
+	specs := []*gauge.Specification{
+		{FileName: "foo.spec", Scenarios: []*gauge.Scenario{deprecated, current}},
+		{FileName: "bar.spec", Scenarios: []*gauge.Scenario{legacy}, Deprecation: &gauge.Deprecation{}},
+	}
+
+	c.Assert(deprecationWarnings(specs), DeepEquals, []string{
+		"foo.spec:4 Scenario 'old checkout' is deprecated. use CHK-200",
+		"bar.spec:3 Scenario 'legacy' is deprecated",
+	})
+}
+
+func (s *MySuite) TestValidationSkipsUnimplementedStepsOfWIPScenarios(c *C) {
+	TableRows = ""
+	runner := &mockRunner{
+		ExecuteMessageFunc: func(m *gauge_messages.Message) (*gauge_messages.Message, error) {
+			res := &gauge_messages.StepValidateResponse{IsValid: false, ErrorType: gauge_messages.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND}
+			return &gauge_messages.Message{MessageType: gauge_messages.Message_StepValidateResponse, StepValidateResponse: res}, nil
+		},
+	}
+	wipStep := &gauge.Step{Value: "draft step", LineText: "draft step", LineNo: 3}
+	step := &gauge.Step{Value: "my step", LineText: "my step", LineNo: 6}
+	wip := &gauge.Scenario{Heading: &gauge.Heading{Value: "draft"}, Steps: []*gauge.Step{wipStep}, Items: []gauge.Item{wipStep}, WIP: true}
+	done := &gauge.Scenario{Heading: &gauge.Heading{Value: "done"}, Steps: []*gauge.Step{step}, Items: []gauge.Item{step}}
+	spec := &gauge.Specification{FileName: "foo.spec", Heading: &gauge.Heading{Value: "spec"}, Scenarios: []*gauge.Scenario{wip, done}, Items: []gauge.Item{wip, done}}
+
+	errs := NewValidator([]*gauge.Specification{spec}, runner, gauge.NewConceptDictionary()).Validate()
+
+	c.Assert(len(errs[spec]), Equals, 1)
+	c.Assert(errs[spec][0].(StepValidationError).Step(), Equals, step)
+}
+
+func (s *MySuite) TestValidateRequiredAnnotations(c *C) {
+	owned := &gauge.Scenario{Heading: &gauge.Heading{Value: "owned", LineNo: 3}, Annotations: gauge.Annotations{"owner": {"payments"}}}
+	unowned := &gauge.Scenario{Heading: &gauge.Heading{Value: "unowned", LineNo: 7}}
+	spec := &gauge.Specification{FileName: "foo.spec", Scenarios: []*gauge.Scenario{owned, unowned}, Annotations: gauge.Annotations{"jira": {"CHK-1"}}}
+
+	errs := validateAnnotations(spec, []string{"owner", "jira"})
+
+	c.Assert(len(errs), Equals, 1)
+	c.Assert(errs[0].Error(), Equals, "foo.spec:7 Scenario 'unowned' is missing the required annotation @owner")
+	c.Assert(validateAnnotations(spec, nil), IsNil)
+}
+
+func (s *MySuite) TestLinkErrors(c *C) {
+	var checked []string
+	checker := &linkChecker{
+		resolve: func(url string) error {
+			checked = append(checked, url)
+			if strings.HasSuffix(url, "/gone") {
+				return errors.New("Got status 404 Not Found")
+			}
+			return nil
+		},
+		allowlist: []string{"https://intranet/"},
+		resolved:  map[string]time.Time{"https://cached/ok": time.Now()},
+		results:   make(map[string]error),
+	}
+	scn := &gauge.Scenario{Comments: []*gauge.Comment{{Value: "See https://example.com/gone, and https://intranet/dashboard.", LineNo: 5}}}
+	spec := &gauge.Specification{FileName: "foo.spec", Scenarios: []*gauge.Scenario{scn}, Comments: []*gauge.Comment{
+		{Value: "Requirements at https://example.com/gone", LineNo: 2},
+		{Value: "Dashboard (https://cached/ok)", LineNo: 3},
+	}}
+
+	errs := checker.linkErrors([]*gauge.Specification{spec})
+
+	c.Assert(checked, DeepEquals, []string{"https://example.com/gone"})
+	c.Assert(len(errs[spec]), Equals, 2)
+	c.Assert(errs[spec][0].Error(), Equals, "foo.spec:2 Link https://example.com/gone is broken. Got status 404 Not Found")
+	c.Assert(errs[spec][1].Error(), Equals, "foo.spec:5 Link https://example.com/gone is broken. Got status 404 Not Found")
+}
+
+func (s *MySuite) TestResolveLink(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c.Assert(resolveLink(server.Client(), server.URL+"/ok"), IsNil)
+	c.Assert(resolveLink(server.Client(), server.URL+"/gone"), ErrorMatches, "Got status 404 Not Found")
+}
+
+func (s *MySuite) TestValidateStepWithUndefinedEnvParam(c *C) {
+	os.Unsetenv("GAUGE_TEST_UNDEFINED_VAR")
+	myStep := &gauge.Step{Value: "log in as {}", LineText: "log in as <env:GAUGE_TEST_UNDEFINED_VAR>", LineNo: 3,
+		Args: []*gauge.StepArg{{Name: "env:GAUGE_TEST_UNDEFINED_VAR", ArgType: gauge.SpecialString}}}
+	specVal := &SpecValidator{specification: &gauge.Specification{FileName: "foo.spec"}}
+
+	specVal.validateEnvParams(myStep)
+
+	c.Assert(specVal.validationErrors, HasLen, 1)
+	c.Assert(specVal.validationErrors[0].Error(), Equals, "foo.spec:3 Environment variable 'GAUGE_TEST_UNDEFINED_VAR' of <env:GAUGE_TEST_UNDEFINED_VAR> is not defined => 'log in as <env:GAUGE_TEST_UNDEFINED_VAR>'")
+}
+
+func (s *MySuite) TestValidateDynamicParamsOfNestedConcepts(c *C) {
+	dict := gauge.NewConceptDictionary()
+	concepts, res := new(parser.ConceptParser).Parse("# log in as <user>\n* open the login page\n* enter <user>\n\n# place an order for <buyer>\n* log in as <buyer>\n* pay\n", "concepts.cpt")
+	c.Assert(res.ParseErrors, HasLen, 0)
+	_, err := parser.AddConcept(concepts, "concepts.cpt", dict)
+	c.Assert(err, IsNil)
+	spec, parseRes, err := new(parser.SpecParser).Parse("# Orders\n\n|name|\n|----|\n|john|\n\n## Order\n* place an order for <name>\n", dict, "a.spec")
+	c.Assert(err, IsNil)
+	c.Assert(parseRes.Ok, Equals, true)
+	specVal := &SpecValidator{specification: spec, conceptsDictionary: dict, scenario: spec.Scenarios[0]}
+	order := spec.Scenarios[0].Steps[0]
+	logIn := order.ConceptSteps[0]
+
+	specVal.validateDynamicParams(order)
+	specVal.validateDynamicParams(logIn)
+	specVal.validateDynamicParams(logIn.ConceptSteps[1])
+	c.Assert(specVal.validationErrors, HasLen, 0)
+
+	logIn.ConceptSteps[1].Args[0].Value = "password"
+	specVal.validateDynamicParams(logIn.ConceptSteps[1])
+	c.Assert(specVal.validationErrors, HasLen, 1)
+	c.Assert(specVal.validationErrors[0].Error(), Equals, "concepts.cpt:3 Dynamic parameter <password> is not a parameter of concept 'log in as <user>' => 'enter <user>'")
+}
+
+func (s *MySuite) TestValidateDynamicParamsWhichAreNotColumnsOfADataTable(c *C) {
+	myStep := &gauge.Step{Value: "log in as {}", LineText: "log in as <user>", LineNo: 3,
+		Args: []*gauge.StepArg{{Name: "user", Value: "user", ArgType: gauge.Dynamic}}}
+	specVal := &SpecValidator{specification: &gauge.Specification{FileName: "foo.spec", DataTable: gauge.DataTable{}}}
+
+	specVal.validateDynamicParams(myStep)
+
+	c.Assert(specVal.validationErrors, HasLen, 1)
+	c.Assert(specVal.validationErrors[0].Error(), Equals, "foo.spec:3 Dynamic parameter <user> is not a column of a data table => 'log in as <user>'")
+}
+
+func (s *MySuite) TestUnusedConceptsAreTheOnesNoSpecOrConceptUses(c *C) {
+	dict := gauge.NewConceptDictionary()
+	concepts, res := new(parser.ConceptParser).Parse("# log in\n* open the login page\n\n# place an order\n* log in\n* pay\n\n# clean up\n* delete everything\n", "concepts.cpt")
+	c.Assert(res.ParseErrors, HasLen, 0)
+	_, err := parser.AddConcept(concepts, "concepts.cpt", dict)
+	c.Assert(err, IsNil)
+	spec, parseRes, err := new(parser.SpecParser).Parse("# Orders\n## Order\n* place an order\n", dict, "a.spec")
+	c.Assert(err, IsNil)
+	c.Assert(parseRes.Ok, Equals, true)
+
+	c.Assert(unusedConcepts([]*gauge.Specification{spec}, dict), DeepEquals, []string{"concepts.cpt:8 Concept 'clean up' is not used by any spec or concept"})
+	c.Assert(unusedConcepts(nil, dict), HasLen, 2)
+}
+
+func (s *MySuite) TestFindingsOfParseAndValidationErrors(c *C) {
+	notFound := gauge_messages.StepValidateResponse_STEP_IMPLEMENTATION_NOT_FOUND
+	duplicate := gauge_messages.StepValidateResponse_DUPLICATE_STEP_IMPLEMENTATION
+	spec := &gauge.Specification{FileName: "a.spec"}
+	unimplemented := &gauge.Step{Value: "open the app", LineText: "open the app", LineNo: 4, LineSpanEnd: 4}
+	duplicated := &gauge.Step{Value: "pay", LineText: "pay", LineNo: 2, LineSpanEnd: 2}
+	errMap := gauge.NewBuildErrors()
+	errMap.SpecErrs[spec] = []error{parser.ParseError{FileName: "a.spec", LineNo: 7, SpanEnd: 7, Message: "Scenario heading should have at least one character", LineText: "##"}}
+	conceptErrs := []parser.ParseError{{FileName: "c.cpt", LineNo: 1, SpanEnd: 1, Message: "Duplicate concept definition found", LineText: "log in"}}
+	validationErrs := validationErrors{spec: []error{
+		NewStepValidationError(unimplemented, "Step implementation not found", "a.spec", &notFound, ""),
+		NewStepValidationError(duplicated, "Duplicate step implementation", "a.spec", &duplicate, ""),
+		NewSpecValidationError("Spec has no scenarios to execute", "b.spec"),
+	}}
+
+	findings := sortFindings(append(parseFindings(conceptErrs, errMap), validationFindings(validationErrs)...))
+
+	c.Assert(findings, DeepEquals, []Finding{
+		{File: "a.spec", LineNo: 2, SpanEnd: 2, Message: "Duplicate step implementation", LineText: "pay", Severity: "error", Category: "validation"},
+		{File: "a.spec", LineNo: 4, SpanEnd: 4, Message: "Step implementation not found", LineText: "open the app", Severity: "error", Category: "unimplemented-step"},
+		{File: "a.spec", LineNo: 7, SpanEnd: 7, Message: "Scenario heading should have at least one character", LineText: "##", Severity: "error", Category: "parse"},
+		{File: "b.spec", Message: "Spec has no scenarios to execute", Severity: "error", Category: "validation"},
+		{File: "c.cpt", LineNo: 1, SpanEnd: 1, Message: "Duplicate concept definition found", LineText: "log in", Severity: "error", Category: "parse"},
+	})
+}
@@ -0,0 +1,87 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Stable channel gets releases only
+	Stable = "stable"
+	// Beta channel gets betas and release candidates as well, like 1.2.3-beta.1
+	Beta = "beta"
+	// Nightly channel gets every build, like 1.2.3.nightly-2020-01-02
+	Nightly = "nightly"
+)
+
+// Channels are the update channels, each one gets the releases of the ones before it
+var Channels = []string{Stable, Beta, Nightly}
+
+var releasePattern = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)(?:[.-](.+))?$`)
+
+// ReleaseChannel gives the channel a release belongs to by its version
+func ReleaseChannel(versionText string) string {
+	_, suffix, err := SplitPreRelease(versionText)
+	switch {
+	case err != nil || suffix == "":
+		return Stable
+	case strings.Contains(suffix, Nightly):
+		return Nightly
+	}
+	return Beta
+}
+
+// InChannel tells if a release is offered on the channel
+func InChannel(versionText, channel string) bool {
+	return channelRank(ReleaseChannel(versionText)) <= channelRank(channel)
+}
+
+func channelRank(channel string) int {
+	for i, c := range Channels {
+		if c == channel {
+			return i
+		}
+	}
+	return 0
+}
+
+// SplitPreRelease splits a version into its release version and its pre-release suffix, 1.2.3-beta.1 gives 1.2.3
+// and beta.1
+func SplitPreRelease(versionText string) (*Version, string, error) {
+	m := releasePattern.FindStringSubmatch(strings.TrimSpace(versionText))
+	if m == nil {
+		return nil, "", fmt.Errorf("incorrect version format %s. version should be in the form 1.5.7 or 1.5.7-beta.1", versionText)
+	}
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	patch, _ := strconv.Atoi(m[3])
+	return &Version{major, minor, patch}, m[4], nil
+}
+
+// IsNewerRelease tells if a release is newer than another. A release is newer than its pre-releases, which are
+// ordered by their suffix. Versions which can not be parsed are older than any other.
+func IsNewerRelease(versionText, otherText string) bool {
+	v, suffix, err := SplitPreRelease(versionText)
+	if err != nil {
+		return false
+	}
+	other, otherSuffix, err := SplitPreRelease(otherText)
+	if err != nil {
+		return true
+	}
+	if !v.IsEqualTo(other) {
+		return v.IsGreaterThan(other)
+	}
+	if suffix == "" || otherSuffix == "" {
+		return suffix == "" && otherSuffix != ""
+	}
+	return suffix > otherSuffix
+}
@@ -0,0 +1,48 @@
+/*----------------------------------------------------------------
+ *  Copyright (c) ThoughtWorks, Inc.
+ *  Licensed under the Apache License, Version 2.0
+ *  See LICENSE in the project root for license information.
+ *----------------------------------------------------------------*/
+
+package version
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+func (s *MySuite) TestReleaseChannel(c *C) {
+	c.Assert(ReleaseChannel("1.2.3"), Equals, Stable)
+	c.Assert(ReleaseChannel("v1.2.3-beta.1"), Equals, Beta)
+	c.Assert(ReleaseChannel("1.2.3-rc.2"), Equals, Beta)
+	c.Assert(ReleaseChannel("1.2.3.nightly-2020-01-02"), Equals, Nightly)
+}
+
+func (s *MySuite) TestInChannel(c *C) {
+	c.Assert(InChannel("1.2.3", Stable), Equals, true)
+	c.Assert(InChannel("1.2.3-beta.1", Stable), Equals, false)
+	c.Assert(InChannel("1.2.3-beta.1", Beta), Equals, true)
+	c.Assert(InChannel("1.2.3.nightly-2020-01-02", Beta), Equals, false)
+	c.Assert(InChannel("1.2.3-beta.1", Nightly), Equals, true)
+	c.Assert(InChannel("1.2.3.nightly-2020-01-02", Nightly), Equals, true)
+}
+
+func (s *MySuite) TestSplitPreRelease(c *C) {
+	v, suffix, err := SplitPreRelease("1.2.3-beta.1")
+
+	c.Assert(err, Equals, nil)
+	c.Assert(v.String(), Equals, "1.2.3")
+	c.Assert(suffix, Equals, "beta.1")
+
+	_, _, err = SplitPreRelease("1.2")
+	c.Assert(err, NotNil)
+}
+
+func (s *MySuite) TestIsNewerRelease(c *C) {
+	c.Assert(IsNewerRelease("1.2.4-beta.1", "1.2.3"), Equals, true)
+	c.Assert(IsNewerRelease("1.2.3", "1.2.3-beta.1"), Equals, true)
+	c.Assert(IsNewerRelease("1.2.3-beta.1", "1.2.3"), Equals, false)
+	c.Assert(IsNewerRelease("1.2.3-beta.2", "1.2.3-beta.1"), Equals, true)
+	c.Assert(IsNewerRelease("1.2.3.nightly-2020-01-03", "1.2.3.nightly-2020-01-02"), Equals, true)
+	c.Assert(IsNewerRelease("1.2.3", "1.2.3"), Equals, false)
+	c.Assert(IsNewerRelease("1.2.3", "invalid"), Equals, true)
+}